@@ -704,15 +704,70 @@ func (x *_Metadata_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Metadata_2_list)(nil)
+
+type _Metadata_2_list struct {
+	list *[]*SnapshotSection
+}
+
+func (x *_Metadata_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Metadata_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotSection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Metadata_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotSection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Metadata_2_list) AppendMutable() protoreflect.Value {
+	v := new(SnapshotSection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Metadata_2_list) NewElement() protoreflect.Value {
+	v := new(SnapshotSection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Metadata_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Metadata              protoreflect.MessageDescriptor
 	fd_Metadata_chunk_hashes protoreflect.FieldDescriptor
+	fd_Metadata_sections     protoreflect.FieldDescriptor
+	fd_Metadata_compressor   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
 	md_Metadata = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("Metadata")
 	fd_Metadata_chunk_hashes = md_Metadata.Fields().ByName("chunk_hashes")
+	fd_Metadata_sections = md_Metadata.Fields().ByName("sections")
+	fd_Metadata_compressor = md_Metadata.Fields().ByName("compressor")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -786,6 +841,18 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if len(x.Sections) != 0 {
+		value := protoreflect.ValueOfList(&_Metadata_2_list{list: &x.Sections})
+		if !f(fd_Metadata_sections, value) {
+			return
+		}
+	}
+	if x.Compressor != "" {
+		value := protoreflect.ValueOfString(x.Compressor)
+		if !f(fd_Metadata_compressor, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -803,6 +870,10 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.base.snapshots.v1beta1.Metadata.chunk_hashes":
 		return len(x.ChunkHashes) != 0
+	case "cosmos.base.snapshots.v1beta1.Metadata.sections":
+		return len(x.Sections) != 0
+	case "cosmos.base.snapshots.v1beta1.Metadata.compressor":
+		return x.Compressor != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -821,6 +892,10 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.base.snapshots.v1beta1.Metadata.chunk_hashes":
 		x.ChunkHashes = nil
+	case "cosmos.base.snapshots.v1beta1.Metadata.sections":
+		x.Sections = nil
+	case "cosmos.base.snapshots.v1beta1.Metadata.compressor":
+		x.Compressor = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -843,6 +918,15 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.snapshots.v1beta1.Metadata.sections":
+		if len(x.Sections) == 0 {
+			return protoreflect.ValueOfList(&_Metadata_2_list{})
+		}
+		listValue := &_Metadata_2_list{list: &x.Sections}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.snapshots.v1beta1.Metadata.compressor":
+		value := x.Compressor
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -867,6 +951,12 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_Metadata_1_list)
 		x.ChunkHashes = *clv.list
+	case "cosmos.base.snapshots.v1beta1.Metadata.sections":
+		lv := value.List()
+		clv := lv.(*_Metadata_2_list)
+		x.Sections = *clv.list
+	case "cosmos.base.snapshots.v1beta1.Metadata.compressor":
+		x.Compressor = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -893,6 +983,14 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.base.snapshots.v1beta1.Metadata.sections":
+		if x.Sections == nil {
+			x.Sections = []*SnapshotSection{}
+		}
+		value := &_Metadata_2_list{list: &x.Sections}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.snapshots.v1beta1.Metadata.compressor":
+		panic(fmt.Errorf("field compressor of message cosmos.base.snapshots.v1beta1.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -909,6 +1007,11 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 	case "cosmos.base.snapshots.v1beta1.Metadata.chunk_hashes":
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_Metadata_1_list{list: &list})
+	case "cosmos.base.snapshots.v1beta1.Metadata.sections":
+		list := []*SnapshotSection{}
+		return protoreflect.ValueOfList(&_Metadata_2_list{list: &list})
+	case "cosmos.base.snapshots.v1beta1.Metadata.compressor":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.Metadata"))
@@ -984,6 +1087,16 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Sections) > 0 {
+			for _, e := range x.Sections {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Compressor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1001,26 +1114,659 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				Buf:               input.Buf,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Compressor) > 0 {
+			i -= len(x.Compressor)
+			copy(dAtA[i:], x.Compressor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Compressor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sections) > 0 {
+			for iNdEx := len(x.Sections) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Sections[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ChunkHashes) > 0 {
+			for iNdEx := len(x.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ChunkHashes[iNdEx])
+				copy(dAtA[i:], x.ChunkHashes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChunkHashes[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Metadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkHashes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChunkHashes = append(x.ChunkHashes, make([]byte, postIndex-iNdEx))
+				copy(x.ChunkHashes[len(x.ChunkHashes)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sections = append(x.Sections, &SnapshotSection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sections[len(x.Sections)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Compressor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Compressor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotSection                  protoreflect.MessageDescriptor
+	fd_SnapshotSection_name             protoreflect.FieldDescriptor
+	fd_SnapshotSection_extension        protoreflect.FieldDescriptor
+	fd_SnapshotSection_extension_format protoreflect.FieldDescriptor
+	fd_SnapshotSection_chunks           protoreflect.FieldDescriptor
+	fd_SnapshotSection_hash             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_snapshots_v1beta1_snapshot_proto_init()
+	md_SnapshotSection = File_cosmos_base_snapshots_v1beta1_snapshot_proto.Messages().ByName("SnapshotSection")
+	fd_SnapshotSection_name = md_SnapshotSection.Fields().ByName("name")
+	fd_SnapshotSection_extension = md_SnapshotSection.Fields().ByName("extension")
+	fd_SnapshotSection_extension_format = md_SnapshotSection.Fields().ByName("extension_format")
+	fd_SnapshotSection_chunks = md_SnapshotSection.Fields().ByName("chunks")
+	fd_SnapshotSection_hash = md_SnapshotSection.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotSection)(nil)
+
+type fastReflection_SnapshotSection SnapshotSection
+
+func (x *SnapshotSection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotSection)(x)
+}
+
+func (x *SnapshotSection) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotSection_messageType fastReflection_SnapshotSection_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotSection_messageType{}
+
+type fastReflection_SnapshotSection_messageType struct{}
+
+func (x fastReflection_SnapshotSection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotSection)(nil)
+}
+func (x fastReflection_SnapshotSection_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotSection)
+}
+func (x fastReflection_SnapshotSection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotSection
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotSection) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotSection
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotSection) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotSection_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotSection) New() protoreflect.Message {
+	return new(fastReflection_SnapshotSection)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotSection) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotSection)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotSection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotSection_name, value) {
+			return
+		}
+	}
+	if x.Extension != false {
+		value := protoreflect.ValueOfBool(x.Extension)
+		if !f(fd_SnapshotSection_extension, value) {
+			return
+		}
+	}
+	if x.ExtensionFormat != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExtensionFormat)
+		if !f(fd_SnapshotSection_extension_format, value) {
+			return
+		}
+	}
+	if x.Chunks != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Chunks)
+		if !f(fd_SnapshotSection_chunks, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_SnapshotSection_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotSection) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.name":
+		return x.Name != ""
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension":
+		return x.Extension != false
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension_format":
+		return x.ExtensionFormat != uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.chunks":
+		return x.Chunks != uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.hash":
+		return len(x.Hash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotSection"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotSection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotSection) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.name":
+		x.Name = ""
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension":
+		x.Extension = false
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension_format":
+		x.ExtensionFormat = uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.chunks":
+		x.Chunks = uint32(0)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.hash":
+		x.Hash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotSection"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotSection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotSection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension":
+		value := x.Extension
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension_format":
+		value := x.ExtensionFormat
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.chunks":
+		value := x.Chunks
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotSection"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotSection does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotSection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension":
+		x.Extension = value.Bool()
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension_format":
+		x.ExtensionFormat = uint32(value.Uint())
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.chunks":
+		x.Chunks = uint32(value.Uint())
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.hash":
+		x.Hash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotSection"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotSection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotSection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.name":
+		panic(fmt.Errorf("field name of message cosmos.base.snapshots.v1beta1.SnapshotSection is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension":
+		panic(fmt.Errorf("field extension of message cosmos.base.snapshots.v1beta1.SnapshotSection is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension_format":
+		panic(fmt.Errorf("field extension_format of message cosmos.base.snapshots.v1beta1.SnapshotSection is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.chunks":
+		panic(fmt.Errorf("field chunks of message cosmos.base.snapshots.v1beta1.SnapshotSection is not mutable"))
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.hash":
+		panic(fmt.Errorf("field hash of message cosmos.base.snapshots.v1beta1.SnapshotSection is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotSection"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotSection does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotSection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.extension_format":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.chunks":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.snapshots.v1beta1.SnapshotSection.hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.snapshots.v1beta1.SnapshotSection"))
+		}
+		panic(fmt.Errorf("message cosmos.base.snapshots.v1beta1.SnapshotSection does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotSection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.snapshots.v1beta1.SnapshotSection", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotSection) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotSection) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotSection) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotSection) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotSection)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Extension {
+			n += 2
+		}
+		if x.ExtensionFormat != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExtensionFormat))
+		}
+		if x.Chunks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Chunks))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotSection)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Chunks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Chunks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ExtensionFormat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExtensionFormat))
+			i--
+			dAtA[i] = 0x18
 		}
-		if len(x.ChunkHashes) > 0 {
-			for iNdEx := len(x.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.ChunkHashes[iNdEx])
-				copy(dAtA[i:], x.ChunkHashes[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChunkHashes[iNdEx])))
-				i--
-				dAtA[i] = 0xa
+		if x.Extension {
+			i--
+			if x.Extension {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1033,7 +1779,7 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Metadata)
+		x := input.Message.Interface().(*SnapshotSection)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1065,15 +1811,105 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotSection: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotSection: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkHashes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Extension = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionFormat", wireType)
+				}
+				x.ExtensionFormat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExtensionFormat |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+				}
+				x.Chunks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Chunks |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1100,8 +1936,10 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChunkHashes = append(x.ChunkHashes, make([]byte, postIndex-iNdEx))
-				copy(x.ChunkHashes[len(x.ChunkHashes)-1], dAtA[iNdEx:postIndex])
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1168,7 +2006,7 @@ func (x *SnapshotItem) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,7 +2990,7 @@ func (x *SnapshotStoreItem) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,7 +3416,7 @@ func (x *SnapshotIAVLItem) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3158,7 +3996,7 @@ func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3624,7 +4462,7 @@ func (x *SnapshotExtensionPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4048,7 +4886,7 @@ func (x *SnapshotKVItem) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotKVItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4580,7 +5418,7 @@ func (x *SnapshotSchema) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotSchema) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
+	mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5082,6 +5920,16 @@ type Metadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"` // SHA-256 chunk hashes
+	// sections are the sections of a snapshot in the parallel format, in the
+	// order of their chunks.
+	//
+	// Since: cosmos-sdk 0.46
+	Sections []*SnapshotSection `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	// compressor is the name of the compressor of the sections of a snapshot in
+	// the parallel format.
+	//
+	// Since: cosmos-sdk 0.46
+	Compressor string `protobuf:"bytes,3,opt,name=compressor,proto3" json:"compressor,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -5111,6 +5959,97 @@ func (x *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (x *Metadata) GetSections() []*SnapshotSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Metadata) GetCompressor() string {
+	if x != nil {
+		return x.Compressor
+	}
+	return ""
+}
+
+// SnapshotSection is an independently compressed and hashed section of a
+// snapshot in the parallel format, containing a store of the multistore or an
+// extension. Each section starts on a new chunk.
+//
+// Since: cosmos-sdk 0.46
+type SnapshotSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the store or the extension.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// extension is true if the section contains an extension.
+	Extension bool `protobuf:"varint,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	// extension_format is the format of the payload of an extension.
+	ExtensionFormat uint32 `protobuf:"varint,3,opt,name=extension_format,json=extensionFormat,proto3" json:"extension_format,omitempty"`
+	// chunks is the number of chunks of the section.
+	Chunks uint32 `protobuf:"varint,4,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// hash is the SHA-256 hash of the uncompressed section.
+	Hash []byte `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *SnapshotSection) Reset() {
+	*x = SnapshotSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotSection) ProtoMessage() {}
+
+// Deprecated: Use SnapshotSection.ProtoReflect.Descriptor instead.
+func (*SnapshotSection) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SnapshotSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotSection) GetExtension() bool {
+	if x != nil {
+		return x.Extension
+	}
+	return false
+}
+
+func (x *SnapshotSection) GetExtensionFormat() uint32 {
+	if x != nil {
+		return x.ExtensionFormat
+	}
+	return 0
+}
+
+func (x *SnapshotSection) GetChunks() uint32 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+func (x *SnapshotSection) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	state         protoimpl.MessageState
@@ -5132,7 +6071,7 @@ type SnapshotItem struct {
 func (x *SnapshotItem) Reset() {
 	*x = SnapshotItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5146,7 +6085,7 @@ func (*SnapshotItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotItem.ProtoReflect.Descriptor instead.
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SnapshotItem) GetItem() isSnapshotItem_Item {
@@ -5250,7 +6189,7 @@ type SnapshotStoreItem struct {
 func (x *SnapshotStoreItem) Reset() {
 	*x = SnapshotStoreItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5264,7 +6203,7 @@ func (*SnapshotStoreItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotStoreItem.ProtoReflect.Descriptor instead.
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SnapshotStoreItem) GetName() string {
@@ -5291,7 +6230,7 @@ type SnapshotIAVLItem struct {
 func (x *SnapshotIAVLItem) Reset() {
 	*x = SnapshotIAVLItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5305,7 +6244,7 @@ func (*SnapshotIAVLItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotIAVLItem.ProtoReflect.Descriptor instead.
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SnapshotIAVLItem) GetKey() []byte {
@@ -5349,7 +6288,7 @@ type SnapshotExtensionMeta struct {
 func (x *SnapshotExtensionMeta) Reset() {
 	*x = SnapshotExtensionMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5363,7 +6302,7 @@ func (*SnapshotExtensionMeta) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionMeta.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SnapshotExtensionMeta) GetName() string {
@@ -5392,7 +6331,7 @@ type SnapshotExtensionPayload struct {
 func (x *SnapshotExtensionPayload) Reset() {
 	*x = SnapshotExtensionPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5406,7 +6345,7 @@ func (*SnapshotExtensionPayload) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionPayload.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapshotExtensionPayload) GetPayload() []byte {
@@ -5429,7 +6368,7 @@ type SnapshotKVItem struct {
 func (x *SnapshotKVItem) Reset() {
 	*x = SnapshotKVItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5443,7 +6382,7 @@ func (*SnapshotKVItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotKVItem.ProtoReflect.Descriptor instead.
 func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SnapshotKVItem) GetKey() []byte {
@@ -5472,7 +6411,7 @@ type SnapshotSchema struct {
 func (x *SnapshotSchema) Reset() {
 	*x = SnapshotSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8]
+		mi := &file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5486,7 +6425,7 @@ func (*SnapshotSchema) ProtoMessage() {}
 
 // Deprecated: Use SnapshotSchema.ProtoReflect.Descriptor instead.
 func (*SnapshotSchema) Descriptor() ([]byte, []int) {
-	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SnapshotSchema) GetKeys() [][]byte {
@@ -5516,84 +6455,100 @@ var file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61,
 	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x81, 0x04, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x4f, 0x0a, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x81, 0x04, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x4f, 0x0a, 0x04, 0x69,
+	0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xe2, 0xde, 0x1f, 0x04,
+	0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x12, 0x54, 0x0a, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e,
 	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d,
-	0x42, 0x08, 0xe2, 0xde, 0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61,
-	0x76, 0x6c, 0x12, 0x54, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x47, 0x0a, 0x02, 0x6b, 0x76,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4b,
+	0x56, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02, 0x4b, 0x56, 0x48, 0x00, 0x52,
+	0x02, 0x6b, 0x76, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x47, 0x0a, 0x02, 0x6b, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x06, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a,
+	0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0x34, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x38, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x24, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x42, 0x9a, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0xe2, 0xde, 0x1f,
-	0x02, 0x4b, 0x56, 0x48, 0x00, 0x52, 0x02, 0x6b, 0x76, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
-	0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x38, 0x0a, 0x0e,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x42, 0x9a, 0x02, 0x0a,
-	0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
-	0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x53, 0xaa, 0x02, 0x1d, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x1d, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x29, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
-	0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x42, 0x53, 0xaa, 0x02, 0x1d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x1d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61,
+	0x73, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x29, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61,
+	0x73, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a,
+	0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5608,31 +6563,33 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescGZIP() []byte {
 	return file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDescData
 }
 
-var file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_cosmos_base_snapshots_v1beta1_snapshot_proto_goTypes = []interface{}{
 	(*Snapshot)(nil),                 // 0: cosmos.base.snapshots.v1beta1.Snapshot
 	(*Metadata)(nil),                 // 1: cosmos.base.snapshots.v1beta1.Metadata
-	(*SnapshotItem)(nil),             // 2: cosmos.base.snapshots.v1beta1.SnapshotItem
-	(*SnapshotStoreItem)(nil),        // 3: cosmos.base.snapshots.v1beta1.SnapshotStoreItem
-	(*SnapshotIAVLItem)(nil),         // 4: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
-	(*SnapshotExtensionMeta)(nil),    // 5: cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
-	(*SnapshotExtensionPayload)(nil), // 6: cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
-	(*SnapshotKVItem)(nil),           // 7: cosmos.base.snapshots.v1beta1.SnapshotKVItem
-	(*SnapshotSchema)(nil),           // 8: cosmos.base.snapshots.v1beta1.SnapshotSchema
+	(*SnapshotSection)(nil),          // 2: cosmos.base.snapshots.v1beta1.SnapshotSection
+	(*SnapshotItem)(nil),             // 3: cosmos.base.snapshots.v1beta1.SnapshotItem
+	(*SnapshotStoreItem)(nil),        // 4: cosmos.base.snapshots.v1beta1.SnapshotStoreItem
+	(*SnapshotIAVLItem)(nil),         // 5: cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
+	(*SnapshotExtensionMeta)(nil),    // 6: cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
+	(*SnapshotExtensionPayload)(nil), // 7: cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
+	(*SnapshotKVItem)(nil),           // 8: cosmos.base.snapshots.v1beta1.SnapshotKVItem
+	(*SnapshotSchema)(nil),           // 9: cosmos.base.snapshots.v1beta1.SnapshotSchema
 }
 var file_cosmos_base_snapshots_v1beta1_snapshot_proto_depIdxs = []int32{
 	1, // 0: cosmos.base.snapshots.v1beta1.Snapshot.metadata:type_name -> cosmos.base.snapshots.v1beta1.Metadata
-	3, // 1: cosmos.base.snapshots.v1beta1.SnapshotItem.store:type_name -> cosmos.base.snapshots.v1beta1.SnapshotStoreItem
-	4, // 2: cosmos.base.snapshots.v1beta1.SnapshotItem.iavl:type_name -> cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
-	5, // 3: cosmos.base.snapshots.v1beta1.SnapshotItem.extension:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
-	6, // 4: cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
-	7, // 5: cosmos.base.snapshots.v1beta1.SnapshotItem.kv:type_name -> cosmos.base.snapshots.v1beta1.SnapshotKVItem
-	8, // 6: cosmos.base.snapshots.v1beta1.SnapshotItem.schema:type_name -> cosmos.base.snapshots.v1beta1.SnapshotSchema
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2, // 1: cosmos.base.snapshots.v1beta1.Metadata.sections:type_name -> cosmos.base.snapshots.v1beta1.SnapshotSection
+	4, // 2: cosmos.base.snapshots.v1beta1.SnapshotItem.store:type_name -> cosmos.base.snapshots.v1beta1.SnapshotStoreItem
+	5, // 3: cosmos.base.snapshots.v1beta1.SnapshotItem.iavl:type_name -> cosmos.base.snapshots.v1beta1.SnapshotIAVLItem
+	6, // 4: cosmos.base.snapshots.v1beta1.SnapshotItem.extension:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionMeta
+	7, // 5: cosmos.base.snapshots.v1beta1.SnapshotItem.extension_payload:type_name -> cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload
+	8, // 6: cosmos.base.snapshots.v1beta1.SnapshotItem.kv:type_name -> cosmos.base.snapshots.v1beta1.SnapshotKVItem
+	9, // 7: cosmos.base.snapshots.v1beta1.SnapshotItem.schema:type_name -> cosmos.base.snapshots.v1beta1.SnapshotSchema
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() }
@@ -5666,7 +6623,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotItem); i {
+			switch v := v.(*SnapshotSection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5678,7 +6635,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotStoreItem); i {
+			switch v := v.(*SnapshotItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5690,7 +6647,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotIAVLItem); i {
+			switch v := v.(*SnapshotStoreItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5702,7 +6659,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionMeta); i {
+			switch v := v.(*SnapshotIAVLItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5714,7 +6671,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionPayload); i {
+			switch v := v.(*SnapshotExtensionMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5726,7 +6683,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotKVItem); i {
+			switch v := v.(*SnapshotExtensionPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5738,6 +6695,18 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotKVItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnapshotSchema); i {
 			case 0:
 				return &v.state
@@ -5750,7 +6719,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			}
 		}
 	}
-	file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_cosmos_base_snapshots_v1beta1_snapshot_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*SnapshotItem_Store)(nil),
 		(*SnapshotItem_Iavl)(nil),
 		(*SnapshotItem_Extension)(nil),
@@ -5764,7 +6733,7 @@ func file_cosmos_base_snapshots_v1beta1_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_base_snapshots_v1beta1_snapshot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,2103 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package storev1beta1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_ReadWriteSet_1_list)(nil)
+
+type _ReadWriteSet_1_list struct {
+	list *[]*StoreReadWriteSet
+}
+
+func (x *_ReadWriteSet_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ReadWriteSet_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ReadWriteSet_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreReadWriteSet)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ReadWriteSet_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreReadWriteSet)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ReadWriteSet_1_list) AppendMutable() protoreflect.Value {
+	v := new(StoreReadWriteSet)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ReadWriteSet_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ReadWriteSet_1_list) NewElement() protoreflect.Value {
+	v := new(StoreReadWriteSet)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ReadWriteSet_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ReadWriteSet        protoreflect.MessageDescriptor
+	fd_ReadWriteSet_stores protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_store_v1beta1_rwset_proto_init()
+	md_ReadWriteSet = File_cosmos_base_store_v1beta1_rwset_proto.Messages().ByName("ReadWriteSet")
+	fd_ReadWriteSet_stores = md_ReadWriteSet.Fields().ByName("stores")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReadWriteSet)(nil)
+
+type fastReflection_ReadWriteSet ReadWriteSet
+
+func (x *ReadWriteSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReadWriteSet)(x)
+}
+
+func (x *ReadWriteSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReadWriteSet_messageType fastReflection_ReadWriteSet_messageType
+var _ protoreflect.MessageType = fastReflection_ReadWriteSet_messageType{}
+
+type fastReflection_ReadWriteSet_messageType struct{}
+
+func (x fastReflection_ReadWriteSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReadWriteSet)(nil)
+}
+func (x fastReflection_ReadWriteSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReadWriteSet)
+}
+func (x fastReflection_ReadWriteSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReadWriteSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReadWriteSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReadWriteSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReadWriteSet) Type() protoreflect.MessageType {
+	return _fastReflection_ReadWriteSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReadWriteSet) New() protoreflect.Message {
+	return new(fastReflection_ReadWriteSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReadWriteSet) Interface() protoreflect.ProtoMessage {
+	return (*ReadWriteSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReadWriteSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Stores) != 0 {
+		value := protoreflect.ValueOfList(&_ReadWriteSet_1_list{list: &x.Stores})
+		if !f(fd_ReadWriteSet_stores, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReadWriteSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.ReadWriteSet.stores":
+		return len(x.Stores) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.ReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.ReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReadWriteSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.ReadWriteSet.stores":
+		x.Stores = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.ReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.ReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReadWriteSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.store.v1beta1.ReadWriteSet.stores":
+		if len(x.Stores) == 0 {
+			return protoreflect.ValueOfList(&_ReadWriteSet_1_list{})
+		}
+		listValue := &_ReadWriteSet_1_list{list: &x.Stores}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.ReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.ReadWriteSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReadWriteSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.ReadWriteSet.stores":
+		lv := value.List()
+		clv := lv.(*_ReadWriteSet_1_list)
+		x.Stores = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.ReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.ReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReadWriteSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.ReadWriteSet.stores":
+		if x.Stores == nil {
+			x.Stores = []*StoreReadWriteSet{}
+		}
+		value := &_ReadWriteSet_1_list{list: &x.Stores}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.ReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.ReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReadWriteSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.ReadWriteSet.stores":
+		list := []*StoreReadWriteSet{}
+		return protoreflect.ValueOfList(&_ReadWriteSet_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.ReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.ReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReadWriteSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.store.v1beta1.ReadWriteSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReadWriteSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReadWriteSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReadWriteSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReadWriteSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReadWriteSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Stores) > 0 {
+			for _, e := range x.Stores {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReadWriteSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Stores) > 0 {
+			for iNdEx := len(x.Stores) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stores[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReadWriteSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReadWriteSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReadWriteSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stores = append(x.Stores, &StoreReadWriteSet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stores[len(x.Stores)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_StoreReadWriteSet_2_list)(nil)
+
+type _StoreReadWriteSet_2_list struct {
+	list *[][]byte
+}
+
+func (x *_StoreReadWriteSet_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreReadWriteSet_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_StoreReadWriteSet_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreReadWriteSet_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreReadWriteSet_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StoreReadWriteSet at list field Reads as it is not of Message kind"))
+}
+
+func (x *_StoreReadWriteSet_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreReadWriteSet_2_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_StoreReadWriteSet_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StoreReadWriteSet_3_list)(nil)
+
+type _StoreReadWriteSet_3_list struct {
+	list *[]*KeyRange
+}
+
+func (x *_StoreReadWriteSet_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreReadWriteSet_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StoreReadWriteSet_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyRange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreReadWriteSet_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyRange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreReadWriteSet_3_list) AppendMutable() protoreflect.Value {
+	v := new(KeyRange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreReadWriteSet_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreReadWriteSet_3_list) NewElement() protoreflect.Value {
+	v := new(KeyRange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreReadWriteSet_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StoreReadWriteSet_4_list)(nil)
+
+type _StoreReadWriteSet_4_list struct {
+	list *[][]byte
+}
+
+func (x *_StoreReadWriteSet_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreReadWriteSet_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_StoreReadWriteSet_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreReadWriteSet_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreReadWriteSet_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StoreReadWriteSet at list field Writes as it is not of Message kind"))
+}
+
+func (x *_StoreReadWriteSet_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreReadWriteSet_4_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_StoreReadWriteSet_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StoreReadWriteSet             protoreflect.MessageDescriptor
+	fd_StoreReadWriteSet_store_key   protoreflect.FieldDescriptor
+	fd_StoreReadWriteSet_reads       protoreflect.FieldDescriptor
+	fd_StoreReadWriteSet_read_ranges protoreflect.FieldDescriptor
+	fd_StoreReadWriteSet_writes      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_store_v1beta1_rwset_proto_init()
+	md_StoreReadWriteSet = File_cosmos_base_store_v1beta1_rwset_proto.Messages().ByName("StoreReadWriteSet")
+	fd_StoreReadWriteSet_store_key = md_StoreReadWriteSet.Fields().ByName("store_key")
+	fd_StoreReadWriteSet_reads = md_StoreReadWriteSet.Fields().ByName("reads")
+	fd_StoreReadWriteSet_read_ranges = md_StoreReadWriteSet.Fields().ByName("read_ranges")
+	fd_StoreReadWriteSet_writes = md_StoreReadWriteSet.Fields().ByName("writes")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoreReadWriteSet)(nil)
+
+type fastReflection_StoreReadWriteSet StoreReadWriteSet
+
+func (x *StoreReadWriteSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreReadWriteSet)(x)
+}
+
+func (x *StoreReadWriteSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoreReadWriteSet_messageType fastReflection_StoreReadWriteSet_messageType
+var _ protoreflect.MessageType = fastReflection_StoreReadWriteSet_messageType{}
+
+type fastReflection_StoreReadWriteSet_messageType struct{}
+
+func (x fastReflection_StoreReadWriteSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreReadWriteSet)(nil)
+}
+func (x fastReflection_StoreReadWriteSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreReadWriteSet)
+}
+func (x fastReflection_StoreReadWriteSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreReadWriteSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoreReadWriteSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreReadWriteSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoreReadWriteSet) Type() protoreflect.MessageType {
+	return _fastReflection_StoreReadWriteSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoreReadWriteSet) New() protoreflect.Message {
+	return new(fastReflection_StoreReadWriteSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoreReadWriteSet) Interface() protoreflect.ProtoMessage {
+	return (*StoreReadWriteSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoreReadWriteSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StoreKey != "" {
+		value := protoreflect.ValueOfString(x.StoreKey)
+		if !f(fd_StoreReadWriteSet_store_key, value) {
+			return
+		}
+	}
+	if len(x.Reads) != 0 {
+		value := protoreflect.ValueOfList(&_StoreReadWriteSet_2_list{list: &x.Reads})
+		if !f(fd_StoreReadWriteSet_reads, value) {
+			return
+		}
+	}
+	if len(x.ReadRanges) != 0 {
+		value := protoreflect.ValueOfList(&_StoreReadWriteSet_3_list{list: &x.ReadRanges})
+		if !f(fd_StoreReadWriteSet_read_ranges, value) {
+			return
+		}
+	}
+	if len(x.Writes) != 0 {
+		value := protoreflect.ValueOfList(&_StoreReadWriteSet_4_list{list: &x.Writes})
+		if !f(fd_StoreReadWriteSet_writes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoreReadWriteSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.store_key":
+		return x.StoreKey != ""
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.reads":
+		return len(x.Reads) != 0
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.read_ranges":
+		return len(x.ReadRanges) != 0
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.writes":
+		return len(x.Writes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.StoreReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.StoreReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreReadWriteSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.store_key":
+		x.StoreKey = ""
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.reads":
+		x.Reads = nil
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.read_ranges":
+		x.ReadRanges = nil
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.writes":
+		x.Writes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.StoreReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.StoreReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoreReadWriteSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.store_key":
+		value := x.StoreKey
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.reads":
+		if len(x.Reads) == 0 {
+			return protoreflect.ValueOfList(&_StoreReadWriteSet_2_list{})
+		}
+		listValue := &_StoreReadWriteSet_2_list{list: &x.Reads}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.read_ranges":
+		if len(x.ReadRanges) == 0 {
+			return protoreflect.ValueOfList(&_StoreReadWriteSet_3_list{})
+		}
+		listValue := &_StoreReadWriteSet_3_list{list: &x.ReadRanges}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.writes":
+		if len(x.Writes) == 0 {
+			return protoreflect.ValueOfList(&_StoreReadWriteSet_4_list{})
+		}
+		listValue := &_StoreReadWriteSet_4_list{list: &x.Writes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.StoreReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.StoreReadWriteSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreReadWriteSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.store_key":
+		x.StoreKey = value.Interface().(string)
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.reads":
+		lv := value.List()
+		clv := lv.(*_StoreReadWriteSet_2_list)
+		x.Reads = *clv.list
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.read_ranges":
+		lv := value.List()
+		clv := lv.(*_StoreReadWriteSet_3_list)
+		x.ReadRanges = *clv.list
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.writes":
+		lv := value.List()
+		clv := lv.(*_StoreReadWriteSet_4_list)
+		x.Writes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.StoreReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.StoreReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreReadWriteSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.reads":
+		if x.Reads == nil {
+			x.Reads = [][]byte{}
+		}
+		value := &_StoreReadWriteSet_2_list{list: &x.Reads}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.read_ranges":
+		if x.ReadRanges == nil {
+			x.ReadRanges = []*KeyRange{}
+		}
+		value := &_StoreReadWriteSet_3_list{list: &x.ReadRanges}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.writes":
+		if x.Writes == nil {
+			x.Writes = [][]byte{}
+		}
+		value := &_StoreReadWriteSet_4_list{list: &x.Writes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.store_key":
+		panic(fmt.Errorf("field store_key of message cosmos.base.store.v1beta1.StoreReadWriteSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.StoreReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.StoreReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoreReadWriteSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.store_key":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.reads":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_StoreReadWriteSet_2_list{list: &list})
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.read_ranges":
+		list := []*KeyRange{}
+		return protoreflect.ValueOfList(&_StoreReadWriteSet_3_list{list: &list})
+	case "cosmos.base.store.v1beta1.StoreReadWriteSet.writes":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_StoreReadWriteSet_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.StoreReadWriteSet"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.StoreReadWriteSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoreReadWriteSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.store.v1beta1.StoreReadWriteSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoreReadWriteSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreReadWriteSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoreReadWriteSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoreReadWriteSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoreReadWriteSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.StoreKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Reads) > 0 {
+			for _, b := range x.Reads {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReadRanges) > 0 {
+			for _, e := range x.ReadRanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Writes) > 0 {
+			for _, b := range x.Writes {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoreReadWriteSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Writes) > 0 {
+			for iNdEx := len(x.Writes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Writes[iNdEx])
+				copy(dAtA[i:], x.Writes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Writes[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ReadRanges) > 0 {
+			for iNdEx := len(x.ReadRanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReadRanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Reads) > 0 {
+			for iNdEx := len(x.Reads) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Reads[iNdEx])
+				copy(dAtA[i:], x.Reads[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reads[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.StoreKey) > 0 {
+			i -= len(x.StoreKey)
+			copy(dAtA[i:], x.StoreKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StoreKey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreReadWriteSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreReadWriteSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreReadWriteSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StoreKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reads = append(x.Reads, make([]byte, postIndex-iNdEx))
+				copy(x.Reads[len(x.Reads)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReadRanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReadRanges = append(x.ReadRanges, &KeyRange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReadRanges[len(x.ReadRanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Writes = append(x.Writes, make([]byte, postIndex-iNdEx))
+				copy(x.Writes[len(x.Writes)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_KeyRange       protoreflect.MessageDescriptor
+	fd_KeyRange_start protoreflect.FieldDescriptor
+	fd_KeyRange_end   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_store_v1beta1_rwset_proto_init()
+	md_KeyRange = File_cosmos_base_store_v1beta1_rwset_proto.Messages().ByName("KeyRange")
+	fd_KeyRange_start = md_KeyRange.Fields().ByName("start")
+	fd_KeyRange_end = md_KeyRange.Fields().ByName("end")
+}
+
+var _ protoreflect.Message = (*fastReflection_KeyRange)(nil)
+
+type fastReflection_KeyRange KeyRange
+
+func (x *KeyRange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KeyRange)(x)
+}
+
+func (x *KeyRange) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KeyRange_messageType fastReflection_KeyRange_messageType
+var _ protoreflect.MessageType = fastReflection_KeyRange_messageType{}
+
+type fastReflection_KeyRange_messageType struct{}
+
+func (x fastReflection_KeyRange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KeyRange)(nil)
+}
+func (x fastReflection_KeyRange_messageType) New() protoreflect.Message {
+	return new(fastReflection_KeyRange)
+}
+func (x fastReflection_KeyRange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyRange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KeyRange) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyRange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KeyRange) Type() protoreflect.MessageType {
+	return _fastReflection_KeyRange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KeyRange) New() protoreflect.Message {
+	return new(fastReflection_KeyRange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KeyRange) Interface() protoreflect.ProtoMessage {
+	return (*KeyRange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KeyRange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Start) != 0 {
+		value := protoreflect.ValueOfBytes(x.Start)
+		if !f(fd_KeyRange_start, value) {
+			return
+		}
+	}
+	if len(x.End) != 0 {
+		value := protoreflect.ValueOfBytes(x.End)
+		if !f(fd_KeyRange_end, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KeyRange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.KeyRange.start":
+		return len(x.Start) != 0
+	case "cosmos.base.store.v1beta1.KeyRange.end":
+		return len(x.End) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.KeyRange"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.KeyRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.KeyRange.start":
+		x.Start = nil
+	case "cosmos.base.store.v1beta1.KeyRange.end":
+		x.End = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.KeyRange"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.KeyRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KeyRange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.store.v1beta1.KeyRange.start":
+		value := x.Start
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.base.store.v1beta1.KeyRange.end":
+		value := x.End
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.KeyRange"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.KeyRange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.KeyRange.start":
+		x.Start = value.Bytes()
+	case "cosmos.base.store.v1beta1.KeyRange.end":
+		x.End = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.KeyRange"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.KeyRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.KeyRange.start":
+		panic(fmt.Errorf("field start of message cosmos.base.store.v1beta1.KeyRange is not mutable"))
+	case "cosmos.base.store.v1beta1.KeyRange.end":
+		panic(fmt.Errorf("field end of message cosmos.base.store.v1beta1.KeyRange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.KeyRange"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.KeyRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KeyRange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.store.v1beta1.KeyRange.start":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.base.store.v1beta1.KeyRange.end":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.store.v1beta1.KeyRange"))
+		}
+		panic(fmt.Errorf("message cosmos.base.store.v1beta1.KeyRange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KeyRange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.store.v1beta1.KeyRange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KeyRange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyRange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KeyRange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KeyRange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KeyRange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Start)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.End)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KeyRange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.End) > 0 {
+			i -= len(x.End)
+			copy(dAtA[i:], x.End)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.End)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Start) > 0 {
+			i -= len(x.Start)
+			copy(dAtA[i:], x.Start)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Start)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KeyRange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyRange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Start = append(x.Start[:0], dAtA[iNdEx:postIndex]...)
+				if x.Start == nil {
+					x.Start = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.End = append(x.End[:0], dAtA[iNdEx:postIndex]...)
+				if x.End == nil {
+					x.End = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/base/store/v1beta1/rwset.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReadWriteSet is the set of keys and key ranges of the KVStores read and
+// written by a transaction.
+//
+// Since: cosmos-sdk 0.46
+type ReadWriteSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stores are the accesses to each KVStore, sorted by store key.
+	Stores []*StoreReadWriteSet `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (x *ReadWriteSet) Reset() {
+	*x = ReadWriteSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadWriteSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadWriteSet) ProtoMessage() {}
+
+// Deprecated: Use ReadWriteSet.ProtoReflect.Descriptor instead.
+func (*ReadWriteSet) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_store_v1beta1_rwset_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReadWriteSet) GetStores() []*StoreReadWriteSet {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+// StoreReadWriteSet is the set of keys and key ranges of a KVStore read and
+// written by a transaction. Keys and ranges are sorted and unique.
+//
+// Since: cosmos-sdk 0.46
+type StoreReadWriteSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// reads are the keys read with Get or Has.
+	Reads [][]byte `protobuf:"bytes,2,rep,name=reads,proto3" json:"reads,omitempty"`
+	// read_ranges are the domains of the iterators.
+	ReadRanges []*KeyRange `protobuf:"bytes,3,rep,name=read_ranges,json=readRanges,proto3" json:"read_ranges,omitempty"`
+	// writes are the keys set or deleted.
+	Writes [][]byte `protobuf:"bytes,4,rep,name=writes,proto3" json:"writes,omitempty"`
+}
+
+func (x *StoreReadWriteSet) Reset() {
+	*x = StoreReadWriteSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreReadWriteSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreReadWriteSet) ProtoMessage() {}
+
+// Deprecated: Use StoreReadWriteSet.ProtoReflect.Descriptor instead.
+func (*StoreReadWriteSet) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_store_v1beta1_rwset_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoreReadWriteSet) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *StoreReadWriteSet) GetReads() [][]byte {
+	if x != nil {
+		return x.Reads
+	}
+	return nil
+}
+
+func (x *StoreReadWriteSet) GetReadRanges() []*KeyRange {
+	if x != nil {
+		return x.ReadRanges
+	}
+	return nil
+}
+
+func (x *StoreReadWriteSet) GetWrites() [][]byte {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+// KeyRange is the key domain [start, end) of an iterator. An empty start or
+// end leaves the domain unbounded on that side.
+//
+// Since: cosmos-sdk 0.46
+type KeyRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   []byte `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *KeyRange) Reset() {
+	*x = KeyRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRange) ProtoMessage() {}
+
+// Deprecated: Use KeyRange.ProtoReflect.Descriptor instead.
+func (*KeyRange) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_store_v1beta1_rwset_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KeyRange) GetStart() []byte {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *KeyRange) GetEnd() []byte {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+var File_cosmos_base_store_v1beta1_rwset_proto protoreflect.FileDescriptor
+
+var file_cosmos_base_store_v1beta1_rwset_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x77, 0x73, 0x65,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x22, 0x54, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x12, 0x44, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22,
+	0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x42, 0xfb, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x52, 0x77, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43,
+	0x42, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02,
+	0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x73,
+	0x65, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_base_store_v1beta1_rwset_proto_rawDescOnce sync.Once
+	file_cosmos_base_store_v1beta1_rwset_proto_rawDescData = file_cosmos_base_store_v1beta1_rwset_proto_rawDesc
+)
+
+func file_cosmos_base_store_v1beta1_rwset_proto_rawDescGZIP() []byte {
+	file_cosmos_base_store_v1beta1_rwset_proto_rawDescOnce.Do(func() {
+		file_cosmos_base_store_v1beta1_rwset_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_base_store_v1beta1_rwset_proto_rawDescData)
+	})
+	return file_cosmos_base_store_v1beta1_rwset_proto_rawDescData
+}
+
+var file_cosmos_base_store_v1beta1_rwset_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_base_store_v1beta1_rwset_proto_goTypes = []interface{}{
+	(*ReadWriteSet)(nil),      // 0: cosmos.base.store.v1beta1.ReadWriteSet
+	(*StoreReadWriteSet)(nil), // 1: cosmos.base.store.v1beta1.StoreReadWriteSet
+	(*KeyRange)(nil),          // 2: cosmos.base.store.v1beta1.KeyRange
+}
+var file_cosmos_base_store_v1beta1_rwset_proto_depIdxs = []int32{
+	1, // 0: cosmos.base.store.v1beta1.ReadWriteSet.stores:type_name -> cosmos.base.store.v1beta1.StoreReadWriteSet
+	2, // 1: cosmos.base.store.v1beta1.StoreReadWriteSet.read_ranges:type_name -> cosmos.base.store.v1beta1.KeyRange
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_base_store_v1beta1_rwset_proto_init() }
+func file_cosmos_base_store_v1beta1_rwset_proto_init() {
+	if File_cosmos_base_store_v1beta1_rwset_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWriteSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreReadWriteSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_store_v1beta1_rwset_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_base_store_v1beta1_rwset_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cosmos_base_store_v1beta1_rwset_proto_goTypes,
+		DependencyIndexes: file_cosmos_base_store_v1beta1_rwset_proto_depIdxs,
+		MessageInfos:      file_cosmos_base_store_v1beta1_rwset_proto_msgTypes,
+	}.Build()
+	File_cosmos_base_store_v1beta1_rwset_proto = out.File
+	file_cosmos_base_store_v1beta1_rwset_proto_rawDesc = nil
+	file_cosmos_base_store_v1beta1_rwset_proto_goTypes = nil
+	file_cosmos_base_store_v1beta1_rwset_proto_depIdxs = nil
+}
@@ -2047,6 +2047,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FieldCodec specifies an alternative encoding of string fields holding
+// numbers in ORM keys, so that the ordering of keys matches the numeric
+// ordering of values and range iteration over these fields is meaningful.
+type FieldCodec int32
+
+const (
+	// FIELD_CODEC_UNSPECIFIED uses the default encoding of the field type.
+	FieldCodec_FIELD_CODEC_UNSPECIFIED FieldCodec = 0
+	// FIELD_CODEC_INT encodes string fields holding base 10 integers of
+	// arbitrary size, such as sdk.Int values. Empty strings are allowed and
+	// ordered before all numbers.
+	FieldCodec_FIELD_CODEC_INT FieldCodec = 1
+	// FIELD_CODEC_DEC encodes string fields holding decimal numbers with at
+	// most 18 fractional digits, such as sdk.Dec values. Values are decoded in
+	// the canonical sdk.Dec format with 18 fractional digits. Empty strings
+	// are allowed and ordered before all numbers.
+	FieldCodec_FIELD_CODEC_DEC FieldCodec = 2
+)
+
+// Enum value maps for FieldCodec.
+var (
+	FieldCodec_name = map[int32]string{
+		0: "FIELD_CODEC_UNSPECIFIED",
+		1: "FIELD_CODEC_INT",
+		2: "FIELD_CODEC_DEC",
+	}
+	FieldCodec_value = map[string]int32{
+		"FIELD_CODEC_UNSPECIFIED": 0,
+		"FIELD_CODEC_INT":         1,
+		"FIELD_CODEC_DEC":         2,
+	}
+)
+
+func (x FieldCodec) Enum() *FieldCodec {
+	p := new(FieldCodec)
+	*p = x
+	return p
+}
+
+func (x FieldCodec) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldCodec) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_orm_v1_orm_proto_enumTypes[0].Descriptor()
+}
+
+func (FieldCodec) Type() protoreflect.EnumType {
+	return &file_cosmos_orm_v1_orm_proto_enumTypes[0]
+}
+
+func (x FieldCodec) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldCodec.Descriptor instead.
+func (FieldCodec) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_orm_v1_orm_proto_rawDescGZIP(), []int{0}
+}
+
 // TableDescriptor describes an ORM table.
 type TableDescriptor struct {
 	state         protoimpl.MessageState
@@ -2134,6 +2194,8 @@ type PrimaryKeyDescriptor struct {
 	//   - enum fields are encoded using varint encoding and do not support sorted
 	//   iteration.
 	//   - bool fields are encoded as a single byte 0 or 1.
+	//   - string fields with the field_codec option are encoded with the
+	//   specified FieldCodec instead.
 	//
 	// All other fields types are unsupported in keys including repeated and
 	// oneof fields.
@@ -2196,6 +2258,11 @@ type SecondaryIndexDescriptor struct {
 	// any additional primary key fields not present in the index fields so that the
 	// primary key can be reconstructed. Unique indexes instead of being suffixed
 	// store the remaining primary key fields in the value..
+	//
+	// Non-unique indexes may also contain one repeated field whose element type
+	// is supported in keys. Such multi-valued indexes store one key for each
+	// distinct element of the repeated field, and none if it is empty, and are
+	// queried by a single element value.
 	Fields string `protobuf:"bytes,1,opt,name=fields,proto3" json:"fields,omitempty"`
 	// id is a non-zero integer ID that must be unique within the indexes for this
 	// table and less than 32768. It may be deprecated in the future when this can
@@ -2302,6 +2369,14 @@ var file_cosmos_orm_v1_orm_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,104503791,opt,name=singleton",
 		Filename:      "cosmos/orm/v1/orm.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldCodec)(nil),
+		Field:         104503792,
+		Name:          "cosmos.orm.v1.field_codec",
+		Tag:           "varint,104503792,opt,name=field_codec,enum=cosmos.orm.v1.FieldCodec",
+		Filename:      "cosmos/orm/v1/orm.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MessageOptions.
@@ -2318,6 +2393,15 @@ var (
 	E_Singleton = &file_cosmos_orm_v1_orm_proto_extTypes[1]
 )
 
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// field_codec specifies an alternative encoding for this field when it is
+	// used in ORM primary keys and indexes.
+	//
+	// optional cosmos.orm.v1.FieldCodec field_codec = 104503792;
+	E_FieldCodec = &file_cosmos_orm_v1_orm_proto_extTypes[2]
+)
+
 var File_cosmos_orm_v1_orm_proto protoreflect.FileDescriptor
 
 var file_cosmos_orm_v1_orm_proto_rawDesc = []byte{
@@ -2349,31 +2433,42 @@ var file_cosmos_orm_v1_orm_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
 	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
 	0x74, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x58, 0x0a,
-	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xee, 0xb3, 0xea, 0x31, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x64, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x74, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xef, 0xb3, 0xea, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x42, 0xa9, 0x01,
-	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d,
-	0x2e, 0x76, 0x31, 0x42, 0x08, 0x4f, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x6f, 0x72, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4f, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x4f, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x53, 0x0a,
+	0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x17, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x44, 0x45, 0x43,
+	0x10, 0x02, 0x3a, 0x58, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xee, 0xb3, 0xea,
+	0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x64, 0x0a, 0x09,
+	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xef, 0xb3, 0xea, 0x31, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x6f, 0x6e, 0x3a, 0x5c, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0xf0, 0xb3, 0xea, 0x31, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43,
+	0x6f, 0x64, 0x65, 0x63, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x63,
+	0x42, 0xa9, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x4f, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f,
+	0x76, 0x31, 0x3b, 0x6f, 0x72, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02,
+	0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4f, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4f, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2388,25 +2483,30 @@ func file_cosmos_orm_v1_orm_proto_rawDescGZIP() []byte {
 	return file_cosmos_orm_v1_orm_proto_rawDescData
 }
 
+var file_cosmos_orm_v1_orm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_cosmos_orm_v1_orm_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cosmos_orm_v1_orm_proto_goTypes = []interface{}{
-	(*TableDescriptor)(nil),             // 0: cosmos.orm.v1.TableDescriptor
-	(*PrimaryKeyDescriptor)(nil),        // 1: cosmos.orm.v1.PrimaryKeyDescriptor
-	(*SecondaryIndexDescriptor)(nil),    // 2: cosmos.orm.v1.SecondaryIndexDescriptor
-	(*SingletonDescriptor)(nil),         // 3: cosmos.orm.v1.SingletonDescriptor
-	(*descriptorpb.MessageOptions)(nil), // 4: google.protobuf.MessageOptions
+	(FieldCodec)(0),                     // 0: cosmos.orm.v1.FieldCodec
+	(*TableDescriptor)(nil),             // 1: cosmos.orm.v1.TableDescriptor
+	(*PrimaryKeyDescriptor)(nil),        // 2: cosmos.orm.v1.PrimaryKeyDescriptor
+	(*SecondaryIndexDescriptor)(nil),    // 3: cosmos.orm.v1.SecondaryIndexDescriptor
+	(*SingletonDescriptor)(nil),         // 4: cosmos.orm.v1.SingletonDescriptor
+	(*descriptorpb.MessageOptions)(nil), // 5: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 6: google.protobuf.FieldOptions
 }
 var file_cosmos_orm_v1_orm_proto_depIdxs = []int32{
-	1, // 0: cosmos.orm.v1.TableDescriptor.primary_key:type_name -> cosmos.orm.v1.PrimaryKeyDescriptor
-	2, // 1: cosmos.orm.v1.TableDescriptor.index:type_name -> cosmos.orm.v1.SecondaryIndexDescriptor
-	4, // 2: cosmos.orm.v1.table:extendee -> google.protobuf.MessageOptions
-	4, // 3: cosmos.orm.v1.singleton:extendee -> google.protobuf.MessageOptions
-	0, // 4: cosmos.orm.v1.table:type_name -> cosmos.orm.v1.TableDescriptor
-	3, // 5: cosmos.orm.v1.singleton:type_name -> cosmos.orm.v1.SingletonDescriptor
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	4, // [4:6] is the sub-list for extension type_name
-	2, // [2:4] is the sub-list for extension extendee
+	2, // 0: cosmos.orm.v1.TableDescriptor.primary_key:type_name -> cosmos.orm.v1.PrimaryKeyDescriptor
+	3, // 1: cosmos.orm.v1.TableDescriptor.index:type_name -> cosmos.orm.v1.SecondaryIndexDescriptor
+	5, // 2: cosmos.orm.v1.table:extendee -> google.protobuf.MessageOptions
+	5, // 3: cosmos.orm.v1.singleton:extendee -> google.protobuf.MessageOptions
+	6, // 4: cosmos.orm.v1.field_codec:extendee -> google.protobuf.FieldOptions
+	1, // 5: cosmos.orm.v1.table:type_name -> cosmos.orm.v1.TableDescriptor
+	4, // 6: cosmos.orm.v1.singleton:type_name -> cosmos.orm.v1.SingletonDescriptor
+	0, // 7: cosmos.orm.v1.field_codec:type_name -> cosmos.orm.v1.FieldCodec
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	5, // [5:8] is the sub-list for extension type_name
+	2, // [2:5] is the sub-list for extension extendee
 	0, // [0:2] is the sub-list for field type_name
 }
 
@@ -2470,13 +2570,14 @@ func file_cosmos_orm_v1_orm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_orm_v1_orm_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   4,
-			NumExtensions: 2,
+			NumExtensions: 3,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_orm_v1_orm_proto_goTypes,
 		DependencyIndexes: file_cosmos_orm_v1_orm_proto_depIdxs,
+		EnumInfos:         file_cosmos_orm_v1_orm_proto_enumTypes,
 		MessageInfos:      file_cosmos_orm_v1_orm_proto_msgTypes,
 		ExtensionInfos:    file_cosmos_orm_v1_orm_proto_extTypes,
 	}.Build()
@@ -28,6 +28,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// ValidatorDowntimeOffenses queries the downtime offense history of given
+	// cons address
+	ValidatorDowntimeOffenses(ctx context.Context, in *QueryValidatorDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryValidatorDowntimeOffensesResponse, error)
+	// DowntimeOffenses queries the downtime offense history of all validators
+	DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error)
 }
 
 type queryClient struct {
@@ -65,6 +70,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) ValidatorDowntimeOffenses(ctx context.Context, in *QueryValidatorDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryValidatorDowntimeOffensesResponse, error) {
+	out := new(QueryValidatorDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/ValidatorDowntimeOffenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error) {
+	out := new(QueryDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimeOffenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -75,6 +98,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// ValidatorDowntimeOffenses queries the downtime offense history of given
+	// cons address
+	ValidatorDowntimeOffenses(context.Context, *QueryValidatorDowntimeOffensesRequest) (*QueryValidatorDowntimeOffensesResponse, error)
+	// DowntimeOffenses queries the downtime offense history of all validators
+	DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -91,6 +119,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) ValidatorDowntimeOffenses(context.Context, *QueryValidatorDowntimeOffensesRequest) (*QueryValidatorDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDowntimeOffenses not implemented")
+}
+func (UnimplementedQueryServer) DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeOffenses not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +192,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorDowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/ValidatorDowntimeOffenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDowntimeOffenses(ctx, req.(*QueryValidatorDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimeOffenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeOffenses(ctx, req.(*QueryDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +247,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "ValidatorDowntimeOffenses",
+			Handler:    _Query_ValidatorDowntimeOffenses_Handler,
+		},
+		{
+			MethodName: "DowntimeOffenses",
+			Handler:    _Query_DowntimeOffenses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
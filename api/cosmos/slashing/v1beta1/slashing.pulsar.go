@@ -17,13 +17,15 @@ import (
 )
 
 var (
-	md_ValidatorSigningInfo                       protoreflect.MessageDescriptor
-	fd_ValidatorSigningInfo_address               protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_start_height          protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_index_offset          protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_jailed_until          protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_tombstoned            protoreflect.FieldDescriptor
-	fd_ValidatorSigningInfo_missed_blocks_counter protoreflect.FieldDescriptor
+	md_ValidatorSigningInfo                            protoreflect.MessageDescriptor
+	fd_ValidatorSigningInfo_address                    protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_start_height               protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_index_offset               protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_jailed_until               protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_tombstoned                 protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_missed_blocks_counter      protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_recent_downtime_offenses   protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_last_downtime_offense_time protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +37,8 @@ func init() {
 	fd_ValidatorSigningInfo_jailed_until = md_ValidatorSigningInfo.Fields().ByName("jailed_until")
 	fd_ValidatorSigningInfo_tombstoned = md_ValidatorSigningInfo.Fields().ByName("tombstoned")
 	fd_ValidatorSigningInfo_missed_blocks_counter = md_ValidatorSigningInfo.Fields().ByName("missed_blocks_counter")
+	fd_ValidatorSigningInfo_recent_downtime_offenses = md_ValidatorSigningInfo.Fields().ByName("recent_downtime_offenses")
+	fd_ValidatorSigningInfo_last_downtime_offense_time = md_ValidatorSigningInfo.Fields().ByName("last_downtime_offense_time")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValidatorSigningInfo)(nil)
@@ -138,6 +142,18 @@ func (x *fastReflection_ValidatorSigningInfo) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.RecentDowntimeOffenses != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecentDowntimeOffenses)
+		if !f(fd_ValidatorSigningInfo_recent_downtime_offenses, value) {
+			return
+		}
+	}
+	if x.LastDowntimeOffenseTime != nil {
+		value := protoreflect.ValueOfMessage(x.LastDowntimeOffenseTime.ProtoReflect())
+		if !f(fd_ValidatorSigningInfo_last_downtime_offense_time, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -165,6 +181,10 @@ func (x *fastReflection_ValidatorSigningInfo) Has(fd protoreflect.FieldDescripto
 		return x.Tombstoned != false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return x.MissedBlocksCounter != int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.recent_downtime_offenses":
+		return x.RecentDowntimeOffenses != uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense_time":
+		return x.LastDowntimeOffenseTime != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -193,6 +213,10 @@ func (x *fastReflection_ValidatorSigningInfo) Clear(fd protoreflect.FieldDescrip
 		x.Tombstoned = false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.recent_downtime_offenses":
+		x.RecentDowntimeOffenses = uint64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense_time":
+		x.LastDowntimeOffenseTime = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -227,6 +251,12 @@ func (x *fastReflection_ValidatorSigningInfo) Get(descriptor protoreflect.FieldD
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		value := x.MissedBlocksCounter
 		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.recent_downtime_offenses":
+		value := x.RecentDowntimeOffenses
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense_time":
+		value := x.LastDowntimeOffenseTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -259,6 +289,10 @@ func (x *fastReflection_ValidatorSigningInfo) Set(fd protoreflect.FieldDescripto
 		x.Tombstoned = value.Bool()
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = value.Int()
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.recent_downtime_offenses":
+		x.RecentDowntimeOffenses = value.Uint()
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense_time":
+		x.LastDowntimeOffenseTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -284,6 +318,11 @@ func (x *fastReflection_ValidatorSigningInfo) Mutable(fd protoreflect.FieldDescr
 			x.JailedUntil = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.JailedUntil.ProtoReflect())
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense_time":
+		if x.LastDowntimeOffenseTime == nil {
+			x.LastDowntimeOffenseTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastDowntimeOffenseTime.ProtoReflect())
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.address":
 		panic(fmt.Errorf("field address of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.start_height":
@@ -294,6 +333,8 @@ func (x *fastReflection_ValidatorSigningInfo) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field tombstoned of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		panic(fmt.Errorf("field missed_blocks_counter of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.recent_downtime_offenses":
+		panic(fmt.Errorf("field recent_downtime_offenses of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -320,6 +361,11 @@ func (x *fastReflection_ValidatorSigningInfo) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.recent_downtime_offenses":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -409,6 +455,13 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 		if x.MissedBlocksCounter != 0 {
 			n += 1 + runtime.Sov(uint64(x.MissedBlocksCounter))
 		}
+		if x.RecentDowntimeOffenses != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecentDowntimeOffenses))
+		}
+		if x.LastDowntimeOffenseTime != nil {
+			l = options.Size(x.LastDowntimeOffenseTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -438,6 +491,25 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.LastDowntimeOffenseTime != nil {
+			encoded, err := options.Marshal(x.LastDowntimeOffenseTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.RecentDowntimeOffenses != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecentDowntimeOffenses))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.MissedBlocksCounter != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedBlocksCounter))
 			i--
@@ -678,6 +750,61 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecentDowntimeOffenses", wireType)
+				}
+				x.RecentDowntimeOffenses = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecentDowntimeOffenses |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeOffenseTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastDowntimeOffenseTime == nil {
+					x.LastDowntimeOffenseTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastDowntimeOffenseTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -714,33 +841,35 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_Params                            protoreflect.MessageDescriptor
-	fd_Params_signed_blocks_window       protoreflect.FieldDescriptor
-	fd_Params_min_signed_per_window      protoreflect.FieldDescriptor
-	fd_Params_downtime_jail_duration     protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_double_sign protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_downtime    protoreflect.FieldDescriptor
+	md_DowntimeOffense                protoreflect.MessageDescriptor
+	fd_DowntimeOffense_address        protoreflect.FieldDescriptor
+	fd_DowntimeOffense_height         protoreflect.FieldDescriptor
+	fd_DowntimeOffense_time           protoreflect.FieldDescriptor
+	fd_DowntimeOffense_offense_count  protoreflect.FieldDescriptor
+	fd_DowntimeOffense_slash_fraction protoreflect.FieldDescriptor
+	fd_DowntimeOffense_jail_duration  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_slashing_v1beta1_slashing_proto_init()
-	md_Params = File_cosmos_slashing_v1beta1_slashing_proto.Messages().ByName("Params")
-	fd_Params_signed_blocks_window = md_Params.Fields().ByName("signed_blocks_window")
-	fd_Params_min_signed_per_window = md_Params.Fields().ByName("min_signed_per_window")
-	fd_Params_downtime_jail_duration = md_Params.Fields().ByName("downtime_jail_duration")
-	fd_Params_slash_fraction_double_sign = md_Params.Fields().ByName("slash_fraction_double_sign")
-	fd_Params_slash_fraction_downtime = md_Params.Fields().ByName("slash_fraction_downtime")
+	md_DowntimeOffense = File_cosmos_slashing_v1beta1_slashing_proto.Messages().ByName("DowntimeOffense")
+	fd_DowntimeOffense_address = md_DowntimeOffense.Fields().ByName("address")
+	fd_DowntimeOffense_height = md_DowntimeOffense.Fields().ByName("height")
+	fd_DowntimeOffense_time = md_DowntimeOffense.Fields().ByName("time")
+	fd_DowntimeOffense_offense_count = md_DowntimeOffense.Fields().ByName("offense_count")
+	fd_DowntimeOffense_slash_fraction = md_DowntimeOffense.Fields().ByName("slash_fraction")
+	fd_DowntimeOffense_jail_duration = md_DowntimeOffense.Fields().ByName("jail_duration")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_DowntimeOffense)(nil)
 
-type fastReflection_Params Params
+type fastReflection_DowntimeOffense DowntimeOffense
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *DowntimeOffense) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DowntimeOffense)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *DowntimeOffense) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -752,43 +881,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_DowntimeOffense_messageType fastReflection_DowntimeOffense_messageType
+var _ protoreflect.MessageType = fastReflection_DowntimeOffense_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_DowntimeOffense_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_DowntimeOffense_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DowntimeOffense)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_DowntimeOffense_messageType) New() protoreflect.Message {
+	return new(fastReflection_DowntimeOffense)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_DowntimeOffense_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DowntimeOffense
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_DowntimeOffense) Descriptor() protoreflect.MessageDescriptor {
+	return md_DowntimeOffense
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_DowntimeOffense) Type() protoreflect.MessageType {
+	return _fastReflection_DowntimeOffense_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_DowntimeOffense) New() protoreflect.Message {
+	return new(fastReflection_DowntimeOffense)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_DowntimeOffense) Interface() protoreflect.ProtoMessage {
+	return (*DowntimeOffense)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -796,34 +925,40 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SignedBlocksWindow != int64(0) {
-		value := protoreflect.ValueOfInt64(x.SignedBlocksWindow)
-		if !f(fd_Params_signed_blocks_window, value) {
+func (x *fastReflection_DowntimeOffense) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_DowntimeOffense_address, value) {
 			return
 		}
 	}
-	if len(x.MinSignedPerWindow) != 0 {
-		value := protoreflect.ValueOfBytes(x.MinSignedPerWindow)
-		if !f(fd_Params_min_signed_per_window, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_DowntimeOffense_height, value) {
 			return
 		}
 	}
-	if x.DowntimeJailDuration != nil {
-		value := protoreflect.ValueOfMessage(x.DowntimeJailDuration.ProtoReflect())
-		if !f(fd_Params_downtime_jail_duration, value) {
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_DowntimeOffense_time, value) {
 			return
 		}
 	}
-	if len(x.SlashFractionDoubleSign) != 0 {
-		value := protoreflect.ValueOfBytes(x.SlashFractionDoubleSign)
-		if !f(fd_Params_slash_fraction_double_sign, value) {
+	if x.OffenseCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OffenseCount)
+		if !f(fd_DowntimeOffense_offense_count, value) {
 			return
 		}
 	}
-	if len(x.SlashFractionDowntime) != 0 {
-		value := protoreflect.ValueOfBytes(x.SlashFractionDowntime)
-		if !f(fd_Params_slash_fraction_downtime, value) {
+	if len(x.SlashFraction) != 0 {
+		value := protoreflect.ValueOfBytes(x.SlashFraction)
+		if !f(fd_DowntimeOffense_slash_fraction, value) {
+			return
+		}
+	}
+	if x.JailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+		if !f(fd_DowntimeOffense_jail_duration, value) {
 			return
 		}
 	}
@@ -840,23 +975,25 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DowntimeOffense) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
-		return x.SignedBlocksWindow != int64(0)
-	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
-		return len(x.MinSignedPerWindow) != 0
-	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
-		return x.DowntimeJailDuration != nil
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
-		return len(x.SlashFractionDoubleSign) != 0
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
-		return len(x.SlashFractionDowntime) != 0
+	case "cosmos.slashing.v1beta1.DowntimeOffense.address":
+		return x.Address != ""
+	case "cosmos.slashing.v1beta1.DowntimeOffense.height":
+		return x.Height != int64(0)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.time":
+		return x.Time != nil
+	case "cosmos.slashing.v1beta1.DowntimeOffense.offense_count":
+		return x.OffenseCount != uint64(0)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.slash_fraction":
+		return len(x.SlashFraction) != 0
+	case "cosmos.slashing.v1beta1.DowntimeOffense.jail_duration":
+		return x.JailDuration != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeOffense"))
 		}
-		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeOffense does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -866,23 +1003,25 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DowntimeOffense) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
-		x.SignedBlocksWindow = int64(0)
-	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
-		x.MinSignedPerWindow = nil
-	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
-		x.DowntimeJailDuration = nil
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
-		x.SlashFractionDoubleSign = nil
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
-		x.SlashFractionDowntime = nil
+	case "cosmos.slashing.v1beta1.DowntimeOffense.address":
+		x.Address = ""
+	case "cosmos.slashing.v1beta1.DowntimeOffense.height":
+		x.Height = int64(0)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.time":
+		x.Time = nil
+	case "cosmos.slashing.v1beta1.DowntimeOffense.offense_count":
+		x.OffenseCount = uint64(0)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.slash_fraction":
+		x.SlashFraction = nil
+	case "cosmos.slashing.v1beta1.DowntimeOffense.jail_duration":
+		x.JailDuration = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeOffense"))
 		}
-		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeOffense does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -892,28 +1031,31 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DowntimeOffense) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
-		value := x.SignedBlocksWindow
+	case "cosmos.slashing.v1beta1.DowntimeOffense.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.height":
+		value := x.Height
 		return protoreflect.ValueOfInt64(value)
-	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
-		value := x.MinSignedPerWindow
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
-		value := x.DowntimeJailDuration
+	case "cosmos.slashing.v1beta1.DowntimeOffense.time":
+		value := x.Time
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
-		value := x.SlashFractionDoubleSign
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
-		value := x.SlashFractionDowntime
+	case "cosmos.slashing.v1beta1.DowntimeOffense.offense_count":
+		value := x.OffenseCount
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.slash_fraction":
+		value := x.SlashFraction
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeOffense"))
 		}
-		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeOffense does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -927,23 +1069,25 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DowntimeOffense) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
-		x.SignedBlocksWindow = value.Int()
-	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
-		x.MinSignedPerWindow = value.Bytes()
-	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
-		x.DowntimeJailDuration = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
-		x.SlashFractionDoubleSign = value.Bytes()
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
-		x.SlashFractionDowntime = value.Bytes()
+	case "cosmos.slashing.v1beta1.DowntimeOffense.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.height":
+		x.Height = value.Int()
+	case "cosmos.slashing.v1beta1.DowntimeOffense.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.slashing.v1beta1.DowntimeOffense.offense_count":
+		x.OffenseCount = value.Uint()
+	case "cosmos.slashing.v1beta1.DowntimeOffense.slash_fraction":
+		x.SlashFraction = value.Bytes()
+	case "cosmos.slashing.v1beta1.DowntimeOffense.jail_duration":
+		x.JailDuration = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeOffense"))
 		}
-		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeOffense does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -957,60 +1101,68 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DowntimeOffense) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
-		if x.DowntimeJailDuration == nil {
-			x.DowntimeJailDuration = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.DowntimeJailDuration.ProtoReflect())
-	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
-		panic(fmt.Errorf("field signed_blocks_window of message cosmos.slashing.v1beta1.Params is not mutable"))
-	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
-		panic(fmt.Errorf("field min_signed_per_window of message cosmos.slashing.v1beta1.Params is not mutable"))
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
-		panic(fmt.Errorf("field slash_fraction_double_sign of message cosmos.slashing.v1beta1.Params is not mutable"))
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
-		panic(fmt.Errorf("field slash_fraction_downtime of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.DowntimeOffense.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cosmos.slashing.v1beta1.DowntimeOffense.jail_duration":
+		if x.JailDuration == nil {
+			x.JailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+	case "cosmos.slashing.v1beta1.DowntimeOffense.address":
+		panic(fmt.Errorf("field address of message cosmos.slashing.v1beta1.DowntimeOffense is not mutable"))
+	case "cosmos.slashing.v1beta1.DowntimeOffense.height":
+		panic(fmt.Errorf("field height of message cosmos.slashing.v1beta1.DowntimeOffense is not mutable"))
+	case "cosmos.slashing.v1beta1.DowntimeOffense.offense_count":
+		panic(fmt.Errorf("field offense_count of message cosmos.slashing.v1beta1.DowntimeOffense is not mutable"))
+	case "cosmos.slashing.v1beta1.DowntimeOffense.slash_fraction":
+		panic(fmt.Errorf("field slash_fraction of message cosmos.slashing.v1beta1.DowntimeOffense is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeOffense"))
 		}
-		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeOffense does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DowntimeOffense) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
+	case "cosmos.slashing.v1beta1.DowntimeOffense.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.slashing.v1beta1.DowntimeOffense.height":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
+	case "cosmos.slashing.v1beta1.DowntimeOffense.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.slashing.v1beta1.DowntimeOffense.offense_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.slashing.v1beta1.DowntimeOffense.slash_fraction":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
+	case "cosmos.slashing.v1beta1.DowntimeOffense.jail_duration":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeOffense"))
 		}
-		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeOffense does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DowntimeOffense) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.slashing.v1beta1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.slashing.v1beta1.DowntimeOffense", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1018,7 +1170,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DowntimeOffense) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1029,7 +1181,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DowntimeOffense) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1041,7 +1193,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_DowntimeOffense) IsValid() bool {
 	return x != nil
 }
 
@@ -1051,9 +1203,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DowntimeOffense) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*DowntimeOffense)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1065,23 +1217,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.SignedBlocksWindow != 0 {
-			n += 1 + runtime.Sov(uint64(x.SignedBlocksWindow))
-		}
-		l = len(x.MinSignedPerWindow)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.DowntimeJailDuration != nil {
-			l = options.Size(x.DowntimeJailDuration)
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SlashFractionDoubleSign)
+		if x.OffenseCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.OffenseCount))
+		}
+		l = len(x.SlashFraction)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.SlashFractionDowntime)
-		if l > 0 {
+		if x.JailDuration != nil {
+			l = options.Size(x.JailDuration)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1094,7 +1249,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*DowntimeOffense)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1113,22 +1268,34 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.SlashFractionDowntime) > 0 {
-			i -= len(x.SlashFractionDowntime)
-			copy(dAtA[i:], x.SlashFractionDowntime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFractionDowntime)))
+		if x.JailDuration != nil {
+			encoded, err := options.Marshal(x.JailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SlashFraction) > 0 {
+			i -= len(x.SlashFraction)
+			copy(dAtA[i:], x.SlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFraction)))
 			i--
 			dAtA[i] = 0x2a
 		}
-		if len(x.SlashFractionDoubleSign) > 0 {
-			i -= len(x.SlashFractionDoubleSign)
-			copy(dAtA[i:], x.SlashFractionDoubleSign)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFractionDoubleSign)))
+		if x.OffenseCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OffenseCount))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x20
 		}
-		if x.DowntimeJailDuration != nil {
-			encoded, err := options.Marshal(x.DowntimeJailDuration)
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1141,10 +1308,892 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.MinSignedPerWindow) > 0 {
-			i -= len(x.MinSignedPerWindow)
-			copy(dAtA[i:], x.MinSignedPerWindow)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSignedPerWindow)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DowntimeOffense)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DowntimeOffense: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DowntimeOffense: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OffenseCount", wireType)
+				}
+				x.OffenseCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OffenseCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFraction = append(x.SlashFraction[:0], dAtA[iNdEx:postIndex]...)
+				if x.SlashFraction == nil {
+					x.SlashFraction = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.JailDuration == nil {
+					x.JailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Params                                    protoreflect.MessageDescriptor
+	fd_Params_signed_blocks_window               protoreflect.FieldDescriptor
+	fd_Params_min_signed_per_window              protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_duration             protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_double_sign         protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_downtime            protoreflect.FieldDescriptor
+	fd_Params_downtime_offense_decay_window      protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_duration_multiplier  protoreflect.FieldDescriptor
+	fd_Params_max_downtime_jail_duration         protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_downtime_multiplier protoreflect.FieldDescriptor
+	fd_Params_max_slash_fraction_downtime        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_slashing_v1beta1_slashing_proto_init()
+	md_Params = File_cosmos_slashing_v1beta1_slashing_proto.Messages().ByName("Params")
+	fd_Params_signed_blocks_window = md_Params.Fields().ByName("signed_blocks_window")
+	fd_Params_min_signed_per_window = md_Params.Fields().ByName("min_signed_per_window")
+	fd_Params_downtime_jail_duration = md_Params.Fields().ByName("downtime_jail_duration")
+	fd_Params_slash_fraction_double_sign = md_Params.Fields().ByName("slash_fraction_double_sign")
+	fd_Params_slash_fraction_downtime = md_Params.Fields().ByName("slash_fraction_downtime")
+	fd_Params_downtime_offense_decay_window = md_Params.Fields().ByName("downtime_offense_decay_window")
+	fd_Params_downtime_jail_duration_multiplier = md_Params.Fields().ByName("downtime_jail_duration_multiplier")
+	fd_Params_max_downtime_jail_duration = md_Params.Fields().ByName("max_downtime_jail_duration")
+	fd_Params_slash_fraction_downtime_multiplier = md_Params.Fields().ByName("slash_fraction_downtime_multiplier")
+	fd_Params_max_slash_fraction_downtime = md_Params.Fields().ByName("max_slash_fraction_downtime")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignedBlocksWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SignedBlocksWindow)
+		if !f(fd_Params_signed_blocks_window, value) {
+			return
+		}
+	}
+	if len(x.MinSignedPerWindow) != 0 {
+		value := protoreflect.ValueOfBytes(x.MinSignedPerWindow)
+		if !f(fd_Params_min_signed_per_window, value) {
+			return
+		}
+	}
+	if x.DowntimeJailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeJailDuration.ProtoReflect())
+		if !f(fd_Params_downtime_jail_duration, value) {
+			return
+		}
+	}
+	if len(x.SlashFractionDoubleSign) != 0 {
+		value := protoreflect.ValueOfBytes(x.SlashFractionDoubleSign)
+		if !f(fd_Params_slash_fraction_double_sign, value) {
+			return
+		}
+	}
+	if len(x.SlashFractionDowntime) != 0 {
+		value := protoreflect.ValueOfBytes(x.SlashFractionDowntime)
+		if !f(fd_Params_slash_fraction_downtime, value) {
+			return
+		}
+	}
+	if x.DowntimeOffenseDecayWindow != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeOffenseDecayWindow.ProtoReflect())
+		if !f(fd_Params_downtime_offense_decay_window, value) {
+			return
+		}
+	}
+	if len(x.DowntimeJailDurationMultiplier) != 0 {
+		value := protoreflect.ValueOfBytes(x.DowntimeJailDurationMultiplier)
+		if !f(fd_Params_downtime_jail_duration_multiplier, value) {
+			return
+		}
+	}
+	if x.MaxDowntimeJailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDowntimeJailDuration.ProtoReflect())
+		if !f(fd_Params_max_downtime_jail_duration, value) {
+			return
+		}
+	}
+	if len(x.SlashFractionDowntimeMultiplier) != 0 {
+		value := protoreflect.ValueOfBytes(x.SlashFractionDowntimeMultiplier)
+		if !f(fd_Params_slash_fraction_downtime_multiplier, value) {
+			return
+		}
+	}
+	if len(x.MaxSlashFractionDowntime) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxSlashFractionDowntime)
+		if !f(fd_Params_max_slash_fraction_downtime, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
+		return x.SignedBlocksWindow != int64(0)
+	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
+		return len(x.MinSignedPerWindow) != 0
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
+		return x.DowntimeJailDuration != nil
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
+		return len(x.SlashFractionDoubleSign) != 0
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
+		return len(x.SlashFractionDowntime) != 0
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay_window":
+		return x.DowntimeOffenseDecayWindow != nil
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		return len(x.DowntimeJailDurationMultiplier) != 0
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		return x.MaxDowntimeJailDuration != nil
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		return len(x.SlashFractionDowntimeMultiplier) != 0
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		return len(x.MaxSlashFractionDowntime) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
+		x.SignedBlocksWindow = int64(0)
+	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
+		x.MinSignedPerWindow = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
+		x.DowntimeJailDuration = nil
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
+		x.SlashFractionDoubleSign = nil
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
+		x.SlashFractionDowntime = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay_window":
+		x.DowntimeOffenseDecayWindow = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		x.DowntimeJailDurationMultiplier = nil
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		x.MaxDowntimeJailDuration = nil
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		x.SlashFractionDowntimeMultiplier = nil
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		x.MaxSlashFractionDowntime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
+		value := x.SignedBlocksWindow
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
+		value := x.MinSignedPerWindow
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
+		value := x.DowntimeJailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
+		value := x.SlashFractionDoubleSign
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
+		value := x.SlashFractionDowntime
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay_window":
+		value := x.DowntimeOffenseDecayWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		value := x.DowntimeJailDurationMultiplier
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		value := x.MaxDowntimeJailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		value := x.SlashFractionDowntimeMultiplier
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		value := x.MaxSlashFractionDowntime
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
+		x.SignedBlocksWindow = value.Int()
+	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
+		x.MinSignedPerWindow = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
+		x.DowntimeJailDuration = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
+		x.SlashFractionDoubleSign = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
+		x.SlashFractionDowntime = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay_window":
+		x.DowntimeOffenseDecayWindow = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		x.DowntimeJailDurationMultiplier = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		x.MaxDowntimeJailDuration = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		x.SlashFractionDowntimeMultiplier = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		x.MaxSlashFractionDowntime = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
+		if x.DowntimeJailDuration == nil {
+			x.DowntimeJailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeJailDuration.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay_window":
+		if x.DowntimeOffenseDecayWindow == nil {
+			x.DowntimeOffenseDecayWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeOffenseDecayWindow.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		if x.MaxDowntimeJailDuration == nil {
+			x.MaxDowntimeJailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDowntimeJailDuration.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
+		panic(fmt.Errorf("field signed_blocks_window of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
+		panic(fmt.Errorf("field min_signed_per_window of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
+		panic(fmt.Errorf("field slash_fraction_double_sign of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
+		panic(fmt.Errorf("field slash_fraction_downtime of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		panic(fmt.Errorf("field downtime_jail_duration_multiplier of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		panic(fmt.Errorf("field slash_fraction_downtime_multiplier of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		panic(fmt.Errorf("field max_slash_fraction_downtime of message cosmos.slashing.v1beta1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_double_sign":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay_window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.slashing.v1beta1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SignedBlocksWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.SignedBlocksWindow))
+		}
+		l = len(x.MinSignedPerWindow)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeJailDuration != nil {
+			l = options.Size(x.DowntimeJailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashFractionDoubleSign)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashFractionDowntime)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeOffenseDecayWindow != nil {
+			l = options.Size(x.DowntimeOffenseDecayWindow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DowntimeJailDurationMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxDowntimeJailDuration != nil {
+			l = options.Size(x.MaxDowntimeJailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashFractionDowntimeMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxSlashFractionDowntime)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxSlashFractionDowntime) > 0 {
+			i -= len(x.MaxSlashFractionDowntime)
+			copy(dAtA[i:], x.MaxSlashFractionDowntime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSlashFractionDowntime)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.SlashFractionDowntimeMultiplier) > 0 {
+			i -= len(x.SlashFractionDowntimeMultiplier)
+			copy(dAtA[i:], x.SlashFractionDowntimeMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFractionDowntimeMultiplier)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.MaxDowntimeJailDuration != nil {
+			encoded, err := options.Marshal(x.MaxDowntimeJailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.DowntimeJailDurationMultiplier) > 0 {
+			i -= len(x.DowntimeJailDurationMultiplier)
+			copy(dAtA[i:], x.DowntimeJailDurationMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DowntimeJailDurationMultiplier)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.DowntimeOffenseDecayWindow != nil {
+			encoded, err := options.Marshal(x.DowntimeOffenseDecayWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SlashFractionDowntime) > 0 {
+			i -= len(x.SlashFractionDowntime)
+			copy(dAtA[i:], x.SlashFractionDowntime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFractionDowntime)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SlashFractionDoubleSign) > 0 {
+			i -= len(x.SlashFractionDoubleSign)
+			copy(dAtA[i:], x.SlashFractionDoubleSign)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFractionDoubleSign)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DowntimeJailDuration != nil {
+			encoded, err := options.Marshal(x.DowntimeJailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinSignedPerWindow) > 0 {
+			i -= len(x.MinSignedPerWindow)
+			copy(dAtA[i:], x.MinSignedPerWindow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSignedPerWindow)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -1359,6 +2408,180 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					x.SlashFractionDowntime = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseDecayWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimeOffenseDecayWindow == nil {
+					x.DowntimeOffenseDecayWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeOffenseDecayWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DowntimeJailDurationMultiplier = append(x.DowntimeJailDurationMultiplier[:0], dAtA[iNdEx:postIndex]...)
+				if x.DowntimeJailDurationMultiplier == nil {
+					x.DowntimeJailDurationMultiplier = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxDowntimeJailDuration == nil {
+					x.MaxDowntimeJailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDowntimeJailDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFractionDowntimeMultiplier = append(x.SlashFractionDowntimeMultiplier[:0], dAtA[iNdEx:postIndex]...)
+				if x.SlashFractionDowntimeMultiplier == nil {
+					x.SlashFractionDowntimeMultiplier = []byte{}
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFractionDowntime", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSlashFractionDowntime = append(x.MaxSlashFractionDowntime[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxSlashFractionDowntime == nil {
+					x.MaxSlashFractionDowntime = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1429,6 +2652,11 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of recent downtime offenses of the validator. The counter decays by
+	// one for every `DowntimeOffenseDecayWindow` elapsed since the last offense.
+	RecentDowntimeOffenses uint64 `protobuf:"varint,7,opt,name=recent_downtime_offenses,json=recentDowntimeOffenses,proto3" json:"recent_downtime_offenses,omitempty"`
+	// Timestamp of the last downtime offense of the validator.
+	LastDowntimeOffenseTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_downtime_offense_time,json=lastDowntimeOffenseTime,proto3" json:"last_downtime_offense_time,omitempty"`
 }
 
 func (x *ValidatorSigningInfo) Reset() {
@@ -1493,6 +2721,102 @@ func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (x *ValidatorSigningInfo) GetRecentDowntimeOffenses() uint64 {
+	if x != nil {
+		return x.RecentDowntimeOffenses
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetLastDowntimeOffenseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDowntimeOffenseTime
+	}
+	return nil
+}
+
+// DowntimeOffense defines a record of a validator being slashed and jailed for
+// downtime.
+type DowntimeOffense struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Height at which the offense was punished.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp at which the offense was punished.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Number of recent downtime offenses of the validator, including this one.
+	OffenseCount uint64 `protobuf:"varint,4,opt,name=offense_count,json=offenseCount,proto3" json:"offense_count,omitempty"`
+	// Fraction of the validator stake slashed for the offense.
+	SlashFraction []byte `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// Duration for which the validator was jailed for the offense.
+	JailDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+}
+
+func (x *DowntimeOffense) Reset() {
+	*x = DowntimeOffense{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DowntimeOffense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DowntimeOffense) ProtoMessage() {}
+
+// Deprecated: Use DowntimeOffense.ProtoReflect.Descriptor instead.
+func (*DowntimeOffense) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DowntimeOffense) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DowntimeOffense) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DowntimeOffense) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *DowntimeOffense) GetOffenseCount() uint64 {
+	if x != nil {
+		return x.OffenseCount
+	}
+	return 0
+}
+
+func (x *DowntimeOffense) GetSlashFraction() []byte {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return nil
+}
+
+func (x *DowntimeOffense) GetJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.JailDuration
+	}
+	return nil
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1504,12 +2828,25 @@ type Params struct {
 	DowntimeJailDuration    *durationpb.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
 	SlashFractionDoubleSign []byte               `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
 	SlashFractionDowntime   []byte               `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+	// downtime_offense_decay_window is the duration after which a recent downtime
+	// offense of a validator is forgotten. A zero duration disables the decay.
+	DowntimeOffenseDecayWindow *durationpb.Duration `protobuf:"bytes,6,opt,name=downtime_offense_decay_window,json=downtimeOffenseDecayWindow,proto3" json:"downtime_offense_decay_window,omitempty"`
+	// downtime_jail_duration_multiplier is the factor applied to the downtime
+	// jail duration for every recent downtime offense of a validator.
+	DowntimeJailDurationMultiplier []byte `protobuf:"bytes,7,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3" json:"downtime_jail_duration_multiplier,omitempty"`
+	// max_downtime_jail_duration caps the escalated downtime jail duration.
+	MaxDowntimeJailDuration *durationpb.Duration `protobuf:"bytes,8,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3" json:"max_downtime_jail_duration,omitempty"`
+	// slash_fraction_downtime_multiplier is the factor applied to the downtime
+	// slash fraction for every recent downtime offense of a validator.
+	SlashFractionDowntimeMultiplier []byte `protobuf:"bytes,9,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3" json:"slash_fraction_downtime_multiplier,omitempty"`
+	// max_slash_fraction_downtime caps the escalated downtime slash fraction.
+	MaxSlashFractionDowntime []byte `protobuf:"bytes,10,opt,name=max_slash_fraction_downtime,json=maxSlashFractionDowntime,proto3" json:"max_slash_fraction_downtime,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1]
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1523,7 +2860,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Params) GetSignedBlocksWindow() int64 {
@@ -1561,6 +2898,41 @@ func (x *Params) GetSlashFractionDowntime() []byte {
 	return nil
 }
 
+func (x *Params) GetDowntimeOffenseDecayWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DowntimeOffenseDecayWindow
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeJailDurationMultiplier() []byte {
+	if x != nil {
+		return x.DowntimeJailDurationMultiplier
+	}
+	return nil
+}
+
+func (x *Params) GetMaxDowntimeJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDowntimeJailDuration
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFractionDowntimeMultiplier() []byte {
+	if x != nil {
+		return x.SlashFractionDowntimeMultiplier
+	}
+	return nil
+}
+
+func (x *Params) GetMaxSlashFractionDowntime() []byte {
+	if x != nil {
+		return x.MaxSlashFractionDowntime
+	}
+	return nil
+}
+
 var File_cosmos_slashing_v1beta1_slashing_proto protoreflect.FileDescriptor
 
 var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
@@ -1575,7 +2947,7 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x03, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
 	0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x07,
 	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
 	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
@@ -1594,52 +2966,120 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x32, 0x0a, 0x15, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
 	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
 	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x65, 0x72, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcd, 0x03,
-	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x61, 0x0a, 0x15, 0x6d, 0x69,
-	0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x59, 0x0a,
-	0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
-	0x1f, 0x01, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x1a, 0x73, 0x6c, 0x61, 0x73,
-	0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2e, 0xc8, 0xde,
+	0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x61, 0x0a,
+	0x1a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f,
+	0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe3, 0x02, 0x0a, 0x0f, 0x44,
+	0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x66, 0x66,
+	0x65, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0e, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x6a, 0x61,
+	0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xfe, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x61, 0x0a,
+	0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2e, 0xc8, 0xde,
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x73, 0x6c,
-	0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x66, 0x0a, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x6d, 0x69,
+	0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x59, 0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69,
+	0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a,
+	0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x1a, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x66, 0x0a, 0x17, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x66, 0x0a, 0x1d, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66,
+	0x65, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x1a, 0x64, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x63,
+	0x61, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x79, 0x0a, 0x21, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x1e, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69,
+	0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x17, 0x6d, 0x61,
+	0x78, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7b, 0x0a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66,
 	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x42, 0xf8, 0x01,
-	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02,
-	0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
-	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x3a, 0x3a, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x1f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x42, 0xf8, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x42, 0x0d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43,
+	0x53, 0x58, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x17, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1654,21 +3094,27 @@ func file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP() []byte {
 	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescData
 }
 
-var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cosmos_slashing_v1beta1_slashing_proto_goTypes = []interface{}{
 	(*ValidatorSigningInfo)(nil),  // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo
-	(*Params)(nil),                // 1: cosmos.slashing.v1beta1.Params
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(*DowntimeOffense)(nil),       // 1: cosmos.slashing.v1beta1.DowntimeOffense
+	(*Params)(nil),                // 2: cosmos.slashing.v1beta1.Params
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
 }
 var file_cosmos_slashing_v1beta1_slashing_proto_depIdxs = []int32{
-	2, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
-	3, // 1: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
+	3, // 1: cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense_time:type_name -> google.protobuf.Timestamp
+	3, // 2: cosmos.slashing.v1beta1.DowntimeOffense.time:type_name -> google.protobuf.Timestamp
+	4, // 3: cosmos.slashing.v1beta1.DowntimeOffense.jail_duration:type_name -> google.protobuf.Duration
+	4, // 4: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
+	4, // 5: cosmos.slashing.v1beta1.Params.downtime_offense_decay_window:type_name -> google.protobuf.Duration
+	4, // 6: cosmos.slashing.v1beta1.Params.max_downtime_jail_duration:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_slashing_v1beta1_slashing_proto_init() }
@@ -1690,6 +3136,18 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 			}
 		}
 		file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DowntimeOffense); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -1708,7 +3166,7 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_slashing_v1beta1_slashing_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
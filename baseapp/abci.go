@@ -7,16 +7,22 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"syscall"
 
+	"github.com/armon/go-metrics"
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/rwsetkv"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -277,7 +283,17 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	}()
 
 	ctx := app.getContextForTx(runTxModeDeliver, req.Tx)
+	var recorder *rwsetkv.Recorder
+	if app.rwSetLog != nil {
+		ctx, recorder = recordReadWriteSet(ctx)
+	}
 	res, err := app.txHandler.DeliverTx(ctx, tx.Request{TxBytes: req.Tx})
+	if recorder != nil {
+		app.blockRWSets = append(app.blockRWSets, txReadWriteSet{
+			txHash: tmhash.Sum(req.Tx),
+			rwSet:  recorder.ReadWriteSet(),
+		})
+	}
 	if err != nil {
 		abciRes = sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
 		return abciRes
@@ -301,7 +317,8 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 // height.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
-	header := app.deliverState.ctx.BlockHeader()
+	ctx := app.deliverState.ctx
+	header := ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
@@ -311,6 +328,13 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	if app.rwSetLog != nil {
+		if err := app.writeReadWriteSets(header.Height); err != nil {
+			app.logger.Error("failed to write read/write sets", "height", header.Height, "err", err)
+		}
+		app.blockRWSets = nil
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -330,6 +354,34 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		halt = true
 	}
 
+	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(header.Height)
+	}
+
+	// skip the measurement if the previous one is still running
+	if app.stateSizeInterval > 0 && uint64(header.Height)%app.stateSizeInterval == 0 &&
+		atomic.CompareAndSwapUint32(&app.stateSizeRunning, 0, 1) {
+		go app.emitStateSizes(header.Height)
+	}
+
+	res = abci.ResponseCommit{
+		Data:         commitID.Hash,
+		RetainHeight: retainHeight,
+	}
+
+	// call the streaming service hooks with the Commit response, a streaming
+	// service may request to halt the node once the block is committed
+	for _, streamingListener := range app.abciListeners {
+		if commitListener, ok := streamingListener.(CommitListener); ok {
+			if err := commitListener.ListenCommit(ctx, res); err != nil {
+				app.logger.Error("Commit listening hook failed", "height", header.Height, "err", err)
+				if errors.Is(err, ErrHaltNode) {
+					halt = true
+				}
+			}
+		}
+	}
+
 	if halt {
 		// Halt the binary and allow Tendermint to receive the ResponseCommit
 		// response with the commit ID hash. This will allow the node to successfully
@@ -338,14 +390,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
-	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
-		go app.snapshot(header.Height)
-	}
-
-	return abci.ResponseCommit{
-		Data:         commitID.Hash,
-		RetainHeight: retainHeight,
-	}
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -370,6 +415,40 @@ func (app *BaseApp) halt() {
 	os.Exit(0)
 }
 
+// emitStateSizes sets the gauges of the number of keys and bytes of the state at a height, by store
+// and key prefix. It is run in the background, one measurement at a time.
+func (app *BaseApp) emitStateSizes(height int64) {
+	defer atomic.StoreUint32(&app.stateSizeRunning, 0)
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("failed to compute state sizes", "height", height, "err", r)
+		}
+	}()
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		app.logger.Info("state sizes not supported by the multistore")
+		return
+	}
+
+	sizes, err := rms.StateSizes(height, app.stateSizePrefixes.PrefixFunc(1))
+	if err != nil {
+		app.logger.Error("failed to compute state sizes", "height", height, "err", err)
+		return
+	}
+	for _, size := range sizes {
+		labels := []metrics.Label{
+			telemetry.NewLabel("store", size.Store),
+			telemetry.NewLabel("prefix", fmt.Sprintf("%X", size.Prefix)),
+		}
+		if _, name, ok := app.stateSizePrefixes.Lookup(size.Store, size.Prefix); ok {
+			labels = append(labels, telemetry.NewLabel("name", name))
+		}
+		telemetry.SetGaugeWithLabels([]string{"store", "size", "keys"}, float32(size.Keys), labels)
+		telemetry.SetGaugeWithLabels([]string{"store", "size", "bytes"}, float32(size.KeyBytes+size.ValueBytes), labels)
+	}
+}
+
 // snapshot takes a snapshot of the current state and prunes any old snapshottypes.
 func (app *BaseApp) snapshot(height int64) {
 	if app.snapshotManager == nil {
@@ -740,14 +819,15 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 		case "simulate":
 			txBytes := req.Data
 
-			gInfo, res, err := app.Simulate(txBytes)
+			gInfo, res, rwSet, err := app.simulate(txBytes)
 			if err != nil {
 				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to simulate tx"), app.trace)
 			}
 
 			simRes := &sdk.SimulationResponse{
-				GasInfo: gInfo,
-				Result:  res,
+				GasInfo:      gInfo,
+				Result:       res,
+				ReadWriteSet: rwSet,
 			}
 
 			bz, err := codec.ProtoMarshalJSON(simRes, app.interfaceRegistry)
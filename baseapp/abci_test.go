@@ -1,6 +1,7 @@
 package baseapp_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,6 +11,8 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGetBlockRentionHeight(t *testing.T) {
@@ -155,3 +158,41 @@ func TestBaseAppCreateQueryContext(t *testing.T) {
 		})
 	}
 }
+
+// mockCommitListener is a StreamingService recording the Commit responses it is called with
+type mockCommitListener struct {
+	commits []abci.ResponseCommit
+	heights []int64
+}
+
+func (m *mockCommitListener) Stream(wg *sync.WaitGroup) error { return nil }
+func (m *mockCommitListener) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+func (m *mockCommitListener) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+func (m *mockCommitListener) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+func (m *mockCommitListener) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+func (m *mockCommitListener) Close() error { return nil }
+func (m *mockCommitListener) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	m.commits = append(m.commits, res)
+	m.heights = append(m.heights, ctx.BlockHeight())
+	return nil
+}
+
+func TestCommitListener(t *testing.T) {
+	app := baseapp.NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB())
+	listener := &mockCommitListener{}
+	app.SetStreamingService(listener)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.Commit()
+
+	require.Equal(t, []abci.ResponseCommit{res}, listener.commits)
+	require.Equal(t, []int64{1}, listener.heights)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -62,9 +63,21 @@ type BaseApp struct { // nolint: maligned
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
-	snapshotManager    *snapshots.Manager
-	snapshotInterval   uint64 // block interval between state sync snapshots
-	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotManager     *snapshots.Manager
+	snapshotInterval    uint64 // block interval between state sync snapshots
+	snapshotKeepRecent  uint32 // recent state sync snapshots to keep
+	snapshotFormat      uint32 // format of state sync snapshots, 0 for the current format
+	snapshotCompressor  string // compressor of state sync snapshots in the parallel format
+	snapshotConcurrency int    // sections processed concurrently in the parallel format
+
+	// state size telemetry, emitted at certain intervals
+	stateSizeInterval uint64                  // block interval between state size measurements
+	stateSizePrefixes sdk.StorePrefixRegistry // names of the key prefixes the sizes are grouped by
+	stateSizeRunning  uint32                  // set while a state size measurement is running, accessed atomically
+
+	// read/write set log, recording the keys accessed by the txs of each block
+	rwSetLog    io.Writer        // destination of the log, nil if disabled
+	blockRWSets []txReadWriteSet // read/write sets of the txs delivered in the current block
 
 	// volatile states:
 	//
@@ -276,6 +289,11 @@ func (app *BaseApp) SnapshotManager() *snapshots.Manager {
 	return app.snapshotManager
 }
 
+// StorePrefixes returns the names of the key prefixes of the module stores.
+func (app *BaseApp) StorePrefixes() sdk.StorePrefixRegistry {
+	return app.stateSizePrefixes
+}
+
 // LoadVersion loads the BaseApp application version. It will panic if called
 // more than once on a running baseapp.
 func (app *BaseApp) LoadVersion(version int64) error {
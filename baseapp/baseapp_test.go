@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -222,9 +223,9 @@ func setupBaseAppWithSnapshots(t *testing.T, blocks uint, blockTxs int, options
 				if time.Since(start) > snapshotTimeout {
 					t.Errorf("timed out waiting for snapshot after %v", snapshotTimeout)
 				}
-				snapshot, err := snapshotStore.Get(uint64(height), snapshottypes.CurrentFormat)
+				list, err := snapshotStore.List()
 				require.NoError(t, err)
-				if snapshot != nil {
+				if len(list) > 0 && list[0].Height == uint64(height) {
 					break
 				}
 				time.Sleep(100 * time.Millisecond)
@@ -1265,6 +1266,95 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// The keys accessed by a tx should be returned by Query("/app/simulate", txBytes)
+// and logged on Commit if the read/write set log is enabled.
+func TestReadWriteSet(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+
+	txHandlerOpt := func(bapp *baseapp.BaseApp) {
+		legacyRouter := middleware.NewLegacyRouter()
+		handler := handlerMsgCounter(t, capKey1, deliverKey)
+		legacyRouter.AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			res, err := handler(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+			// Return dummy MsgResponse for the simulation response to be JSON encoded.
+			any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
+			if err != nil {
+				return nil, err
+			}
+			res.MsgResponses = []*codectypes.Any{any}
+			return res, nil
+		}))
+		txHandler := testTxHandler(
+			middleware.TxHandlerOptions{
+				LegacyRouter:     legacyRouter,
+				MsgServiceRouter: middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry),
+				TxDecoder:        testTxDecoder(encCfg.Amino),
+			},
+			customHandlerTxTest(t, capKey1, anteKey),
+		)
+		bapp.SetTxHandler(txHandler)
+	}
+	rwSetLog := &bytes.Buffer{}
+	app := setupBaseApp(t, txHandlerOpt, baseapp.SetReadWriteSetLog(rwSetLog))
+
+	app.InitChain(abci.RequestInitChain{})
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := encCfg.Amino.Marshal(tx)
+	require.NoError(t, err)
+
+	queryResult := app.Query(abci.RequestQuery{Path: "/app/simulate", Data: txBytes})
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+	var simRes sdk.SimulationResponse
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
+	expected := &storetypes.ReadWriteSet{Stores: []*storetypes.StoreReadWriteSet{{
+		StoreKey:   capKey1.Name(),
+		Reads:      [][]byte{anteKey, deliverKey},
+		ReadRanges: []*storetypes.KeyRange{},
+		Writes:     [][]byte{anteKey, deliverKey},
+	}}}
+	require.Equal(t, expected, simRes.ReadWriteSet)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	var entry struct {
+		Height int64 `json:"height"`
+		Txs    []struct {
+			TxHash       string          `json:"tx_hash"`
+			ReadWriteSet json.RawMessage `json:"read_write_set"`
+		} `json:"txs"`
+	}
+	line, err := rwSetLog.ReadBytes('\n')
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(line, &entry))
+	require.Equal(t, int64(1), entry.Height)
+	require.Len(t, entry.Txs, 1)
+	require.Equal(t, fmt.Sprintf("%X", tmhash.Sum(txBytes)), entry.Txs[0].TxHash)
+	var rwSet storetypes.ReadWriteSet
+	require.NoError(t, jsonpb.Unmarshal(bytes.NewReader(entry.Txs[0].ReadWriteSet), &rwSet))
+	require.Equal(t, expected, &rwSet)
+	require.Zero(t, rwSetLog.Len())
+
+	// without a read/write set log, simulations don't record the read/write sets either
+	app = setupBaseApp(t, txHandlerOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	queryResult = app.Query(abci.RequestQuery{Path: "/app/simulate", Data: txBytes})
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+	simRes = sdk.SimulationResponse{}
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
+	require.Nil(t, simRes.ReadWriteSet)
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	txHandlerOpt := func(bapp *baseapp.BaseApp) {
 		legacyRouter := middleware.NewLegacyRouter()
@@ -2066,6 +2156,42 @@ func TestApplySnapshotChunk(t *testing.T) {
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
 }
 
+func TestApplySnapshotChunkParallelFormat(t *testing.T) {
+	source, teardown := setupBaseAppWithSnapshots(t, 4, 10,
+		baseapp.SetSnapshotFormat(snapshottypes.ParallelFormat, snapshots.CompressorZstd),
+		baseapp.SetSnapshotConcurrency(2))
+	defer teardown()
+
+	target, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	respList := source.ListSnapshots(abci.RequestListSnapshots{})
+	require.NotEmpty(t, respList.Snapshots)
+	snapshot := respList.Snapshots[0]
+	require.Equal(t, snapshottypes.ParallelFormat, snapshot.Format)
+
+	respOffer := target.OfferSnapshot(abci.RequestOfferSnapshot{Snapshot: snapshot})
+	require.Equal(t, abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, respOffer)
+
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		respChunk := source.LoadSnapshotChunk(abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		require.NotNil(t, respChunk.Chunk)
+		respApply := target.ApplySnapshotChunk(abci.RequestApplySnapshotChunk{
+			Index: index,
+			Chunk: respChunk.Chunk,
+		})
+		require.Equal(t, abci.ResponseApplySnapshotChunk{
+			Result: abci.ResponseApplySnapshotChunk_ACCEPT,
+		}, respApply)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+}
+
 // NOTE: represents a new custom router for testing purposes of WithRouter()
 type testCustomRouter struct {
 	routes sync.Map
@@ -79,11 +79,31 @@ func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
 }
 
+// SetSnapshotFormat sets the format and compressor of the snapshots taken.
+func SetSnapshotFormat(format uint32, compressor string) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotFormat(format, compressor) }
+}
+
+// SetSnapshotConcurrency sets the number of snapshot sections processed concurrently.
+func SetSnapshotConcurrency(concurrency int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotConcurrency(concurrency) }
+}
+
 // SetSnapshotStore sets the snapshot store.
 func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetStateSizeInterval sets the block interval between the state size gauges.
+func SetStateSizeInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateSizeInterval(interval) }
+}
+
+// SetReadWriteSetLog sets the writer the read/write sets of the txs of each block are logged to.
+func SetReadWriteSetLog(w io.Writer) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetReadWriteSetLog(w) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -211,6 +231,42 @@ func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
 		return
 	}
 	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms, nil)
+	app.configureSnapshotManager()
+}
+
+// SetSnapshotFormat sets the format of the snapshots taken, and the compressor used by
+// snapshots in the parallel format. A zero format keeps the current format.
+func (app *BaseApp) SetSnapshotFormat(format uint32, compressor string) {
+	if app.sealed {
+		panic("SetSnapshotFormat() on sealed BaseApp")
+	}
+	app.snapshotFormat = format
+	app.snapshotCompressor = compressor
+	app.configureSnapshotManager()
+}
+
+// SetSnapshotConcurrency sets the number of sections of parallel format snapshots created or
+// restored concurrently. Zero uses the number of CPUs.
+func (app *BaseApp) SetSnapshotConcurrency(concurrency int) {
+	if app.sealed {
+		panic("SetSnapshotConcurrency() on sealed BaseApp")
+	}
+	app.snapshotConcurrency = concurrency
+	app.configureSnapshotManager()
+}
+
+// configureSnapshotManager applies the snapshot format and concurrency to the snapshot manager,
+// regardless of the order the options are set in.
+func (app *BaseApp) configureSnapshotManager() {
+	if app.snapshotManager == nil {
+		return
+	}
+	if app.snapshotFormat != 0 {
+		if err := app.snapshotManager.SetFormat(app.snapshotFormat, app.snapshotCompressor); err != nil {
+			panic(err)
+		}
+	}
+	app.snapshotManager.SetConcurrency(app.snapshotConcurrency)
 }
 
 // SetSnapshotInterval sets the snapshot interval.
@@ -229,6 +285,34 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetStateSizeInterval sets the block interval between the state size gauges emitted by store
+// and key prefix. A zero interval disables them.
+func (app *BaseApp) SetStateSizeInterval(interval uint64) {
+	if app.sealed {
+		panic("SetStateSizeInterval() on sealed BaseApp")
+	}
+	app.stateSizeInterval = interval
+}
+
+// SetStorePrefixes sets the names of the key prefixes of the module stores. The state sizes
+// are grouped by these prefixes, or else by the first byte of the keys.
+func (app *BaseApp) SetStorePrefixes(prefixes sdk.StorePrefixRegistry) {
+	if app.sealed {
+		panic("SetStorePrefixes() on sealed BaseApp")
+	}
+	app.stateSizePrefixes = prefixes
+}
+
+// SetReadWriteSetLog sets the writer the read/write sets of the txs of each block are logged to
+// on Commit, as one JSON line per block. The keys and key ranges accessed by each tx are
+// recorded only when the writer is not nil.
+func (app *BaseApp) SetReadWriteSetLog(w io.Writer) {
+	if app.sealed {
+		panic("SetReadWriteSetLog() on sealed BaseApp")
+	}
+	app.rwSetLog = w
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -0,0 +1,63 @@
+package baseapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rwsetkv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txReadWriteSet is the read/write set of a tx delivered in the current block.
+type txReadWriteSet struct {
+	txHash []byte
+	rwSet  *storetypes.ReadWriteSet
+}
+
+// blockReadWriteSets is an entry of the read/write set log, holding the read/write sets of the
+// txs of a block in their execution order.
+type blockReadWriteSets struct {
+	Height int64                 `json:"height"`
+	Txs    []blockTxReadWriteSet `json:"txs"`
+}
+
+type blockTxReadWriteSet struct {
+	TxHash       string          `json:"tx_hash"`
+	ReadWriteSet json.RawMessage `json:"read_write_set"`
+}
+
+// recordReadWriteSet returns a context whose multistore records the accesses of the tx to its
+// KVStores, and the Recorder holding them. The context is returned unchanged with a nil Recorder
+// if its multistore is not a CacheMultiStore.
+func recordReadWriteSet(goCtx context.Context) (context.Context, *rwsetkv.Recorder) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cms, ok := ctx.MultiStore().(sdk.CacheMultiStore)
+	if !ok {
+		return goCtx, nil
+	}
+	recorder := rwsetkv.NewRecorder()
+	return sdk.WrapSDKContext(ctx.WithMultiStore(rwsetkv.NewMultiStore(cms, recorder))), recorder
+}
+
+// writeReadWriteSets writes the read/write sets of the txs of the block at the given height to
+// the read/write set log as a JSON line.
+func (app *BaseApp) writeReadWriteSets(height int64) error {
+	entry := blockReadWriteSets{Height: height, Txs: make([]blockTxReadWriteSet, len(app.blockRWSets))}
+	for i, tx := range app.blockRWSets {
+		bz, err := codec.ProtoMarshalJSON(tx.rwSet, nil)
+		if err != nil {
+			return err
+		}
+		entry.Txs[i] = blockTxReadWriteSet{TxHash: fmt.Sprintf("%X", tx.txHash), ReadWriteSet: bz}
+	}
+
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = app.rwSetLog.Write(append(bz, '\n'))
+	return err
+}
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"io"
 	"sync"
 
@@ -20,6 +21,16 @@ type ABCIListener interface {
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
 }
 
+// ErrHaltNode can be wrapped in the error returned by ListenCommit to gracefully
+// halt the node once the block is committed.
+var ErrHaltNode = errors.New("streaming service requested to halt the node")
+
+// CommitListener is an optional interface of an ABCIListener for hooking into the Commit ABCI message of the BaseApp
+type CommitListener interface {
+	// ListenCommit updates the streaming service with the latest Commit response
+	ListenCommit(ctx types.Context, res abci.ResponseCommit) error
+}
+
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
 type StreamingService interface {
 	// Stream is the streaming service loop, awaits kv pairs and writes them to some destination stream or file
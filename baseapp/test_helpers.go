@@ -4,6 +4,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/store/rwsetkv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -36,22 +38,37 @@ func (app *BaseApp) SimCheck(txEncoder sdk.TxEncoder, sdkTx sdk.Tx) (sdk.GasInfo
 
 // Simulate executes a tx in simulate mode to get result and gas info.
 func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+	gasInfo, res, _, err := app.simulate(txBytes)
+	return gasInfo, res, err
+}
+
+// simulate executes a tx in simulate mode to get result and gas info, and the keys and key
+// ranges of the KVStores it reads and writes if read/write sets are logged.
+func (app *BaseApp) simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, *storetypes.ReadWriteSet, error) {
 	ctx := app.getContextForTx(runTxModeSimulate, txBytes)
+	var recorder *rwsetkv.Recorder
+	if app.rwSetLog != nil {
+		ctx, recorder = recordReadWriteSet(ctx)
+	}
 	res, err := app.txHandler.SimulateTx(ctx, tx.Request{TxBytes: txBytes})
 	gasInfo := sdk.GasInfo{
 		GasWanted: res.GasWanted,
 		GasUsed:   res.GasUsed,
 	}
 	if err != nil {
-		return gasInfo, nil, err
+		return gasInfo, nil, nil, err
 	}
 
 	data, err := makeABCIData(res)
 	if err != nil {
-		return gasInfo, nil, err
+		return gasInfo, nil, nil, err
 	}
 
-	return gasInfo, &sdk.Result{Data: data, Log: res.Log, Events: res.Events, MsgResponses: res.MsgResponses}, nil
+	result := &sdk.Result{Data: data, Log: res.Log, Events: res.Events, MsgResponses: res.MsgResponses}
+	if recorder == nil {
+		return gasInfo, result, nil, nil
+	}
+	return gasInfo, result, recorder.ReadWriteSet(), nil
 }
 
 // SimDeliver defines a DeliverTx helper function that used in tests and
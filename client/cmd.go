@@ -1,6 +1,7 @@
 package client
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -171,7 +172,35 @@ func readQueryCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context,
 		clientCtx = clientCtx.WithUseLedger(useLedger)
 	}
 
-	return ReadPersistentCommandFlags(clientCtx, flagSet)
+	clientCtx, err := ReadPersistentCommandFlags(clientCtx, flagSet)
+	if err != nil {
+		return clientCtx, err
+	}
+
+	// the light client depends on the chain ID, node and home directory read above
+	if clientCtx.LightClient == nil || flagSet.Changed(flags.FlagProve) {
+		prove, _ := flagSet.GetBool(flags.FlagProve)
+		if !prove {
+			return clientCtx.WithLightClient(nil), nil
+		}
+
+		trustingPeriod, _ := flagSet.GetDuration(flags.FlagTrustingPeriod)
+		trustHeight, _ := flagSet.GetInt64(flags.FlagTrustHeight)
+		trustHashHex, _ := flagSet.GetString(flags.FlagTrustHash)
+		trustHash, err := hex.DecodeString(trustHashHex)
+		if err != nil {
+			return clientCtx, fmt.Errorf("invalid trusted header hash: %w", err)
+		}
+		witnesses, _ := flagSet.GetStringSlice(flags.FlagWitnesses)
+
+		lightClient, err := NewLightClient(clientCtx, trustingPeriod, trustHeight, trustHash, witnesses)
+		if err != nil {
+			return clientCtx, fmt.Errorf("failed to create light client: %w", err)
+		}
+		clientCtx = clientCtx.WithLightClient(lightClient)
+	}
+
+	return clientCtx, nil
 }
 
 // readTxCommandFlags returns an updated Context with fields set based on flags
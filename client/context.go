@@ -26,6 +26,7 @@ type Context struct {
 	FromAddress       sdk.AccAddress
 	Client            rpcclient.Client
 	GRPCClient        *grpc.ClientConn
+	LightClient       LightClient
 	ChainID           string
 	Codec             codec.Codec
 	InterfaceRegistry codectypes.InterfaceRegistry
@@ -144,6 +145,13 @@ func (ctx Context) WithUseLedger(useLedger bool) Context {
 	return ctx
 }
 
+// WithLightClient returns a copy of the context with an updated light client,
+// used to verify the proofs of query results.
+func (ctx Context) WithLightClient(lightClient LightClient) Context {
+	ctx.LightClient = lightClient
+	return ctx
+}
+
 // WithChainID returns a copy of the context with an updated chain ID.
 func (ctx Context) WithChainID(chainID string) Context {
 	ctx.ChainID = chainID
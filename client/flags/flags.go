@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
@@ -79,6 +80,10 @@ const (
 	FlagReverse          = "reverse"
 	FlagTip              = "tip"
 	FlagAux              = "aux"
+	FlagTrustHeight      = "trust-height"
+	FlagTrustHash        = "trust-hash"
+	FlagTrustingPeriod   = "trusting-period"
+	FlagWitnesses        = "witnesses"
 
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
@@ -94,10 +99,21 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
 	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	AddProveFlagsToCmd(cmd)
 
 	cmd.MarkFlagRequired(FlagChainID)
 }
 
+// AddProveFlagsToCmd adds the light client flags verifying the results of a query command. Only store
+// queries and the gRPC queries with a registered client.QueryVerifier can be verified.
+func AddProveFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagProve, false, "Verify the query results with a light client, only supported by store queries and gRPC queries with a verifier")
+	cmd.Flags().Int64(FlagTrustHeight, 0, "Height of a trusted header initializing the light client verifying queries, if it has no trusted headers yet")
+	cmd.Flags().String(FlagTrustHash, "", "Hex-encoded hash of the trusted header at --trust-height")
+	cmd.Flags().Duration(FlagTrustingPeriod, 168*time.Hour, "Period during which the headers trusted by the light client can be trusted, shorter than the unbonding period")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "Nodes cross-checking the headers served to the light client (default the queried node)")
+}
+
 // AddTxFlagsToCmd adds common flags to a module tx command.
 func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json)")
@@ -56,7 +56,8 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		return err
 	}
 
-	if ctx.GRPCClient != nil {
+	// gRPC query results have no proofs, so verified queries are answered by their verifiers
+	if ctx.GRPCClient != nil && ctx.LightClient == nil {
 		// Case 2-1. Invoke grpc.
 		return ctx.GRPCClient.Invoke(grpcCtx, method, req, reply, opts...)
 	}
@@ -83,20 +84,29 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		ctx = ctx.WithHeight(height)
 	}
 
-	abciReq := abci.RequestQuery{
-		Path:   method,
-		Data:   reqBz,
-		Height: ctx.Height,
-	}
+	var height int64
+	if ctx.LightClient != nil {
+		height, err = ctx.verifyQuery(method, req, reply)
+		if err != nil {
+			return err
+		}
+	} else {
+		abciReq := abci.RequestQuery{
+			Path:   method,
+			Data:   reqBz,
+			Height: ctx.Height,
+		}
 
-	res, err := ctx.QueryABCI(abciReq)
-	if err != nil {
-		return err
-	}
+		res, err := ctx.QueryABCI(abciReq)
+		if err != nil {
+			return err
+		}
 
-	err = ctx.gRPCCodec().Unmarshal(res.Value, reply)
-	if err != nil {
-		return err
+		err = ctx.gRPCCodec().Unmarshal(res.Value, reply)
+		if err != nil {
+			return err
+		}
+		height = res.Height
 	}
 
 	// Create header metadata. For now the headers contain:
@@ -104,7 +114,7 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 	// We then parse all the call options, if the call option is a
 	// HeaderCallOption, then we manually set the value of that header to the
 	// metadata.
-	md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(res.Height, 10))
+	md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
 	for _, callOpt := range opts {
 		header, ok := callOpt.(grpc.HeaderCallOption)
 		if !ok {
@@ -14,6 +14,7 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -96,6 +97,20 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 		Prove:  req.Prove,
 	}
 
+	// queries are verified against the app hashes verified by the light client, if any
+	if ctx.LightClient != nil {
+		if !isQueryStoreWithProof(req.Path) {
+			return abci.ResponseQuery{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+				"query %s cannot be verified, only store key queries have proofs", req.Path)
+		}
+		if opts.Height == 0 {
+			if opts.Height, err = ctx.latestVerifiableHeight(); err != nil {
+				return abci.ResponseQuery{}, err
+			}
+		}
+		opts.Prove = true
+	}
+
 	result, err := node.ABCIQueryWithOptions(context.Background(), req.Path, req.Data, opts)
 	if err != nil {
 		return abci.ResponseQuery{}, err
@@ -106,10 +121,19 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 	}
 
 	// data from trusted node or subspace query doesn't need verification
-	if !opts.Prove || !isQueryStoreWithProof(req.Path) {
+	if ctx.LightClient == nil || !isQueryStoreWithProof(req.Path) {
 		return result.Response, nil
 	}
 
+	if result.Response.Height != opts.Height {
+		return abci.ResponseQuery{}, sdkerrors.Wrapf(storetypes.ErrInvalidProof,
+			"query result is at height %d instead of %d", result.Response.Height, opts.Height)
+	}
+	storeName := strings.SplitN(req.Path[1:], "/", 3)[1]
+	if err := ctx.verifyProof(storeName, req.Data, result.Response); err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
 	return result.Response, nil
 }
 
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/light"
+	lightstore "github.com/tendermint/tendermint/light/store"
+	lightdb "github.com/tendermint/tendermint/light/store/db"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// LightClient provides the headers of a chain verified by a Tendermint light client, such as
+// *light.Client. When a Context has a light client, the results of store queries are verified
+// against the app hashes of these headers, so that the node queried does not need to be trusted.
+type LightClient interface {
+	// Update verifies and returns the latest header of the chain.
+	Update(ctx context.Context, now time.Time) (*tmtypes.LightBlock, error)
+	// VerifyLightBlockAtHeight verifies and returns the header of the chain at a height.
+	VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*tmtypes.LightBlock, error)
+}
+
+// QueryVerifier answers a gRPC query from store queries, which are verified by the light client of
+// the context, since the results of gRPC queries have no proofs. It returns the height of the result.
+type QueryVerifier func(ctx Context, req, reply interface{}) (int64, error)
+
+var queryVerifiers = make(map[string]QueryVerifier)
+
+// RegisterQueryVerifier registers the verifier answering a gRPC query method, such as
+// "/cosmos.bank.v1beta1.Query/Balance", when the context has a light client. Queries without a
+// verifier fail when the context has a light client.
+func RegisterQueryVerifier(method string, verifier QueryVerifier) {
+	if _, ok := queryVerifiers[method]; ok {
+		panic(fmt.Sprintf("query verifier for %s already registered", method))
+	}
+	queryVerifiers[method] = verifier
+}
+
+// verifyQuery answers a gRPC query with its registered verifier.
+func (ctx Context) verifyQuery(method string, req, reply interface{}) (int64, error) {
+	verifier, ok := queryVerifiers[method]
+	if !ok {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query %s cannot be verified, it has no verifier", method)
+	}
+	return verifier(ctx, req, reply)
+}
+
+// NewLightClient creates a light client verifying the headers served by the node of the context and
+// cross-checking them with the witness nodes, which default to the node itself. The trusted headers
+// are stored in the data directory of the home directory of the context, which is only opened while
+// the light client accesses them. The light client is initialized from the headers it previously
+// stored, or else from the header at the trusted height and hash.
+func NewLightClient(ctx Context, trustingPeriod time.Duration, trustHeight int64, trustHash []byte, witnesses []string) (*light.Client, error) {
+	if ctx.ChainID == "" {
+		return nil, errors.New("a chain ID is required to verify queries")
+	}
+	if ctx.NodeURI == "" {
+		return nil, errors.New("a node is required to verify queries")
+	}
+	if len(witnesses) == 0 {
+		witnesses = []string{ctx.NodeURI}
+	}
+
+	store := lightStore{dir: filepath.Join(ctx.HomeDir, "data")}
+	if trustHeight > 0 {
+		trustOptions := light.TrustOptions{Period: trustingPeriod, Height: trustHeight, Hash: trustHash}
+		return light.NewHTTPClient(context.Background(), ctx.ChainID, trustOptions, ctx.NodeURI, witnesses, store)
+	}
+	return light.NewHTTPClientFromTrustedStore(ctx.ChainID, trustingPeriod, ctx.NodeURI, witnesses, store)
+}
+
+// lightStore stores the headers trusted by a light client in a database which is opened for each
+// access only, so that it is always closed once the light client is no longer used.
+type lightStore struct {
+	dir string
+}
+
+var _ lightstore.Store = lightStore{}
+
+// use calls fn with the store of the database, which is closed when fn returns.
+func (s lightStore) use(fn func(store lightstore.Store) error) error {
+	db, err := dbm.NewDB("light", dbm.GoLevelDBBackend, s.dir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return fn(lightdb.New(db))
+}
+
+func (s lightStore) SaveLightBlock(lb *tmtypes.LightBlock) error {
+	return s.use(func(store lightstore.Store) error {
+		return store.SaveLightBlock(lb)
+	})
+}
+
+func (s lightStore) DeleteLightBlock(height int64) error {
+	return s.use(func(store lightstore.Store) error {
+		return store.DeleteLightBlock(height)
+	})
+}
+
+func (s lightStore) LightBlock(height int64) (lb *tmtypes.LightBlock, err error) {
+	err = s.use(func(store lightstore.Store) error {
+		lb, err = store.LightBlock(height)
+		return err
+	})
+	return lb, err
+}
+
+func (s lightStore) LastLightBlockHeight() (height int64, err error) {
+	err = s.use(func(store lightstore.Store) error {
+		height, err = store.LastLightBlockHeight()
+		return err
+	})
+	return height, err
+}
+
+func (s lightStore) FirstLightBlockHeight() (height int64, err error) {
+	err = s.use(func(store lightstore.Store) error {
+		height, err = store.FirstLightBlockHeight()
+		return err
+	})
+	return height, err
+}
+
+func (s lightStore) LightBlockBefore(height int64) (lb *tmtypes.LightBlock, err error) {
+	err = s.use(func(store lightstore.Store) error {
+		lb, err = store.LightBlockBefore(height)
+		return err
+	})
+	return lb, err
+}
+
+func (s lightStore) Prune(size uint16) error {
+	return s.use(func(store lightstore.Store) error {
+		return store.Prune(size)
+	})
+}
+
+// Size returns 0 if the database cannot be opened, which makes the light client skip pruning.
+func (s lightStore) Size() (size uint16) {
+	_ = s.use(func(store lightstore.Store) error {
+		size = store.Size()
+		return nil
+	})
+	return size
+}
+
+// QueryStoreProto queries the value of a key of a store and unmarshals it into ptr. The value is
+// verified if the context has a light client. It returns the height of the value, and a
+// ErrKeyNotFound error if the key does not exist.
+func (ctx Context) QueryStoreProto(key []byte, storeName string, ptr codec.ProtoMarshaler) (int64, error) {
+	bz, height, err := ctx.QueryStore(key, storeName)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "key %X in store %s", key, storeName)
+	}
+	return height, ctx.Codec.Unmarshal(bz, ptr)
+}
+
+// latestVerifiableHeight returns the latest height of the state whose app hash is in a header verified
+// by the light client, since the app hash of a state is committed in the header of the next height.
+func (ctx Context) latestVerifiableHeight() (int64, error) {
+	block, err := ctx.LightClient.Update(context.Background(), time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if block == nil {
+		return 0, errors.New("light client has no trusted header")
+	}
+	return block.Height - 1, nil
+}
+
+// verifyProof verifies the proof of the result of a query of a key of a store against the app hash
+// verified by the light client at the height of the result.
+func (ctx Context) verifyProof(storeName string, key []byte, resp abci.ResponseQuery) error {
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return sdkerrors.Wrap(storetypes.ErrInvalidProof, "query result has no proof")
+	}
+	if !bytes.Equal(resp.Key, key) {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "query result is for key %X instead of %X", resp.Key, key)
+	}
+
+	block, err := ctx.LightClient.VerifyLightBlockAtHeight(context.Background(), resp.Height+1, time.Now())
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to verify header at height %d", resp.Height+1)
+	}
+
+	// keys are hashed into the paths of SMT stores
+	if resp.ProofOps.Ops[0].Type == storetypes.ProofOpSMTCommitment {
+		hashed := sha256.Sum256(key)
+		key = hashed[:]
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(resp.Value) == 0 {
+		err = prt.VerifyAbsence(resp.ProofOps, block.AppHash, keyPath)
+	} else {
+		err = prt.VerifyValue(resp.ProofOps, block.AppHash, keyPath, resp.Value)
+	}
+	if err != nil {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "failed to verify query result at height %d: %s", resp.Height, err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// storeNode is an RPC client serving the store queries of a multistore, optionally tampering with
+// the results.
+type storeNode struct {
+	rpcclient.Client
+	store  *rootmulti.Store
+	tamper func(*abci.ResponseQuery)
+}
+
+func (n storeNode) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res := n.store.Query(abci.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if n.tamper != nil {
+		n.tamper(&res)
+	}
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+// storeLightClient serves headers committing the app hashes of a multistore, the latest header being
+// the one of the latest version of the multistore, which commits the app hash of the previous one.
+type storeLightClient struct {
+	store *rootmulti.Store
+}
+
+func (c storeLightClient) Update(ctx context.Context, now time.Time) (*tmtypes.LightBlock, error) {
+	return c.VerifyLightBlockAtHeight(ctx, c.store.LastCommitID().Version, now)
+}
+
+func (c storeLightClient) VerifyLightBlockAtHeight(_ context.Context, height int64, _ time.Time) (*tmtypes.LightBlock, error) {
+	cInfo, err := c.store.GetCommitInfo(height - 1)
+	if err != nil {
+		return nil, err
+	}
+	header := &tmtypes.Header{Height: height, AppHash: cInfo.Hash()}
+	return &tmtypes.LightBlock{SignedHeader: &tmtypes.SignedHeader{Header: header}}, nil
+}
+
+func TestVerifiedQueries(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	store.MountStoreWithDB(storetypes.NewKVStoreKey("bank"), storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(storetypes.NewKVStoreKey("staking"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	coin := sdk.NewInt64Coin("stake", 10)
+	kvStore := store.GetStoreByName("bank").(storetypes.KVStore)
+	kvStore.Set([]byte("coin"), cdc.MustMarshal(&coin))
+	store.Commit()
+	kvStore.Set([]byte("coin"), []byte("changed"))
+	store.Commit()
+
+	node := &storeNode{store: store}
+	ctx := Context{}.WithClient(node).WithCodec(cdc).WithLightClient(storeLightClient{store})
+
+	// the latest state verifiable is the one before the latest header
+	var res sdk.Coin
+	height, err := ctx.QueryStoreProto([]byte("coin"), "bank", &res)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+	require.Equal(t, coin, res)
+
+	// absent keys are verified too
+	_, err = ctx.QueryStoreProto([]byte("missing"), "bank", &res)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// queries without proofs are rejected
+	_, _, err = ctx.QueryWithData("/cosmos.bank.v1beta1.Query/Balance", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// gRPC queries are answered by their verifiers, and rejected without one
+	RegisterQueryVerifier("/test.Query/Coin", func(ctx Context, _, reply interface{}) (int64, error) {
+		return ctx.QueryStoreProto([]byte("coin"), "bank", reply.(*sdk.Coin))
+	})
+	var header metadata.MD
+	res = sdk.Coin{}
+	require.NoError(t, ctx.Invoke(context.Background(), "/test.Query/Coin", &sdk.Coin{}, &res, grpc.Header(&header)))
+	require.Equal(t, coin, res)
+	require.Equal(t, []string{"1"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+	err = ctx.Invoke(context.Background(), "/test.Query/Missing", &sdk.Coin{}, &res)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// results tampered with fail to verify
+	for name, tamper := range map[string]func(*abci.ResponseQuery){
+		"value":    func(res *abci.ResponseQuery) { res.Value = []byte("changed") },
+		"absence":  func(res *abci.ResponseQuery) { res.Value = nil },
+		"key":      func(res *abci.ResponseQuery) { res.Key = []byte("other") },
+		"height":   func(res *abci.ResponseQuery) { res.Height++ },
+		"no proof": func(res *abci.ResponseQuery) { res.ProofOps = nil },
+	} {
+		node.tamper = tamper
+		_, _, err = ctx.QueryStore([]byte("coin"), "bank")
+		require.ErrorIs(t, err, storetypes.ErrInvalidProof, name)
+	}
+
+	// results are not verified without a light client
+	node.tamper = func(res *abci.ResponseQuery) { res.Value = []byte("tampered") }
+	bz, _, err := ctx.WithLightClient(nil).QueryStore([]byte("coin"), "bank")
+	require.NoError(t, err)
+	require.Equal(t, []byte("tampered"), bz)
+}
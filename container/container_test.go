@@ -215,6 +215,21 @@ func TestSimple(t *testing.T) {
 	)
 }
 
+func TestMultipleOutputs(t *testing.T) {
+	require.NoError(t,
+		container.Run(
+			func(x int, y string, z float64) {
+				require.Equal(t, 1, x)
+				require.Equal(t, "a", y)
+				require.Equal(t, 2.5, z)
+			},
+			container.Provide(
+				func() (int, string, float64) { return 1, "a", 2.5 },
+			),
+		),
+	)
+}
+
 func TestModuleScoped(t *testing.T) {
 	require.Error(t,
 		container.Run(func(int) {},
@@ -0,0 +1,580 @@
+// Package boltdb implements a db.DBConnection backed by bbolt.
+//
+// The working state and all saved versions are kept in a single database file. Each saved
+// version only stores the records which changed since the previous one, so saving a version
+// takes time and disk space proportional to the writes made since then.
+package boltdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	dbutil "github.com/cosmos/cosmos-sdk/db/internal"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	currentDBFileName string = "current.db"
+
+	// The working state, keyed by user key.
+	dataBucket = []byte("data")
+	// Keys written to the working state since the last saved version.
+	pendingBucket = []byte("pending")
+	// One nested bucket per user key, mapping each version at which the key changed to its
+	// record at that version.
+	historyBucket = []byte("history")
+	// One nested bucket per saved version, holding the keys which changed at that version.
+	versionsBucket = []byte("versions")
+
+	// Leading byte of a history record, followed by the value if it is present.
+	recordDeleted = byte(0)
+	recordPresent = byte(1)
+
+	// Bolt remaps its data file when it grows, which blocks until all read transactions are
+	// closed. A large initial mmap size avoids deadlocking writers in the presence of
+	// long-lived readers for most workloads.
+	defaultInitialMmapSize = 1 << 28 // 256MB
+)
+
+var (
+	_ db.DBConnection = (*BoltDB)(nil)
+	_ db.DBReader     = (*dbTxn)(nil)
+	_ db.DBWriter     = (*dbWriter)(nil)
+	_ db.DBReadWriter = (*dbWriter)(nil)
+)
+
+// BoltDB is a connection to a bbolt key-value database.
+// Only one write transaction may be open at a time; opening a writer blocks until any other
+// open writer has been committed or discarded.
+type BoltDB = dbManager
+
+type dbManager struct {
+	current *bolt.DB
+	dir     string
+	vmgr    *db.VersionManager
+	mtx     sync.RWMutex
+	// Track open DBWriters
+	openWriters int32
+	// Number of open readers of each saved version
+	versionReaders versionReaderCount
+	// Track all open transactions, so they can be closed along with the DB
+	openTxns map[*dbTxn]struct{}
+	txnsMtx  sync.Mutex
+}
+
+type versionReaderCount struct {
+	count map[uint64]uint
+	mtx   sync.RWMutex
+}
+
+type dbTxn struct {
+	txn *bolt.Tx
+	// The data bucket, or the history bucket for readers of a saved version
+	bucket  *bolt.Bucket
+	mgr     *dbManager
+	version uint64
+}
+type dbWriter struct {
+	*dbTxn
+	pending *bolt.Bucket
+}
+
+// NewDB creates a new bbolt key-value database inside the given directory, using default options.
+// If dir does not exist, it will be created.
+func NewDB(dir string) (*dbManager, error) {
+	return NewDBWithOptions(dir, &bolt.Options{InitialMmapSize: defaultInitialMmapSize})
+}
+
+// NewDBWithOptions creates a new bbolt key-value database inside the given directory, using
+// the given options to open the underlying database file.
+// If dir does not exist, it will be created.
+func NewDBWithOptions(dir string, opts *bolt.Options) (*dbManager, error) {
+	if opts == nil {
+		opts = bolt.DefaultOptions
+	}
+	mgr := &dbManager{
+		dir:            dir,
+		versionReaders: versionReaderCount{count: map[uint64]uint{}},
+		openTxns:       map[*dbTxn]struct{}{},
+	}
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	if mgr.current, err = bolt.Open(filepath.Join(dir, currentDBFileName), 0644, opts); err != nil {
+		return nil, err
+	}
+	err = mgr.current.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{dataBucket, pendingBucket, historyBucket, versionsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		mgr.vmgr = readVersions(tx)
+		return nil
+	})
+	if err != nil {
+		mgr.current.Close()
+		return nil, err
+	}
+	return mgr, nil
+}
+
+// Reads the saved versions from the versions bucket
+func readVersions(tx *bolt.Tx) *db.VersionManager {
+	var versions []uint64
+	c := tx.Bucket(versionsBucket).Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		versions = append(versions, binary.BigEndian.Uint64(k))
+	}
+	return db.NewVersionManager(versions)
+}
+
+func (mgr *dbManager) newTxn(writable bool, version uint64) (*dbTxn, error) {
+	txn, err := mgr.current.Begin(writable)
+	if err != nil {
+		return nil, err
+	}
+	name := dataBucket
+	if version != 0 {
+		name = historyBucket
+	}
+	bucket := txn.Bucket(name)
+	if bucket == nil {
+		txn.Rollback()
+		return nil, fmt.Errorf("missing bucket: %s", name)
+	}
+	ret := &dbTxn{txn: txn, bucket: bucket, mgr: mgr, version: version}
+	mgr.txnsMtx.Lock()
+	mgr.openTxns[ret] = struct{}{}
+	mgr.txnsMtx.Unlock()
+	return ret, nil
+}
+
+// Reader implements DBConnection.
+// Panics if the underlying database is closed.
+func (mgr *dbManager) Reader() db.DBReader {
+	txn, err := mgr.newTxn(false, 0)
+	if err != nil {
+		panic(err)
+	}
+	return txn
+}
+
+// ReaderAt implements DBConnection.
+func (mgr *dbManager) ReaderAt(version uint64) (db.DBReader, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	if !mgr.vmgr.Exists(version) {
+		return nil, db.ErrVersionDoesNotExist
+	}
+	mgr.versionReaders.increment(version)
+	txn, err := mgr.newTxn(false, version)
+	if err != nil {
+		mgr.versionReaders.decrement(version)
+		return nil, err
+	}
+	return txn, nil
+}
+
+// ReadWriter implements DBConnection.
+// Panics if the underlying database is closed.
+func (mgr *dbManager) ReadWriter() db.DBReadWriter {
+	atomic.AddInt32(&mgr.openWriters, 1)
+	txn, err := mgr.newTxn(true, 0)
+	if err != nil {
+		atomic.AddInt32(&mgr.openWriters, -1)
+		panic(err)
+	}
+	return &dbWriter{dbTxn: txn, pending: txn.txn.Bucket(pendingBucket)}
+}
+
+// Writer implements DBConnection.
+func (mgr *dbManager) Writer() db.DBWriter {
+	return mgr.ReadWriter()
+}
+
+// Versions implements DBConnection.
+func (mgr *dbManager) Versions() (db.VersionSet, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	return mgr.vmgr, nil
+}
+
+// SaveNextVersion implements DBConnection.
+func (mgr *dbManager) SaveNextVersion() (uint64, error) {
+	return mgr.save(0)
+}
+
+// SaveVersion implements DBConnection.
+func (mgr *dbManager) SaveVersion(target uint64) error {
+	if target == 0 {
+		return db.ErrInvalidVersion
+	}
+	_, err := mgr.save(target)
+	return err
+}
+
+// Records the working value of each pending key in the history at the new version.
+func (mgr *dbManager) save(target uint64) (uint64, error) {
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	if atomic.LoadInt32(&mgr.openWriters) > 0 {
+		return 0, db.ErrOpenTransactions
+	}
+	newVmgr := mgr.vmgr.Copy()
+	target, err := newVmgr.Save(target)
+	if err != nil {
+		return 0, err
+	}
+	err = mgr.current.Update(func(tx *bolt.Tx) error {
+		changes, err := tx.Bucket(versionsBucket).CreateBucket(versionKey(target))
+		if err != nil {
+			return err
+		}
+		data, history := tx.Bucket(dataBucket), tx.Bucket(historyBucket)
+		err = tx.Bucket(pendingBucket).ForEach(func(key, _ []byte) error {
+			kh, err := history.CreateBucketIfNotExists(key)
+			if err != nil {
+				return err
+			}
+			if err = kh.Put(versionKey(target), newRecord(data.Get(key))); err != nil {
+				return err
+			}
+			return changes.Put(key, []byte{})
+		})
+		if err != nil {
+			return err
+		}
+		return resetBucket(tx, pendingBucket)
+	})
+	if err != nil {
+		return 0, err
+	}
+	mgr.vmgr = newVmgr
+	return target, nil
+}
+
+// DeleteVersion implements DBConnection.
+// Records of the deleted version which are still visible at the next saved version are moved
+// to it; if there is no next version, their keys are marked as pending so that Revert restores
+// them from the new last version.
+func (mgr *dbManager) DeleteVersion(ver uint64) error {
+	if mgr.versionReaders.has(ver) {
+		return db.ErrOpenTransactions
+	}
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	if !mgr.vmgr.Exists(ver) {
+		return db.ErrVersionDoesNotExist
+	}
+	err := mgr.current.Update(func(tx *bolt.Tx) error {
+		versions, history := tx.Bucket(versionsBucket), tx.Bucket(historyBucket)
+		var next *bolt.Bucket
+		c := versions.Cursor()
+		c.Seek(versionKey(ver))
+		nextKey, _ := c.Next()
+		if nextKey != nil {
+			nextKey = copyBytes(nextKey)
+			next = versions.Bucket(nextKey)
+		}
+		pending := tx.Bucket(pendingBucket)
+		changes := versions.Bucket(versionKey(ver))
+		err := changes.ForEach(func(key, _ []byte) error {
+			kh := history.Bucket(key)
+			switch {
+			case next == nil:
+				if err := pending.Put(key, []byte{}); err != nil {
+					return err
+				}
+			case next.Get(key) == nil:
+				if err := kh.Put(nextKey, copyBytes(kh.Get(versionKey(ver)))); err != nil {
+					return err
+				}
+				if err := next.Put(key, []byte{}); err != nil {
+					return err
+				}
+			}
+			if err := kh.Delete(versionKey(ver)); err != nil {
+				return err
+			}
+			if k, _ := kh.Cursor().First(); k == nil {
+				return history.DeleteBucket(key)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return versions.DeleteBucket(versionKey(ver))
+	})
+	if err != nil {
+		return err
+	}
+	mgr.vmgr = mgr.vmgr.Copy()
+	mgr.vmgr.Delete(ver)
+	return nil
+}
+
+// Revert implements DBConnection.
+// Each pending key is restored to its value at the last saved version within a single write
+// transaction, so open readers retain a consistent view.
+func (mgr *dbManager) Revert() error {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	if atomic.LoadInt32(&mgr.openWriters) > 0 {
+		return db.ErrOpenTransactions
+	}
+	last := mgr.vmgr.Last()
+	return mgr.current.Update(func(tx *bolt.Tx) error {
+		data, history := tx.Bucket(dataBucket), tx.Bucket(historyBucket)
+		err := tx.Bucket(pendingBucket).ForEach(func(key, _ []byte) error {
+			if value := getAt(history, key, last); value != nil {
+				return data.Put(key, copyBytes(value))
+			}
+			return data.Delete(key)
+		})
+		if err != nil {
+			return err
+		}
+		return resetBucket(tx, pendingBucket)
+	})
+}
+
+// Close implements DBConnection.
+// Any transactions which are still open are discarded.
+func (mgr *dbManager) Close() error {
+	mgr.txnsMtx.Lock()
+	txns := make([]*dbTxn, 0, len(mgr.openTxns))
+	for txn := range mgr.openTxns {
+		txns = append(txns, txn)
+	}
+	mgr.txnsMtx.Unlock()
+	var err error
+	for _, txn := range txns {
+		err = dbutil.CombineErrors(err, txn.Discard(), "Discard also failed")
+	}
+	return dbutil.CombineErrors(err, mgr.current.Close(), "Close also failed")
+}
+
+// Stats implements DBConnection.
+func (mgr *dbManager) Stats() map[string]string {
+	stats := mgr.current.Stats()
+	return map[string]string{
+		"bolt.free_pages":    strconv.Itoa(stats.FreePageN),
+		"bolt.pending_pages": strconv.Itoa(stats.PendingPageN),
+		"bolt.open_txns":     strconv.Itoa(stats.OpenTxN),
+		"bolt.total_txns":    strconv.Itoa(stats.TxN),
+	}
+}
+
+// Get implements DBReader.
+func (tx *dbTxn) Get(key []byte) ([]byte, error) {
+	if tx.txn == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return nil, db.ErrKeyEmpty
+	}
+	return copyBytes(tx.get(key)), nil
+}
+
+// Has implements DBReader.
+func (tx *dbTxn) Has(key []byte) (bool, error) {
+	if tx.txn == nil {
+		return false, db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return false, db.ErrKeyEmpty
+	}
+	return tx.get(key) != nil, nil
+}
+
+func (tx *dbTxn) get(key []byte) []byte {
+	if tx.version == 0 {
+		return tx.bucket.Get(key)
+	}
+	return getAt(tx.bucket, key, tx.version)
+}
+
+// Set implements DBWriter.
+func (tx *dbWriter) Set(key []byte, value []byte) error {
+	if tx.txn == nil {
+		return db.ErrTransactionClosed
+	}
+	if err := dbutil.ValidateKv(key, value); err != nil {
+		return err
+	}
+	if err := tx.pending.Put(key, []byte{}); err != nil {
+		return err
+	}
+	return tx.bucket.Put(key, value)
+}
+
+// Delete implements DBWriter.
+func (tx *dbWriter) Delete(key []byte) error {
+	if tx.txn == nil {
+		return db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return db.ErrKeyEmpty
+	}
+	if err := tx.pending.Put(key, []byte{}); err != nil {
+		return err
+	}
+	return tx.bucket.Delete(key)
+}
+
+// Commit implements DBWriter.
+func (tx *dbWriter) Commit() error {
+	if tx.txn == nil {
+		return db.ErrTransactionClosed
+	}
+	defer tx.close()
+	return tx.txn.Commit()
+}
+
+// Discard implements DBReader and DBWriter.
+func (tx *dbTxn) Discard() error {
+	if tx.txn == nil {
+		return nil // Discard() is idempotent
+	}
+	defer tx.close()
+	err := tx.txn.Rollback()
+	if tx.version == 0 {
+		return err
+	}
+	if !tx.mgr.versionReaders.decrement(tx.version) {
+		return fmt.Errorf("transaction has no corresponding version reader entry: %v", tx.version)
+	}
+	return err
+}
+
+// Removes the transaction from the set of open transactions and marks it closed.
+func (tx *dbTxn) close() {
+	if tx.txn.Writable() {
+		atomic.AddInt32(&tx.mgr.openWriters, -1)
+	}
+	tx.mgr.txnsMtx.Lock()
+	delete(tx.mgr.openTxns, tx)
+	tx.mgr.txnsMtx.Unlock()
+	tx.txn = nil
+	tx.bucket = nil
+}
+
+// Iterator implements DBReader.
+func (tx *dbTxn) Iterator(start, end []byte) (db.Iterator, error) {
+	if tx.txn == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, db.ErrKeyEmpty
+	}
+	return tx.newIterator(start, end, false), nil
+}
+
+// ReverseIterator implements DBReader.
+func (tx *dbTxn) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	if tx.txn == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, db.ErrKeyEmpty
+	}
+	return tx.newIterator(start, end, true), nil
+}
+
+func (tx *dbTxn) newIterator(start, end []byte, isReverse bool) db.Iterator {
+	itr := newBoltIterator(tx.bucket.Cursor(), start, end, isReverse)
+	if tx.version == 0 {
+		return itr
+	}
+	return &versionIterator{boltIterator: itr, history: tx.bucket, version: tx.version}
+}
+
+func (vrc *versionReaderCount) has(ver uint64) bool {
+	vrc.mtx.RLock()
+	defer vrc.mtx.RUnlock()
+	_, has := vrc.count[ver]
+	return has
+}
+
+func (vrc *versionReaderCount) increment(ver uint64) {
+	vrc.mtx.Lock()
+	defer vrc.mtx.Unlock()
+	vrc.count[ver] += 1
+}
+
+func (vrc *versionReaderCount) decrement(ver uint64) bool {
+	vrc.mtx.Lock()
+	defer vrc.mtx.Unlock()
+	count, has := vrc.count[ver]
+	if !has {
+		return false
+	}
+	if count == 1 {
+		delete(vrc.count, ver)
+	} else {
+		vrc.count[ver] = count - 1
+	}
+	return true
+}
+
+// Returns the value of key at the given version from the history bucket, or nil if the key
+// was absent or deleted at that version. The slice is only valid for the life of the
+// transaction.
+func getAt(history *bolt.Bucket, key []byte, version uint64) []byte {
+	kh := history.Bucket(key)
+	if kh == nil {
+		return nil
+	}
+	c := kh.Cursor()
+	target := versionKey(version)
+	k, record := c.Seek(target)
+	if k == nil {
+		k, record = c.Last()
+	} else if string(k) != string(target) {
+		k, record = c.Prev()
+	}
+	if k == nil || record[0] == recordDeleted {
+		return nil
+	}
+	return record[1:]
+}
+
+func newRecord(value []byte) []byte {
+	if value == nil {
+		return []byte{recordDeleted}
+	}
+	return append([]byte{recordPresent}, value...)
+}
+
+func versionKey(version uint64) []byte {
+	ret := make([]byte, 8)
+	binary.BigEndian.PutUint64(ret, version)
+	return ret
+}
+
+func resetBucket(tx *bolt.Tx, name []byte) error {
+	if err := tx.DeleteBucket(name); err != nil {
+		return err
+	}
+	_, err := tx.CreateBucket(name)
+	return err
+}
+
+// Copies a slice out of bolt's memory map, which is only valid for the life of a transaction.
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+	ret := make([]byte, len(bz))
+	copy(ret, bz)
+	return ret
+}
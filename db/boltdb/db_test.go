@@ -0,0 +1,122 @@
+package boltdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/dbtest"
+	bolt "go.etcd.io/bbolt"
+)
+
+func load(t *testing.T, dir string) db.DBConnection {
+	d, err := NewDB(dir)
+	require.NoError(t, err)
+	return d
+}
+
+func TestGetSetHasDelete(t *testing.T) {
+	dbtest.DoTestGetSetHasDelete(t, load)
+}
+
+func TestIterators(t *testing.T) {
+	dbtest.DoTestIterators(t, load)
+}
+
+func TestTransactions(t *testing.T) {
+	dbtest.DoTestTransactions(t, load, false)
+}
+
+func TestVersioning(t *testing.T) {
+	dbtest.DoTestVersioning(t, load)
+}
+
+func TestRevert(t *testing.T) {
+	dbtest.DoTestRevert(t, load, false)
+	dbtest.DoTestRevert(t, load, true)
+}
+
+func TestReloadDB(t *testing.T) {
+	dbtest.DoTestReloadDB(t, load)
+}
+
+// Test that closing the DB discards any open transactions
+func TestCloseWithOpenTransactions(t *testing.T) {
+	dir := t.TempDir()
+	d, err := NewDB(dir)
+	require.NoError(t, err)
+	txn := d.Writer()
+	require.NoError(t, txn.Set([]byte{1}, []byte{1}))
+	require.NoError(t, txn.Commit())
+	_, err = d.SaveNextVersion()
+	require.NoError(t, err)
+
+	view := d.Reader()
+	viewAt, err := d.ReaderAt(1)
+	require.NoError(t, err)
+	txn = d.Writer()
+	require.NoError(t, txn.Set([]byte{2}, []byte{2}))
+	require.NoError(t, d.Close())
+
+	_, err = view.Get([]byte{1})
+	require.Equal(t, db.ErrTransactionClosed, err)
+	_, err = viewAt.Get([]byte{1})
+	require.Equal(t, db.ErrTransactionClosed, err)
+	require.Equal(t, db.ErrTransactionClosed, txn.Set([]byte{3}, []byte{3}))
+	require.NoError(t, txn.Discard())
+}
+
+// Test that saved versions only store changed keys, and that deleting a version keeps the
+// others readable
+func TestDeleteIntermediateVersion(t *testing.T) {
+	d, err := NewDB(t.TempDir())
+	require.NoError(t, err)
+	write := func(kvs ...[]byte) uint64 {
+		txn := d.Writer()
+		for i := 0; i < len(kvs); i += 2 {
+			if kvs[i+1] == nil {
+				require.NoError(t, txn.Delete(kvs[i]))
+			} else {
+				require.NoError(t, txn.Set(kvs[i], kvs[i+1]))
+			}
+		}
+		require.NoError(t, txn.Commit())
+		ver, err := d.SaveNextVersion()
+		require.NoError(t, err)
+		return ver
+	}
+	v1 := write([]byte("a"), []byte{1}, []byte("b"), []byte{1})
+	v2 := write([]byte("a"), []byte{2}, []byte("b"), nil)
+	v3 := write([]byte("c"), []byte{3})
+
+	view, err := d.ReaderAt(v3)
+	require.NoError(t, err)
+	require.Equal(t, db.ErrOpenTransactions, d.DeleteVersion(v3))
+	require.NoError(t, view.Discard())
+
+	// v3 only stores the key written since v2
+	var changed [][]byte
+	require.NoError(t, d.current.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(versionsBucket).Bucket(versionKey(v3)).ForEach(func(k, _ []byte) error {
+			changed = append(changed, copyBytes(k))
+			return nil
+		})
+	}))
+	require.Equal(t, [][]byte{[]byte("c")}, changed)
+
+	require.NoError(t, d.DeleteVersion(v2))
+	require.NoError(t, d.DeleteVersion(v1))
+	view, err = d.ReaderAt(v3)
+	require.NoError(t, err)
+	it, err := view.Iterator(nil, nil)
+	require.NoError(t, err)
+	contents := map[string][]byte{}
+	for it.Next() {
+		contents[string(it.Key())] = it.Value()
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, map[string][]byte{"a": {2}, "c": {3}}, contents)
+	require.NoError(t, view.Discard())
+	require.NoError(t, d.Close())
+}
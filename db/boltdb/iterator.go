@@ -0,0 +1,154 @@
+package boltdb
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	bolt "go.etcd.io/bbolt"
+)
+
+type boltIterator struct {
+	source     *bolt.Cursor
+	start, end []byte
+	isReverse  bool
+	isInvalid  bool
+	// Whether iterator has been advanced to the first element (is fully initialized)
+	primed bool
+	// Current key and value, which are only valid until the cursor is moved
+	key, value []byte
+}
+
+var _ db.Iterator = (*boltIterator)(nil)
+
+func newBoltIterator(source *bolt.Cursor, start, end []byte, isReverse bool) *boltIterator {
+	itr := &boltIterator{
+		source:    source,
+		start:     start,
+		end:       end,
+		isReverse: isReverse,
+	}
+	if isReverse {
+		if end == nil {
+			itr.key, itr.value = source.Last()
+		} else {
+			itr.key, itr.value = source.Seek(end)
+			if itr.key == nil {
+				itr.key, itr.value = source.Last()
+			} else if bytes.Compare(end, itr.key) <= 0 {
+				itr.key, itr.value = source.Prev()
+			}
+		}
+	} else {
+		if start == nil {
+			itr.key, itr.value = source.First()
+		} else {
+			itr.key, itr.value = source.Seek(start)
+		}
+	}
+	return itr
+}
+
+// Domain implements Iterator.
+func (itr *boltIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *boltIterator) Valid() bool {
+	if !itr.primed {
+		return false
+	}
+
+	if itr.isInvalid {
+		return false
+	}
+
+	if itr.key == nil {
+		itr.isInvalid = true
+		return false
+	}
+
+	// If key is end or past it, invalid.
+	if itr.isReverse {
+		if itr.start != nil && bytes.Compare(itr.key, itr.start) < 0 {
+			itr.isInvalid = true
+			return false
+		}
+	} else {
+		if itr.end != nil && bytes.Compare(itr.key, itr.end) >= 0 {
+			itr.isInvalid = true
+			return false
+		}
+	}
+	return true
+}
+
+// Key implements Iterator.
+func (itr *boltIterator) Key() []byte {
+	itr.assertIsValid()
+	return copyBytes(itr.key)
+}
+
+// Value implements Iterator.
+func (itr *boltIterator) Value() []byte {
+	itr.assertIsValid()
+	return copyBytes(itr.value)
+}
+
+// Next implements Iterator.
+func (itr *boltIterator) Next() bool {
+	if !itr.primed {
+		itr.primed = true
+	} else {
+		if itr.isReverse {
+			itr.key, itr.value = itr.source.Prev()
+		} else {
+			itr.key, itr.value = itr.source.Next()
+		}
+	}
+	return itr.Valid()
+}
+
+// Error implements Iterator.
+func (itr *boltIterator) Error() error {
+	return nil
+}
+
+// Close implements Iterator.
+func (itr *boltIterator) Close() error {
+	itr.isInvalid = true
+	return nil
+}
+
+func (itr *boltIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+// Iterates over the keys of a history bucket, skipping keys which are absent at the version.
+type versionIterator struct {
+	*boltIterator
+	history *bolt.Bucket
+	version uint64
+	// Value of the current key at the version
+	value []byte
+}
+
+var _ db.Iterator = (*versionIterator)(nil)
+
+// Value implements Iterator.
+func (itr *versionIterator) Value() []byte {
+	itr.assertIsValid()
+	return copyBytes(itr.value)
+}
+
+// Next implements Iterator.
+func (itr *versionIterator) Next() bool {
+	for itr.boltIterator.Next() {
+		if itr.value = getAt(itr.history, itr.key, itr.version); itr.value != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -10,6 +10,7 @@ require (
 	github.com/dgraph-io/ristretto v0.1.0
 	github.com/google/btree v1.0.1
 	github.com/stretchr/testify v1.7.1
+	go.etcd.io/bbolt v1.3.6
 )
 
 require (
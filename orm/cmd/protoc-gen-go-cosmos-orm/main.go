@@ -1,11 +1,18 @@
 package main
 
 import (
+	"flag"
+
 	"google.golang.org/protobuf/compiler/protogen"
 
 	"github.com/cosmos/cosmos-sdk/orm/internal/codegen"
 )
 
 func main() {
-	protogen.Options{}.Run(codegen.PluginRunner)
+	var flags flag.FlagSet
+	var options codegen.Options
+	flags.BoolVar(&options.QueryService, "query_service", false, "generate a Query service for the tables of each file")
+	protogen.Options{ParamFunc: flags.Set}.Run(func(p *protogen.Plugin) error {
+		return codegen.PluginRunner(options)(p)
+	})
 }
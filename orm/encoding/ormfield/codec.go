@@ -3,6 +3,9 @@ package ormfield
 import (
 	"io"
 
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+	"google.golang.org/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -61,6 +64,24 @@ func GetCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, erro
 		return nil, ormerrors.InvalidKeyField.Wrapf("repeated field %s", field.FullName())
 	}
 
+	return getCodec(field, nonTerminal)
+}
+
+// GetElementCodec returns the Codec for the elements of the provided repeated
+// field if one is defined. It is used by indexes storing one entry for each
+// element of a repeated field. nonTerminal has the same meaning as in GetCodec.
+func GetElementCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, error) {
+	if field == nil {
+		return nil, ormerrors.InvalidKeyField.Wrap("nil field")
+	}
+	if !field.IsList() {
+		return nil, ormerrors.InvalidKeyField.Wrapf("%s is not a repeated field", field.FullName())
+	}
+
+	return getCodec(field, nonTerminal)
+}
+
+func getCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, error) {
 	if field.ContainingOneof() != nil {
 		return nil, ormerrors.InvalidKeyField.Wrapf("oneof field %s", field.FullName())
 	}
@@ -69,6 +90,10 @@ func GetCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, erro
 		return nil, ormerrors.InvalidKeyField.Wrapf("optional field %s", field.FullName())
 	}
 
+	if fieldCodec := getFieldCodecOption(field); fieldCodec != ormv1.FieldCodec_FIELD_CODEC_UNSPECIFIED {
+		return getCustomCodec(field, fieldCodec)
+	}
+
 	switch field.Kind() {
 	case protoreflect.BytesKind:
 		if nonTerminal {
@@ -112,3 +137,24 @@ func GetCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, erro
 		return nil, ormerrors.InvalidKeyField.Wrapf("%s of kind %s", field.FullName(), field.Kind())
 	}
 }
+
+func getFieldCodecOption(field protoreflect.FieldDescriptor) ormv1.FieldCodec {
+	return proto.GetExtension(field.Options(), ormv1.E_FieldCodec).(ormv1.FieldCodec)
+}
+
+// getCustomCodec returns the Codec specified by the field_codec option of a
+// field.
+func getCustomCodec(field protoreflect.FieldDescriptor, fieldCodec ormv1.FieldCodec) (Codec, error) {
+	if field.Kind() != protoreflect.StringKind {
+		return nil, ormerrors.InvalidKeyField.Wrapf("field codec %s on %s of kind %s", fieldCodec, field.FullName(), field.Kind())
+	}
+
+	switch fieldCodec {
+	case ormv1.FieldCodec_FIELD_CODEC_INT:
+		return IntStringCodec{}, nil
+	case ormv1.FieldCodec_FIELD_CODEC_DEC:
+		return DecStringCodec{}, nil
+	default:
+		return nil, ormerrors.InvalidKeyField.Wrapf("unknown field codec %s on %s", fieldCodec, field.FullName())
+	}
+}
@@ -76,6 +76,61 @@ func TestUnsupportedFields(t *testing.T) {
 	assert.ErrorContains(t, err, ormerrors.InvalidKeyField.Error())
 }
 
+func TestElementCodec(t *testing.T) {
+	cdc, err := ormfield.GetElementCodec(testutil.GetTestField("repeated"), false)
+	assert.NilError(t, err)
+	assert.Equal(t, ormfield.CompactUint32Codec{}, cdc)
+	_, err = ormfield.GetElementCodec(testutil.GetTestField("u32"), false)
+	assert.ErrorContains(t, err, ormerrors.InvalidKeyField.Error())
+	_, err = ormfield.GetElementCodec(testutil.GetTestField("map"), false)
+	assert.ErrorContains(t, err, ormerrors.InvalidKeyField.Error())
+}
+
+func TestNumericStringCodecs(t *testing.T) {
+	cdc, err := ormfield.GetCodec(testutil.GetTestField("int"), true)
+	assert.NilError(t, err)
+	assert.Equal(t, ormfield.IntStringCodec{}, cdc)
+	cdc, err = ormfield.GetCodec(testutil.GetTestField("dec"), false)
+	assert.NilError(t, err)
+	assert.Equal(t, ormfield.DecStringCodec{}, cdc)
+
+	testOrder := func(cdc ormfield.Codec, values ...string) {
+		var lastBz []byte
+		for i, x := range values {
+			buf := &bytes.Buffer{}
+			assert.NilError(t, cdc.Encode(protoreflect.ValueOfString(x), buf))
+			if i > 0 {
+				assert.Assert(t, bytes.Compare(lastBz, buf.Bytes()) < 0, "%s", x)
+			}
+			lastBz = buf.Bytes()
+		}
+	}
+	testOrder(ormfield.IntStringCodec{}, "", "-1000", "-999", "-1", "0", "1", "9", "10", "0100", "1000000000000000000000")
+	testOrder(ormfield.DecStringCodec{}, "", "-10", "-9.99", "-0.000000000000000001", "0", "0.000000000000000001", "0.1", "1", "1.5", "10")
+
+	testDecode := func(cdc ormfield.Codec, x, expected string) {
+		buf := &bytes.Buffer{}
+		assert.NilError(t, cdc.Encode(protoreflect.ValueOfString(x), buf))
+		y, err := cdc.Decode(buf)
+		assert.NilError(t, err)
+		assert.Equal(t, expected, y.String())
+	}
+	testDecode(ormfield.IntStringCodec{}, "", "")
+	testDecode(ormfield.IntStringCodec{}, "-0", "0")
+	testDecode(ormfield.IntStringCodec{}, "+007", "7")
+	testDecode(ormfield.DecStringCodec{}, "", "")
+	testDecode(ormfield.DecStringCodec{}, "1.5", "1.500000000000000000")
+	testDecode(ormfield.DecStringCodec{}, "-0.25", "-0.250000000000000000")
+	testDecode(ormfield.DecStringCodec{}, "12", "12.000000000000000000")
+
+	for _, x := range []string{"1.5", "abc", "1e3", " 1"} {
+		assert.ErrorContains(t, ormfield.IntStringCodec{}.Encode(protoreflect.ValueOfString(x), &bytes.Buffer{}), "invalid integer")
+	}
+	for _, x := range []string{"1.", ".5", "-", "+1", "1.2.3", "0.1234567890123456789", "1,5"} {
+		assert.ErrorContains(t, ormfield.DecStringCodec{}.Encode(protoreflect.ValueOfString(x), &bytes.Buffer{}), "invalid decimal")
+	}
+}
+
 func TestCompactUInt32(t *testing.T) {
 	var lastBz []byte
 	testEncodeDecode := func(x uint32, expectedLen int) {
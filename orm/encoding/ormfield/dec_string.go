@@ -0,0 +1,108 @@
+package ormfield
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DecStringCodec encodes strings holding decimal numbers with at most 18
+// fractional digits, such as sdk.Dec values, so that the ordering of keys
+// matches the numeric ordering of values. Empty strings are ordered before
+// all numbers.
+//
+// Values are scaled by 10^18 and encoded as integers with IntStringCodec.
+// They are decoded in the canonical sdk.Dec format with 18 fractional digits,
+// so "1.5" is decoded as "1.500000000000000000".
+type DecStringCodec struct{}
+
+// decPrecision is the number of fractional digits of sdk.Dec values.
+const decPrecision = 18
+
+var decScale = new(big.Int).Exp(big.NewInt(10), big.NewInt(decPrecision), nil)
+
+func (d DecStringCodec) Decode(r Reader) (protoreflect.Value, error) {
+	x, empty, err := decodeBigInt(r)
+	if err != nil || empty {
+		return protoreflect.ValueOfString(""), err
+	}
+	return protoreflect.ValueOfString(formatDecString(x)), nil
+}
+
+func (d DecStringCodec) Encode(value protoreflect.Value, w io.Writer) error {
+	x, err := parseDecString(value.String())
+	if err != nil {
+		return err
+	}
+	return encodeBigInt(x, w)
+}
+
+func (d DecStringCodec) Compare(v1, v2 protoreflect.Value) int {
+	return compareBigInts(v1, v2, parseDecString)
+}
+
+func (d DecStringCodec) IsOrdered() bool {
+	return true
+}
+
+func (d DecStringCodec) FixedBufferSize() int {
+	return -1
+}
+
+func (d DecStringCodec) ComputeBufferSize(value protoreflect.Value) (int, error) {
+	x, err := parseDecString(value.String())
+	if err != nil {
+		return 0, err
+	}
+	return bigIntBufferSize(x), nil
+}
+
+// parseDecString parses a decimal number and returns its value scaled by
+// 10^18, or nil for the empty string.
+func parseDecString(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+		if len(fracPart) == 0 || len(fracPart) > decPrecision {
+			return nil, fmt.Errorf("invalid decimal string in key: %q", s)
+		}
+	}
+
+	digits := strings.TrimPrefix(intPart, "-")
+	if len(digits) == 0 || !isDigits(digits) || !isDigits(fracPart) {
+		return nil, fmt.Errorf("invalid decimal string in key: %q", s)
+	}
+
+	x, ok := new(big.Int).SetString(intPart+fracPart+strings.Repeat("0", decPrecision-len(fracPart)), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal string in key: %q", s)
+	}
+	return x, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// formatDecString formats a decimal number scaled by 10^18 like sdk.Dec.
+func formatDecString(x *big.Int) string {
+	abs := new(big.Int).Abs(x)
+	intPart, fracPart := new(big.Int).QuoRem(abs, decScale, new(big.Int))
+	sign := ""
+	if x.Sign() < 0 {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%s.%0*d", sign, intPart, decPrecision, fracPart)
+}
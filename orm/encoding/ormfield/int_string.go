@@ -0,0 +1,175 @@
+package ormfield
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IntStringCodec encodes strings holding base 10 integers of arbitrary size,
+// such as sdk.Int values, so that the ordering of keys matches the numeric
+// ordering of values. Empty strings are ordered before all numbers.
+//
+// Values are encoded as a sign byte (0x0 for the empty string, 0x1 for
+// negative numbers, 0x2 for zero and 0x3 for positive numbers) followed, for
+// non-zero numbers, by the byte length and the big-endian bytes of their
+// absolute value. The length and bytes of negative numbers are complemented.
+// The encoding is self-delimiting so it is the same in terminal and
+// non-terminal key segments.
+type IntStringCodec struct{}
+
+func (i IntStringCodec) Decode(r Reader) (protoreflect.Value, error) {
+	x, empty, err := decodeBigInt(r)
+	if err != nil || empty {
+		return protoreflect.ValueOfString(""), err
+	}
+	return protoreflect.ValueOfString(x.String()), nil
+}
+
+func (i IntStringCodec) Encode(value protoreflect.Value, w io.Writer) error {
+	x, err := parseIntString(value.String())
+	if err != nil {
+		return err
+	}
+	return encodeBigInt(x, w)
+}
+
+func (i IntStringCodec) Compare(v1, v2 protoreflect.Value) int {
+	return compareBigInts(v1, v2, parseIntString)
+}
+
+func (i IntStringCodec) IsOrdered() bool {
+	return true
+}
+
+func (i IntStringCodec) FixedBufferSize() int {
+	return -1
+}
+
+func (i IntStringCodec) ComputeBufferSize(value protoreflect.Value) (int, error) {
+	x, err := parseIntString(value.String())
+	if err != nil {
+		return 0, err
+	}
+	return bigIntBufferSize(x), nil
+}
+
+// parseIntString parses a base 10 integer and returns nil for the empty
+// string.
+func parseIntString(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer string in key: %q", s)
+	}
+	return x, nil
+}
+
+const (
+	bigIntEmptyPrefix byte = iota
+	bigIntNegativePrefix
+	bigIntZeroPrefix
+	bigIntPositivePrefix
+)
+
+// encodeBigInt encodes a big integer, nil representing the empty string.
+func encodeBigInt(x *big.Int, w io.Writer) error {
+	if x == nil {
+		_, err := w.Write([]byte{bigIntEmptyPrefix})
+		return err
+	}
+
+	if x.Sign() == 0 {
+		_, err := w.Write([]byte{bigIntZeroPrefix})
+		return err
+	}
+
+	bz := x.Bytes()
+	if len(bz) > 255 {
+		return fmt.Errorf("integer %s too large to be encoded in a key", x)
+	}
+	if x.Sign() > 0 {
+		_, err := w.Write(append([]byte{bigIntPositivePrefix, byte(len(bz))}, bz...))
+		return err
+	}
+
+	bz = append([]byte{bigIntNegativePrefix, byte(len(bz))}, bz...)
+	for i := 1; i < len(bz); i++ {
+		bz[i] = ^bz[i]
+	}
+	_, err := w.Write(bz)
+	return err
+}
+
+// decodeBigInt decodes a big integer encoded with encodeBigInt, returning
+// true if it was the empty string.
+func decodeBigInt(r Reader) (*big.Int, bool, error) {
+	prefix, err := r.ReadByte()
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch prefix {
+	case bigIntEmptyPrefix:
+		return nil, true, nil
+	case bigIntZeroPrefix:
+		return new(big.Int), false, nil
+	case bigIntPositivePrefix, bigIntNegativePrefix:
+		n, err := r.ReadByte()
+		if err != nil {
+			return nil, false, err
+		}
+		negative := prefix == bigIntNegativePrefix
+		if negative {
+			n = ^n
+		}
+		bz := make([]byte, n)
+		if _, err := io.ReadFull(r, bz); err != nil {
+			return nil, false, err
+		}
+		x := new(big.Int)
+		if negative {
+			for i := range bz {
+				bz[i] = ^bz[i]
+			}
+			return x.SetBytes(bz).Neg(x), false, nil
+		}
+		return x.SetBytes(bz), false, nil
+	default:
+		return nil, false, fmt.Errorf("unexpected integer prefix %x in key", prefix)
+	}
+}
+
+func bigIntBufferSize(x *big.Int) int {
+	if x == nil || x.Sign() == 0 {
+		return 1
+	}
+	return 2 + len(x.Bytes())
+}
+
+// compareBigInts compares two string values parsed with parse, ordering empty
+// strings first. Values which can't be parsed are compared as strings.
+func compareBigInts(v1, v2 protoreflect.Value, parse func(string) (*big.Int, error)) int {
+	s1, s2 := v1.String(), v2.String()
+	x, err1 := parse(s1)
+	y, err2 := parse(s2)
+	if err1 != nil || err2 != nil {
+		return strings.Compare(s1, s2)
+	}
+
+	switch {
+	case x == nil && y == nil:
+		return 0
+	case x == nil:
+		return -1
+	case y == nil:
+		return 1
+	default:
+		return x.Cmp(y)
+	}
+}
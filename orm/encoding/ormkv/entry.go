@@ -8,6 +8,8 @@ import (
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
 )
 
 // Entry defines a logical representation of a kv-store entry for ORM instances.
@@ -136,4 +138,59 @@ func (s *SeqEntry) String() string {
 	return fmt.Sprintf("SEQ %s %d", s.TableName, s.Value)
 }
 
-var _, _, _ Entry = &PrimaryKeyEntry{}, &IndexKeyEntry{}, &SeqEntry{}
+// SchemaEntry represents the table schema stored by the last migration of a
+// table.
+type SchemaEntry struct {
+
+	// TableName is the table this entry represents.
+	TableName protoreflect.FullName
+
+	// TableDescriptor is the schema of the table.
+	TableDescriptor *ormv1.TableDescriptor
+}
+
+func (s *SchemaEntry) GetTableName() protoreflect.FullName {
+	return s.TableName
+}
+
+func (s *SchemaEntry) doNotImplement() {}
+
+func (s *SchemaEntry) String() string {
+	valBz, err := stablejson.Marshal(s.TableDescriptor)
+	valStr := string(valBz)
+	if err != nil {
+		valStr = fmt.Sprintf("ERR %v", err)
+	}
+	return fmt.Sprintf("SCHEMA %s %s", s.TableName, valStr)
+}
+
+// MigrationEntry represents a row moved out of the way of a new primary key
+// while a table migration is in progress.
+type MigrationEntry struct {
+
+	// TableName is the table this entry represents.
+	TableName protoreflect.FullName
+
+	// Row is the number of the row in the migration.
+	Row uint64
+
+	// Value represents the message of the row.
+	Value proto.Message
+}
+
+func (m *MigrationEntry) GetTableName() protoreflect.FullName {
+	return m.TableName
+}
+
+func (m *MigrationEntry) doNotImplement() {}
+
+func (m *MigrationEntry) String() string {
+	valBz, err := stablejson.Marshal(m.Value)
+	valStr := string(valBz)
+	if err != nil {
+		valStr = fmt.Sprintf("ERR %v", err)
+	}
+	return fmt.Sprintf("MIGRATION %s %d -> %s", m.TableName, m.Row, valStr)
+}
+
+var _, _, _, _, _ Entry = &PrimaryKeyEntry{}, &IndexKeyEntry{}, &SeqEntry{}, &SchemaEntry{}, &MigrationEntry{}
@@ -18,7 +18,9 @@ type IndexKeyCodec struct {
 var _ IndexCodec = &IndexKeyCodec{}
 
 // NewIndexKeyCodec creates a new IndexKeyCodec with an optional prefix for the
-// provided message descriptor, index and primary key fields.
+// provided message descriptor, index and primary key fields. One of the index
+// fields may be a repeated field, in which case the index is multi-valued and
+// stores one key for each element of that field.
 func NewIndexKeyCodec(prefix []byte, messageType protoreflect.MessageType, indexFields, primaryKeyFields []protoreflect.Name) (*IndexKeyCodec, error) {
 	if len(indexFields) == 0 {
 		return nil, ormerrors.InvalidTableDefinition.Wrapf("index fields are empty")
@@ -50,7 +52,7 @@ func NewIndexKeyCodec(prefix []byte, messageType protoreflect.MessageType, index
 		k++
 	}
 
-	cdc, err := NewKeyCodec(prefix, messageType, keyFields)
+	cdc, err := newKeyCodec(prefix, messageType, keyFields, true)
 	if err != nil {
 		return nil, err
 	}
@@ -122,3 +124,63 @@ func (cdc IndexKeyCodec) EncodeKVFromMessage(message protoreflect.Message) (k, v
 	_, k, err = cdc.EncodeKeyFromMessage(message)
 	return k, []byte{}, err
 }
+
+// EncodeKeyFromMessage encodes the key of the message in this index. It is
+// unsupported by multi-valued indexes, see EncodeKeysFromMessage.
+func (cdc IndexKeyCodec) EncodeKeyFromMessage(message protoreflect.Message) ([]protoreflect.Value, []byte, error) {
+	if cdc.IsMultiValued() {
+		return nil, nil, ormerrors.UnsupportedOperation.Wrapf(
+			"multi-valued index on %s has one key per element of %s",
+			cdc.messageType.Descriptor().FullName(),
+			cdc.fieldNames[cdc.repeatedField],
+		)
+	}
+	return cdc.KeyCodec.EncodeKeyFromMessage(message)
+}
+
+// IsMultiValued returns true if one of the index fields is a repeated field,
+// in which case the index stores one key for each element of that field.
+func (cdc IndexKeyCodec) IsMultiValued() bool {
+	return cdc.repeatedField >= 0
+}
+
+// GetMultiKeyValues extracts the values of all the keys of the message in this
+// index. Multi-valued indexes have one key per element of their repeated field
+// and none if it is empty, other indexes have exactly one key.
+func (cdc IndexKeyCodec) GetMultiKeyValues(message protoreflect.Message) [][]protoreflect.Value {
+	values := cdc.GetKeyValues(message)
+	if !cdc.IsMultiValued() {
+		return [][]protoreflect.Value{values}
+	}
+
+	list := values[cdc.repeatedField].List()
+	res := make([][]protoreflect.Value, list.Len())
+	for i := range res {
+		elemValues := make([]protoreflect.Value, len(values))
+		copy(elemValues, values)
+		elemValues[cdc.repeatedField] = list.Get(i)
+		res[i] = elemValues
+	}
+	return res
+}
+
+// EncodeKeysFromMessage encodes all the keys of the message in this index,
+// skipping the duplicates produced by repeated elements.
+func (cdc IndexKeyCodec) EncodeKeysFromMessage(message protoreflect.Message) ([][]byte, error) {
+	multiValues := cdc.GetMultiKeyValues(message)
+	keys := make([][]byte, 0, len(multiValues))
+	seen := make(map[string]bool, len(multiValues))
+	for _, values := range multiValues {
+		k, err := cdc.EncodeKey(values)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[string(k)] {
+			continue
+		}
+		seen[string(k)] = true
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"gotest.tools/v3/assert"
 	"pgregory.net/rapid"
 
 	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
 	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
 	"github.com/cosmos/cosmos-sdk/orm/internal/testutil"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 )
 
 func TestIndexKeyCodec(t *testing.T) {
@@ -61,3 +63,35 @@ func TestIndexKeyCodec(t *testing.T) {
 		}
 	})
 }
+
+func TestMultiValuedIndexKeyCodec(t *testing.T) {
+	messageType := (&testpb.ExampleTable{}).ProtoReflect().Type()
+	cdc, err := ormkv.NewIndexKeyCodec(nil, messageType, []protoreflect.Name{"repeated", "str"}, []protoreflect.Name{"u32"})
+	assert.NilError(t, err)
+	assert.Assert(t, cdc.IsMultiValued())
+
+	msg := (&testpb.ExampleTable{U32: 7, Str: "abc", Repeated: []uint32{2, 1, 2}}).ProtoReflect()
+	keys, err := cdc.EncodeKeysFromMessage(msg)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(keys))
+	for i, elem := range []uint32{2, 1} {
+		idxValues, pk, err := cdc.DecodeIndexKey(keys[i], nil)
+		assert.NilError(t, err)
+		assert.Equal(t, elem, uint32(idxValues[0].Uint()))
+		assert.Equal(t, "abc", idxValues[1].String())
+		assert.Equal(t, uint32(7), uint32(pk[0].Uint()))
+	}
+
+	_, _, err = cdc.EncodeKVFromMessage(msg)
+	assert.ErrorIs(t, err, ormerrors.UnsupportedOperation)
+
+	keys, err = cdc.EncodeKeysFromMessage((&testpb.ExampleTable{U32: 7}).ProtoReflect())
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	// repeated fields are only supported in non-unique index keys
+	_, err = ormkv.NewKeyCodec(nil, messageType, []protoreflect.Name{"repeated"})
+	assert.ErrorIs(t, err, ormerrors.InvalidKeyField)
+	_, err = ormkv.NewUniqueKeyCodec(nil, messageType, []protoreflect.Name{"repeated"}, []protoreflect.Name{"u32"})
+	assert.ErrorIs(t, err, ormerrors.InvalidKeyField)
+}
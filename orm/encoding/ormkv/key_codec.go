@@ -24,11 +24,21 @@ type KeyCodec struct {
 	fieldNames       []protoreflect.Name
 	fieldCodecs      []ormfield.Codec
 	messageType      protoreflect.MessageType
+
+	// repeatedField is the position of the repeated field in keys with
+	// one value per element of that field, or -1
+	repeatedField int
 }
 
 // NewKeyCodec returns a new KeyCodec with an optional prefix for the provided
 // message descriptor and fields.
 func NewKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames []protoreflect.Name) (*KeyCodec, error) {
+	return newKeyCodec(prefix, messageType, fieldNames, false)
+}
+
+// newKeyCodec creates a KeyCodec which, if allowRepeated is true, may include
+// one repeated field encoded as one of its elements.
+func newKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames []protoreflect.Name, allowRepeated bool) (*KeyCodec, error) {
 	n := len(fieldNames)
 	fieldCodecs := make([]ormfield.Codec, n)
 	fieldDescriptors := make([]protoreflect.FieldDescriptor, n)
@@ -37,6 +47,7 @@ func NewKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames
 		i   int
 	}
 	fixedSize := 0
+	repeatedField := -1
 	messageFields := messageType.Descriptor().Fields()
 
 	for i := 0; i < n; i++ {
@@ -45,7 +56,18 @@ func NewKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames
 		if field == nil {
 			return nil, ormerrors.FieldNotFound.Wrapf("field %s on %s", fieldNames[i], messageType.Descriptor().FullName())
 		}
-		cdc, err := ormfield.GetCodec(field, nonTerminal)
+		var cdc ormfield.Codec
+		var err error
+		if allowRepeated && field.IsList() {
+			if repeatedField >= 0 {
+				return nil, ormerrors.InvalidKeyField.Wrapf("more than one repeated field in key: %s and %s",
+					fieldDescriptors[repeatedField].FullName(), field.FullName())
+			}
+			repeatedField = i
+			cdc, err = ormfield.GetElementCodec(field, nonTerminal)
+		} else {
+			cdc, err = ormfield.GetCodec(field, nonTerminal)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -69,6 +91,7 @@ func NewKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames
 		fixedSize:        fixedSize,
 		variableSizers:   variableSizers,
 		messageType:      messageType,
+		repeatedField:    repeatedField,
 	}, nil
 }
 
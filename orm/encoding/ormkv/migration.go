@@ -0,0 +1,121 @@
+package ormkv
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// SchemaCodec is the codec for the table schema stored by table migrations.
+type SchemaCodec struct {
+	messageType protoreflect.FullName
+	prefix      []byte
+}
+
+// NewSchemaCodec creates a new SchemaCodec.
+func NewSchemaCodec(messageType protoreflect.MessageType, prefix []byte) *SchemaCodec {
+	return &SchemaCodec{messageType: messageType.Descriptor().FullName(), prefix: prefix}
+}
+
+var _ EntryCodec = &SchemaCodec{}
+
+func (s SchemaCodec) DecodeEntry(k, v []byte) (Entry, error) {
+	if !bytes.Equal(k, s.prefix) {
+		return nil, ormerrors.UnexpectedDecodePrefix
+	}
+
+	desc := &ormv1.TableDescriptor{}
+	err := proto.Unmarshal(v, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchemaEntry{
+		TableName:       s.messageType,
+		TableDescriptor: desc,
+	}, nil
+}
+
+func (s SchemaCodec) EncodeEntry(entry Entry) (k, v []byte, err error) {
+	schemaEntry, ok := entry.(*SchemaEntry)
+	if !ok {
+		return nil, nil, ormerrors.BadDecodeEntry
+	}
+
+	if schemaEntry.TableName != s.messageType {
+		return nil, nil, ormerrors.BadDecodeEntry
+	}
+
+	v, err = proto.MarshalOptions{Deterministic: true}.Marshal(schemaEntry.TableDescriptor)
+	return s.prefix, v, err
+}
+
+func (s SchemaCodec) Prefix() []byte {
+	return s.prefix
+}
+
+// MigrationCodec is the codec for the rows moved out of the way of a new
+// primary key while a table migration is in progress.
+type MigrationCodec struct {
+	messageType      protoreflect.MessageType
+	prefix           []byte
+	unmarshalOptions proto.UnmarshalOptions
+}
+
+// NewMigrationCodec creates a new MigrationCodec.
+func NewMigrationCodec(messageType protoreflect.MessageType, prefix []byte, unmarshalOptions proto.UnmarshalOptions) *MigrationCodec {
+	return &MigrationCodec{messageType: messageType, prefix: prefix, unmarshalOptions: unmarshalOptions}
+}
+
+var _ EntryCodec = &MigrationCodec{}
+
+func (m MigrationCodec) DecodeEntry(k, v []byte) (Entry, error) {
+	if !bytes.HasPrefix(k, m.prefix) || len(k) != len(m.prefix)+8 {
+		return nil, ormerrors.UnexpectedDecodePrefix
+	}
+
+	msg := m.messageType.New().Interface()
+	err := m.unmarshalOptions.Unmarshal(v, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MigrationEntry{
+		TableName: m.messageType.Descriptor().FullName(),
+		Row:       binary.BigEndian.Uint64(k[len(m.prefix):]),
+		Value:     msg,
+	}, nil
+}
+
+func (m MigrationCodec) EncodeEntry(entry Entry) (k, v []byte, err error) {
+	migrationEntry, ok := entry.(*MigrationEntry)
+	if !ok {
+		return nil, nil, ormerrors.BadDecodeEntry
+	}
+
+	if migrationEntry.TableName != m.messageType.Descriptor().FullName() {
+		return nil, nil, ormerrors.BadDecodeEntry
+	}
+
+	v, err = proto.MarshalOptions{Deterministic: true}.Marshal(migrationEntry.Value)
+	return m.EncodeKey(migrationEntry.Row), v, err
+}
+
+func (m MigrationCodec) Prefix() []byte {
+	return m.prefix
+}
+
+// EncodeKey encodes the key of the row with the provided number in the
+// migration in progress.
+func (m MigrationCodec) EncodeKey(row uint64) []byte {
+	key := make([]byte, len(m.prefix)+8)
+	copy(key, m.prefix)
+	binary.BigEndian.PutUint64(key[len(m.prefix):], row)
+	return key
+}
@@ -0,0 +1,55 @@
+package ormkv_test
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gotest.tools/v3/assert"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+)
+
+func TestSchemaCodec(t *testing.T) {
+	typ := (&testpb.ExampleTable{}).ProtoReflect().Type()
+	cdc := ormkv.NewSchemaCodec(typ, []byte{1, 2})
+
+	entry := &ormkv.SchemaEntry{
+		TableName:       typ.Descriptor().FullName(),
+		TableDescriptor: &ormv1.TableDescriptor{Id: 1, PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "u32"}},
+	}
+	k, v, err := cdc.EncodeEntry(entry)
+	assert.NilError(t, err)
+	assert.Assert(t, bytes.Equal(cdc.Prefix(), k))
+	entry2, err := cdc.DecodeEntry(k, v)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, entry, entry2, protocmp.Transform())
+
+	_, err = cdc.DecodeEntry([]byte{1, 3}, v)
+	assert.ErrorContains(t, err, "unexpected prefix")
+}
+
+func TestMigrationCodec(t *testing.T) {
+	typ := (&testpb.ExampleTable{}).ProtoReflect().Type()
+	cdc := ormkv.NewMigrationCodec(typ, []byte{1, 3}, proto.UnmarshalOptions{})
+
+	entry := &ormkv.MigrationEntry{
+		TableName: typ.Descriptor().FullName(),
+		Row:       7,
+		Value:     &testpb.ExampleTable{U32: 4, Str: "s"},
+	}
+	k, v, err := cdc.EncodeEntry(entry)
+	assert.NilError(t, err)
+	assert.Assert(t, bytes.Equal(cdc.EncodeKey(7), k))
+	assert.Assert(t, bytes.HasPrefix(k, cdc.Prefix()))
+	entry2, err := cdc.DecodeEntry(k, v)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, entry, entry2, protocmp.Transform())
+
+	_, err = cdc.DecodeEntry(cdc.Prefix(), v)
+	assert.ErrorContains(t, err, "unexpected prefix")
+}
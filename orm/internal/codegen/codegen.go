@@ -17,30 +17,48 @@ const (
 	ormTablePkg = protogen.GoImportPath("github.com/cosmos/cosmos-sdk/orm/model/ormtable")
 )
 
-func PluginRunner(p *protogen.Plugin) error {
-	for _, f := range p.Files {
-		if !f.Generate {
-			continue
-		}
+// Options are the options of the protoc-gen-go-cosmos-orm plugin.
+type Options struct {
+	// QueryService enables the generation of a Query service for the tables
+	// of each file. It is defined in a {file}_query.proto file next to the
+	// original one, whose Go code must be generated in a second pass, and
+	// implemented by the server in {file}_query.cosmos_orm.go.
+	QueryService bool
+}
 
-		if !hasTables(f) {
-			continue
-		}
+// PluginRunner returns the function generating ORM code with the provided options.
+func PluginRunner(options Options) func(p *protogen.Plugin) error {
+	return func(p *protogen.Plugin) error {
+		for _, f := range p.Files {
+			if !f.Generate {
+				continue
+			}
 
-		gen := p.NewGeneratedFile(fmt.Sprintf("%s.cosmos_orm.go", f.GeneratedFilenamePrefix), f.GoImportPath)
-		cgen := &generator.GeneratedFile{
-			GeneratedFile: gen,
-			LocalPackages: map[string]bool{},
-		}
-		f := fileGen{GeneratedFile: cgen, file: f}
-		err := f.gen()
-		if err != nil {
-			return err
+			if !hasTables(f) {
+				continue
+			}
+
+			gen := p.NewGeneratedFile(fmt.Sprintf("%s.cosmos_orm.go", f.GeneratedFilenamePrefix), f.GoImportPath)
+			cgen := &generator.GeneratedFile{
+				GeneratedFile: gen,
+				LocalPackages: map[string]bool{},
+			}
+			fgen := fileGen{GeneratedFile: cgen, file: f}
+			err := fgen.gen()
+			if err != nil {
+				return err
+			}
+
+			if options.QueryService {
+				err = genQueryService(p, f)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
+		return nil
 	}
-
-	return nil
 }
 
 func hasTables(file *protogen.File) bool {
@@ -0,0 +1,477 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+
+	"github.com/cosmos/cosmos-sdk/orm/internal/fieldnames"
+)
+
+const (
+	paginationProto = "cosmos/base/query/v1beta1/pagination.proto"
+
+	// queryDefaultLimit is the number of values returned by List queries
+	// which don't specify a limit.
+	queryDefaultLimit = 100
+)
+
+// queryGen generates the Query service of the tables of a file as a
+// {file}_query.proto file, and its server in {file}_query.cosmos_orm.go.
+type queryGen struct {
+	fileGen
+	proto  *protogen.GeneratedFile
+	tables []queryTable
+}
+
+// queryTable is a table or singleton of the file.
+type queryTable struct {
+	msg       *protogen.Message
+	table     *ormv1.TableDescriptor
+	singleton bool
+	fields    map[protoreflect.Name]*protogen.Field
+}
+
+// queryIndex is an index of a table, with the primary key as id 0.
+type queryIndex struct {
+	id     uint32
+	fields []protoreflect.Name
+	unique bool
+}
+
+func genQueryService(p *protogen.Plugin, file *protogen.File) error {
+	protoName := strings.TrimSuffix(file.Desc.Path(), ".proto") + "_query.proto"
+	gen := p.NewGeneratedFile(fmt.Sprintf("%s_query.cosmos_orm.go", file.GeneratedFilenamePrefix), file.GoImportPath)
+	q := queryGen{
+		fileGen: fileGen{
+			GeneratedFile: &generator.GeneratedFile{GeneratedFile: gen, LocalPackages: map[string]bool{}},
+			file:          file,
+		},
+		proto: p.NewGeneratedFile(protoName, ""),
+	}
+
+	for _, msg := range file.Messages {
+		t := queryTable{msg: msg, fields: map[protoreflect.Name]*protogen.Field{}}
+		for _, field := range msg.Fields {
+			t.fields[field.Desc.Name()] = field
+		}
+		if tableDesc := proto.GetExtension(msg.Desc.Options(), ormv1.E_Table).(*ormv1.TableDescriptor); tableDesc != nil {
+			t.table = tableDesc
+		} else if singletonDesc := proto.GetExtension(msg.Desc.Options(), ormv1.E_Singleton).(*ormv1.SingletonDescriptor); singletonDesc != nil {
+			t.singleton = true
+		} else {
+			continue
+		}
+		q.tables = append(q.tables, t)
+	}
+
+	q.genProto()
+	q.genServer()
+	return nil
+}
+
+func (q queryGen) serviceName() string {
+	return strcase.ToCamel(q.fileShortName()) + "QueryService"
+}
+
+func (t queryTable) name() string {
+	return t.msg.GoIdent.GoName
+}
+
+func (t queryTable) indexes() []queryIndex {
+	indexes := []queryIndex{{
+		id:     0,
+		fields: fieldnames.CommaSeparatedFieldNames(t.table.PrimaryKey.Fields).Names(),
+		unique: true,
+	}}
+	for _, idx := range t.table.Index {
+		indexes = append(indexes, queryIndex{
+			id:     idx.Id,
+			fields: fieldnames.CommaSeparatedFieldNames(idx.Fields).Names(),
+			unique: idx.Unique,
+		})
+	}
+	return indexes
+}
+
+// camelName is the name of the index in the generated code, ex. U64Str.
+func (idx queryIndex) camelName() string {
+	names := make([]string, len(idx.fields))
+	for i, name := range idx.fields {
+		names[i] = strcase.ToCamel(string(name))
+	}
+	return strings.Join(names, "")
+}
+
+// snakeName is the name of the index key field in IndexKey, ex. u64_str.
+func (idx queryIndex) snakeName() string {
+	return strings.Join(idx.fieldNames(), "_")
+}
+
+func (idx queryIndex) fieldNames() []string {
+	names := make([]string, len(idx.fields))
+	for i, name := range idx.fields {
+		names[i] = string(name)
+	}
+	return names
+}
+
+// description names the primary key or unique index in comments.
+func (idx queryIndex) description() string {
+	if idx.id == 0 {
+		return "primary key"
+	}
+	return idx.camelName() + " unique index"
+}
+
+func (q queryGen) genProto() {
+	q.proto.P("// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.")
+	q.proto.P()
+	q.proto.P(`syntax = "proto3";`)
+	q.proto.P()
+	q.proto.P("package ", q.file.Desc.Package(), ";")
+	q.proto.P()
+	for _, imp := range q.protoImports() {
+		q.proto.P(`import "`, imp, `";`)
+	}
+	q.proto.P()
+	if goPackage := q.file.Proto.GetOptions().GetGoPackage(); goPackage != "" {
+		q.proto.P(`option go_package = "`, goPackage, `";`)
+		q.proto.P()
+	}
+
+	q.proto.P("// ", q.serviceName(), " queries the state of the tables defined in ", q.file.Desc.Path(), ".")
+	q.proto.P("service ", q.serviceName(), " {")
+	first := true
+	rpc := func(comment, method string) {
+		if !first {
+			q.proto.P()
+		}
+		first = false
+		q.proto.P("  // ", method, " ", comment)
+		q.proto.P("  rpc ", method, "(", method, "Request) returns (", method, "Response);")
+	}
+	for _, t := range q.tables {
+		if t.singleton {
+			rpc("gets the "+t.name()+" singleton.", "Get"+t.name())
+			continue
+		}
+
+		for _, idx := range t.indexes() {
+			if idx.unique {
+				rpc("gets the "+t.name()+" with the provided "+idx.description()+".", q.getMethodName(t, idx))
+			}
+		}
+		rpc("lists the "+t.name()+" values by prefix or range of any index, with pagination.", "List"+t.name())
+	}
+	q.proto.P("}")
+
+	for _, t := range q.tables {
+		if t.singleton {
+			q.genProtoGetMessages(t, "Get"+t.name(), nil, "")
+			continue
+		}
+
+		for _, idx := range t.indexes() {
+			if !idx.unique {
+				continue
+			}
+			q.genProtoGetMessages(t, q.getMethodName(t, idx), idx.fields, idx.description())
+		}
+		q.genProtoListMessages(t)
+	}
+}
+
+func (q queryGen) getMethodName(t queryTable, idx queryIndex) string {
+	if idx.id == 0 {
+		return "Get" + t.name()
+	}
+	return "Get" + t.name() + "By" + idx.camelName()
+}
+
+func (q queryGen) genProtoGetMessages(t queryTable, method string, fields []protoreflect.Name, description string) {
+	q.proto.P()
+	q.proto.P("// ", method, "Request is the ", q.serviceName(), "/", method, " request type.")
+	q.proto.P("message ", method, "Request {")
+	for i, name := range fields {
+		if i > 0 {
+			q.proto.P()
+		}
+		q.proto.P("  // ", name, " is the value of the ", name, " field of the ", description, ".")
+		q.proto.P("  ", q.protoType(t.fields[name]), " ", name, " = ", i+1, ";")
+	}
+	q.proto.P("}")
+	q.proto.P()
+	q.proto.P("// ", method, "Response is the ", q.serviceName(), "/", method, " response type.")
+	q.proto.P("message ", method, "Response {")
+	q.proto.P("  // value is the ", t.name(), " found.")
+	q.proto.P("  ", q.protoMessageName(t.msg.Desc), " value = 1;")
+	q.proto.P("}")
+}
+
+func (q queryGen) genProtoListMessages(t queryTable) {
+	method := "List" + t.name()
+	q.proto.P()
+	q.proto.P("// ", method, "Request is the ", q.serviceName(), "/", method, " request type.")
+	q.proto.P("message ", method, "Request {")
+	q.proto.P("  // IndexKey is a full or prefix key of one of the ", t.name(), " indexes.")
+	q.proto.P("  message IndexKey {")
+	for _, idx := range t.indexes() {
+		q.proto.P("    // ", idx.camelName(), " is a key of the ", strings.Join(idx.fieldNames(), ","), " index. Only a leading")
+		q.proto.P("    // sequence of its fields may be set, to list the values by prefix.")
+		q.proto.P("    message ", idx.camelName(), " {")
+		for i, name := range idx.fields {
+			field := t.fields[name]
+			if i > 0 {
+				q.proto.P()
+			}
+			if field.Desc.Kind() == protoreflect.MessageKind {
+				q.proto.P("      // ", name, " is the value of the ", name, " field.")
+				q.proto.P("      ", q.protoType(field), " ", name, " = ", i+1, ";")
+				continue
+			}
+			// a oneof tells unset fields from fields set to their default value
+			q.proto.P("      // ", name, "_value is set with the value of the ", name, " field.")
+			q.proto.P("      oneof ", name, "_value {")
+			q.proto.P("        ", q.protoType(field), " ", name, " = ", i+1, ";")
+			q.proto.P("      }")
+		}
+		q.proto.P("    }")
+		q.proto.P()
+	}
+	q.proto.P("    // key is the key of one of the indexes. If unset, all the values are listed")
+	q.proto.P("    // by primary key.")
+	q.proto.P("    oneof key {")
+	for _, idx := range t.indexes() {
+		q.proto.P("      ", idx.camelName(), " ", idx.snakeName(), " = ", idx.id+1, ";")
+	}
+	q.proto.P("    }")
+	q.proto.P("  }")
+	q.proto.P()
+	q.proto.P("  // RangeQuery lists the values between two keys of the same index, inclusive.")
+	q.proto.P("  message RangeQuery {")
+	q.proto.P("    // from is the start of the range.")
+	q.proto.P("    IndexKey from = 1;")
+	q.proto.P()
+	q.proto.P("    // to is the end of the range.")
+	q.proto.P("    IndexKey to = 2;")
+	q.proto.P("  }")
+	q.proto.P()
+	q.proto.P("  // query is a prefix or range query. If unset, all the values are listed by")
+	q.proto.P("  // primary key.")
+	q.proto.P("  oneof query {")
+	q.proto.P("    IndexKey prefix_query = 1;")
+	q.proto.P("    RangeQuery range_query = 2;")
+	q.proto.P("  }")
+	q.proto.P()
+	q.proto.P("  // pagination defines optional pagination parameters. At most ", queryDefaultLimit, " values are")
+	q.proto.P("  // returned if no limit is set.")
+	q.proto.P("  cosmos.base.query.v1beta1.PageRequest pagination = 3;")
+	q.proto.P("}")
+	q.proto.P()
+	q.proto.P("// ", method, "Response is the ", q.serviceName(), "/", method, " response type.")
+	q.proto.P("message ", method, "Response {")
+	q.proto.P("  // values are the ", t.name(), " values found.")
+	q.proto.P("  repeated ", q.protoMessageName(t.msg.Desc), " values = 1;")
+	q.proto.P()
+	q.proto.P("  // pagination is the pagination response.")
+	q.proto.P("  cosmos.base.query.v1beta1.PageResponse pagination = 2;")
+	q.proto.P("}")
+}
+
+// protoImports returns the files to import in the query proto file.
+func (q queryGen) protoImports() []string {
+	imports := map[string]bool{
+		paginationProto:    true,
+		q.file.Desc.Path(): true,
+	}
+	for _, t := range q.tables {
+		if t.singleton {
+			continue
+		}
+		for _, idx := range t.indexes() {
+			for _, name := range idx.fields {
+				field := t.fields[name].Desc
+				switch field.Kind() {
+				case protoreflect.EnumKind:
+					imports[field.Enum().ParentFile().Path()] = true
+				case protoreflect.MessageKind:
+					imports[field.Message().ParentFile().Path()] = true
+				}
+			}
+		}
+	}
+
+	res := make([]string, 0, len(imports))
+	for imp := range imports {
+		res = append(res, imp)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// protoType returns the type of a key field in the query proto file. Repeated
+// fields in multi-valued indexes are keyed by a single element.
+func (q queryGen) protoType(field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		return q.protoTypeName(field.Desc.Enum().FullName())
+	case protoreflect.MessageKind:
+		return q.protoMessageName(field.Desc.Message())
+	default:
+		return field.Desc.Kind().String()
+	}
+}
+
+func (q queryGen) protoMessageName(desc protoreflect.MessageDescriptor) string {
+	return q.protoTypeName(desc.FullName())
+}
+
+// protoTypeName returns the name of a type relative to the package of the file.
+func (q queryGen) protoTypeName(name protoreflect.FullName) string {
+	pkg := string(q.file.Desc.Package())
+	if pkg != "" && strings.HasPrefix(string(name), pkg+".") {
+		return strings.TrimPrefix(string(name), pkg+".")
+	}
+	return string(name)
+}
+
+func (q queryGen) genServer() {
+	q.P("// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.")
+	q.P()
+	q.P("package ", q.file.GoPackageName)
+	q.P()
+
+	serverName := strcase.ToLowerCamel(q.serviceName())
+	q.P("type ", serverName, " struct {")
+	q.P("Unimplemented", q.serviceName(), "Server")
+	q.P("store ", q.storeInterfaceName())
+	q.P("}")
+	q.P()
+	q.P("// New", q.serviceName(), " returns a ", q.serviceName(), "Server querying the tables of store.")
+	q.P("func New", q.serviceName(), "(store ", q.storeInterfaceName(), ") ", q.serviceName(), "Server {")
+	q.P("return ", serverName, "{store: store}")
+	q.P("}")
+	q.P()
+	q.P("var _ ", q.serviceName(), "Server = ", serverName, "{}")
+	q.P()
+
+	for _, t := range q.tables {
+		receiver := fmt.Sprintf("func (x %s) ", serverName)
+		tableAccessor := "x.store." + q.messageTableInterfaceName(t.msg) + "()"
+		if t.singleton {
+			method := "Get" + t.name()
+			q.P(receiver, method, "(ctx ", contextPkg.Ident("Context"), ", request *", method, "Request) (*", method, "Response, error) {")
+			q.P("value, err := ", tableAccessor, ".Get(ctx)")
+			q.P("if err != nil {")
+			q.P("return nil, err")
+			q.P("}")
+			q.P("return &", method, "Response{Value: value}, nil")
+			q.P("}")
+			q.P()
+			continue
+		}
+
+		for _, idx := range t.indexes() {
+			if !idx.unique {
+				continue
+			}
+			method := q.getMethodName(t, idx)
+			getter := "Get"
+			if idx.id != 0 {
+				getter = "GetBy" + idx.camelName()
+			}
+			args := make([]string, len(idx.fields))
+			for i, name := range idx.fields {
+				args[i] = "request." + t.fields[name].GoName
+			}
+			q.P(receiver, method, "(ctx ", contextPkg.Ident("Context"), ", request *", method, "Request) (*", method, "Response, error) {")
+			q.genNilRequestCheck()
+			q.P("value, err := ", tableAccessor, ".", getter, "(ctx, ", strings.Join(args, ", "), ")")
+			q.P("if err != nil {")
+			q.P("return nil, err")
+			q.P("}")
+			q.P("return &", method, "Response{Value: value}, nil")
+			q.P("}")
+			q.P()
+		}
+
+		q.genServerList(t, receiver, tableAccessor)
+	}
+}
+
+func (q queryGen) genNilRequestCheck() {
+	q.P("if request == nil {")
+	q.P("return nil, ", ormErrPkg.Ident("InvalidQuery"), `.Wrap("empty request")`)
+	q.P("}")
+}
+
+func (q queryGen) genServerList(t queryTable, receiver, tableAccessor string) {
+	method := "List" + t.name()
+	indexKeyFunc := q.param(t.name()) + "QueryIndexKey"
+	iteratorName := t.name() + "Iterator"
+
+	q.P(receiver, method, "(ctx ", contextPkg.Ident("Context"), ", request *", method, "Request) (*", method, "Response, error) {")
+	q.genNilRequestCheck()
+	q.P("opts := []", ormListPkg.Ident("Option"), "{", ormListPkg.Ident("DefaultLimit"), "(", queryDefaultLimit, "), ", ormListPkg.Ident("Paginate"), "(request.Pagination)}")
+	q.P("var it ", iteratorName)
+	q.P("if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {")
+	q.P("from, err := ", indexKeyFunc, "(rangeQuery.From)")
+	q.P("if err != nil {")
+	q.P("return nil, err")
+	q.P("}")
+	q.P("to, err := ", indexKeyFunc, "(rangeQuery.To)")
+	q.P("if err != nil {")
+	q.P("return nil, err")
+	q.P("}")
+	q.P("if from.id() != to.id() {")
+	q.P("return nil, ", ormErrPkg.Ident("InvalidQuery"), `.Wrap("range query keys of different indexes")`)
+	q.P("}")
+	q.P("it, err = ", tableAccessor, ".ListRange(ctx, from, to, opts...)")
+	q.P("if err != nil {")
+	q.P("return nil, err")
+	q.P("}")
+	q.P("} else {")
+	q.P("prefix, err := ", indexKeyFunc, "(request.GetPrefixQuery())")
+	q.P("if err != nil {")
+	q.P("return nil, err")
+	q.P("}")
+	q.P("it, err = ", tableAccessor, ".List(ctx, prefix, opts...)")
+	q.P("if err != nil {")
+	q.P("return nil, err")
+	q.P("}")
+	q.P("}")
+	q.P("defer it.Close()")
+	q.P()
+	q.P("res := &", method, "Response{}")
+	q.P("for it.Next() {")
+	q.P("value, err := it.Value()")
+	q.P("if err != nil {")
+	q.P("return nil, err")
+	q.P("}")
+	q.P("res.Values = append(res.Values, value)")
+	q.P("}")
+	q.P("res.Pagination = it.PageResponse()")
+	q.P("return res, nil")
+	q.P("}")
+	q.P()
+
+	q.P("func ", indexKeyFunc, "(key *", method, "Request_IndexKey) (", t.name(), "IndexKey, error) {")
+	for _, idx := range t.indexes() {
+		q.P("if k := key.Get", strcase.ToCamel(idx.snakeName()), "(); k != nil {")
+		q.P("values, err := ", ormTablePkg.Ident("PrefixKeyValues"), "(k)")
+		q.P("return ", t.name(), idx.camelName(), "IndexKey{vs: values}, err")
+		q.P("}")
+	}
+	q.P("return ", t.name(), "PrimaryKey{}, nil")
+	q.P("}")
+	q.P()
+}
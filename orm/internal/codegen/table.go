@@ -62,6 +62,7 @@ func (t tableGen) getTableInterface() {
 	t.P("Insert(ctx ", contextPkg.Ident("Context"), ", ", t.param(t.msg.GoIdent.GoName), " *", t.QualifiedGoIdent(t.msg.GoIdent), ") error")
 	if t.table.PrimaryKey.AutoIncrement {
 		t.P("InsertReturningID(ctx ", contextPkg.Ident("Context"), ", ", t.param(t.msg.GoIdent.GoName), " *", t.QualifiedGoIdent(t.msg.GoIdent), ") (uint64, error)")
+		t.P("LastInsertedSequence(ctx ", contextPkg.Ident("Context"), ") (uint64, error)")
 	}
 	t.P("Update(ctx ", contextPkg.Ident("Context"), ", ", t.param(t.msg.GoIdent.GoName), " *", t.QualifiedGoIdent(t.msg.GoIdent), ") error")
 	t.P("Save(ctx ", contextPkg.Ident("Context"), ", ", t.param(t.msg.GoIdent.GoName), " *", t.QualifiedGoIdent(t.msg.GoIdent), ") error")
@@ -146,10 +147,15 @@ func (t tableGen) fieldsArgs(names []protoreflect.Name) string {
 }
 
 func (t tableGen) fieldArg(name protoreflect.Name) string {
-	typ, pointer := t.GeneratedFile.FieldGoType(t.fields[name])
+	field := t.fields[name]
+	typ, pointer := t.GeneratedFile.FieldGoType(field)
 	if pointer {
 		typ = "*" + typ
 	}
+	// multi-valued indexes on repeated fields are keyed by a single element
+	if field.Desc.IsList() {
+		typ = strings.TrimPrefix(typ, "[]")
+	}
 	return string(name) + " " + typ
 }
 
@@ -184,6 +190,11 @@ func (t tableGen) genTableImpl() {
 		t.P("return ", receiverVar, ".table.InsertReturningID(ctx, ", varName, ")")
 		t.P("}")
 		t.P()
+
+		t.P(receiver, "LastInsertedSequence(ctx ", contextPkg.Ident("Context"), ") (uint64, error) {")
+		t.P("return ", receiverVar, ".table.LastInsertedSequence(ctx)")
+		t.P("}")
+		t.P()
 	}
 
 	// Has
@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.
+
+package testpb
+
+import (
+	context "context"
+	ormlist "github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	ormtable "github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	ormerrors "github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+type bankQueryService struct {
+	UnimplementedBankQueryServiceServer
+	store BankStore
+}
+
+// NewBankQueryService returns a BankQueryServiceServer querying the tables of store.
+func NewBankQueryService(store BankStore) BankQueryServiceServer {
+	return bankQueryService{store: store}
+}
+
+var _ BankQueryServiceServer = bankQueryService{}
+
+func (x bankQueryService) GetBalance(ctx context.Context, request *GetBalanceRequest) (*GetBalanceResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.BalanceTable().Get(ctx, request.Address, request.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &GetBalanceResponse{Value: value}, nil
+}
+
+func (x bankQueryService) ListBalance(ctx context.Context, request *ListBalanceRequest) (*ListBalanceResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it BalanceIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := balanceQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := balanceQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.BalanceTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := balanceQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.BalanceTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListBalanceResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func balanceQueryIndexKey(key *ListBalanceRequest_IndexKey) (BalanceIndexKey, error) {
+	if k := key.GetAddressDenom(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return BalanceAddressDenomIndexKey{vs: values}, err
+	}
+	if k := key.GetDenom(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return BalanceDenomIndexKey{vs: values}, err
+	}
+	return BalancePrimaryKey{}, nil
+}
+
+func (x bankQueryService) GetSupply(ctx context.Context, request *GetSupplyRequest) (*GetSupplyResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.SupplyTable().Get(ctx, request.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &GetSupplyResponse{Value: value}, nil
+}
+
+func (x bankQueryService) ListSupply(ctx context.Context, request *ListSupplyRequest) (*ListSupplyResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it SupplyIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := supplyQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := supplyQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.SupplyTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := supplyQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.SupplyTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListSupplyResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func supplyQueryIndexKey(key *ListSupplyRequest_IndexKey) (SupplyIndexKey, error) {
+	if k := key.GetDenom(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return SupplyDenomIndexKey{vs: values}, err
+	}
+	return SupplyPrimaryKey{}, nil
+}
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: testpb/bank_query.proto
+
+package testpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BankQueryServiceClient is the client API for BankQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BankQueryServiceClient interface {
+	// GetBalance gets the Balance with the provided primary key.
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	// ListBalance lists the Balance values by prefix or range of any index, with pagination.
+	ListBalance(ctx context.Context, in *ListBalanceRequest, opts ...grpc.CallOption) (*ListBalanceResponse, error)
+	// GetSupply gets the Supply with the provided primary key.
+	GetSupply(ctx context.Context, in *GetSupplyRequest, opts ...grpc.CallOption) (*GetSupplyResponse, error)
+	// ListSupply lists the Supply values by prefix or range of any index, with pagination.
+	ListSupply(ctx context.Context, in *ListSupplyRequest, opts ...grpc.CallOption) (*ListSupplyResponse, error)
+}
+
+type bankQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBankQueryServiceClient(cc grpc.ClientConnInterface) BankQueryServiceClient {
+	return &bankQueryServiceClient{cc}
+}
+
+func (c *bankQueryServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
+	out := new(GetBalanceResponse)
+	err := c.cc.Invoke(ctx, "/testpb.BankQueryService/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankQueryServiceClient) ListBalance(ctx context.Context, in *ListBalanceRequest, opts ...grpc.CallOption) (*ListBalanceResponse, error) {
+	out := new(ListBalanceResponse)
+	err := c.cc.Invoke(ctx, "/testpb.BankQueryService/ListBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankQueryServiceClient) GetSupply(ctx context.Context, in *GetSupplyRequest, opts ...grpc.CallOption) (*GetSupplyResponse, error) {
+	out := new(GetSupplyResponse)
+	err := c.cc.Invoke(ctx, "/testpb.BankQueryService/GetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankQueryServiceClient) ListSupply(ctx context.Context, in *ListSupplyRequest, opts ...grpc.CallOption) (*ListSupplyResponse, error) {
+	out := new(ListSupplyResponse)
+	err := c.cc.Invoke(ctx, "/testpb.BankQueryService/ListSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BankQueryServiceServer is the server API for BankQueryService service.
+// All implementations must embed UnimplementedBankQueryServiceServer
+// for forward compatibility
+type BankQueryServiceServer interface {
+	// GetBalance gets the Balance with the provided primary key.
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// ListBalance lists the Balance values by prefix or range of any index, with pagination.
+	ListBalance(context.Context, *ListBalanceRequest) (*ListBalanceResponse, error)
+	// GetSupply gets the Supply with the provided primary key.
+	GetSupply(context.Context, *GetSupplyRequest) (*GetSupplyResponse, error)
+	// ListSupply lists the Supply values by prefix or range of any index, with pagination.
+	ListSupply(context.Context, *ListSupplyRequest) (*ListSupplyResponse, error)
+	mustEmbedUnimplementedBankQueryServiceServer()
+}
+
+// UnimplementedBankQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBankQueryServiceServer struct {
+}
+
+func (UnimplementedBankQueryServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedBankQueryServiceServer) ListBalance(context.Context, *ListBalanceRequest) (*ListBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalance not implemented")
+}
+func (UnimplementedBankQueryServiceServer) GetSupply(context.Context, *GetSupplyRequest) (*GetSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupply not implemented")
+}
+func (UnimplementedBankQueryServiceServer) ListSupply(context.Context, *ListSupplyRequest) (*ListSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupply not implemented")
+}
+func (UnimplementedBankQueryServiceServer) mustEmbedUnimplementedBankQueryServiceServer() {}
+
+// UnsafeBankQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BankQueryServiceServer will
+// result in compilation errors.
+type UnsafeBankQueryServiceServer interface {
+	mustEmbedUnimplementedBankQueryServiceServer()
+}
+
+func RegisterBankQueryServiceServer(s grpc.ServiceRegistrar, srv BankQueryServiceServer) {
+	s.RegisterService(&BankQueryService_ServiceDesc, srv)
+}
+
+func _BankQueryService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankQueryServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.BankQueryService/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankQueryServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankQueryService_ListBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankQueryServiceServer).ListBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.BankQueryService/ListBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankQueryServiceServer).ListBalance(ctx, req.(*ListBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankQueryService_GetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankQueryServiceServer).GetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.BankQueryService/GetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankQueryServiceServer).GetSupply(ctx, req.(*GetSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankQueryService_ListSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankQueryServiceServer).ListSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.BankQueryService/ListSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankQueryServiceServer).ListSupply(ctx, req.(*ListSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BankQueryService_ServiceDesc is the grpc.ServiceDesc for BankQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BankQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testpb.BankQueryService",
+	HandlerType: (*BankQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBalance",
+			Handler:    _BankQueryService_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListBalance",
+			Handler:    _BankQueryService_ListBalance_Handler,
+		},
+		{
+			MethodName: "GetSupply",
+			Handler:    _BankQueryService_GetSupply_Handler,
+		},
+		{
+			MethodName: "ListSupply",
+			Handler:    _BankQueryService_ListSupply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testpb/bank_query.proto",
+}
@@ -216,6 +216,7 @@ func NewExampleTableTable(db ormtable.Schema) (ExampleTableTable, error) {
 type ExampleAutoIncrementTableTable interface {
 	Insert(ctx context.Context, exampleAutoIncrementTable *ExampleAutoIncrementTable) error
 	InsertReturningID(ctx context.Context, exampleAutoIncrementTable *ExampleAutoIncrementTable) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
 	Update(ctx context.Context, exampleAutoIncrementTable *ExampleAutoIncrementTable) error
 	Save(ctx context.Context, exampleAutoIncrementTable *ExampleAutoIncrementTable) error
 	Delete(ctx context.Context, exampleAutoIncrementTable *ExampleAutoIncrementTable) error
@@ -302,6 +303,10 @@ func (this exampleAutoIncrementTableTable) InsertReturningID(ctx context.Context
 	return this.table.InsertReturningID(ctx, exampleAutoIncrementTable)
 }
 
+func (this exampleAutoIncrementTableTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
 func (this exampleAutoIncrementTableTable) Has(ctx context.Context, id uint64) (found bool, err error) {
 	return this.table.PrimaryKey().Has(ctx, id)
 }
@@ -401,6 +406,7 @@ func NewExampleSingletonTable(db ormtable.Schema) (ExampleSingletonTable, error)
 type ExampleTimestampTable interface {
 	Insert(ctx context.Context, exampleTimestamp *ExampleTimestamp) error
 	InsertReturningID(ctx context.Context, exampleTimestamp *ExampleTimestamp) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
 	Update(ctx context.Context, exampleTimestamp *ExampleTimestamp) error
 	Save(ctx context.Context, exampleTimestamp *ExampleTimestamp) error
 	Delete(ctx context.Context, exampleTimestamp *ExampleTimestamp) error
@@ -484,6 +490,10 @@ func (this exampleTimestampTable) InsertReturningID(ctx context.Context, example
 	return this.table.InsertReturningID(ctx, exampleTimestamp)
 }
 
+func (this exampleTimestampTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
 func (this exampleTimestampTable) Has(ctx context.Context, id uint64) (found bool, err error) {
 	return this.table.PrimaryKey().Has(ctx, id)
 }
@@ -680,12 +690,324 @@ func NewSimpleExampleTable(db ormtable.Schema) (SimpleExampleTable, error) {
 	return simpleExampleTable{table}, nil
 }
 
+type ExampleRepeatedTable interface {
+	Insert(ctx context.Context, exampleRepeated *ExampleRepeated) error
+	InsertReturningID(ctx context.Context, exampleRepeated *ExampleRepeated) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+	Update(ctx context.Context, exampleRepeated *ExampleRepeated) error
+	Save(ctx context.Context, exampleRepeated *ExampleRepeated) error
+	Delete(ctx context.Context, exampleRepeated *ExampleRepeated) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ExampleRepeated, error)
+	List(ctx context.Context, prefixKey ExampleRepeatedIndexKey, opts ...ormlist.Option) (ExampleRepeatedIterator, error)
+	ListRange(ctx context.Context, from, to ExampleRepeatedIndexKey, opts ...ormlist.Option) (ExampleRepeatedIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ExampleRepeatedIndexKey) error
+	DeleteRange(ctx context.Context, from, to ExampleRepeatedIndexKey) error
+
+	doNotImplement()
+}
+
+type ExampleRepeatedIterator struct {
+	ormtable.Iterator
+}
+
+func (i ExampleRepeatedIterator) Value() (*ExampleRepeated, error) {
+	var exampleRepeated ExampleRepeated
+	err := i.UnmarshalMessage(&exampleRepeated)
+	return &exampleRepeated, err
+}
+
+type ExampleRepeatedIndexKey interface {
+	id() uint32
+	values() []interface{}
+	exampleRepeatedIndexKey()
+}
+
+// primary key starting index..
+type ExampleRepeatedPrimaryKey = ExampleRepeatedIdIndexKey
+
+type ExampleRepeatedIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleRepeatedIdIndexKey) id() uint32               { return 0 }
+func (x ExampleRepeatedIdIndexKey) values() []interface{}    { return x.vs }
+func (x ExampleRepeatedIdIndexKey) exampleRepeatedIndexKey() {}
+
+func (this ExampleRepeatedIdIndexKey) WithId(id uint64) ExampleRepeatedIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ExampleRepeatedTagsIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleRepeatedTagsIndexKey) id() uint32               { return 1 }
+func (x ExampleRepeatedTagsIndexKey) values() []interface{}    { return x.vs }
+func (x ExampleRepeatedTagsIndexKey) exampleRepeatedIndexKey() {}
+
+func (this ExampleRepeatedTagsIndexKey) WithTags(tags string) ExampleRepeatedTagsIndexKey {
+	this.vs = []interface{}{tags}
+	return this
+}
+
+type ExampleRepeatedOwnerTagsIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleRepeatedOwnerTagsIndexKey) id() uint32               { return 2 }
+func (x ExampleRepeatedOwnerTagsIndexKey) values() []interface{}    { return x.vs }
+func (x ExampleRepeatedOwnerTagsIndexKey) exampleRepeatedIndexKey() {}
+
+func (this ExampleRepeatedOwnerTagsIndexKey) WithOwner(owner string) ExampleRepeatedOwnerTagsIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this ExampleRepeatedOwnerTagsIndexKey) WithOwnerTags(owner string, tags string) ExampleRepeatedOwnerTagsIndexKey {
+	this.vs = []interface{}{owner, tags}
+	return this
+}
+
+type exampleRepeatedTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this exampleRepeatedTable) Insert(ctx context.Context, exampleRepeated *ExampleRepeated) error {
+	return this.table.Insert(ctx, exampleRepeated)
+}
+
+func (this exampleRepeatedTable) Update(ctx context.Context, exampleRepeated *ExampleRepeated) error {
+	return this.table.Update(ctx, exampleRepeated)
+}
+
+func (this exampleRepeatedTable) Save(ctx context.Context, exampleRepeated *ExampleRepeated) error {
+	return this.table.Save(ctx, exampleRepeated)
+}
+
+func (this exampleRepeatedTable) Delete(ctx context.Context, exampleRepeated *ExampleRepeated) error {
+	return this.table.Delete(ctx, exampleRepeated)
+}
+
+func (this exampleRepeatedTable) InsertReturningID(ctx context.Context, exampleRepeated *ExampleRepeated) (uint64, error) {
+	return this.table.InsertReturningID(ctx, exampleRepeated)
+}
+
+func (this exampleRepeatedTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
+func (this exampleRepeatedTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this exampleRepeatedTable) Get(ctx context.Context, id uint64) (*ExampleRepeated, error) {
+	var exampleRepeated ExampleRepeated
+	found, err := this.table.PrimaryKey().Get(ctx, &exampleRepeated, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &exampleRepeated, nil
+}
+
+func (this exampleRepeatedTable) List(ctx context.Context, prefixKey ExampleRepeatedIndexKey, opts ...ormlist.Option) (ExampleRepeatedIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ExampleRepeatedIterator{it}, err
+}
+
+func (this exampleRepeatedTable) ListRange(ctx context.Context, from, to ExampleRepeatedIndexKey, opts ...ormlist.Option) (ExampleRepeatedIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ExampleRepeatedIterator{it}, err
+}
+
+func (this exampleRepeatedTable) DeleteBy(ctx context.Context, prefixKey ExampleRepeatedIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this exampleRepeatedTable) DeleteRange(ctx context.Context, from, to ExampleRepeatedIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this exampleRepeatedTable) doNotImplement() {}
+
+var _ ExampleRepeatedTable = exampleRepeatedTable{}
+
+func NewExampleRepeatedTable(db ormtable.Schema) (ExampleRepeatedTable, error) {
+	table := db.GetTable(&ExampleRepeated{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ExampleRepeated{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return exampleRepeatedTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ExampleAmountTable interface {
+	Insert(ctx context.Context, exampleAmount *ExampleAmount) error
+	InsertReturningID(ctx context.Context, exampleAmount *ExampleAmount) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+	Update(ctx context.Context, exampleAmount *ExampleAmount) error
+	Save(ctx context.Context, exampleAmount *ExampleAmount) error
+	Delete(ctx context.Context, exampleAmount *ExampleAmount) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ExampleAmount, error)
+	List(ctx context.Context, prefixKey ExampleAmountIndexKey, opts ...ormlist.Option) (ExampleAmountIterator, error)
+	ListRange(ctx context.Context, from, to ExampleAmountIndexKey, opts ...ormlist.Option) (ExampleAmountIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ExampleAmountIndexKey) error
+	DeleteRange(ctx context.Context, from, to ExampleAmountIndexKey) error
+
+	doNotImplement()
+}
+
+type ExampleAmountIterator struct {
+	ormtable.Iterator
+}
+
+func (i ExampleAmountIterator) Value() (*ExampleAmount, error) {
+	var exampleAmount ExampleAmount
+	err := i.UnmarshalMessage(&exampleAmount)
+	return &exampleAmount, err
+}
+
+type ExampleAmountIndexKey interface {
+	id() uint32
+	values() []interface{}
+	exampleAmountIndexKey()
+}
+
+// primary key starting index..
+type ExampleAmountPrimaryKey = ExampleAmountIdIndexKey
+
+type ExampleAmountIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleAmountIdIndexKey) id() uint32             { return 0 }
+func (x ExampleAmountIdIndexKey) values() []interface{}  { return x.vs }
+func (x ExampleAmountIdIndexKey) exampleAmountIndexKey() {}
+
+func (this ExampleAmountIdIndexKey) WithId(id uint64) ExampleAmountIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ExampleAmountAmountIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleAmountAmountIndexKey) id() uint32             { return 1 }
+func (x ExampleAmountAmountIndexKey) values() []interface{}  { return x.vs }
+func (x ExampleAmountAmountIndexKey) exampleAmountIndexKey() {}
+
+func (this ExampleAmountAmountIndexKey) WithAmount(amount string) ExampleAmountAmountIndexKey {
+	this.vs = []interface{}{amount}
+	return this
+}
+
+type ExampleAmountPriceAmountIndexKey struct {
+	vs []interface{}
+}
+
+func (x ExampleAmountPriceAmountIndexKey) id() uint32             { return 2 }
+func (x ExampleAmountPriceAmountIndexKey) values() []interface{}  { return x.vs }
+func (x ExampleAmountPriceAmountIndexKey) exampleAmountIndexKey() {}
+
+func (this ExampleAmountPriceAmountIndexKey) WithPrice(price string) ExampleAmountPriceAmountIndexKey {
+	this.vs = []interface{}{price}
+	return this
+}
+
+func (this ExampleAmountPriceAmountIndexKey) WithPriceAmount(price string, amount string) ExampleAmountPriceAmountIndexKey {
+	this.vs = []interface{}{price, amount}
+	return this
+}
+
+type exampleAmountTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this exampleAmountTable) Insert(ctx context.Context, exampleAmount *ExampleAmount) error {
+	return this.table.Insert(ctx, exampleAmount)
+}
+
+func (this exampleAmountTable) Update(ctx context.Context, exampleAmount *ExampleAmount) error {
+	return this.table.Update(ctx, exampleAmount)
+}
+
+func (this exampleAmountTable) Save(ctx context.Context, exampleAmount *ExampleAmount) error {
+	return this.table.Save(ctx, exampleAmount)
+}
+
+func (this exampleAmountTable) Delete(ctx context.Context, exampleAmount *ExampleAmount) error {
+	return this.table.Delete(ctx, exampleAmount)
+}
+
+func (this exampleAmountTable) InsertReturningID(ctx context.Context, exampleAmount *ExampleAmount) (uint64, error) {
+	return this.table.InsertReturningID(ctx, exampleAmount)
+}
+
+func (this exampleAmountTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
+func (this exampleAmountTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this exampleAmountTable) Get(ctx context.Context, id uint64) (*ExampleAmount, error) {
+	var exampleAmount ExampleAmount
+	found, err := this.table.PrimaryKey().Get(ctx, &exampleAmount, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &exampleAmount, nil
+}
+
+func (this exampleAmountTable) List(ctx context.Context, prefixKey ExampleAmountIndexKey, opts ...ormlist.Option) (ExampleAmountIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ExampleAmountIterator{it}, err
+}
+
+func (this exampleAmountTable) ListRange(ctx context.Context, from, to ExampleAmountIndexKey, opts ...ormlist.Option) (ExampleAmountIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ExampleAmountIterator{it}, err
+}
+
+func (this exampleAmountTable) DeleteBy(ctx context.Context, prefixKey ExampleAmountIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this exampleAmountTable) DeleteRange(ctx context.Context, from, to ExampleAmountIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this exampleAmountTable) doNotImplement() {}
+
+var _ ExampleAmountTable = exampleAmountTable{}
+
+func NewExampleAmountTable(db ormtable.Schema) (ExampleAmountTable, error) {
+	table := db.GetTable(&ExampleAmount{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ExampleAmount{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return exampleAmountTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type TestSchemaStore interface {
 	ExampleTableTable() ExampleTableTable
 	ExampleAutoIncrementTableTable() ExampleAutoIncrementTableTable
 	ExampleSingletonTable() ExampleSingletonTable
 	ExampleTimestampTable() ExampleTimestampTable
 	SimpleExampleTable() SimpleExampleTable
+	ExampleRepeatedTable() ExampleRepeatedTable
+	ExampleAmountTable() ExampleAmountTable
 
 	doNotImplement()
 }
@@ -696,6 +1018,8 @@ type testSchemaStore struct {
 	exampleSingleton          ExampleSingletonTable
 	exampleTimestamp          ExampleTimestampTable
 	simpleExample             SimpleExampleTable
+	exampleRepeated           ExampleRepeatedTable
+	exampleAmount             ExampleAmountTable
 }
 
 func (x testSchemaStore) ExampleTableTable() ExampleTableTable {
@@ -718,6 +1042,14 @@ func (x testSchemaStore) SimpleExampleTable() SimpleExampleTable {
 	return x.simpleExample
 }
 
+func (x testSchemaStore) ExampleRepeatedTable() ExampleRepeatedTable {
+	return x.exampleRepeated
+}
+
+func (x testSchemaStore) ExampleAmountTable() ExampleAmountTable {
+	return x.exampleAmount
+}
+
 func (testSchemaStore) doNotImplement() {}
 
 var _ TestSchemaStore = testSchemaStore{}
@@ -748,11 +1080,23 @@ func NewTestSchemaStore(db ormtable.Schema) (TestSchemaStore, error) {
 		return nil, err
 	}
 
+	exampleRepeatedTable, err := NewExampleRepeatedTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	exampleAmountTable, err := NewExampleAmountTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return testSchemaStore{
 		exampleTableTable,
 		exampleAutoIncrementTableTable,
 		exampleSingletonTable,
 		exampleTimestampTable,
 		simpleExampleTable,
+		exampleRepeatedTable,
+		exampleAmountTable,
 	}, nil
 }
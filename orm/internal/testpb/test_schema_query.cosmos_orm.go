@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.
+
+package testpb
+
+import (
+	context "context"
+	ormlist "github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	ormtable "github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	ormerrors "github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+type testSchemaQueryService struct {
+	UnimplementedTestSchemaQueryServiceServer
+	store TestSchemaStore
+}
+
+// NewTestSchemaQueryService returns a TestSchemaQueryServiceServer querying the tables of store.
+func NewTestSchemaQueryService(store TestSchemaStore) TestSchemaQueryServiceServer {
+	return testSchemaQueryService{store: store}
+}
+
+var _ TestSchemaQueryServiceServer = testSchemaQueryService{}
+
+func (x testSchemaQueryService) GetExampleTable(ctx context.Context, request *GetExampleTableRequest) (*GetExampleTableResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.ExampleTableTable().Get(ctx, request.U32, request.I64, request.Str)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleTableResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) GetExampleTableByU64Str(ctx context.Context, request *GetExampleTableByU64StrRequest) (*GetExampleTableByU64StrResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.ExampleTableTable().GetByU64Str(ctx, request.U64, request.Str)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleTableByU64StrResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) ListExampleTable(ctx context.Context, request *ListExampleTableRequest) (*ListExampleTableResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it ExampleTableIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := exampleTableQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := exampleTableQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.ExampleTableTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := exampleTableQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.ExampleTableTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListExampleTableResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func exampleTableQueryIndexKey(key *ListExampleTableRequest_IndexKey) (ExampleTableIndexKey, error) {
+	if k := key.GetU32I64Str(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleTableU32I64StrIndexKey{vs: values}, err
+	}
+	if k := key.GetU64Str(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleTableU64StrIndexKey{vs: values}, err
+	}
+	if k := key.GetStrU32(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleTableStrU32IndexKey{vs: values}, err
+	}
+	if k := key.GetBzStr(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleTableBzStrIndexKey{vs: values}, err
+	}
+	return ExampleTablePrimaryKey{}, nil
+}
+
+func (x testSchemaQueryService) GetExampleAutoIncrementTable(ctx context.Context, request *GetExampleAutoIncrementTableRequest) (*GetExampleAutoIncrementTableResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.ExampleAutoIncrementTableTable().Get(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleAutoIncrementTableResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) GetExampleAutoIncrementTableByX(ctx context.Context, request *GetExampleAutoIncrementTableByXRequest) (*GetExampleAutoIncrementTableByXResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.ExampleAutoIncrementTableTable().GetByX(ctx, request.X)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleAutoIncrementTableByXResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) ListExampleAutoIncrementTable(ctx context.Context, request *ListExampleAutoIncrementTableRequest) (*ListExampleAutoIncrementTableResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it ExampleAutoIncrementTableIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := exampleAutoIncrementTableQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := exampleAutoIncrementTableQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.ExampleAutoIncrementTableTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := exampleAutoIncrementTableQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.ExampleAutoIncrementTableTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListExampleAutoIncrementTableResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func exampleAutoIncrementTableQueryIndexKey(key *ListExampleAutoIncrementTableRequest_IndexKey) (ExampleAutoIncrementTableIndexKey, error) {
+	if k := key.GetId(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleAutoIncrementTableIdIndexKey{vs: values}, err
+	}
+	if k := key.GetX(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleAutoIncrementTableXIndexKey{vs: values}, err
+	}
+	return ExampleAutoIncrementTablePrimaryKey{}, nil
+}
+
+func (x testSchemaQueryService) GetExampleSingleton(ctx context.Context, request *GetExampleSingletonRequest) (*GetExampleSingletonResponse, error) {
+	value, err := x.store.ExampleSingletonTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleSingletonResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) GetExampleTimestamp(ctx context.Context, request *GetExampleTimestampRequest) (*GetExampleTimestampResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.ExampleTimestampTable().Get(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleTimestampResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) ListExampleTimestamp(ctx context.Context, request *ListExampleTimestampRequest) (*ListExampleTimestampResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it ExampleTimestampIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := exampleTimestampQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := exampleTimestampQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.ExampleTimestampTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := exampleTimestampQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.ExampleTimestampTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListExampleTimestampResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func exampleTimestampQueryIndexKey(key *ListExampleTimestampRequest_IndexKey) (ExampleTimestampIndexKey, error) {
+	if k := key.GetId(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleTimestampIdIndexKey{vs: values}, err
+	}
+	if k := key.GetTs(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleTimestampTsIndexKey{vs: values}, err
+	}
+	return ExampleTimestampPrimaryKey{}, nil
+}
+
+func (x testSchemaQueryService) GetSimpleExample(ctx context.Context, request *GetSimpleExampleRequest) (*GetSimpleExampleResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.SimpleExampleTable().Get(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &GetSimpleExampleResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) GetSimpleExampleByUnique(ctx context.Context, request *GetSimpleExampleByUniqueRequest) (*GetSimpleExampleByUniqueResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.SimpleExampleTable().GetByUnique(ctx, request.Unique)
+	if err != nil {
+		return nil, err
+	}
+	return &GetSimpleExampleByUniqueResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) ListSimpleExample(ctx context.Context, request *ListSimpleExampleRequest) (*ListSimpleExampleResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it SimpleExampleIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := simpleExampleQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := simpleExampleQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.SimpleExampleTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := simpleExampleQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.SimpleExampleTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListSimpleExampleResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func simpleExampleQueryIndexKey(key *ListSimpleExampleRequest_IndexKey) (SimpleExampleIndexKey, error) {
+	if k := key.GetName(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return SimpleExampleNameIndexKey{vs: values}, err
+	}
+	if k := key.GetUnique(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return SimpleExampleUniqueIndexKey{vs: values}, err
+	}
+	return SimpleExamplePrimaryKey{}, nil
+}
+
+func (x testSchemaQueryService) GetExampleRepeated(ctx context.Context, request *GetExampleRepeatedRequest) (*GetExampleRepeatedResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.ExampleRepeatedTable().Get(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleRepeatedResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) ListExampleRepeated(ctx context.Context, request *ListExampleRepeatedRequest) (*ListExampleRepeatedResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it ExampleRepeatedIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := exampleRepeatedQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := exampleRepeatedQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.ExampleRepeatedTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := exampleRepeatedQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.ExampleRepeatedTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListExampleRepeatedResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func exampleRepeatedQueryIndexKey(key *ListExampleRepeatedRequest_IndexKey) (ExampleRepeatedIndexKey, error) {
+	if k := key.GetId(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleRepeatedIdIndexKey{vs: values}, err
+	}
+	if k := key.GetTags(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleRepeatedTagsIndexKey{vs: values}, err
+	}
+	if k := key.GetOwnerTags(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleRepeatedOwnerTagsIndexKey{vs: values}, err
+	}
+	return ExampleRepeatedPrimaryKey{}, nil
+}
+
+func (x testSchemaQueryService) GetExampleAmount(ctx context.Context, request *GetExampleAmountRequest) (*GetExampleAmountResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	value, err := x.store.ExampleAmountTable().Get(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExampleAmountResponse{Value: value}, nil
+}
+
+func (x testSchemaQueryService) ListExampleAmount(ctx context.Context, request *ListExampleAmountRequest) (*ListExampleAmountResponse, error) {
+	if request == nil {
+		return nil, ormerrors.InvalidQuery.Wrap("empty request")
+	}
+	opts := []ormlist.Option{ormlist.DefaultLimit(100), ormlist.Paginate(request.Pagination)}
+	var it ExampleAmountIterator
+	if rangeQuery := request.GetRangeQuery(); rangeQuery != nil {
+		from, err := exampleAmountQueryIndexKey(rangeQuery.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := exampleAmountQueryIndexKey(rangeQuery.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.id() != to.id() {
+			return nil, ormerrors.InvalidQuery.Wrap("range query keys of different indexes")
+		}
+		it, err = x.store.ExampleAmountTable().ListRange(ctx, from, to, opts...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		prefix, err := exampleAmountQueryIndexKey(request.GetPrefixQuery())
+		if err != nil {
+			return nil, err
+		}
+		it, err = x.store.ExampleAmountTable().List(ctx, prefix, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer it.Close()
+
+	res := &ListExampleAmountResponse{}
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		res.Values = append(res.Values, value)
+	}
+	res.Pagination = it.PageResponse()
+	return res, nil
+}
+
+func exampleAmountQueryIndexKey(key *ListExampleAmountRequest_IndexKey) (ExampleAmountIndexKey, error) {
+	if k := key.GetId(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleAmountIdIndexKey{vs: values}, err
+	}
+	if k := key.GetAmount(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleAmountAmountIndexKey{vs: values}, err
+	}
+	if k := key.GetPriceAmount(); k != nil {
+		values, err := ormtable.PrefixKeyValues(k)
+		return ExampleAmountPriceAmountIndexKey{vs: values}, err
+	}
+	return ExampleAmountPrimaryKey{}, nil
+}
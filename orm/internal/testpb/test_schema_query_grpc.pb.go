@@ -0,0 +1,678 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: testpb/test_schema_query.proto
+
+package testpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TestSchemaQueryServiceClient is the client API for TestSchemaQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TestSchemaQueryServiceClient interface {
+	// GetExampleTable gets the ExampleTable with the provided primary key.
+	GetExampleTable(ctx context.Context, in *GetExampleTableRequest, opts ...grpc.CallOption) (*GetExampleTableResponse, error)
+	// GetExampleTableByU64Str gets the ExampleTable with the provided U64Str unique index.
+	GetExampleTableByU64Str(ctx context.Context, in *GetExampleTableByU64StrRequest, opts ...grpc.CallOption) (*GetExampleTableByU64StrResponse, error)
+	// ListExampleTable lists the ExampleTable values by prefix or range of any index, with pagination.
+	ListExampleTable(ctx context.Context, in *ListExampleTableRequest, opts ...grpc.CallOption) (*ListExampleTableResponse, error)
+	// GetExampleAutoIncrementTable gets the ExampleAutoIncrementTable with the provided primary key.
+	GetExampleAutoIncrementTable(ctx context.Context, in *GetExampleAutoIncrementTableRequest, opts ...grpc.CallOption) (*GetExampleAutoIncrementTableResponse, error)
+	// GetExampleAutoIncrementTableByX gets the ExampleAutoIncrementTable with the provided X unique index.
+	GetExampleAutoIncrementTableByX(ctx context.Context, in *GetExampleAutoIncrementTableByXRequest, opts ...grpc.CallOption) (*GetExampleAutoIncrementTableByXResponse, error)
+	// ListExampleAutoIncrementTable lists the ExampleAutoIncrementTable values by prefix or range of any index, with pagination.
+	ListExampleAutoIncrementTable(ctx context.Context, in *ListExampleAutoIncrementTableRequest, opts ...grpc.CallOption) (*ListExampleAutoIncrementTableResponse, error)
+	// GetExampleSingleton gets the ExampleSingleton singleton.
+	GetExampleSingleton(ctx context.Context, in *GetExampleSingletonRequest, opts ...grpc.CallOption) (*GetExampleSingletonResponse, error)
+	// GetExampleTimestamp gets the ExampleTimestamp with the provided primary key.
+	GetExampleTimestamp(ctx context.Context, in *GetExampleTimestampRequest, opts ...grpc.CallOption) (*GetExampleTimestampResponse, error)
+	// ListExampleTimestamp lists the ExampleTimestamp values by prefix or range of any index, with pagination.
+	ListExampleTimestamp(ctx context.Context, in *ListExampleTimestampRequest, opts ...grpc.CallOption) (*ListExampleTimestampResponse, error)
+	// GetSimpleExample gets the SimpleExample with the provided primary key.
+	GetSimpleExample(ctx context.Context, in *GetSimpleExampleRequest, opts ...grpc.CallOption) (*GetSimpleExampleResponse, error)
+	// GetSimpleExampleByUnique gets the SimpleExample with the provided Unique unique index.
+	GetSimpleExampleByUnique(ctx context.Context, in *GetSimpleExampleByUniqueRequest, opts ...grpc.CallOption) (*GetSimpleExampleByUniqueResponse, error)
+	// ListSimpleExample lists the SimpleExample values by prefix or range of any index, with pagination.
+	ListSimpleExample(ctx context.Context, in *ListSimpleExampleRequest, opts ...grpc.CallOption) (*ListSimpleExampleResponse, error)
+	// GetExampleRepeated gets the ExampleRepeated with the provided primary key.
+	GetExampleRepeated(ctx context.Context, in *GetExampleRepeatedRequest, opts ...grpc.CallOption) (*GetExampleRepeatedResponse, error)
+	// ListExampleRepeated lists the ExampleRepeated values by prefix or range of any index, with pagination.
+	ListExampleRepeated(ctx context.Context, in *ListExampleRepeatedRequest, opts ...grpc.CallOption) (*ListExampleRepeatedResponse, error)
+	// GetExampleAmount gets the ExampleAmount with the provided primary key.
+	GetExampleAmount(ctx context.Context, in *GetExampleAmountRequest, opts ...grpc.CallOption) (*GetExampleAmountResponse, error)
+	// ListExampleAmount lists the ExampleAmount values by prefix or range of any index, with pagination.
+	ListExampleAmount(ctx context.Context, in *ListExampleAmountRequest, opts ...grpc.CallOption) (*ListExampleAmountResponse, error)
+}
+
+type testSchemaQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTestSchemaQueryServiceClient(cc grpc.ClientConnInterface) TestSchemaQueryServiceClient {
+	return &testSchemaQueryServiceClient{cc}
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleTable(ctx context.Context, in *GetExampleTableRequest, opts ...grpc.CallOption) (*GetExampleTableResponse, error) {
+	out := new(GetExampleTableResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleTableByU64Str(ctx context.Context, in *GetExampleTableByU64StrRequest, opts ...grpc.CallOption) (*GetExampleTableByU64StrResponse, error) {
+	out := new(GetExampleTableByU64StrResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleTableByU64Str", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) ListExampleTable(ctx context.Context, in *ListExampleTableRequest, opts ...grpc.CallOption) (*ListExampleTableResponse, error) {
+	out := new(ListExampleTableResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/ListExampleTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleAutoIncrementTable(ctx context.Context, in *GetExampleAutoIncrementTableRequest, opts ...grpc.CallOption) (*GetExampleAutoIncrementTableResponse, error) {
+	out := new(GetExampleAutoIncrementTableResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleAutoIncrementTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleAutoIncrementTableByX(ctx context.Context, in *GetExampleAutoIncrementTableByXRequest, opts ...grpc.CallOption) (*GetExampleAutoIncrementTableByXResponse, error) {
+	out := new(GetExampleAutoIncrementTableByXResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleAutoIncrementTableByX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) ListExampleAutoIncrementTable(ctx context.Context, in *ListExampleAutoIncrementTableRequest, opts ...grpc.CallOption) (*ListExampleAutoIncrementTableResponse, error) {
+	out := new(ListExampleAutoIncrementTableResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/ListExampleAutoIncrementTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleSingleton(ctx context.Context, in *GetExampleSingletonRequest, opts ...grpc.CallOption) (*GetExampleSingletonResponse, error) {
+	out := new(GetExampleSingletonResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleSingleton", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleTimestamp(ctx context.Context, in *GetExampleTimestampRequest, opts ...grpc.CallOption) (*GetExampleTimestampResponse, error) {
+	out := new(GetExampleTimestampResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) ListExampleTimestamp(ctx context.Context, in *ListExampleTimestampRequest, opts ...grpc.CallOption) (*ListExampleTimestampResponse, error) {
+	out := new(ListExampleTimestampResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/ListExampleTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetSimpleExample(ctx context.Context, in *GetSimpleExampleRequest, opts ...grpc.CallOption) (*GetSimpleExampleResponse, error) {
+	out := new(GetSimpleExampleResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetSimpleExample", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetSimpleExampleByUnique(ctx context.Context, in *GetSimpleExampleByUniqueRequest, opts ...grpc.CallOption) (*GetSimpleExampleByUniqueResponse, error) {
+	out := new(GetSimpleExampleByUniqueResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetSimpleExampleByUnique", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) ListSimpleExample(ctx context.Context, in *ListSimpleExampleRequest, opts ...grpc.CallOption) (*ListSimpleExampleResponse, error) {
+	out := new(ListSimpleExampleResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/ListSimpleExample", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleRepeated(ctx context.Context, in *GetExampleRepeatedRequest, opts ...grpc.CallOption) (*GetExampleRepeatedResponse, error) {
+	out := new(GetExampleRepeatedResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleRepeated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) ListExampleRepeated(ctx context.Context, in *ListExampleRepeatedRequest, opts ...grpc.CallOption) (*ListExampleRepeatedResponse, error) {
+	out := new(ListExampleRepeatedResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/ListExampleRepeated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) GetExampleAmount(ctx context.Context, in *GetExampleAmountRequest, opts ...grpc.CallOption) (*GetExampleAmountResponse, error) {
+	out := new(GetExampleAmountResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/GetExampleAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSchemaQueryServiceClient) ListExampleAmount(ctx context.Context, in *ListExampleAmountRequest, opts ...grpc.CallOption) (*ListExampleAmountResponse, error) {
+	out := new(ListExampleAmountResponse)
+	err := c.cc.Invoke(ctx, "/testpb.TestSchemaQueryService/ListExampleAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TestSchemaQueryServiceServer is the server API for TestSchemaQueryService service.
+// All implementations must embed UnimplementedTestSchemaQueryServiceServer
+// for forward compatibility
+type TestSchemaQueryServiceServer interface {
+	// GetExampleTable gets the ExampleTable with the provided primary key.
+	GetExampleTable(context.Context, *GetExampleTableRequest) (*GetExampleTableResponse, error)
+	// GetExampleTableByU64Str gets the ExampleTable with the provided U64Str unique index.
+	GetExampleTableByU64Str(context.Context, *GetExampleTableByU64StrRequest) (*GetExampleTableByU64StrResponse, error)
+	// ListExampleTable lists the ExampleTable values by prefix or range of any index, with pagination.
+	ListExampleTable(context.Context, *ListExampleTableRequest) (*ListExampleTableResponse, error)
+	// GetExampleAutoIncrementTable gets the ExampleAutoIncrementTable with the provided primary key.
+	GetExampleAutoIncrementTable(context.Context, *GetExampleAutoIncrementTableRequest) (*GetExampleAutoIncrementTableResponse, error)
+	// GetExampleAutoIncrementTableByX gets the ExampleAutoIncrementTable with the provided X unique index.
+	GetExampleAutoIncrementTableByX(context.Context, *GetExampleAutoIncrementTableByXRequest) (*GetExampleAutoIncrementTableByXResponse, error)
+	// ListExampleAutoIncrementTable lists the ExampleAutoIncrementTable values by prefix or range of any index, with pagination.
+	ListExampleAutoIncrementTable(context.Context, *ListExampleAutoIncrementTableRequest) (*ListExampleAutoIncrementTableResponse, error)
+	// GetExampleSingleton gets the ExampleSingleton singleton.
+	GetExampleSingleton(context.Context, *GetExampleSingletonRequest) (*GetExampleSingletonResponse, error)
+	// GetExampleTimestamp gets the ExampleTimestamp with the provided primary key.
+	GetExampleTimestamp(context.Context, *GetExampleTimestampRequest) (*GetExampleTimestampResponse, error)
+	// ListExampleTimestamp lists the ExampleTimestamp values by prefix or range of any index, with pagination.
+	ListExampleTimestamp(context.Context, *ListExampleTimestampRequest) (*ListExampleTimestampResponse, error)
+	// GetSimpleExample gets the SimpleExample with the provided primary key.
+	GetSimpleExample(context.Context, *GetSimpleExampleRequest) (*GetSimpleExampleResponse, error)
+	// GetSimpleExampleByUnique gets the SimpleExample with the provided Unique unique index.
+	GetSimpleExampleByUnique(context.Context, *GetSimpleExampleByUniqueRequest) (*GetSimpleExampleByUniqueResponse, error)
+	// ListSimpleExample lists the SimpleExample values by prefix or range of any index, with pagination.
+	ListSimpleExample(context.Context, *ListSimpleExampleRequest) (*ListSimpleExampleResponse, error)
+	// GetExampleRepeated gets the ExampleRepeated with the provided primary key.
+	GetExampleRepeated(context.Context, *GetExampleRepeatedRequest) (*GetExampleRepeatedResponse, error)
+	// ListExampleRepeated lists the ExampleRepeated values by prefix or range of any index, with pagination.
+	ListExampleRepeated(context.Context, *ListExampleRepeatedRequest) (*ListExampleRepeatedResponse, error)
+	// GetExampleAmount gets the ExampleAmount with the provided primary key.
+	GetExampleAmount(context.Context, *GetExampleAmountRequest) (*GetExampleAmountResponse, error)
+	// ListExampleAmount lists the ExampleAmount values by prefix or range of any index, with pagination.
+	ListExampleAmount(context.Context, *ListExampleAmountRequest) (*ListExampleAmountResponse, error)
+	mustEmbedUnimplementedTestSchemaQueryServiceServer()
+}
+
+// UnimplementedTestSchemaQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTestSchemaQueryServiceServer struct {
+}
+
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleTable(context.Context, *GetExampleTableRequest) (*GetExampleTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleTable not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleTableByU64Str(context.Context, *GetExampleTableByU64StrRequest) (*GetExampleTableByU64StrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleTableByU64Str not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) ListExampleTable(context.Context, *ListExampleTableRequest) (*ListExampleTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExampleTable not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleAutoIncrementTable(context.Context, *GetExampleAutoIncrementTableRequest) (*GetExampleAutoIncrementTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleAutoIncrementTable not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleAutoIncrementTableByX(context.Context, *GetExampleAutoIncrementTableByXRequest) (*GetExampleAutoIncrementTableByXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleAutoIncrementTableByX not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) ListExampleAutoIncrementTable(context.Context, *ListExampleAutoIncrementTableRequest) (*ListExampleAutoIncrementTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExampleAutoIncrementTable not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleSingleton(context.Context, *GetExampleSingletonRequest) (*GetExampleSingletonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleSingleton not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleTimestamp(context.Context, *GetExampleTimestampRequest) (*GetExampleTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleTimestamp not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) ListExampleTimestamp(context.Context, *ListExampleTimestampRequest) (*ListExampleTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExampleTimestamp not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetSimpleExample(context.Context, *GetSimpleExampleRequest) (*GetSimpleExampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimpleExample not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetSimpleExampleByUnique(context.Context, *GetSimpleExampleByUniqueRequest) (*GetSimpleExampleByUniqueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimpleExampleByUnique not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) ListSimpleExample(context.Context, *ListSimpleExampleRequest) (*ListSimpleExampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSimpleExample not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleRepeated(context.Context, *GetExampleRepeatedRequest) (*GetExampleRepeatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleRepeated not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) ListExampleRepeated(context.Context, *ListExampleRepeatedRequest) (*ListExampleRepeatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExampleRepeated not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) GetExampleAmount(context.Context, *GetExampleAmountRequest) (*GetExampleAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExampleAmount not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) ListExampleAmount(context.Context, *ListExampleAmountRequest) (*ListExampleAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExampleAmount not implemented")
+}
+func (UnimplementedTestSchemaQueryServiceServer) mustEmbedUnimplementedTestSchemaQueryServiceServer() {
+}
+
+// UnsafeTestSchemaQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TestSchemaQueryServiceServer will
+// result in compilation errors.
+type UnsafeTestSchemaQueryServiceServer interface {
+	mustEmbedUnimplementedTestSchemaQueryServiceServer()
+}
+
+func RegisterTestSchemaQueryServiceServer(s grpc.ServiceRegistrar, srv TestSchemaQueryServiceServer) {
+	s.RegisterService(&TestSchemaQueryService_ServiceDesc, srv)
+}
+
+func _TestSchemaQueryService_GetExampleTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleTable(ctx, req.(*GetExampleTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetExampleTableByU64Str_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleTableByU64StrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleTableByU64Str(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleTableByU64Str",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleTableByU64Str(ctx, req.(*GetExampleTableByU64StrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_ListExampleTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExampleTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).ListExampleTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/ListExampleTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).ListExampleTable(ctx, req.(*ListExampleTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetExampleAutoIncrementTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleAutoIncrementTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleAutoIncrementTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleAutoIncrementTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleAutoIncrementTable(ctx, req.(*GetExampleAutoIncrementTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetExampleAutoIncrementTableByX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleAutoIncrementTableByXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleAutoIncrementTableByX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleAutoIncrementTableByX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleAutoIncrementTableByX(ctx, req.(*GetExampleAutoIncrementTableByXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_ListExampleAutoIncrementTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExampleAutoIncrementTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).ListExampleAutoIncrementTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/ListExampleAutoIncrementTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).ListExampleAutoIncrementTable(ctx, req.(*ListExampleAutoIncrementTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetExampleSingleton_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleSingletonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleSingleton(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleSingleton",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleSingleton(ctx, req.(*GetExampleSingletonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetExampleTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleTimestamp(ctx, req.(*GetExampleTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_ListExampleTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExampleTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).ListExampleTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/ListExampleTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).ListExampleTimestamp(ctx, req.(*ListExampleTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetSimpleExample_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimpleExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetSimpleExample(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetSimpleExample",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetSimpleExample(ctx, req.(*GetSimpleExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetSimpleExampleByUnique_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimpleExampleByUniqueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetSimpleExampleByUnique(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetSimpleExampleByUnique",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetSimpleExampleByUnique(ctx, req.(*GetSimpleExampleByUniqueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_ListSimpleExample_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSimpleExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).ListSimpleExample(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/ListSimpleExample",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).ListSimpleExample(ctx, req.(*ListSimpleExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetExampleRepeated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleRepeatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleRepeated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleRepeated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleRepeated(ctx, req.(*GetExampleRepeatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_ListExampleRepeated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExampleRepeatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).ListExampleRepeated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/ListExampleRepeated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).ListExampleRepeated(ctx, req.(*ListExampleRepeatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_GetExampleAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExampleAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).GetExampleAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/GetExampleAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).GetExampleAmount(ctx, req.(*GetExampleAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSchemaQueryService_ListExampleAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExampleAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSchemaQueryServiceServer).ListExampleAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testpb.TestSchemaQueryService/ListExampleAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSchemaQueryServiceServer).ListExampleAmount(ctx, req.(*ListExampleAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TestSchemaQueryService_ServiceDesc is the grpc.ServiceDesc for TestSchemaQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TestSchemaQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testpb.TestSchemaQueryService",
+	HandlerType: (*TestSchemaQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetExampleTable",
+			Handler:    _TestSchemaQueryService_GetExampleTable_Handler,
+		},
+		{
+			MethodName: "GetExampleTableByU64Str",
+			Handler:    _TestSchemaQueryService_GetExampleTableByU64Str_Handler,
+		},
+		{
+			MethodName: "ListExampleTable",
+			Handler:    _TestSchemaQueryService_ListExampleTable_Handler,
+		},
+		{
+			MethodName: "GetExampleAutoIncrementTable",
+			Handler:    _TestSchemaQueryService_GetExampleAutoIncrementTable_Handler,
+		},
+		{
+			MethodName: "GetExampleAutoIncrementTableByX",
+			Handler:    _TestSchemaQueryService_GetExampleAutoIncrementTableByX_Handler,
+		},
+		{
+			MethodName: "ListExampleAutoIncrementTable",
+			Handler:    _TestSchemaQueryService_ListExampleAutoIncrementTable_Handler,
+		},
+		{
+			MethodName: "GetExampleSingleton",
+			Handler:    _TestSchemaQueryService_GetExampleSingleton_Handler,
+		},
+		{
+			MethodName: "GetExampleTimestamp",
+			Handler:    _TestSchemaQueryService_GetExampleTimestamp_Handler,
+		},
+		{
+			MethodName: "ListExampleTimestamp",
+			Handler:    _TestSchemaQueryService_ListExampleTimestamp_Handler,
+		},
+		{
+			MethodName: "GetSimpleExample",
+			Handler:    _TestSchemaQueryService_GetSimpleExample_Handler,
+		},
+		{
+			MethodName: "GetSimpleExampleByUnique",
+			Handler:    _TestSchemaQueryService_GetSimpleExampleByUnique_Handler,
+		},
+		{
+			MethodName: "ListSimpleExample",
+			Handler:    _TestSchemaQueryService_ListSimpleExample_Handler,
+		},
+		{
+			MethodName: "GetExampleRepeated",
+			Handler:    _TestSchemaQueryService_GetExampleRepeated_Handler,
+		},
+		{
+			MethodName: "ListExampleRepeated",
+			Handler:    _TestSchemaQueryService_ListExampleRepeated_Handler,
+		},
+		{
+			MethodName: "GetExampleAmount",
+			Handler:    _TestSchemaQueryService_GetExampleAmount_Handler,
+		},
+		{
+			MethodName: "ListExampleAmount",
+			Handler:    _TestSchemaQueryService_ListExampleAmount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testpb/test_schema_query.proto",
+}
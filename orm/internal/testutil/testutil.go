@@ -3,6 +3,7 @@ package testutil
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -105,8 +106,38 @@ var TestFieldSpecs = []TestFieldSpec{
 			return protoreflect.EnumNumber(x)
 		}),
 	},
+	{
+		"int",
+		rapid.OneOf(rapid.Just(""), genBigInt.Map(func(x *big.Int) string {
+			return x.String()
+		})),
+	},
+	{
+		"dec",
+		rapid.OneOf(rapid.Just(""), genBigInt.Map(func(x *big.Int) string {
+			// format as a decimal with 18 fractional digits like sdk.Dec
+			str := new(big.Int).Abs(x).String()
+			if len(str) <= 18 {
+				str = strings.Repeat("0", 19-len(str)) + str
+			}
+			if x.Sign() < 0 {
+				str = "-" + str
+			}
+			return str[:len(str)-18] + "." + str[len(str)-18:]
+		})),
+	},
 }
 
+// genBigInt generates integers of up to 320 bits.
+var genBigInt = rapid.Custom(func(t *rapid.T) *big.Int {
+	bz := rapid.SliceOfN(rapid.Byte(), 0, 40).Draw(t, "bytes").([]byte)
+	x := new(big.Int).SetBytes(bz)
+	if rapid.Bool().Draw(t, "negative").(bool) {
+		x.Neg(x)
+	}
+	return x
+})
+
 func MakeTestCodec(fname protoreflect.Name, nonTerminal bool) (ormfield.Codec, error) {
 	field := GetTestField(fname)
 	if field == nil {
@@ -0,0 +1,58 @@
+package ormdb
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+)
+
+// MigrateOptions are options for migrating the tables of a ModuleDB.
+type MigrateOptions struct {
+	// Previous optionally defines, by table name, the schemas the data of
+	// tables was written with when no schema has been stored for them yet.
+	// See ormtable.MigrateOptions.Previous.
+	Previous map[protoreflect.FullName]*ormv1.TableDescriptor
+
+	// Transforms optionally defines, by table name, functions called on each
+	// row of tables whose primary key changes.
+	// See ormtable.MigrateOptions.Transform.
+	Transforms map[protoreflect.FullName]func(proto.Message) error
+
+	// BatchSize is the number of rows migrated between progress reports. If
+	// it is zero, a default of 1000 is used.
+	BatchSize int
+
+	// OnProgress is an optional callback reporting the progress of the
+	// migration.
+	OnProgress func(ormtable.MigrateProgress)
+}
+
+func (m moduleDB) Migrate(ctx context.Context, options MigrateOptions) error {
+	// migrate tables in a deterministic order
+	names := make([]string, 0, len(m.tablesByName))
+	for name := range m.tablesByName {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		tableName := protoreflect.FullName(name)
+		err := ormtable.Migrate(ctx, m.tablesByName[tableName], ormtable.MigrateOptions{
+			Previous:   options.Previous[tableName],
+			Transform:  options.Transforms[tableName],
+			BatchSize:  options.BatchSize,
+			OnProgress: options.OnProgress,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -41,6 +41,12 @@ type ModuleDB interface {
 
 	// ExportJSON exports JSON for each table in the module.
 	ExportJSON(context.Context, ormjson.WriteTarget) error
+
+	// Migrate migrates the data of each table in the module from the schema
+	// it was stored with to its current schema, and stores the current schemas
+	// for later migrations. It should be called from the store migration
+	// handler of a module whenever its table definitions change.
+	Migrate(context.Context, MigrateOptions) error
 }
 
 type moduleDB struct {
@@ -0,0 +1,121 @@
+package ormdb_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	queryv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+func balanceKey(address, denom string) *testpb.ListBalanceRequest_IndexKey {
+	key := &testpb.ListBalanceRequest_IndexKey_AddressDenom{}
+	if address != "" {
+		key.AddressValue = &testpb.ListBalanceRequest_IndexKey_AddressDenom_Address{Address: address}
+	}
+	if denom != "" {
+		key.DenomValue = &testpb.ListBalanceRequest_IndexKey_AddressDenom_Denom{Denom: denom}
+	}
+	return &testpb.ListBalanceRequest_IndexKey{
+		Key: &testpb.ListBalanceRequest_IndexKey_AddressDenom_{AddressDenom: key},
+	}
+}
+
+func balanceAmounts(values []*testpb.Balance) []uint64 {
+	var amounts []uint64
+	for _, value := range values {
+		amounts = append(amounts, value.Amount)
+	}
+	return amounts
+}
+
+func TestQueryService(t *testing.T) {
+	db, err := ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	ctx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+	store, err := testpb.NewBankStore(db)
+	assert.NilError(t, err)
+	k := keeper{store}
+	assert.NilError(t, k.Mint(ctx, "alice", "bar", 1))
+	assert.NilError(t, k.Mint(ctx, "alice", "foo", 2))
+	assert.NilError(t, k.Mint(ctx, "bob", "bar", 3))
+	assert.NilError(t, k.Mint(ctx, "carol", "foo", 4))
+
+	qs := testpb.NewBankQueryService(store)
+
+	// get by primary key
+	getRes, err := qs.GetBalance(ctx, &testpb.GetBalanceRequest{Address: "bob", Denom: "bar"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(3), getRes.Value.Amount)
+	_, err = qs.GetBalance(ctx, &testpb.GetBalanceRequest{Address: "bob", Denom: "foo"})
+	assert.Assert(t, ormerrors.IsNotFound(err))
+	supplyRes, err := qs.GetSupply(ctx, &testpb.GetSupplyRequest{Denom: "foo"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(6), supplyRes.Value.Amount)
+	_, err = qs.GetSupply(ctx, nil)
+	assert.ErrorIs(t, err, ormerrors.InvalidQuery)
+
+	// list everything
+	listRes, err := qs.ListBalance(ctx, &testpb.ListBalanceRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2, 3, 4}, balanceAmounts(listRes.Values))
+
+	// list by prefix of the primary key
+	listRes, err = qs.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_PrefixQuery{PrefixQuery: balanceKey("alice", "")},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2}, balanceAmounts(listRes.Values))
+
+	// list by secondary index
+	listRes, err = qs.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_PrefixQuery{PrefixQuery: &testpb.ListBalanceRequest_IndexKey{
+			Key: &testpb.ListBalanceRequest_IndexKey_Denom_{Denom: &testpb.ListBalanceRequest_IndexKey_Denom{
+				DenomValue: &testpb.ListBalanceRequest_IndexKey_Denom_Denom{Denom: "foo"},
+			}},
+		}},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{2, 4}, balanceAmounts(listRes.Values))
+
+	// list by range with pagination
+	req := &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_RangeQuery_{RangeQuery: &testpb.ListBalanceRequest_RangeQuery{
+			From: balanceKey("alice", "foo"),
+			To:   balanceKey("carol", ""),
+		}},
+		Pagination: &queryv1beta1.PageRequest{Limit: 2, CountTotal: true},
+	}
+	listRes, err = qs.ListBalance(ctx, req)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{2, 3}, balanceAmounts(listRes.Values))
+	assert.Equal(t, uint64(3), listRes.Pagination.Total)
+	req.Pagination = &queryv1beta1.PageRequest{Key: listRes.Pagination.NextKey}
+	listRes, err = qs.ListBalance(ctx, req)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{4}, balanceAmounts(listRes.Values))
+	assert.Assert(t, listRes.Pagination.NextKey == nil)
+
+	// a key field can't be set after an unset one
+	_, err = qs.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_PrefixQuery{PrefixQuery: balanceKey("", "foo")},
+	})
+	assert.ErrorIs(t, err, ormerrors.InvalidQuery)
+
+	// range keys must be keys of the same index
+	_, err = qs.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_RangeQuery_{RangeQuery: &testpb.ListBalanceRequest_RangeQuery{
+			From: balanceKey("alice", ""),
+			To: &testpb.ListBalanceRequest_IndexKey{
+				Key: &testpb.ListBalanceRequest_IndexKey_Denom_{Denom: &testpb.ListBalanceRequest_IndexKey_Denom{}},
+			},
+		}},
+	})
+	assert.ErrorIs(t, err, ormerrors.InvalidQuery)
+}
@@ -31,6 +31,46 @@ func (t autoIncrementTable) InsertReturningID(ctx context.Context, message proto
 	return t.save(ctx, backend, message, saveModeInsert)
 }
 
+func (t autoIncrementTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	backend, err := t.getBackend(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.curSeqValue(backend.IndexStoreReader())
+}
+
+func (t autoIncrementTable) InsertWithID(ctx context.Context, message proto.Message) error {
+	backend, err := t.getWriteBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	if message.ProtoReflect().Get(t.autoIncField).Uint() == 0 {
+		return ormerrors.AutoIncrementKeyNotSet
+	}
+
+	// tableImpl doesn't know about auto-incrementing IDs and inserts the
+	// message with its ID
+	return t.tableImpl.save(ctx, backend, message, saveModeInsert)
+}
+
+func (t autoIncrementTable) SetLastInsertedSequence(ctx context.Context, seq uint64) error {
+	backend, err := t.getWriteBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	writer := newBatchIndexCommitmentWriter(backend)
+	defer writer.Close()
+	err = t.setSeqValue(writer.IndexStore(), seq)
+	if err != nil {
+		return err
+	}
+
+	return writer.Write()
+}
+
 func (t autoIncrementTable) Save(ctx context.Context, message proto.Message) error {
 	backend, err := t.getWriteBackend(ctx)
 	if err != nil {
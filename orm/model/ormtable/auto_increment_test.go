@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/orm/internal/testkv"
 	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 )
 
 func TestAutoIncrementScenario(t *testing.T) {
@@ -75,11 +76,43 @@ func runAutoIncrementScenario(t *testing.T, table ormtable.AutoIncrementTable, c
 	golden.Assert(t, buf.String(), "trivial_auto_inc_export.golden")
 	store3 := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
 	assert.NilError(t, table.ImportJSON(store3, bytes.NewReader(buf.Bytes())))
+	seq, err := table.LastInsertedSequence(store3)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(2), seq)
 	ex1.Id = 0
 	assert.NilError(t, table.Insert(store3, ex1))
 	assert.Equal(t, uint64(3), ex1.Id) // should equal 3 because the sequence number 2 should have been imported from JSON
 }
 
+func TestAutoIncrementImport(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	autoTable := table.(ormtable.AutoIncrementTable)
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+
+	err = autoTable.InsertWithID(ctx, &testpb.ExampleAutoIncrementTable{X: "foo"})
+	assert.ErrorIs(t, err, ormerrors.AutoIncrementKeyNotSet)
+
+	assert.NilError(t, autoTable.InsertWithID(ctx, &testpb.ExampleAutoIncrementTable{Id: 4, X: "foo"}))
+	err = autoTable.InsertWithID(ctx, &testpb.ExampleAutoIncrementTable{Id: 4, X: "bar"})
+	assert.ErrorIs(t, err, ormerrors.AlreadyExists)
+	seq, err := autoTable.LastInsertedSequence(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(0), seq)
+
+	assert.NilError(t, autoTable.SetLastInsertedSequence(ctx, 7))
+	ex := &testpb.ExampleAutoIncrementTable{X: "bar"}
+	id, err := autoTable.InsertReturningID(ctx, ex)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(8), id)
+
+	found, err := autoTable.Has(ctx, &testpb.ExampleAutoIncrementTable{Id: 4})
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+}
+
 func TestBadJSON(t *testing.T) {
 	table, err := ormtable.Build(ormtable.Options{
 		MessageType: (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type(),
@@ -22,6 +22,8 @@ const (
 	primaryKeyId uint32 = 0
 	indexIdLimit uint32 = 32768
 	seqId               = indexIdLimit
+	schemaId            = seqId + 1
+	migrationId         = seqId + 2
 )
 
 // Options are options for building a Table.
@@ -135,6 +137,10 @@ func Build(options Options) (Table, error) {
 		return nil, ormerrors.InvalidTableDefinition.Wrapf("missing table descriptor for %s", messageDescriptor.FullName())
 	}
 
+	// options are kept to build the table with the previous schema of the table when migrating
+	table.options = options
+	table.options.TableDescriptor = tableDesc
+
 	tableId := tableDesc.Id
 	if tableId == 0 {
 		return nil, ormerrors.InvalidTableId.Wrapf("table %s", messageDescriptor.FullName())
@@ -145,6 +151,16 @@ func Build(options Options) (Table, error) {
 	table.tablePrefix = prefix
 	table.tableId = tableId
 
+	// the records of table migrations are stored under the table prefix too
+	table.schemaCodec = ormkv.NewSchemaCodec(options.MessageType, encodeutil.AppendVarUInt32(prefix, schemaId))
+	table.entryCodecsById[schemaId] = table.schemaCodec
+	table.migrationCodec = ormkv.NewMigrationCodec(
+		options.MessageType,
+		encodeutil.AppendVarUInt32(prefix, migrationId),
+		proto.UnmarshalOptions{Resolver: options.TypeResolver},
+	)
+	table.entryCodecsById[migrationId] = table.migrationCodec
+
 	if tableDesc.PrimaryKey == nil {
 		return nil, ormerrors.MissingPrimaryKey.Wrap(string(messageDescriptor.FullName()))
 	}
@@ -21,6 +21,10 @@ type Index interface {
 	// Prefix key values must correspond in type to the index's fields and the
 	// number of values provided cannot exceed the number of fields in the index,
 	// although fewer values can be provided.
+	//
+	// Values for a repeated field are single elements of that field and, as
+	// multi-valued indexes store a key per element, iterating over them
+	// without such a value may return the same row several times.
 	List(ctx context.Context, prefixKey []interface{}, options ...ormlist.Option) (Iterator, error)
 
 	// ListRange does range iteration over the index with the provided from and to
@@ -67,14 +67,24 @@ var _ Index = &indexKeyIndex{}
 func (i indexKeyIndex) doNotImplement() {}
 
 func (i indexKeyIndex) onInsert(store kv.Store, message protoreflect.Message) error {
-	k, v, err := i.EncodeKVFromMessage(message)
+	keys, err := i.EncodeKeysFromMessage(message)
 	if err != nil {
 		return err
 	}
-	return store.Set(k, v)
+
+	for _, k := range keys {
+		if err = store.Set(k, []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (i indexKeyIndex) onUpdate(store kv.Store, new, existing protoreflect.Message) error {
+	if i.IsMultiValued() {
+		return i.onUpdateMultiValued(store, new, existing)
+	}
+
 	newValues := i.GetKeyValues(new)
 	existingValues := i.GetKeyValues(existing)
 	if i.CompareKeys(newValues, existingValues) == 0 {
@@ -97,12 +107,60 @@ func (i indexKeyIndex) onUpdate(store kv.Store, new, existing protoreflect.Messa
 	return store.Set(newKey, []byte{})
 }
 
+// onUpdateMultiValued only deletes the keys of the elements which were removed
+// and sets those of the elements which were added.
+func (i indexKeyIndex) onUpdateMultiValued(store kv.Store, new, existing protoreflect.Message) error {
+	newKeys, err := i.EncodeKeysFromMessage(new)
+	if err != nil {
+		return err
+	}
+
+	existingKeys, err := i.EncodeKeysFromMessage(existing)
+	if err != nil {
+		return err
+	}
+
+	added := make(map[string]bool, len(newKeys))
+	for _, k := range newKeys {
+		added[string(k)] = true
+	}
+
+	for _, k := range existingKeys {
+		if added[string(k)] {
+			// the key is already stored
+			delete(added, string(k))
+			continue
+		}
+
+		if err = store.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range newKeys {
+		if !added[string(k)] {
+			continue
+		}
+
+		if err = store.Set(k, []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (i indexKeyIndex) onDelete(store kv.Store, message protoreflect.Message) error {
-	_, key, err := i.EncodeKeyFromMessage(message)
+	keys, err := i.EncodeKeysFromMessage(message)
 	if err != nil {
 		return err
 	}
-	return store.Delete(key)
+
+	for _, k := range keys {
+		if err = store.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (i indexKeyIndex) readValueFromIndexKey(backend ReadBackend, primaryKey []protoreflect.Value, _ []byte, message proto.Message) error {
@@ -0,0 +1,436 @@
+package ormtable
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/encodeutil"
+	"github.com/cosmos/cosmos-sdk/orm/internal/fieldnames"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/kv"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// defaultMigrateBatchSize is the default number of rows migrated between
+// progress reports.
+const defaultMigrateBatchSize = 1000
+
+// MigrateOptions are options for migrating the data of a table to the current
+// schema of the table.
+type MigrateOptions struct {
+	// Previous is an optional descriptor of the schema the table data was
+	// written with, used when no schema has been stored for the table yet.
+	// If it is nil, the data of tables without a stored schema is assumed to
+	// match the current schema.
+	Previous *ormv1.TableDescriptor
+
+	// Transform is an optional function called on each row of the table when
+	// its primary key changes, before the row is inserted with the new
+	// primary key. It can be used to fill in new primary key fields.
+	Transform func(message proto.Message) error
+
+	// BatchSize is the number of rows migrated between progress reports. If
+	// it is zero, a default of 1000 is used.
+	BatchSize int
+
+	// OnProgress is an optional callback reporting the progress of the
+	// migration.
+	OnProgress func(MigrateProgress)
+}
+
+// MigrateProgress reports the progress of a table migration.
+type MigrateProgress struct {
+	// Table is the name of the table being migrated.
+	Table protoreflect.FullName
+
+	// Step describes the migration step in progress.
+	Step string
+
+	// Rows is the number of rows or index entries processed so far by the step.
+	Rows uint64
+}
+
+// Migrate migrates the data of a table from the schema it was stored with to
+// the current schema of the table, and stores the current schema in the table
+// for later migrations. If the primary key has changed, all rows are
+// re-inserted with the new primary key. Otherwise, indexes which have been
+// removed or changed are deleted and indexes which have been added or changed
+// are built from the existing rows. Unique index violations in the existing
+// rows will cause an error.
+//
+// Migrate bypasses validate and write hooks. Like ImportJSON, it is not atomic
+// with respect to the underlying store and is assumed to be called in the
+// context of some larger transaction isolation, such as a store migration
+// handler.
+func Migrate(ctx context.Context, table Table, options MigrateOptions) error {
+	to := tableImplOf(table)
+	if to == nil {
+		// singletons have no keys or indexes to migrate
+		return nil
+	}
+
+	backend, err := to.getWriteBackend(ctx)
+	if err != nil {
+		return err
+	}
+	// migrations are not regular writes
+	backend = backend.WithValidateHooks(nil).WithWriteHooks(nil)
+
+	prev, err := to.storedSchema(backend)
+	if err != nil {
+		return err
+	}
+	if prev == nil {
+		prev = options.Previous
+	}
+	if prev != nil && !proto.Equal(prev, to.options.TableDescriptor) {
+		if prev.Id != to.tableId {
+			return ormerrors.InvalidTableDefinition.Wrapf("table %s changed id from %d to %d", to.MessageType().Descriptor().FullName(), prev.Id, to.tableId)
+		}
+
+		fromOptions := to.options
+		fromOptions.TableDescriptor = prev
+		fromTable, err := Build(fromOptions)
+		if err != nil {
+			return err
+		}
+
+		m := &migration{
+			ctx:     ctx,
+			backend: backend,
+			from:    fromTable,
+			to:      table,
+			options: options,
+		}
+		if m.options.BatchSize <= 0 {
+			m.options.BatchSize = defaultMigrateBatchSize
+		}
+		if err = m.run(); err != nil {
+			return err
+		}
+	}
+
+	return to.storeSchema(backend)
+}
+
+// tableImplOf returns the implementation of a regular or auto-increment table,
+// or nil for singletons.
+func tableImplOf(table Table) *tableImpl {
+	switch t := table.(type) {
+	case *tableImpl:
+		return t
+	case *autoIncrementTable:
+		return t.tableImpl
+	default:
+		return nil
+	}
+}
+
+// storedSchema returns the table descriptor stored by the last migration or nil.
+func (t tableImpl) storedSchema(backend ReadBackend) (*ormv1.TableDescriptor, error) {
+	bz, err := backend.CommitmentStoreReader().Get(t.schemaCodec.Prefix())
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	desc := &ormv1.TableDescriptor{}
+	return desc, proto.Unmarshal(bz, desc)
+}
+
+func (t tableImpl) storeSchema(backend Backend) error {
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(t.options.TableDescriptor)
+	if err != nil {
+		return err
+	}
+
+	return backend.CommitmentStore().Set(t.schemaCodec.Prefix(), bz)
+}
+
+type migration struct {
+	ctx      context.Context
+	backend  Backend
+	from, to Table
+	options  MigrateOptions
+}
+
+func (m *migration) run() error {
+	from, to := tableImplOf(m.from), tableImplOf(m.to)
+	fromDesc, toDesc := from.options.TableDescriptor, to.options.TableDescriptor
+	if !proto.Equal(fromDesc.PrimaryKey, toDesc.PrimaryKey) {
+		return m.rekey(from, to)
+	}
+
+	fromIndexes := map[uint32]*ormv1.SecondaryIndexDescriptor{}
+	for _, idx := range fromDesc.Index {
+		fromIndexes[idx.Id] = idx
+	}
+	toIndexes := map[uint32]*ormv1.SecondaryIndexDescriptor{}
+	for _, idx := range toDesc.Index {
+		toIndexes[idx.Id] = idx
+	}
+
+	for _, idx := range fromDesc.Index {
+		if !sameIndex(idx, toIndexes[idx.Id]) {
+			err := m.dropIndex(from, idx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, idx := range toDesc.Index {
+		if !sameIndex(idx, fromIndexes[idx.Id]) {
+			err := m.buildIndex(to, idx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func sameIndex(a, b *ormv1.SecondaryIndexDescriptor) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Unique == b.Unique &&
+		fieldnames.CommaSeparatedFieldNames(a.Fields) == fieldnames.CommaSeparatedFieldNames(b.Fields)
+}
+
+func (m *migration) progress(step string, rows uint64) {
+	if m.options.OnProgress != nil {
+		m.options.OnProgress(MigrateProgress{
+			Table: m.to.MessageType().Descriptor().FullName(),
+			Step:  step,
+			Rows:  rows,
+		})
+	}
+}
+
+// dropIndex deletes all the entries of an index.
+func (m *migration) dropIndex(from *tableImpl, idx *ormv1.SecondaryIndexDescriptor) error {
+	step := fmt.Sprintf("drop index %s", idx.Fields)
+	prefix := encodeutil.AppendVarUInt32(from.tablePrefix, idx.Id)
+	store := m.backend.IndexStore()
+	var rows uint64
+	for {
+		keys, _, err := m.readBatch(store, prefix)
+		if err != nil {
+			return err
+		}
+
+		if len(keys) == 0 {
+			return nil
+		}
+
+		for _, key := range keys {
+			err = store.Delete(key)
+			if err != nil {
+				return err
+			}
+		}
+
+		rows += uint64(len(keys))
+		m.progress(step, rows)
+	}
+}
+
+// buildIndex adds the entries of an index for all the rows of the table.
+func (m *migration) buildIndex(to *tableImpl, idx *ormv1.SecondaryIndexDescriptor) error {
+	step := fmt.Sprintf("build index %s", idx.Fields)
+	indexer := to.entryCodecsById[idx.Id].(indexer)
+	store := m.backend.IndexStore()
+	var rows uint64
+	var cursor []byte
+	for {
+		var messages []proto.Message
+		it, err := to.primaryKeyIndex.List(m.ctx, nil, ormlist.Cursor(cursor))
+		if err != nil {
+			return err
+		}
+
+		for len(messages) < m.options.BatchSize && it.Next() {
+			msg, err := it.GetMessage()
+			if err != nil {
+				it.Close()
+				return err
+			}
+
+			messages = append(messages, msg)
+			cursor = it.Cursor()
+		}
+		it.Close()
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		for _, msg := range messages {
+			err = indexer.onInsert(store, msg.ProtoReflect())
+			if err != nil {
+				return err
+			}
+		}
+
+		rows += uint64(len(messages))
+		m.progress(step, rows)
+	}
+}
+
+// rekey re-inserts all the rows of the table with a new primary key. The rows
+// are first moved with their indexes out of the way of the new primary key,
+// and then transformed and inserted with the new primary key and indexes.
+func (m *migration) rekey(from, to *tableImpl) error {
+	commitment, index := m.backend.CommitmentStore(), m.backend.IndexStore()
+	migrationPrefix := from.migrationCodec.Prefix()
+
+	var rows uint64
+	for {
+		var messages []proto.Message
+		var pks [][]byte
+		it, err := from.primaryKeyIndex.List(m.ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		for len(messages) < m.options.BatchSize && it.Next() {
+			msg, err := it.GetMessage()
+			if err != nil {
+				it.Close()
+				return err
+			}
+
+			_, pk, err := from.EncodeKeyFromMessage(msg.ProtoReflect())
+			if err != nil {
+				it.Close()
+				return err
+			}
+
+			messages = append(messages, msg)
+			pks = append(pks, pk)
+		}
+		it.Close()
+
+		if len(messages) == 0 {
+			break
+		}
+
+		for i, msg := range messages {
+			err = commitment.Delete(pks[i])
+			if err != nil {
+				return err
+			}
+
+			for _, idx := range from.indexers {
+				err = idx.onDelete(index, msg.ProtoReflect())
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+			if err != nil {
+				return err
+			}
+
+			err = commitment.Set(from.migrationCodec.EncodeKey(rows+uint64(i)), bz)
+			if err != nil {
+				return err
+			}
+		}
+
+		rows += uint64(len(messages))
+		m.progress("move rows", rows)
+	}
+
+	fromAutoInc, _ := m.from.(*autoIncrementTable)
+	toAutoInc, _ := m.to.(*autoIncrementTable)
+	var maxId uint64
+
+	rows = 0
+	for {
+		keys, values, err := m.readBatch(commitment, migrationPrefix)
+		if err != nil {
+			return err
+		}
+
+		if len(keys) == 0 {
+			break
+		}
+
+		for i, key := range keys {
+			msg := to.MessageType().New().Interface()
+			err = proto.UnmarshalOptions{Resolver: to.typeResolver}.Unmarshal(values[i], msg)
+			if err != nil {
+				return err
+			}
+
+			if m.options.Transform != nil {
+				err = m.options.Transform(msg)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = to.save(m.ctx, m.backend, msg, saveModeInsert)
+			if err != nil {
+				return err
+			}
+
+			if toAutoInc != nil {
+				if id := msg.ProtoReflect().Get(toAutoInc.autoIncField).Uint(); id > maxId {
+					maxId = id
+				}
+			}
+
+			err = commitment.Delete(key)
+			if err != nil {
+				return err
+			}
+		}
+
+		rows += uint64(len(keys))
+		m.progress("insert rows", rows)
+	}
+
+	switch {
+	case toAutoInc != nil:
+		// the sequence must be past all the ids inserted
+		seq, err := toAutoInc.curSeqValue(index)
+		if err != nil {
+			return err
+		}
+
+		if maxId > seq {
+			return toAutoInc.setSeqValue(index, maxId)
+		}
+	case fromAutoInc != nil:
+		return index.Delete(fromAutoInc.seqCodec.Prefix())
+	}
+
+	return nil
+}
+
+// readBatch reads up to a batch of entries under a prefix, closing the
+// iterator before they get written.
+func (m *migration) readBatch(store kv.ReadonlyStore, prefix []byte) (keys, values [][]byte, err error) {
+	it, err := store.Iterator(prefix, prefixEndBytes(prefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	for ; len(keys) < m.options.BatchSize && it.Valid(); it.Next() {
+		keys = append(keys, append([]byte{}, it.Key()...))
+		values = append(values, append([]byte{}, it.Value()...))
+	}
+
+	return keys, values, it.Error()
+}
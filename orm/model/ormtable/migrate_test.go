@@ -0,0 +1,225 @@
+package ormtable_test
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gotest.tools/v3/assert"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testkv"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/kv"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+func exampleTableDescriptor() *ormv1.TableDescriptor {
+	return proto.GetExtension((&testpb.ExampleTable{}).ProtoReflect().Descriptor().Options(), ormv1.E_Table).(*ormv1.TableDescriptor)
+}
+
+func buildExampleTable(t *testing.T, desc *ormv1.TableDescriptor) ormtable.Table {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType:     (&testpb.ExampleTable{}).ProtoReflect().Type(),
+		TableDescriptor: desc,
+	})
+	assert.NilError(t, err)
+	return table
+}
+
+func countKeys(t *testing.T, store kv.ReadonlyStore, prefix []byte) int {
+	end := append(append([]byte{}, prefix...), 0xff)
+	it, err := store.Iterator(prefix, end)
+	assert.NilError(t, err)
+	defer it.Close()
+	n := 0
+	for ; it.Valid(); it.Next() {
+		n++
+	}
+	return n
+}
+
+func TestMigrateIndexes(t *testing.T) {
+	current := exampleTableDescriptor()
+	// the previous schema has an index on i32 with id 4, and no index with id 3
+	previous := proto.Clone(current).(*ormv1.TableDescriptor)
+	previous.Index[2] = &ormv1.SecondaryIndexDescriptor{Id: 4, Fields: "i32"}
+
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	old := buildExampleTable(t, previous)
+	for i := uint32(1); i <= 5; i++ {
+		assert.NilError(t, old.Insert(ctx, &testpb.ExampleTable{U32: i, U64: uint64(i), Str: "s", Bz: []byte{byte(i)}, I32: int32(i)}))
+	}
+	assert.Equal(t, 5, countKeys(t, backend.IndexStoreReader(), []byte{1, 4}))
+	assert.Equal(t, 0, countKeys(t, backend.IndexStoreReader(), []byte{1, 3}))
+
+	table := buildExampleTable(t, nil)
+	var progress []ormtable.MigrateProgress
+	assert.NilError(t, ormtable.Migrate(ctx, table, ormtable.MigrateOptions{
+		Previous:   previous,
+		BatchSize:  2,
+		OnProgress: func(p ormtable.MigrateProgress) { progress = append(progress, p) },
+	}))
+
+	// the removed index is dropped and the added one is built
+	assert.Equal(t, 0, countKeys(t, backend.IndexStoreReader(), []byte{1, 4}))
+	assert.Equal(t, 5, countKeys(t, backend.IndexStoreReader(), []byte{1, 3}))
+	it, err := table.GetIndex("bz,str").List(ctx, []interface{}{[]byte{3}})
+	assert.NilError(t, err)
+	assert.Assert(t, it.Next())
+	msg, err := it.GetMessage()
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(3), msg.(*testpb.ExampleTable).U32)
+	assert.Assert(t, !it.Next())
+	it.Close()
+
+	assert.DeepEqual(t, []ormtable.MigrateProgress{
+		{Table: "testpb.ExampleTable", Step: "drop index i32", Rows: 2},
+		{Table: "testpb.ExampleTable", Step: "drop index i32", Rows: 4},
+		{Table: "testpb.ExampleTable", Step: "drop index i32", Rows: 5},
+		{Table: "testpb.ExampleTable", Step: "build index bz,str", Rows: 2},
+		{Table: "testpb.ExampleTable", Step: "build index bz,str", Rows: 4},
+		{Table: "testpb.ExampleTable", Step: "build index bz,str", Rows: 5},
+	}, progress)
+
+	// the current schema is stored, so migrating again does nothing
+	progress = nil
+	assert.NilError(t, ormtable.Migrate(ctx, table, ormtable.MigrateOptions{Previous: previous}))
+	assert.Equal(t, 0, len(progress))
+	assert.Equal(t, 5, countKeys(t, backend.IndexStoreReader(), []byte{1, 3}))
+}
+
+func TestMigrateUniqueViolation(t *testing.T) {
+	current := exampleTableDescriptor()
+	// the previous schema has no unique index on u64,str
+	previous := proto.Clone(current).(*ormv1.TableDescriptor)
+	previous.Index = previous.Index[1:]
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	old := buildExampleTable(t, previous)
+	assert.NilError(t, old.Insert(ctx, &testpb.ExampleTable{U32: 1, U64: 7, Str: "s"}))
+	assert.NilError(t, old.Insert(ctx, &testpb.ExampleTable{U32: 2, U64: 7, Str: "s"}))
+
+	err := ormtable.Migrate(ctx, buildExampleTable(t, nil), ormtable.MigrateOptions{Previous: previous})
+	assert.ErrorIs(t, err, ormerrors.UniqueKeyViolation)
+}
+
+func TestMigratePrimaryKey(t *testing.T) {
+	current := exampleTableDescriptor()
+	// the previous schema has a primary key on u32 only
+	previous := proto.Clone(current).(*ormv1.TableDescriptor)
+	previous.PrimaryKey = &ormv1.PrimaryKeyDescriptor{Fields: "u32"}
+
+	backend := testkv.NewSharedMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	old := buildExampleTable(t, previous)
+	for i := uint32(1); i <= 5; i++ {
+		assert.NilError(t, old.Insert(ctx, &testpb.ExampleTable{U32: i, U64: uint64(i), Str: "s"}))
+	}
+
+	table := buildExampleTable(t, nil)
+	var steps []string
+	assert.NilError(t, ormtable.Migrate(ctx, table, ormtable.MigrateOptions{
+		Previous: previous,
+		Transform: func(message proto.Message) error {
+			msg := message.(*testpb.ExampleTable)
+			msg.I64 = int64(msg.U32) * 10
+			return nil
+		},
+		BatchSize:  3,
+		OnProgress: func(p ormtable.MigrateProgress) { steps = append(steps, p.Step) },
+	}))
+	assert.DeepEqual(t, []string{"move rows", "move rows", "insert rows", "insert rows"}, steps)
+
+	// rows are stored with the new primary key and indexes only
+	for i := uint32(1); i <= 5; i++ {
+		msg := &testpb.ExampleTable{U32: i, I64: int64(i) * 10, Str: "s"}
+		found, err := table.Get(ctx, msg)
+		assert.NilError(t, err)
+		assert.Assert(t, found)
+		assert.DeepEqual(t, &testpb.ExampleTable{U32: i, U64: uint64(i), I64: int64(i) * 10, Str: "s"}, msg, protocmp.Transform())
+
+		found, err = table.GetUniqueIndex("u64,str").Get(ctx, msg, uint64(i), "s")
+		assert.NilError(t, err)
+		assert.Assert(t, found)
+	}
+	// 5 rows, 3 index entries per row and the schema
+	assert.Equal(t, 5*4+1, countKeys(t, backend.CommitmentStoreReader(), []byte{1}))
+}
+
+func TestMigrateTableId(t *testing.T) {
+	previous := proto.Clone(exampleTableDescriptor()).(*ormv1.TableDescriptor)
+	previous.Id = 2
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	err := ormtable.Migrate(ctx, buildExampleTable(t, nil), ormtable.MigrateOptions{Previous: previous})
+	assert.ErrorIs(t, err, ormerrors.InvalidTableDefinition)
+}
+
+// decodeAllEntries decodes every key of the store with the table and checks
+// the decoded entries encode back to the same key and value.
+func decodeAllEntries(t *testing.T, table ormtable.Table, store kv.ReadonlyStore) []ormkv.Entry {
+	it, err := store.Iterator(nil, nil)
+	assert.NilError(t, err)
+	defer it.Close()
+
+	var entries []ormkv.Entry
+	for ; it.Valid(); it.Next() {
+		entry, err := table.DecodeEntry(it.Key(), it.Value())
+		assert.NilError(t, err)
+		k, v, err := table.EncodeEntry(entry)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, it.Key(), k)
+		assert.DeepEqual(t, it.Value(), v)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestMigrateDecodeEntries(t *testing.T) {
+	previous := proto.Clone(exampleTableDescriptor()).(*ormv1.TableDescriptor)
+	previous.PrimaryKey = &ormv1.PrimaryKeyDescriptor{Fields: "u32"}
+
+	backend := testkv.NewSharedMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	old := buildExampleTable(t, previous)
+	for i := uint32(1); i <= 5; i++ {
+		assert.NilError(t, old.Insert(ctx, &testpb.ExampleTable{U32: i, U64: uint64(i), Str: "s"}))
+	}
+
+	// the rows moved out of the way of the new primary key by an interrupted
+	// migration can be decoded
+	table := buildExampleTable(t, nil)
+	err := ormtable.Migrate(ctx, table, ormtable.MigrateOptions{
+		Previous:  previous,
+		Transform: func(proto.Message) error { return fmt.Errorf("interrupted") },
+	})
+	assert.ErrorContains(t, err, "interrupted")
+	var migrationRows int
+	for _, entry := range decodeAllEntries(t, table, backend.CommitmentStoreReader()) {
+		if entry, ok := entry.(*ormkv.MigrationEntry); ok {
+			assert.Equal(t, uint32(migrationRows+1), entry.Value.(*testpb.ExampleTable).U32)
+			migrationRows++
+		}
+	}
+	assert.Equal(t, 5, migrationRows)
+
+	// the schema stored by a completed migration can be decoded
+	assert.NilError(t, ormtable.Migrate(ctx, table, ormtable.MigrateOptions{Previous: previous}))
+	var schemas []*ormkv.SchemaEntry
+	for _, entry := range decodeAllEntries(t, table, backend.CommitmentStoreReader()) {
+		switch entry := entry.(type) {
+		case *ormkv.SchemaEntry:
+			schemas = append(schemas, entry)
+		case *ormkv.MigrationEntry:
+			t.Fatalf("unexpected migration entry %s", entry)
+		}
+	}
+	assert.Equal(t, 1, len(schemas))
+	assert.DeepEqual(t, exampleTableDescriptor(), schemas[0].TableDescriptor, protocmp.Transform())
+}
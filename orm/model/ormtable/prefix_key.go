@@ -0,0 +1,34 @@
+package ormtable
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// PrefixKeyValues returns the values of the populated fields of a message
+// representing a prefix key, in field order, as used by the query services
+// generated by protoc-gen-go-cosmos-orm. The fields of the message must
+// correspond to the fields of the index and only a leading sequence of them
+// may be populated.
+func PrefixKeyValues(message proto.Message) ([]interface{}, error) {
+	msg := message.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	n := fields.Len()
+	values := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		field := fields.Get(i)
+		if !msg.Has(field) {
+			for j := i + 1; j < n; j++ {
+				if msg.Has(fields.Get(j)) {
+					return nil, ormerrors.InvalidQuery.Wrapf(
+						"%s is set without %s in prefix key", fields.Get(j).Name(), field.Name())
+				}
+			}
+			break
+		}
+
+		values = append(values, msg.Get(field).Interface())
+	}
+	return values, nil
+}
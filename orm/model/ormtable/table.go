@@ -150,10 +150,28 @@ type Schema interface {
 	GetTable(message proto.Message) Table
 }
 
+// AutoIncrementTable is a Table with an auto-incrementing uint64 primary key.
+// All of its methods, including the ones reading and setting the sequence and
+// inserting entries with their IDs, are part of the stable API of the ORM.
 type AutoIncrementTable interface {
 	Table
 
 	// InsertReturningID inserts the provided entry in the store and returns the newly
 	// generated ID for the message or an error.
 	InsertReturningID(ctx context.Context, message proto.Message) (newId uint64, err error)
+
+	// LastInsertedSequence returns the last sequence number used to generate
+	// an ID, or 0 if no ID was generated yet.
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+
+	// InsertWithID inserts the provided entry keeping its ID, which must be
+	// set, instead of generating a new one. It is meant for importing state,
+	// together with SetLastInsertedSequence, as the sequence is not updated.
+	// Unlike ImportJSON, it does not require the types of the Any fields of
+	// the entry to be resolvable.
+	InsertWithID(ctx context.Context, message proto.Message) error
+
+	// SetLastInsertedSequence sets the last sequence number used to generate
+	// an ID, so that the next generated ID is seq + 1.
+	SetLastInsertedSequence(ctx context.Context, seq uint64) error
 }
@@ -28,8 +28,11 @@ type tableImpl struct {
 	entryCodecsById       map[uint32]ormkv.EntryCodec
 	tablePrefix           []byte
 	tableId               uint32
+	schemaCodec           *ormkv.SchemaCodec
+	migrationCodec        *ormkv.MigrationCodec
 	typeResolver          TypeResolver
 	customJSONValidator   func(message proto.Message) error
+	options               Options
 }
 
 func (t *tableImpl) GetTable(message proto.Message) Table {
@@ -382,6 +385,10 @@ func (t tableImpl) EncodeEntry(entry ormkv.Entry) (k, v []byte, err error) {
 		}
 
 		return idx.EncodeEntry(entry)
+	case *ormkv.SchemaEntry:
+		return t.schemaCodec.EncodeEntry(entry)
+	case *ormkv.MigrationEntry:
+		return t.migrationCodec.EncodeEntry(entry)
 	default:
 		return nil, nil, ormerrors.BadDecodeEntry.Wrapf("%s", entry)
 	}
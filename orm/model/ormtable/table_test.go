@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/orm/types/kv"
 
 	queryv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
 	sdkerrors "github.com/cosmos/cosmos-sdk/errors"
 	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
 	"github.com/cosmos/cosmos-sdk/orm/internal/testkv"
@@ -145,6 +146,133 @@ func TestImportedMessageIterator(t *testing.T) {
 	}
 }
 
+func TestRepeatedIndex(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleRepeated{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	store, err := testpb.NewExampleRepeatedTable(table)
+	assert.NilError(t, err)
+
+	listIds := func(prefixKey testpb.ExampleRepeatedIndexKey) []uint64 {
+		it, err := store.List(ctx, prefixKey)
+		assert.NilError(t, err)
+		defer it.Close()
+		var ids []uint64
+		for it.Next() {
+			v, err := it.Value()
+			assert.NilError(t, err)
+			ids = append(ids, v.Id)
+		}
+		return ids
+	}
+	byTag := func(tag string) []uint64 {
+		return listIds(testpb.ExampleRepeatedTagsIndexKey{}.WithTags(tag))
+	}
+
+	a := &testpb.ExampleRepeated{Owner: "alice", Tags: []string{"x", "y", "x"}}
+	b := &testpb.ExampleRepeated{Owner: "bob", Tags: []string{"y"}}
+	c := &testpb.ExampleRepeated{Owner: "alice"}
+	assert.NilError(t, store.Insert(ctx, a))
+	assert.NilError(t, store.Insert(ctx, b))
+	assert.NilError(t, store.Insert(ctx, c))
+
+	// one entry per distinct element, none for empty fields
+	assert.DeepEqual(t, []uint64{1}, byTag("x"))
+	assert.DeepEqual(t, []uint64{1, 2}, byTag("y"))
+	assert.DeepEqual(t, []uint64{1, 1, 2}, listIds(testpb.ExampleRepeatedTagsIndexKey{}))
+	assert.DeepEqual(t, []uint64{1}, listIds(testpb.ExampleRepeatedOwnerTagsIndexKey{}.WithOwnerTags("alice", "y")))
+	assert.DeepEqual(t, []uint64{1, 2, 3}, listIds(testpb.ExampleRepeatedIdIndexKey{}))
+	checkEncodeDecodeEntries(t, table, backend.IndexStoreReader())
+
+	// updates only change the entries of added and removed elements
+	a.Tags = []string{"y", "z"}
+	assert.NilError(t, store.Update(ctx, a))
+	assert.Equal(t, 0, len(byTag("x")))
+	assert.DeepEqual(t, []uint64{1, 2}, byTag("y"))
+	assert.DeepEqual(t, []uint64{1}, byTag("z"))
+	assert.DeepEqual(t, []uint64{1}, listIds(testpb.ExampleRepeatedOwnerTagsIndexKey{}.WithOwnerTags("alice", "z")))
+
+	assert.NilError(t, store.Delete(ctx, b))
+	assert.DeepEqual(t, []uint64{1}, byTag("y"))
+
+	assert.NilError(t, store.DeleteBy(ctx, testpb.ExampleRepeatedTagsIndexKey{}.WithTags("z")))
+	assert.Equal(t, 0, len(byTag("y")))
+	assert.Equal(t, 0, len(listIds(testpb.ExampleRepeatedOwnerTagsIndexKey{}.WithOwner("alice"))))
+	assert.DeepEqual(t, []uint64{3}, listIds(testpb.ExampleRepeatedIdIndexKey{}))
+	checkEncodeDecodeEntries(t, table, backend.IndexStoreReader())
+
+	// repeated fields can't be used in primary keys and unique indexes
+	_, err = ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleRepeated{}).ProtoReflect().Type(),
+		TableDescriptor: &ormv1.TableDescriptor{
+			Id:         1,
+			PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "id"},
+			Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 1, Fields: "tags", Unique: true}},
+		},
+	})
+	assert.ErrorIs(t, err, ormerrors.InvalidKeyField)
+}
+
+func TestNumericStringIndex(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleAmount{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	store, err := testpb.NewExampleAmountTable(table)
+	assert.NilError(t, err)
+
+	collect := func(it testpb.ExampleAmountIterator, err error) []uint64 {
+		assert.NilError(t, err)
+		defer it.Close()
+		var ids []uint64
+		for it.Next() {
+			v, err := it.Value()
+			assert.NilError(t, err)
+			ids = append(ids, v.Id)
+		}
+		return ids
+	}
+
+	for _, x := range []*testpb.ExampleAmount{
+		{Amount: "100", Price: "2.5"},
+		{Amount: "9", Price: "10"},
+		{Amount: "-20", Price: "-0.75"},
+		{Amount: "1000000000000000000000", Price: "0.000000000000000001"},
+		{Amount: "0", Price: "2.5"},
+	} {
+		assert.NilError(t, store.Insert(ctx, x))
+	}
+
+	// keys are ordered numerically and not lexicographically
+	assert.DeepEqual(t, []uint64{3, 5, 2, 1, 4}, collect(store.List(ctx, testpb.ExampleAmountAmountIndexKey{})))
+	assert.DeepEqual(t, []uint64{3, 4, 5, 1, 2}, collect(store.List(ctx, testpb.ExampleAmountPriceAmountIndexKey{})))
+	assert.DeepEqual(t, []uint64{5, 2, 1}, collect(store.ListRange(ctx,
+		testpb.ExampleAmountAmountIndexKey{}.WithAmount("0"),
+		testpb.ExampleAmountAmountIndexKey{}.WithAmount("100"),
+	)))
+	assert.DeepEqual(t, []uint64{5, 1}, collect(store.List(ctx, testpb.ExampleAmountPriceAmountIndexKey{}.WithPrice("2.50"))))
+	checkEncodeDecodeEntries(t, table, backend.IndexStoreReader())
+
+	// values are read back from keys in their canonical format
+	it, err := store.List(ctx, testpb.ExampleAmountPriceAmountIndexKey{}.WithPrice("-0.75"))
+	assert.NilError(t, err)
+	assert.Assert(t, it.Next())
+	key, _, err := it.Keys()
+	assert.NilError(t, err)
+	assert.Equal(t, "-0.750000000000000000", key[0].String())
+	assert.Equal(t, "-20", key[1].String())
+	it.Close()
+
+	// invalid numbers can't be inserted
+	assert.ErrorContains(t, store.Insert(ctx, &testpb.ExampleAmount{Amount: "1.5"}), "invalid integer")
+	assert.ErrorContains(t, store.Insert(ctx, &testpb.ExampleAmount{Price: "1.0000000000000000001"}), "invalid decimal")
+}
+
 // check that the ormkv.Entry's decode and encode to the same bytes
 func checkEncodeDecodeEntries(t *testing.T, table ormtable.Table, store kv.ReadonlyStore) {
 	it, err := store.Iterator(nil, nil)
@@ -43,4 +43,6 @@ var (
 	ReadOnly                      = errors.New(codespace, 30, "database is read-only")
 	AlreadyExists                 = errors.RegisterWithGRPCCode(codespace, 31, codes.AlreadyExists, "already exists")
 	ConstraintViolation           = errors.RegisterWithGRPCCode(codespace, 32, codes.FailedPrecondition, "failed precondition")
+	InvalidQuery                  = errors.RegisterWithGRPCCode(codespace, 33, codes.InvalidArgument, "invalid query")
+	AutoIncrementKeyNotSet        = errors.New(codespace, 34, "can't import with auto-increment primary key not set")
 )
@@ -0,0 +1,240 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	runtimev1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/module/v1alpha1"
+	appv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+// App is a wrapper around BaseApp and ModuleManager that can be used in hybrid
+// app.go/app config scenarios or directly as a servertypes.Application instance.
+// To get an instance of *App, *AppBuilder must be requested as a dependency
+// in a container which declares the runtime module and the AppBuilder.Build()
+// method must be called.
+//
+// App can be used to create a hybrid app.go setup where some configuration is
+// done declaratively with an app config and the rest of it is done the old way.
+// See simapp/app.go for an example of this setup.
+type App struct {
+	*baseapp.BaseApp
+
+	ModuleManager *module.Manager
+
+	config            *runtimev1alpha1.Module
+	appConfig         *appv1alpha1.Config
+	storeKeyOverrides map[string]string
+	storeKeys         []storetypes.StoreKey
+	interfaceRegistry codectypes.InterfaceRegistry
+	cdc               codec.Codec
+	amino             *codec.LegacyAmino
+	msgServiceRouter  *authmiddleware.MsgServiceRouter
+	legacyRouter      sdk.Router
+	configurator      module.Configurator
+	baseAppOptions    []BaseAppOption
+	txHandlerProvider TxHandlerProvider
+	appOpts           servertypes.AppOptions
+	streamingServices []baseapp.StreamingService
+	streamingWG       *sync.WaitGroup
+}
+
+// Close closes the streaming services of the app and waits for them to stop.
+func (a *App) Close() error {
+	var errs []string
+	for _, streamingService := range a.streamingServices {
+		if err := streamingService.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	a.streamingServices = nil
+
+	if a.streamingWG != nil {
+		a.streamingWG.Wait()
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to close the streaming services: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// RegisterModules registers the provided modules with the module manager and
+// the basic module manager. This is the primary hook for integrating with
+// modules which are not registered using the app config.
+func (a *App) RegisterModules(modules ...module.AppModule) error {
+	for _, appModule := range modules {
+		name := appModule.Name()
+		if _, ok := a.ModuleManager.Modules[name]; ok {
+			return fmt.Errorf("AppModule named %q already exists", name)
+		}
+
+		a.ModuleManager.Modules[name] = appModule
+	}
+
+	return nil
+}
+
+// RegisterStores registers the provided store keys with the app so that their
+// stores are mounted on Load. This is the primary hook for integrating with
+// modules which are not registered using the app config.
+func (a *App) RegisterStores(keys ...storetypes.StoreKey) {
+	a.storeKeys = append(a.storeKeys, keys...)
+}
+
+func (a *App) registerStoreKey(key storetypes.StoreKey) {
+	a.storeKeys = append(a.storeKeys, key)
+}
+
+// UnsafeFindStoreKey returns the registered store key with the provided name,
+// or nil if there isn't any. It should only be used in testing.
+func (a *App) UnsafeFindStoreKey(storeKey string) storetypes.StoreKey {
+	for _, key := range a.storeKeys {
+		if key.Name() == storeKey {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// Load finishes all initialization operations and loads the app. The begin
+// blocker, end blocker and genesis orders of the module manager are set from
+// the app config, module routes and services are registered, stores are
+// mounted and the tx handler is set.
+func (a *App) Load(loadLatest bool) error {
+	if err := a.setModuleOrders(); err != nil {
+		return err
+	}
+
+	a.ModuleManager.RegisterRoutes(a.legacyRouter, a.QueryRouter(), a.amino)
+	a.configurator = module.NewConfigurator(a.cdc, a.msgServiceRouter, a.GRPCQueryRouter())
+	a.ModuleManager.RegisterServices(a.configurator)
+
+	kvStoreKeys := map[string]*storetypes.KVStoreKey{}
+	for _, key := range a.storeKeys {
+		if kvStoreKey, ok := key.(*storetypes.KVStoreKey); ok {
+			kvStoreKeys[kvStoreKey.Name()] = kvStoreKey
+		}
+	}
+
+	// configure state listening capabilities using AppOptions, the streaming
+	// services are closed with the app
+	streamingServices, streamingWG, err := streaming.LoadStreamingServices(a.BaseApp, a.appOpts, a.cdc, kvStoreKeys)
+	if err != nil {
+		return err
+	}
+	a.streamingServices, a.streamingWG = streamingServices, streamingWG
+
+	for _, key := range a.storeKeys {
+		switch key.(type) {
+		case *storetypes.KVStoreKey:
+			a.MountStore(key, storetypes.StoreTypeIAVL)
+		case *storetypes.TransientStoreKey:
+			a.MountStore(key, storetypes.StoreTypeTransient)
+		case *storetypes.MemoryStoreKey:
+			a.MountStore(key, storetypes.StoreTypeMemory)
+		default:
+			return fmt.Errorf("unsupported store key type %T for store %s", key, key.Name())
+		}
+	}
+
+	if a.txHandlerProvider != nil {
+		txHandler, err := a.txHandlerProvider(a.BaseApp, a.appOpts)
+		if err != nil {
+			return err
+		}
+		a.SetTxHandler(txHandler)
+	}
+
+	if loadLatest {
+		if err := a.LoadLatestVersion(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setModuleOrders sets the module orders of the module manager from the app
+// config, defaulting to the alphabetical order of module names. Like the
+// module manager setters, it panics if a module is missing from an order.
+func (a *App) setModuleOrders() error {
+	moduleNames := make([]string, 0, len(a.ModuleManager.Modules))
+	for name := range a.ModuleManager.Modules {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	orderOrDefault := func(order []string) []string {
+		if len(order) == 0 {
+			return moduleNames
+		}
+		return order
+	}
+
+	initGenesis := orderOrDefault(a.config.InitGenesis)
+	exportGenesis := a.config.ExportGenesis
+	if len(exportGenesis) == 0 {
+		exportGenesis = initGenesis
+	}
+
+	for _, order := range [][]string{a.config.BeginBlockers, a.config.EndBlockers, initGenesis, exportGenesis} {
+		for _, name := range order {
+			if _, ok := a.ModuleManager.Modules[name]; !ok {
+				return fmt.Errorf("module %q in the module orders of the app config isn't registered", name)
+			}
+		}
+	}
+
+	a.ModuleManager.SetOrderBeginBlockers(orderOrDefault(a.config.BeginBlockers)...)
+	a.ModuleManager.SetOrderEndBlockers(orderOrDefault(a.config.EndBlockers)...)
+	a.ModuleManager.SetOrderInitGenesis(initGenesis...)
+	a.ModuleManager.SetOrderExportGenesis(exportGenesis...)
+	return nil
+}
+
+// BeginBlocker application updates every begin block
+func (a *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	return a.ModuleManager.BeginBlock(ctx, req)
+}
+
+// EndBlocker application updates every end block
+func (a *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	return a.ModuleManager.EndBlock(ctx, req)
+}
+
+// InitChainer initializes the chain.
+func (a *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+	var genesisState map[string]json.RawMessage
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
+	return a.ModuleManager.InitGenesis(ctx, a.cdc, genesisState)
+}
+
+// Configurator returns the module configurator of the app, it is only set
+// once the app has been loaded.
+func (a *App) Configurator() module.Configurator {
+	return a.configurator
+}
+
+// AppConfig returns the app config the app was built from.
+func (a *App) AppConfig() *appv1alpha1.Config {
+	return a.appConfig
+}
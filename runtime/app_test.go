@@ -0,0 +1,77 @@
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/runtime/appconfig"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+)
+
+const appConfig = `
+modules:
+  - name: runtime
+    config:
+      "@type": cosmos.app.module.v1alpha1.Module
+      app_name: TestApp
+      override_store_keys:
+        - module_name: params
+          kv_store_key: params_override
+  - name: params
+    config:
+      "@type": cosmos.params.module.v1.Module
+`
+
+func TestAppBuilder(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	var (
+		builder      *runtime.AppBuilder
+		paramsKeeper paramskeeper.Keeper
+	)
+	err := container.Run(
+		func(b *runtime.AppBuilder, k paramskeeper.Keeper) {
+			builder = b
+			paramsKeeper = k
+		},
+		appconfig.LoadYAML([]byte(appConfig)),
+		runtime.ProvideCodecs(encCfg.InterfaceRegistry, encCfg.Codec, encCfg.Amino, encCfg.TxConfig),
+	)
+	require.NoError(t, err)
+
+	app := builder.Build(log.NewNopLogger(), dbm.NewMemDB(), nil, simapp.EmptyAppOptions{})
+	require.Equal(t, "TestApp", app.Name())
+	require.Contains(t, app.ModuleManager.Modules, "params")
+
+	// the params module gets its store keys from the runtime
+	require.IsType(t, &storetypes.KVStoreKey{}, app.UnsafeFindStoreKey("params_override"))
+	require.IsType(t, &storetypes.TransientStoreKey{}, app.UnsafeFindStoreKey("transient_params"))
+	require.Nil(t, app.UnsafeFindStoreKey("params"))
+
+	// modules which aren't in the app config can be registered by hand
+	testKey := storetypes.NewKVStoreKey("test")
+	app.RegisterStores(testKey)
+	require.Equal(t, testKey, app.UnsafeFindStoreKey("test"))
+	require.NoError(t, app.RegisterModules())
+	require.Error(t, app.RegisterModules(params.NewAppModule(paramsKeeper)))
+
+	require.NoError(t, app.Load(true))
+	require.Equal(t, []string{"params"}, app.ModuleManager.OrderInitGenesis)
+	require.Equal(t, []string{"params"}, app.ModuleManager.OrderExportGenesis)
+
+	ctx := app.NewContext(true, tmproto.Header{})
+	require.NotNil(t, ctx.KVStore(testKey))
+
+	// the BaseApp param store is set by the params module
+	_, ok := paramsKeeper.GetSubspace("baseapp")
+	require.True(t, ok)
+}
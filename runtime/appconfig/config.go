@@ -0,0 +1,148 @@
+// Package appconfig loads an app config, which lists the modules of an app and
+// their config objects, into a container option wiring those modules together.
+// Modules must be registered with appmodule.Register to be loaded.
+package appconfig
+
+import (
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"sigs.k8s.io/yaml"
+
+	appv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime/internal/registry"
+)
+
+// LoadJSON loads an app config in JSON format.
+func LoadJSON(bz []byte) container.Option {
+	config, err := ParseJSON(bz)
+	if err != nil {
+		return container.Error(err)
+	}
+
+	return Compose(config)
+}
+
+// LoadYAML loads an app config in YAML format.
+func LoadYAML(bz []byte) container.Option {
+	config, err := ParseYAML(bz)
+	if err != nil {
+		return container.Error(err)
+	}
+
+	return Compose(config)
+}
+
+// ParseJSON parses an app config in JSON format.
+func ParseJSON(bz []byte) (*appv1alpha1.Config, error) {
+	config := &appv1alpha1.Config{}
+	err := protojson.Unmarshal(bz, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// ParseYAML parses an app config in YAML format.
+func ParseYAML(bz []byte) (*appv1alpha1.Config, error) {
+	j, err := yaml.YAMLToJSON(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseJSON(j)
+}
+
+// Compose composes an app config into a container option. The config object
+// of each module is provided in the scope of that module together with the
+// providers registered for the module, and the app config itself is provided
+// globally.
+func Compose(appConfig *appv1alpha1.Config) container.Option {
+	opts := []container.Option{
+		container.Supply(appConfig),
+	}
+
+	for _, module := range appConfig.Modules {
+		if module.Name == "" {
+			return container.Error(fmt.Errorf("module is missing name"))
+		}
+
+		if module.Config == nil {
+			return container.Error(fmt.Errorf("module %q is missing a config object", module.Name))
+		}
+
+		msgType, err := protoregistry.GlobalTypes.FindMessageByURL(module.Config.TypeUrl)
+		if err != nil {
+			return container.Error(fmt.Errorf("can't find config type %s of module %q: %w", module.Config.TypeUrl, module.Name, err))
+		}
+
+		init, ok := registry.ModuleRegistry[msgType.Descriptor().FullName()]
+		if !ok {
+			modDesc := proto.GetExtension(msgType.Descriptor().Options(), appv1alpha1.E_Module).(*appv1alpha1.ModuleDescriptor)
+			if modDesc == nil || modDesc.GoImport == "" {
+				return container.Error(fmt.Errorf("no module registered for config type %s of module %q",
+					msgType.Descriptor().FullName(), module.Name))
+			}
+
+			return container.Error(fmt.Errorf("no module registered for config type %s of module %q, did you forget to import %s?",
+				msgType.Descriptor().FullName(), module.Name, modDesc.GoImport))
+		}
+
+		if init.Error != nil {
+			return container.Error(fmt.Errorf("can't load module %q: %w", module.Name, init.Error))
+		}
+
+		config := init.ConfigProtoMessage.ProtoReflect().Type().New().Interface()
+		err = module.Config.UnmarshalTo(config)
+		if err != nil {
+			return container.Error(err)
+		}
+
+		opts = append(opts, container.ProvideInModule(module.Name, container.ProviderDescriptor{
+			Outputs: []container.ProviderOutput{{Type: init.ConfigGoType}},
+			Fn: func(values []reflect.Value) ([]reflect.Value, error) {
+				return []reflect.Value{reflect.ValueOf(config)}, nil
+			},
+			Location: container.LocationFromCaller(0),
+		}))
+
+		for _, provider := range init.Providers {
+			moduleScoped, err := isModuleScoped(provider)
+			if err != nil {
+				return container.Error(fmt.Errorf("invalid provider for module %q: %w", module.Name, err))
+			}
+
+			if moduleScoped {
+				opts = append(opts, container.Provide(provider))
+			} else {
+				opts = append(opts, container.ProvideInModule(module.Name, provider))
+			}
+		}
+	}
+
+	return container.Options(opts...)
+}
+
+var moduleKeyType = reflect.TypeOf(container.ModuleKey{})
+
+// isModuleScoped returns whether the provider takes a container.ModuleKey
+// input, in which case it is called once for each module depending on it.
+func isModuleScoped(provider interface{}) (bool, error) {
+	desc, err := container.ExtractProviderDescriptor(provider)
+	if err != nil {
+		return false, err
+	}
+
+	for _, in := range desc.Inputs {
+		if in.Type == moduleKeyType {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
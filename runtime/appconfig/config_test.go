@@ -0,0 +1,95 @@
+package appconfig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bankmodulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/bank/module/v1"
+	_ "github.com/cosmos/cosmos-sdk/api/cosmos/feegrant/module/v1"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime/appconfig"
+	"github.com/cosmos/cosmos-sdk/runtime/appmodule"
+)
+
+type keyName string
+
+func init() {
+	appmodule.Register(&bankmodulev1.Module{},
+		appmodule.Provide(
+			func(config *bankmodulev1.Module) []string {
+				return config.BlockedModuleAccountsOverride
+			},
+			func(key container.ModuleKey) keyName {
+				return keyName(key.Name())
+			},
+		),
+	)
+}
+
+func TestLoadYAML(t *testing.T) {
+	opt := appconfig.LoadYAML([]byte(`
+modules:
+  - name: bank
+    config:
+      "@type": cosmos.bank.module.v1.Module
+      blocked_module_accounts_override: [a, b]
+`))
+
+	require.NoError(t, container.Run(func(blocked []string) {
+		require.Equal(t, []string{"a", "b"}, blocked)
+	}, opt))
+}
+
+func TestLoadJSON(t *testing.T) {
+	opt := appconfig.LoadJSON([]byte(`{"modules": [{"name": "bank", "config": {"@type": "cosmos.bank.module.v1.Module"}}]}`))
+	require.NoError(t, container.Run(func(blocked []string) {
+		require.Empty(t, blocked)
+	}, opt, container.ProvideInModule("consumer", func(name keyName) int {
+		require.Equal(t, keyName("consumer"), name)
+		return 0
+	})))
+}
+
+func TestComposeErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		errMsg string
+	}{
+		{
+			"missing name",
+			`modules: [{config: {"@type": cosmos.bank.module.v1.Module}}]`,
+			"module is missing name",
+		},
+		{
+			"missing config",
+			`modules: [{name: bank}]`,
+			`module "bank" is missing a config object`,
+		},
+		{
+			"unregistered module",
+			`modules: [{name: feegrant, config: {"@type": cosmos.feegrant.module.v1.Module}}]`,
+			"did you forget to import github.com/cosmos/cosmos-sdk/x/feegrant/module",
+		},
+		{
+			"duplicate module",
+			`modules: [{name: a, config: {"@type": cosmos.bank.module.v1.Module}}, {name: b, config: {"@type": cosmos.bank.module.v1.Module}}]`,
+			"duplicate",
+		},
+		{
+			"invalid yaml",
+			`modules: [`,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := container.Run(func() {}, appconfig.LoadYAML([]byte(tc.config)))
+			require.Error(t, err)
+			require.Contains(t, fmt.Sprintf("%v", err), tc.errMsg)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Package appmodule allows modules to register themselves so that they can be
+// loaded from an app config (see the appconfig package).
+package appmodule
+
+import (
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/runtime/internal/registry"
+)
+
+// Register registers a module with the provided config message type, which
+// should have the cosmos.app.v1alpha1.module option, and the provided
+// options. It should be called in the init function of the module's package.
+func Register(configMsg proto.Message, options ...Option) {
+	fullName := configMsg.ProtoReflect().Descriptor().FullName()
+	init := &registry.ModuleInitializer{
+		ConfigProtoMessage: configMsg,
+		ConfigGoType:       reflect.TypeOf(configMsg),
+	}
+
+	if _, ok := registry.ModuleRegistry[fullName]; ok {
+		init.Error = fmt.Errorf("module with config type %s registered twice", fullName)
+	}
+	registry.ModuleRegistry[fullName] = init
+
+	for _, option := range options {
+		if err := option.apply(init); err != nil {
+			init.Error = err
+			return
+		}
+	}
+}
+
+// Option is a functional option for registering a module.
+type Option interface {
+	apply(*registry.ModuleInitializer) error
+}
+
+type funcOption func(initializer *registry.ModuleInitializer) error
+
+func (f funcOption) apply(initializer *registry.ModuleInitializer) error {
+	return f(initializer)
+}
+
+// Provide registers dependency injection providers for the module. Providers
+// are run in the scope of the module they are registered for (see
+// container.ProvideInModule) with the module's config message available as an
+// input. Providers which take a container.ModuleKey input are instead
+// module-scoped providers which are called once for each module depending on
+// their outputs (see container.Provide).
+func Provide(providers ...interface{}) Option {
+	return funcOption(func(initializer *registry.ModuleInitializer) error {
+		initializer.Providers = append(initializer.Providers, providers...)
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"io"
+	"sort"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AppBuilder is a type that is injected into a container by the runtime module
+// (as *AppBuilder) which can be used to create an app which is compatible with
+// the existing app.go initialization conventions.
+type AppBuilder struct {
+	app     *App
+	modules map[string]AppModuleWrapper
+}
+
+// Build builds an *App instance. The modules of the app config are registered
+// with the module manager of the app, other modules and stores can be
+// registered with RegisterModules and RegisterStores before calling Load.
+func (a *AppBuilder) Build(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions,
+	baseAppOptions ...func(*baseapp.BaseApp),
+) *App {
+	for _, option := range a.app.baseAppOptions {
+		baseAppOptions = append(baseAppOptions, option)
+	}
+
+	bApp := baseapp.NewBaseApp(a.app.config.AppName, logger, db, baseAppOptions...)
+	bApp.SetCommitMultiStoreTracer(traceStore)
+	bApp.SetVersion(version.Version)
+	bApp.SetInterfaceRegistry(a.app.interfaceRegistry)
+
+	names := make([]string, 0, len(a.modules))
+	for name := range a.modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	modules := make([]module.AppModule, 0, len(names))
+	for _, name := range names {
+		modules = append(modules, a.modules[name].AppModule)
+	}
+
+	a.app.BaseApp = bApp
+	a.app.appOpts = appOpts
+	a.app.ModuleManager = module.NewManager(modules...)
+	a.app.SetInitChainer(a.app.InitChainer)
+	a.app.SetBeginBlocker(a.app.BeginBlocker)
+	a.app.SetEndBlocker(a.app.EndBlocker)
+	return a.app
+}
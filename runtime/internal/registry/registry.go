@@ -0,0 +1,30 @@
+// Package registry holds the modules which have been registered with
+// appmodule.Register so that they can be loaded from an app config.
+package registry
+
+import (
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ModuleInitializer describes how to initialize a module from its config
+// object.
+type ModuleInitializer struct {
+	// ConfigProtoMessage is an empty instance of the module's config message.
+	ConfigProtoMessage proto.Message
+
+	// ConfigGoType is the Go type of the module's config message.
+	ConfigGoType reflect.Type
+
+	// Providers are the dependency injection providers of the module.
+	Providers []interface{}
+
+	// Error is any error which occurred while registering the module.
+	Error error
+}
+
+// ModuleRegistry maps the full names of module config messages to the
+// initializers of their modules.
+var ModuleRegistry = map[protoreflect.FullName]*ModuleInitializer{}
@@ -0,0 +1,132 @@
+package runtime
+
+import (
+	"fmt"
+
+	runtimev1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/module/v1alpha1"
+	appv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/app/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime/appmodule"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+// AppModuleWrapper is a type used for injecting a module.AppModule so that it
+// can be used as a one-per-module type.
+type AppModuleWrapper struct {
+	module.AppModule
+}
+
+// WrapAppModule wraps a module.AppModule so that it can be injected.
+func WrapAppModule(appModule module.AppModule) AppModuleWrapper {
+	return AppModuleWrapper{AppModule: appModule}
+}
+
+func (AppModuleWrapper) IsOnePerModuleType() {}
+
+// BaseAppOption is a container.AutoGroupType which can be used to pass
+// BaseApp options into the app being built.
+type BaseAppOption func(*baseapp.BaseApp)
+
+func (BaseAppOption) IsAutoGroupType() {}
+
+// TxHandlerProvider creates the tx handler of an app once all of its modules
+// have been registered. It is provided by the module building the tx handler.
+type TxHandlerProvider func(app *baseapp.BaseApp, appOpts servertypes.AppOptions) (tx.Handler, error)
+
+// ProvideCodecs returns a container option which provides the codecs and the
+// tx config of an app to its modules.
+func ProvideCodecs(interfaceRegistry codectypes.InterfaceRegistry, cdc codec.Codec, amino *codec.LegacyAmino, txConfig client.TxConfig) container.Option {
+	return container.Provide(func() (codectypes.InterfaceRegistry, codec.Codec, *codec.LegacyAmino, client.TxConfig) {
+		return interfaceRegistry, cdc, amino, txConfig
+	})
+}
+
+func init() {
+	appmodule.Register(&runtimev1alpha1.Module{},
+		appmodule.Provide(
+			provideApp,
+			provideKVStoreKey,
+			provideTransientStoreKey,
+			provideMemoryStoreKey,
+			provideAppBuilder,
+		),
+	)
+}
+
+// appWrapper is the type of the app under construction in the container, it
+// is unexported so that modules can't depend on the app directly.
+type appWrapper *App
+
+func provideApp(
+	config *runtimev1alpha1.Module,
+	appConfig *appv1alpha1.Config,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	cdc codec.Codec,
+	amino *codec.LegacyAmino,
+) (appWrapper, *authmiddleware.MsgServiceRouter, sdk.Router) {
+	storeKeyOverrides := make(map[string]string, len(config.OverrideStoreKeys))
+	for _, override := range config.OverrideStoreKeys {
+		storeKeyOverrides[override.ModuleName] = override.KvStoreKey
+	}
+
+	app := &App{
+		config:            config,
+		appConfig:         appConfig,
+		storeKeyOverrides: storeKeyOverrides,
+		interfaceRegistry: interfaceRegistry,
+		cdc:               cdc,
+		amino:             amino,
+		msgServiceRouter:  authmiddleware.NewMsgServiceRouter(interfaceRegistry),
+		legacyRouter:      authmiddleware.NewLegacyRouter(),
+	}
+
+	return app, app.msgServiceRouter, app.legacyRouter
+}
+
+func provideKVStoreKey(key container.ModuleKey, app appWrapper) *storetypes.KVStoreKey {
+	name := key.Name()
+	if override, ok := (*App)(app).storeKeyOverrides[name]; ok {
+		name = override
+	}
+
+	storeKey := storetypes.NewKVStoreKey(name)
+	(*App)(app).registerStoreKey(storeKey)
+	return storeKey
+}
+
+func provideTransientStoreKey(key container.ModuleKey, app appWrapper) *storetypes.TransientStoreKey {
+	storeKey := storetypes.NewTransientStoreKey(fmt.Sprintf("transient_%s", key.Name()))
+	(*App)(app).registerStoreKey(storeKey)
+	return storeKey
+}
+
+func provideMemoryStoreKey(key container.ModuleKey, app appWrapper) *storetypes.MemoryStoreKey {
+	storeKey := storetypes.NewMemoryStoreKey(fmt.Sprintf("memory:%s", key.Name()))
+	(*App)(app).registerStoreKey(storeKey)
+	return storeKey
+}
+
+type appBuilderInputs struct {
+	container.In
+
+	App               appWrapper
+	Modules           map[string]AppModuleWrapper `optional:"true"`
+	TxHandlerProvider TxHandlerProvider           `optional:"true"`
+	BaseAppOptions    []BaseAppOption             `optional:"true"`
+}
+
+func provideAppBuilder(inputs appBuilderInputs) *AppBuilder {
+	app := (*App)(inputs.App)
+	app.txHandlerProvider = inputs.TxHandlerProvider
+	app.baseAppOptions = inputs.BaseAppOptions
+	return &AppBuilder{app: app, modules: inputs.Modules}
+}
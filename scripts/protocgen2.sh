@@ -5,6 +5,7 @@ set -eo pipefail
 protoc_install_gopulsar() {
   go install github.com/cosmos/cosmos-proto/cmd/protoc-gen-go-pulsar@latest
   go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest
+  (cd orm; go install ./cmd/protoc-gen-go-cosmos-orm)
 }
 
 protoc_install_gopulsar
@@ -17,3 +18,6 @@ echo "Generating API module"
 
 echo "Generate Pulsar Test Data"
 (cd testutil/testdata; buf generate --template buf.gen.pulsar.yaml)
+
+echo "Generate x/group ORM state"
+(cd x/group/internal; buf generate)
@@ -163,6 +163,17 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotFormat sets the format of the state sync snapshots taken. Format 3 exports
+	// stores concurrently into independently compressed and hashed sections.
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
+
+	// SnapshotCompressor sets the compression algorithm of snapshots in format 3.
+	SnapshotCompressor string `mapstructure:"snapshot-compressor"`
+
+	// SnapshotConcurrency sets the number of sections exported or restored concurrently
+	// for snapshots in format 3. 0 uses the number of CPUs.
+	SnapshotConcurrency uint32 `mapstructure:"snapshot-concurrency"`
 }
 
 // Config defines the server's top level configuration
@@ -250,6 +261,8 @@ func DefaultConfig() *Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
+			SnapshotFormat:     2,
+			SnapshotCompressor: "zlib",
 		},
 	}
 }
@@ -287,6 +300,7 @@ func GetConfig(v *viper.Viper) Config {
 			EnableServiceLabel:      v.GetBool("telemetry.enable-service-label"),
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
+			StateSizeInterval:       v.GetUint64("telemetry.state-size-interval"),
 		},
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
@@ -316,8 +330,11 @@ func GetConfig(v *viper.Viper) Config {
 			EnableUnsafeCORS: v.GetBool("grpc-web.enable-unsafe-cors"),
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
-			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotInterval:    v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent:  v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotFormat:      v.GetUint32("state-sync.snapshot-format"),
+			SnapshotCompressor:  v.GetString("state-sync.snapshot-compressor"),
+			SnapshotConcurrency: v.GetUint32("state-sync.snapshot-concurrency"),
 		},
 	}
 }
@@ -116,6 +116,11 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# StateSizeInterval, when positive, defines the block interval between the
+# measurements of the number of keys and bytes of the application state by
+# store and key prefix. The measurement iterates the whole state (0 disables it).
+state-size-interval = {{ .Telemetry.StateSizeInterval }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -212,6 +217,18 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-format specifies the format of the snapshots taken. Format 2 streams all stores into
+# a single zlib compressed stream, format 3 exports stores concurrently into independently
+# compressed and hashed sections, which makes both snapshotting and restoring faster.
+snapshot-format = {{ .StateSync.SnapshotFormat }}
+
+# snapshot-compressor specifies the compression of format 3 snapshots: zlib, zstd or none.
+snapshot-compressor = "{{ .StateSync.SnapshotCompressor }}"
+
+# snapshot-concurrency specifies the number of sections of format 3 snapshots exported or
+# restored concurrently (0 to use the number of CPUs).
+snapshot-concurrency = {{ .StateSync.SnapshotConcurrency }}
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// iavlTree reads the nodes of the versions of an IAVL tree directly from the database of its
+// store, so that two versions can be compared without iterating over the keys they share.
+type iavlTree struct {
+	db dbm.DB
+}
+
+// iavlNode is a node of an IAVL tree, as encoded by iavl.MakeNode.
+type iavlNode struct {
+	hash                []byte
+	height              int8
+	key, value          []byte
+	leftHash, rightHash []byte
+}
+
+// root returns the hash of the root node of a version, which is empty if the tree is empty, and
+// whether the version exists.
+func (t iavlTree) root(version int64) ([]byte, bool, error) {
+	key := make([]byte, 9)
+	key[0] = 'r'
+	binary.BigEndian.PutUint64(key[1:], uint64(version))
+	has, err := t.db.Has(key)
+	if err != nil || !has {
+		return nil, false, err
+	}
+	hash, err := t.db.Get(key)
+	return hash, true, err
+}
+
+func (t iavlTree) node(hash []byte) (*iavlNode, error) {
+	bz, err := t.db.Get(append([]byte{'n'}, hash...))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("missing IAVL node %X", hash)
+	}
+	node, err := decodeIAVLNode(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IAVL node %X: %w", hash, err)
+	}
+	node.hash = hash
+	return node, nil
+}
+
+func decodeIAVLNode(bz []byte) (*iavlNode, error) {
+	node := &iavlNode{}
+	// height, size and version
+	for i := 0; i < 3; i++ {
+		v, n := binary.Varint(bz)
+		if n <= 0 {
+			return nil, errors.New("invalid varint")
+		}
+		if i == 0 {
+			node.height = int8(v)
+		}
+		bz = bz[n:]
+	}
+	fields := []*[]byte{&node.key, &node.value}
+	if node.height != 0 {
+		fields = []*[]byte{&node.key, &node.leftHash, &node.rightHash}
+	}
+	for _, field := range fields {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, errors.New("invalid length-prefixed bytes")
+		}
+		*field, bz = bz[n:n+int(size)], bz[n+int(size):]
+	}
+	return node, nil
+}
+
+// diff calls fn with the writes making the version of the tree with the root from equal to the
+// one with the root to, in ascending key order. An empty root is an empty tree. Subtrees with the
+// same hash in both versions are skipped, so the cost is proportional to the number of changed
+// keys rather than to the size of the tree.
+func (t iavlTree) diff(from, to []byte, fn func(kvWrite) error) error {
+	fromItr, toItr := &iavlSubtrees{tree: t}, &iavlSubtrees{tree: t}
+	if err := fromItr.push(from); err != nil {
+		return err
+	}
+	if err := toItr.push(to); err != nil {
+		return err
+	}
+	for {
+		a, b := fromItr.head(), toItr.head()
+		var err error
+		switch {
+		case a == nil && b == nil:
+			return nil
+		case a != nil && b != nil && bytes.Equal(a.hash, b.hash):
+			fromItr.pop()
+			toItr.pop()
+		case b == nil || (a != nil && a.height > b.height):
+			if a.height != 0 {
+				err = fromItr.expand()
+			} else if err = fn(kvWrite{key: a.key, delete: true}); err == nil {
+				fromItr.pop()
+			}
+		case a == nil || b.height > a.height:
+			if b.height != 0 {
+				err = toItr.expand()
+			} else if err = fn(kvWrite{key: b.key, value: b.value}); err == nil {
+				toItr.pop()
+			}
+		case a.height != 0:
+			err = fromItr.expand()
+		default: // two leaves
+			switch cmp := bytes.Compare(a.key, b.key); {
+			case cmp < 0:
+				err = fn(kvWrite{key: a.key, delete: true})
+				fromItr.pop()
+			case cmp > 0:
+				err = fn(kvWrite{key: b.key, value: b.value})
+				toItr.pop()
+			default:
+				if !bytes.Equal(a.value, b.value) {
+					err = fn(kvWrite{key: b.key, value: b.value})
+				}
+				fromItr.pop()
+				toItr.pop()
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// iavlSubtrees iterates over the disjoint subtrees of a tree in ascending key order, each of
+// which can be replaced by its two children.
+type iavlSubtrees struct {
+	tree iavlTree
+	// the subtrees left to iterate over, the next one last
+	stack []*iavlNode
+}
+
+func (s *iavlSubtrees) head() *iavlNode {
+	if len(s.stack) == 0 {
+		return nil
+	}
+	return s.stack[len(s.stack)-1]
+}
+
+func (s *iavlSubtrees) pop() {
+	s.stack = s.stack[:len(s.stack)-1]
+}
+
+func (s *iavlSubtrees) push(hash []byte) error {
+	if len(hash) == 0 {
+		return nil
+	}
+	node, err := s.tree.node(hash)
+	if err != nil {
+		return err
+	}
+	s.stack = append(s.stack, node)
+	return nil
+}
+
+// expand replaces the next subtree by its children.
+func (s *iavlSubtrees) expand() error {
+	node := s.head()
+	s.pop()
+	if err := s.push(node.rightHash); err != nil {
+		return err
+	}
+	return s.push(node.leftHash)
+}
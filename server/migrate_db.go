@@ -0,0 +1,702 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	dbm2 "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/db/boltdb"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagTargetDir = "target-dir"
+	flagBatchSize = "batch-size"
+	flagStoreV2   = "store-v2"
+
+	migrationProgressFile = "migrate-db.json"
+)
+
+// NewMigrateDBCmd creates a command copying the application database to another database
+// backend.
+func NewMigrateDBCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-db [target-backend]",
+		Short: "Copy the application database to another database backend",
+		Long: `Copy every key of the application database, including all the historical versions of its
+stores, into a new database of the given backend (e.g. goleveldb to badgerdb). The source
+backend is read from the app-db-backend config option. The node must be stopped.
+
+Keys are copied in batches, and the progress is recorded in the target directory after each of
+them, so an interrupted migration resumes where it stopped when the command is run again with
+the same target directory. Once all keys are copied, the commit hashes of every version of the
+target database are compared to the source ones.
+
+With --store-v2, the target is instead a DBConnection of the given backend (badgerdb or
+boltdb) as used by store/v2alpha1. The keys of the source stores which changed at each version,
+found by comparing the IAVL trees of consecutive versions, are then committed by a
+store/v2alpha1 multistore, which saves them as the same version of the DBConnection. An
+interrupted migration resumes after the last saved version. Since the new stores commit to their
+state with different hashes, the migration is verified by comparing the changed keys of every
+store at every version, and all the keys of the latest version, instead.
+
+To use the migrated database, replace data/application.db with the one in the target directory
+and set app-db-backend to the target backend.
+`,
+		Example: fmt.Sprintf(`$ %s migrate-db badgerdb --target-dir /tmp/migrated
+$ %s migrate-db badgerdb --store-v2 --target-dir /tmp/migrated`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			sourceBackend := GetAppDBBackend(ctx.Viper)
+			targetBackend := dbm.BackendType(args[0])
+			targetDir, _ := cmd.Flags().GetString(flagTargetDir)
+			if targetDir == "" {
+				targetDir = filepath.Join(ctx.Config.RootDir, "data", "migrated")
+			}
+			if filepath.Clean(targetDir) == filepath.Join(ctx.Config.RootDir, "data") {
+				return errors.New("the target directory must differ from the node data directory")
+			}
+			batchSize, _ := cmd.Flags().GetInt(flagBatchSize)
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid batch size %d", batchSize)
+			}
+
+			storeV2, _ := cmd.Flags().GetBool(flagStoreV2)
+
+			source, err := openDB(ctx.Config.RootDir, sourceBackend)
+			if err != nil {
+				return err
+			}
+			defer source.Close()
+			if err := os.MkdirAll(targetDir, 0755); err != nil {
+				return err
+			}
+			if storeV2 {
+				return migrateDBToStoreV2(cmd, source, sourceBackend, targetBackend, targetDir, batchSize)
+			}
+			target, err := dbm.NewDB("application", targetBackend, targetDir)
+			if err != nil {
+				return err
+			}
+			defer target.Close()
+
+			migration := dbMigration{
+				source:       source,
+				target:       target,
+				progressPath: filepath.Join(targetDir, migrationProgressFile),
+				batchSize:    batchSize,
+			}
+			progress, err := migration.run(sourceBackend, targetBackend, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Copied %d keys from %s to %s\n", progress.Keys, sourceBackend, targetBackend)
+
+			commitID, versions, err := verifyMigration(source, target)
+			if err != nil {
+				return fmt.Errorf("failed to verify the migrated database: %w", err)
+			}
+			cmd.Printf("Verified the commit hashes of %d versions, latest %X at height %d\n",
+				versions, commitID.Hash, commitID.Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagTargetDir, "", "Directory of the target database (default <home>/data/migrated)")
+	cmd.Flags().Int(flagBatchSize, 10000, "Number of keys written to the target database at once")
+	cmd.Flags().Bool(flagStoreV2, false, "Migrate to a DBConnection committing every version through a store/v2alpha1 multistore")
+	return cmd
+}
+
+// migrateDBToStoreV2 migrates the source database to a DBConnection of the target backend, and
+// verifies every migrated version.
+func migrateDBToStoreV2(cmd *cobra.Command, source dbm.DB, sourceBackend, targetBackend dbm.BackendType, targetDir string, batchSize int) error {
+	target, err := openDBConnection(targetBackend, filepath.Join(targetDir, "application.db"))
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	migration := storeV2Migration{
+		source:       source,
+		target:       target,
+		progressPath: filepath.Join(targetDir, migrationProgressFile),
+		batchSize:    batchSize,
+	}
+	progress, err := migration.run(sourceBackend, targetBackend, cmd.OutOrStdout())
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Migrated %d versions from %s to %s\n", progress.Versions, sourceBackend, targetBackend)
+
+	versions, err := migration.verify()
+	if err != nil {
+		return fmt.Errorf("failed to verify the migrated database: %w", err)
+	}
+	cmd.Printf("Verified the state of %d versions\n", versions)
+	return nil
+}
+
+// openDBConnection opens a DBConnection of the given backend in dir.
+func openDBConnection(backend dbm.BackendType, dir string) (dbm2.DBConnection, error) {
+	switch backend {
+	case dbm.BadgerDBBackend:
+		return badgerdb.NewDB(dir)
+	case dbm.BoltDBBackend:
+		return boltdb.NewDB(dir)
+	default:
+		return nil, fmt.Errorf("unsupported backend %s for store v2, expected %s or %s",
+			backend, dbm.BadgerDBBackend, dbm.BoltDBBackend)
+	}
+}
+
+// migrationProgress is the state of a database migration, persisted in the target directory so
+// that interrupted migrations can be resumed.
+type migrationProgress struct {
+	SourceBackend dbm.BackendType `json:"source_backend"`
+	TargetBackend dbm.BackendType `json:"target_backend"`
+	// StoreV2 is set when the target is a DBConnection written through a store/v2alpha1
+	// multistore.
+	StoreV2 bool `json:"store_v2,omitempty"`
+	// LastKey is the last key copied to the target database.
+	LastKey []byte `json:"last_key,omitempty"`
+	Keys    uint64 `json:"keys"`
+	// Versions is the number of versions saved to a store v2 target.
+	Versions uint64 `json:"versions,omitempty"`
+	Done     bool   `json:"done"`
+}
+
+// dbMigration copies all the keys of a database to another one.
+type dbMigration struct {
+	source, target dbm.DB
+	progressPath   string
+	batchSize      int
+}
+
+// run copies the keys of the source database which were not copied by a previous run, and
+// returns the final progress of the migration.
+func (m dbMigration) run(sourceBackend, targetBackend dbm.BackendType, out io.Writer) (*migrationProgress, error) {
+	progress, err := loadMigrationProgress(m.progressPath)
+	if err != nil {
+		return nil, err
+	}
+	if progress == nil {
+		// refuse to overwrite an unrelated database
+		itr, err := m.target.Iterator(nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		empty := !itr.Valid()
+		itr.Close()
+		if !empty {
+			return nil, errors.New("the target database is not empty and has no migration in progress")
+		}
+		progress = &migrationProgress{SourceBackend: sourceBackend, TargetBackend: targetBackend}
+		if err := saveMigrationProgress(m.progressPath, progress); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := progress.check(sourceBackend, targetBackend, false); err != nil {
+			return nil, err
+		}
+		if progress.Done {
+			return progress, nil
+		}
+		fmt.Fprintf(out, "Resuming migration after %d keys\n", progress.Keys)
+	}
+
+	var start []byte
+	if progress.LastKey != nil {
+		// the smallest key after the last copied one
+		start = append(append([]byte{}, progress.LastKey...), 0)
+	}
+	itr, err := m.source.Iterator(start, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	batch := m.target.NewBatch()
+	defer func() { batch.Close() }()
+	var batched int
+	var lastKey []byte
+	flush := func() error {
+		if batched == 0 {
+			return nil
+		}
+		if err := batch.WriteSync(); err != nil {
+			return err
+		}
+		batch.Close()
+		batch = m.target.NewBatch()
+		progress.LastKey = lastKey
+		progress.Keys += uint64(batched)
+		batched = 0
+		return saveMigrationProgress(m.progressPath, progress)
+	}
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Set(itr.Key(), itr.Value()); err != nil {
+			return nil, err
+		}
+		lastKey = itr.Key()
+		batched++
+		if batched == m.batchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	progress.Done = true
+	return progress, saveMigrationProgress(m.progressPath, progress)
+}
+
+// check returns an error if the progress is the one of a migration to another target.
+func (p *migrationProgress) check(sourceBackend, targetBackend dbm.BackendType, storeV2 bool) error {
+	if p.SourceBackend != sourceBackend || p.TargetBackend != targetBackend || p.StoreV2 != storeV2 {
+		target := string(p.TargetBackend)
+		if p.StoreV2 {
+			target += " (store v2)"
+		}
+		return fmt.Errorf("the target directory contains a migration from %s to %s", p.SourceBackend, target)
+	}
+	return nil
+}
+
+// loadMigrationProgress returns the progress of a previous run of a migration, or nil if there
+// was none.
+func loadMigrationProgress(path string) (*migrationProgress, error) {
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var progress migrationProgress
+	if err := json.Unmarshal(bz, &progress); err != nil {
+		return nil, fmt.Errorf("invalid migration progress file %s: %w", path, err)
+	}
+	return &progress, nil
+}
+
+// saveMigrationProgress atomically replaces the progress file of a migration.
+func saveMigrationProgress(path string, progress *migrationProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// storeVersions returns the versions of a multistore whose stores were not pruned, in
+// ascending order.
+func storeVersions(store *rootmulti.Store) []int64 {
+	seen := make(map[int64]bool)
+	var versions []int64
+	for _, key := range store.StoreKeysByName() {
+		iavlStore, ok := store.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+		for _, version := range iavlStore.GetAllVersions() {
+			if !seen[int64(version)] {
+				seen[int64(version)] = true
+				versions = append(versions, int64(version))
+			}
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+// verifyMigration checks that the target database commits to the same state as the source one,
+// by comparing the commit hashes of every version of both databases and the hashes of the stores
+// loaded from the target at each version to the ones committed by the source. It returns the
+// latest commit ID and the number of verified versions.
+func verifyMigration(source, target dbm.DB) (storetypes.CommitID, int, error) {
+	sourceStore, err := loadCommittedMultiStore(source)
+	if err != nil {
+		return storetypes.CommitID{}, 0, err
+	}
+	targetStore, err := loadCommittedMultiStore(target)
+	if err != nil {
+		return storetypes.CommitID{}, 0, err
+	}
+	sourceID, targetID := sourceStore.LastCommitID(), targetStore.LastCommitID()
+	if sourceID.Version != targetID.Version || !bytes.Equal(sourceID.Hash, targetID.Hash) {
+		return storetypes.CommitID{}, 0, fmt.Errorf("commit hash %X at height %d != %X at height %d",
+			targetID.Hash, targetID.Version, sourceID.Hash, sourceID.Version)
+	}
+
+	versions := storeVersions(sourceStore)
+	for _, version := range versions {
+		if err := verifyVersion(stateVersion{sourceStore, version}, stateVersion{targetStore, version}); err != nil {
+			return storetypes.CommitID{}, 0, fmt.Errorf("version %d: %w", version, err)
+		}
+	}
+	return sourceID, len(versions), nil
+}
+
+// verifyVersion checks that a version of the target commits to the same state as the source.
+func verifyVersion(source, target stateVersion) error {
+	sourceInfo, err := source.store.GetCommitInfo(source.version)
+	if err != nil {
+		return err
+	}
+	targetInfo, err := target.store.GetCommitInfo(target.version)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(sourceInfo.Hash(), targetInfo.Hash()) {
+		return fmt.Errorf("commit hash %X != %X", targetInfo.Hash(), sourceInfo.Hash())
+	}
+
+	for _, storeInfo := range sourceInfo.StoreInfos {
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+		store, err := target.kvStore(storeInfo.Name)
+		if err != nil {
+			return err
+		}
+		if hash := store.(storetypes.Committer).LastCommitID().Hash; !bytes.Equal(hash, storeInfo.CommitId.Hash) {
+			return fmt.Errorf("store %s: hash %X != %X", storeInfo.Name, hash, storeInfo.CommitId.Hash)
+		}
+	}
+	return nil
+}
+
+// storeV2Migration commits every version of the state of a database written by a rootmulti
+// store to a DBConnection, through a store/v2alpha1 multistore.
+type storeV2Migration struct {
+	source       dbm.DB
+	target       dbm2.DBConnection
+	progressPath string
+	batchSize    int
+}
+
+// run commits the versions of the source database which were not saved to the target by a
+// previous run, and returns the final progress of the migration.
+func (m storeV2Migration) run(sourceBackend, targetBackend dbm.BackendType, out io.Writer) (*migrationProgress, error) {
+	progress, err := loadMigrationProgress(m.progressPath)
+	if err != nil {
+		return nil, err
+	}
+	if progress == nil {
+		// refuse to overwrite an unrelated database
+		saved, err := m.target.Versions()
+		if err != nil {
+			return nil, err
+		}
+		if saved.Count() != 0 {
+			return nil, errors.New("the target database is not empty and has no migration in progress")
+		}
+		progress = &migrationProgress{SourceBackend: sourceBackend, TargetBackend: targetBackend, StoreV2: true}
+		if err := saveMigrationProgress(m.progressPath, progress); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := progress.check(sourceBackend, targetBackend, true); err != nil {
+			return nil, err
+		}
+		if progress.Done {
+			return progress, nil
+		}
+		fmt.Fprintf(out, "Resuming migration after %d versions\n", progress.Versions)
+	}
+
+	sourceStore, err := loadCommittedMultiStore(m.source)
+	if err != nil {
+		return nil, err
+	}
+	targetStore, err := m.openTarget(sourceStore)
+	if err != nil {
+		return nil, err
+	}
+	defer targetStore.Close()
+
+	// the target store reverts to its last saved version when opened, so the versions up to it
+	// were fully migrated
+	prev := targetStore.LastCommitID().Version
+	for _, version := range storeVersions(sourceStore) {
+		if version <= prev {
+			continue
+		}
+		err := m.storeChanges(sourceStore, prev, version, func(name string, key storetypes.StoreKey, w *kvWrite, sourceKV storetypes.KVStore) error {
+			target := targetStore.GetKVStore(key)
+			switch {
+			case w == nil:
+				syncKVStore(target, sourceKV, m.batchSize)
+			case w.delete:
+				target.Delete(w.key)
+			default:
+				target.Set(w.key, w.value)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("version %d: %w", version, err)
+		}
+		// commit the version under the same number, even if previous versions were pruned
+		if err := targetStore.SetInitialVersion(uint64(version)); err != nil {
+			return nil, err
+		}
+		if id := targetStore.Commit(); id.Version != version {
+			return nil, fmt.Errorf("committed version %d instead of %d", id.Version, version)
+		}
+		prev = version
+		progress.Versions++
+		if err := saveMigrationProgress(m.progressPath, progress); err != nil {
+			return nil, err
+		}
+	}
+	progress.Done = true
+	return progress, saveMigrationProgress(m.progressPath, progress)
+}
+
+// storeChanges calls fn with each write making the source stores at the version prev (empty
+// if 0) equal to the ones at version, computed from the nodes of their IAVL trees which differ
+// between the versions. If a store can't be compared to prev because that version was pruned,
+// fn is called once for it with a nil write and the store at version instead, which is nil if
+// it is empty.
+func (m storeV2Migration) storeChanges(
+	sourceStore *rootmulti.Store, prev, version int64,
+	fn func(name string, key storetypes.StoreKey, w *kvWrite, sourceKV storetypes.KVStore) error,
+) error {
+	source := stateVersion{sourceStore, version}
+	hashes, err := source.storeHashes()
+	if err != nil {
+		return err
+	}
+	prevHashes := map[string][]byte{}
+	if prev != 0 {
+		if prevHashes, err = (stateVersion{sourceStore, prev}).storeHashes(); err != nil {
+			prevHashes = nil
+		}
+	}
+	for name, key := range sourceStore.StoreKeysByName() {
+		tree := iavlTree{dbm.NewPrefixDB(m.source, []byte("s/k:"+name+"/"))}
+		var from, to []byte
+		found := prevHashes != nil
+		if _, ok := prevHashes[name]; ok {
+			if from, found, err = tree.root(prev); err != nil {
+				return err
+			}
+		}
+		if _, ok := hashes[name]; ok {
+			var exists bool
+			if to, exists, err = tree.root(version); err != nil {
+				return err
+			} else if !exists {
+				return fmt.Errorf("version %d of store %s does not exist or was pruned", version, name)
+			}
+		}
+		if !found {
+			var sourceKV storetypes.KVStore
+			if _, ok := hashes[name]; ok {
+				if sourceKV, err = source.kvStore(name); err != nil {
+					return err
+				}
+			}
+			if err := fn(name, key, nil, sourceKV); err != nil {
+				return err
+			}
+			continue
+		}
+		err = tree.diff(from, to, func(w kvWrite) error { return fn(name, key, &w, nil) })
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openTarget opens the store/v2alpha1 multistore of the target database, with a persistent
+// substore for each store of the source.
+func (m storeV2Migration) openTarget(sourceStore *rootmulti.Store) (*multi.Store, error) {
+	config := multi.DefaultStoreConfig()
+	config.Pruning = storetypes.PruneNothing
+	for name := range sourceStore.StoreKeysByName() {
+		if err := config.RegisterSubstore(name, storetypes.StoreTypePersistent); err != nil {
+			return nil, err
+		}
+	}
+	return multi.NewStore(m.target, config)
+}
+
+// verify compares the keys of the source stores which changed at each version of the source
+// database to the ones of the target, then all the keys of the latest version, and returns the
+// number of verified versions.
+func (m storeV2Migration) verify() (int, error) {
+	sourceStore, err := loadCommittedMultiStore(m.source)
+	if err != nil {
+		return 0, err
+	}
+	targetStore, err := m.openTarget(sourceStore)
+	if err != nil {
+		return 0, err
+	}
+	defer targetStore.Close()
+
+	versions := storeVersions(sourceStore)
+	var prev int64
+	for _, version := range versions {
+		view, err := targetStore.GetVersion(version)
+		if err != nil {
+			return 0, fmt.Errorf("version %d: %w", version, err)
+		}
+		err = m.storeChanges(sourceStore, prev, version, func(name string, key storetypes.StoreKey, w *kvWrite, sourceKV storetypes.KVStore) error {
+			return compareKVStore(name, view.GetKVStore(key), w, sourceKV)
+		})
+		if err != nil {
+			return 0, fmt.Errorf("version %d: %w", version, err)
+		}
+		prev = version
+	}
+	if prev != 0 {
+		source := stateVersion{sourceStore, prev}
+		hashes, err := source.storeHashes()
+		if err != nil {
+			return 0, err
+		}
+		view, err := targetStore.GetVersion(prev)
+		if err != nil {
+			return 0, fmt.Errorf("version %d: %w", prev, err)
+		}
+		for name, key := range sourceStore.StoreKeysByName() {
+			var sourceKV storetypes.KVStore
+			if _, ok := hashes[name]; ok {
+				if sourceKV, err = source.kvStore(name); err != nil {
+					return 0, err
+				}
+			}
+			if err := compareKVStore(name, view.GetKVStore(key), nil, sourceKV); err != nil {
+				return 0, fmt.Errorf("version %d: %w", prev, err)
+			}
+		}
+	}
+	return len(versions), nil
+}
+
+// compareKVStore returns an error if the target store differs from the write w, or from all the
+// keys of the source store if w is nil.
+func compareKVStore(name string, target storetypes.KVStore, w *kvWrite, sourceKV storetypes.KVStore) error {
+	if w == nil {
+		if writes, _ := diffKVStores(target, sourceKV, nil, 1); len(writes) != 0 {
+			w = &writes[0]
+		}
+	} else if bytes.Equal(target.Get(w.key), w.value) {
+		w = nil
+	}
+	if w != nil {
+		return fmt.Errorf("store %s differs at key %X", name, w.key)
+	}
+	return nil
+}
+
+// kvWrite is a write making a key of a store equal to the one of another store.
+type kvWrite struct {
+	key, value []byte
+	delete     bool
+}
+
+// diffKVStores returns at most limit writes making the keys of dst from start on equal to the
+// ones of src, and the key from which the stores must be compared next, or nil if they were
+// compared to the end. A nil src is empty.
+func diffKVStores(dst, src storetypes.KVStore, start []byte, limit int) ([]kvWrite, []byte) {
+	dstItr := dst.Iterator(start, nil)
+	defer dstItr.Close()
+	var srcItr storetypes.Iterator
+	if src != nil {
+		srcItr = src.Iterator(start, nil)
+		defer srcItr.Close()
+	}
+	srcValid := func() bool { return srcItr != nil && srcItr.Valid() }
+
+	var writes []kvWrite
+	for dstItr.Valid() || srcValid() {
+		var cmp int
+		switch {
+		case !dstItr.Valid():
+			cmp = 1
+		case !srcValid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(dstItr.Key(), srcItr.Key())
+		}
+
+		var key []byte
+		switch {
+		case cmp < 0:
+			key = dstItr.Key()
+			writes = append(writes, kvWrite{key: copyKey(key), delete: true})
+			dstItr.Next()
+		case cmp > 0:
+			key = srcItr.Key()
+			writes = append(writes, kvWrite{key: copyKey(key), value: srcItr.Value()})
+			srcItr.Next()
+		default:
+			key = dstItr.Key()
+			if !bytes.Equal(dstItr.Value(), srcItr.Value()) {
+				writes = append(writes, kvWrite{key: copyKey(key), value: srcItr.Value()})
+			}
+			dstItr.Next()
+			srcItr.Next()
+		}
+		if len(writes) == limit {
+			// the smallest key after the last compared one
+			return writes, append(copyKey(key), 0)
+		}
+	}
+	return writes, nil
+}
+
+// syncKVStore makes the contents of dst equal to the ones of src, writing at most batchSize keys
+// at once as a store can't be written while it is iterated. A nil src is empty.
+func syncKVStore(dst, src storetypes.KVStore, batchSize int) {
+	var start []byte
+	for {
+		writes, next := diffKVStores(dst, src, start, batchSize)
+		for _, w := range writes {
+			if w.delete {
+				dst.Delete(w.key)
+			} else {
+				dst.Set(w.key, w.value)
+			}
+		}
+		if next == nil {
+			return
+		}
+		start = next
+	}
+}
+
+func copyKey(key []byte) []byte {
+	return append([]byte{}, key...)
+}
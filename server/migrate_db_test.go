@@ -0,0 +1,288 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newMigrationSourceDB(t *testing.T) dbm.DB {
+	db := dbm.NewMemDB()
+	store := rootmulti.NewStore(db)
+	for _, name := range []string{"bank", "staking"} {
+		store.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+	for version := 0; version < 5; version++ {
+		commitMigrationSourceVersion(store, version)
+	}
+	return db
+}
+
+func commitMigrationSourceVersion(store *rootmulti.Store, version int) {
+	for _, name := range []string{"bank", "staking"} {
+		kvStore := store.GetStoreByName(name).(storetypes.KVStore)
+		for i := 0; i < 10; i++ {
+			kvStore.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("%d-%d", version, i)))
+		}
+		kvStore.Delete([]byte(fmt.Sprintf("key-%d", version%10)))
+	}
+	store.Commit()
+}
+
+func countKeys(t *testing.T, db dbm.DB) (n uint64) {
+	itr, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		n++
+	}
+	return
+}
+
+func TestMigrateDB(t *testing.T) {
+	source := newMigrationSourceDB(t)
+	total := countKeys(t, source)
+	dir := t.TempDir()
+
+	target := dbm.NewMemDB()
+	migration := dbMigration{
+		source:       source,
+		target:       target,
+		progressPath: filepath.Join(dir, migrationProgressFile),
+		batchSize:    7,
+	}
+	progress, err := migration.run(dbm.MemDBBackend, dbm.GoLevelDBBackend, io.Discard)
+	require.NoError(t, err)
+	require.True(t, progress.Done)
+	require.Equal(t, total, progress.Keys)
+	require.Equal(t, total, countKeys(t, target))
+
+	commitID, versions, err := verifyMigration(source, target)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), commitID.Version)
+	require.Equal(t, 5, versions)
+
+	// a completed migration is not copied again
+	progress, err = migration.run(dbm.MemDBBackend, dbm.GoLevelDBBackend, io.Discard)
+	require.NoError(t, err)
+	require.Equal(t, total, progress.Keys)
+
+	// the backends must match the ones of the migration in progress
+	_, err = migration.run(dbm.MemDBBackend, dbm.BadgerDBBackend, io.Discard)
+	require.Error(t, err)
+
+	// a non-empty target database without migration in progress is not overwritten
+	migration.progressPath = filepath.Join(dir, "other.json")
+	_, err = migration.run(dbm.MemDBBackend, dbm.GoLevelDBBackend, io.Discard)
+	require.Error(t, err)
+
+	// verification fails if the target does not commit to the same state
+	commitInfo, err := source.Get([]byte("s/4"))
+	require.NoError(t, err)
+	require.NoError(t, target.Set([]byte("s/5"), commitInfo))
+	_, _, err = verifyMigration(source, target)
+	require.Error(t, err)
+
+	// including at a previous version
+	commitInfo, err = source.Get([]byte("s/5"))
+	require.NoError(t, err)
+	require.NoError(t, target.Set([]byte("s/5"), commitInfo))
+	_, _, err = verifyMigration(source, target)
+	require.NoError(t, err)
+	commitInfo, err = source.Get([]byte("s/3"))
+	require.NoError(t, err)
+	require.NoError(t, target.Set([]byte("s/2"), commitInfo))
+	_, _, err = verifyMigration(source, target)
+	require.ErrorContains(t, err, "version 2")
+}
+
+func TestMigrateDBResume(t *testing.T) {
+	source := newMigrationSourceDB(t)
+	total := countKeys(t, source)
+
+	// simulate a migration interrupted after some batches
+	target := dbm.NewMemDB()
+	migration := dbMigration{
+		source:       source,
+		target:       target,
+		progressPath: filepath.Join(t.TempDir(), migrationProgressFile),
+		batchSize:    5,
+	}
+	progress := &migrationProgress{SourceBackend: dbm.MemDBBackend, TargetBackend: dbm.GoLevelDBBackend}
+	itr, err := source.Iterator(nil, nil)
+	require.NoError(t, err)
+	for ; itr.Valid() && progress.Keys < total/2; itr.Next() {
+		require.NoError(t, target.Set(itr.Key(), itr.Value()))
+		progress.LastKey = itr.Key()
+		progress.Keys++
+	}
+	require.NoError(t, itr.Close())
+	require.NoError(t, saveMigrationProgress(migration.progressPath, progress))
+
+	out := &bytes.Buffer{}
+	progress, err = migration.run(dbm.MemDBBackend, dbm.GoLevelDBBackend, out)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("Resuming migration after %d keys\n", total/2), out.String())
+	require.True(t, progress.Done)
+	require.Equal(t, total, progress.Keys)
+	require.Equal(t, total, countKeys(t, target))
+	_, _, err = verifyMigration(source, target)
+	require.NoError(t, err)
+}
+
+func TestMigrateDBStoreV2(t *testing.T) {
+	for _, backend := range []dbm.BackendType{dbm.BadgerDBBackend, dbm.BoltDBBackend} {
+		t.Run(string(backend), func(t *testing.T) { testMigrateDBStoreV2(t, backend) })
+	}
+}
+
+func testMigrateDBStoreV2(t *testing.T, backend dbm.BackendType) {
+	source := newMigrationSourceDB(t)
+	dir := t.TempDir()
+
+	target, err := openDBConnection(backend, filepath.Join(dir, "application.db"))
+	require.NoError(t, err)
+	defer target.Close()
+	migration := storeV2Migration{
+		source:       source,
+		target:       target,
+		progressPath: filepath.Join(dir, migrationProgressFile),
+		batchSize:    3,
+	}
+	progress, err := migration.run(dbm.MemDBBackend, backend, io.Discard)
+	require.NoError(t, err)
+	require.True(t, progress.Done)
+	require.Equal(t, uint64(5), progress.Versions)
+	versions, err := migration.verify()
+	require.NoError(t, err)
+	require.Equal(t, 5, versions)
+
+	saved, err := target.Versions()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), saved.Last())
+
+	// a completed migration is not run again, and can't be resumed as a copy of the keys
+	_, err = migration.run(dbm.MemDBBackend, backend, io.Discard)
+	require.NoError(t, err)
+	_, err = (dbMigration{source: source, target: dbm.NewMemDB(), progressPath: migration.progressPath, batchSize: 3}).
+		run(dbm.MemDBBackend, backend, io.Discard)
+	require.Error(t, err)
+
+	// versions committed to the source after the migration are migrated when it is resumed
+	sourceStore, err := loadCommittedMultiStore(source)
+	require.NoError(t, err)
+	for version := 5; version < 8; version++ {
+		commitMigrationSourceVersion(sourceStore, version)
+	}
+	progress.Done = false
+	require.NoError(t, saveMigrationProgress(migration.progressPath, progress))
+	out := &bytes.Buffer{}
+	progress, err = migration.run(dbm.MemDBBackend, backend, out)
+	require.NoError(t, err)
+	require.Equal(t, "Resuming migration after 5 versions\n", out.String())
+	require.Equal(t, uint64(8), progress.Versions)
+	versions, err = migration.verify()
+	require.NoError(t, err)
+	require.Equal(t, 8, versions)
+
+	// a non-empty target database without migration in progress is not overwritten
+	migration.progressPath = filepath.Join(dir, "other.json")
+	_, err = migration.run(dbm.MemDBBackend, backend, io.Discard)
+	require.Error(t, err)
+}
+
+func TestMigrateDBStoreV2Verify(t *testing.T) {
+	source := newMigrationSourceDB(t)
+	target := memdb.NewDB()
+	migration := storeV2Migration{
+		source:       source,
+		target:       target,
+		progressPath: filepath.Join(t.TempDir(), migrationProgressFile),
+		batchSize:    100,
+	}
+	_, err := migration.run(dbm.MemDBBackend, dbm.BadgerDBBackend, io.Discard)
+	require.NoError(t, err)
+
+	// commit a sixth version to both databases, differing in one key
+	sourceStore, err := loadCommittedMultiStore(source)
+	require.NoError(t, err)
+	commitMigrationSourceVersion(sourceStore, 5)
+	targetStore, err := migration.openTarget(sourceStore)
+	require.NoError(t, err)
+	for name, key := range sourceStore.StoreKeysByName() {
+		kvStore := targetStore.GetKVStore(key)
+		for i := 0; i < 10; i++ {
+			kvStore.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("5-%d", i)))
+		}
+		// the source deletes key-5
+		if name != "bank" {
+			kvStore.Delete([]byte("key-5"))
+		}
+	}
+	targetStore.Commit()
+	require.NoError(t, targetStore.Close())
+
+	_, err = migration.verify()
+	require.ErrorContains(t, err, "version 6: store bank differs at key 6B65792D35")
+}
+
+func TestIAVLTreeDiff(t *testing.T) {
+	source := newMigrationSourceDB(t)
+	sourceStore, err := loadCommittedMultiStore(source)
+	require.NoError(t, err)
+	// a version deleting all the keys of a store, and one setting them again
+	kvStore := sourceStore.GetStoreByName("bank").(storetypes.KVStore)
+	for i := 0; i < 10; i++ {
+		kvStore.Delete([]byte(fmt.Sprintf("key-%d", i)))
+	}
+	sourceStore.Commit()
+	commitMigrationSourceVersion(sourceStore, 6)
+
+	tree := iavlTree{dbm.NewPrefixDB(source, []byte("s/k:bank/"))}
+	contents := map[string][]byte{}
+	var prevRoot []byte
+	for version := int64(1); version <= 7; version++ {
+		root, found, err := tree.root(version)
+		require.NoError(t, err)
+		require.True(t, found)
+		var writes int
+		require.NoError(t, tree.diff(prevRoot, root, func(w kvWrite) error {
+			writes++
+			if w.delete {
+				delete(contents, string(w.key))
+			} else {
+				contents[string(w.key)] = w.value
+			}
+			return nil
+		}))
+		prevRoot = root
+
+		expected := map[string][]byte{}
+		view, err := stateVersion{sourceStore, version}.kvStore("bank")
+		require.NoError(t, err)
+		itr := view.Iterator(nil, nil)
+		for ; itr.Valid(); itr.Next() {
+			expected[string(itr.Key())] = itr.Value()
+		}
+		itr.Close()
+		require.Equal(t, expected, contents, "version %d", version)
+		if version > 1 && version != 7 {
+			// only the keys set to new values and the deleted one differ
+			require.LessOrEqual(t, writes, 11, "version %d", version)
+		}
+	}
+
+	_, found, err := tree.root(8)
+	require.NoError(t, err)
+	require.False(t, found)
+}
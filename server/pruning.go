@@ -2,15 +2,24 @@ package server
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
+const flagCompact = "compact"
+
 // GetPruningOptionsFromFlags parses command flags and returns the correct
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
@@ -37,3 +46,112 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (storetypes.PruningOpt
 		return store.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// NewPruneCmd creates a command to prune the historical versions of an existing application
+// database offline, with a pruning strategy which may differ from the one it was created with.
+func NewPruneCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune historical application state versions offline",
+		Long: `Prune the historical versions of every store of the application database, keeping the
+versions the given pruning strategy would retain at the latest height. Pruning options otherwise
+only take effect going forward while the node runs, so this allows nodes which ran with a less
+aggressive strategy (e.g. 'nothing') to reclaim space. The node must be stopped.
+
+The underlying database only releases the space of deleted versions once compacted, which the
+'--compact' flag triggers (goleveldb only).
+`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			serverCtx.Viper.BindPFlags(cmd.Flags())
+
+			_, err := GetPruningOptionsFromFlags(serverCtx.Viper)
+			return err
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			pruningOpts, err := GetPruningOptionsFromFlags(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			dbDir := filepath.Join(ctx.Config.RootDir, "data", "application.db")
+			sizeBefore, err := dirSize(dbDir)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cms := rootmulti.NewStore(db)
+			if err := cms.MountCommittedStores(); err != nil {
+				return err
+			}
+			if err := cms.LoadLatestVersion(); err != nil {
+				return err
+			}
+
+			pruned, err := cms.PruneVersions(pruningOpts)
+			if err != nil {
+				return fmt.Errorf("failed to prune versions: %w", err)
+			}
+			if len(pruned) == 0 {
+				cmd.Println("No versions to prune")
+			} else {
+				cmd.Printf("Pruned %d versions from %d to %d\n", len(pruned), pruned[0], pruned[len(pruned)-1])
+			}
+
+			if compact, _ := cmd.Flags().GetBool(flagCompact); compact {
+				levelDB, ok := db.(*dbm.GoLevelDB)
+				if !ok {
+					return fmt.Errorf("compaction is not supported by the %T database", db)
+				}
+				cmd.Println("Compacting database...")
+				if err := levelDB.DB().CompactRange(util.Range{}); err != nil {
+					return fmt.Errorf("failed to compact database: %w", err)
+				}
+			}
+
+			if err := db.Close(); err != nil {
+				return err
+			}
+			sizeAfter, err := dirSize(dbDir)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Database size: %d bytes before, %d bytes after, %d bytes reclaimed\n",
+				sizeBefore, sizeAfter, sizeBefore-sizeAfter)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(flagCompact, false, "Compact the database after pruning to reclaim disk space (goleveldb only)")
+	return cmd
+}
+
+// dirSize returns the total size of the files in a directory, or 0 if it does not exist.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// NewSnapshotCmd creates the command managing the local state sync snapshots of a node, which
+// allows snapshots to be moved between nodes as archive files and restored without any peers.
+func NewSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+	}
+
+	cmd.AddCommand(
+		listSnapshotsCmd(defaultNodeHome),
+		exportSnapshotCmd(defaultNodeHome),
+		importSnapshotCmd(defaultNodeHome),
+		restoreSnapshotCmd(appCreator, defaultNodeHome),
+	)
+	return cmd
+}
+
+func listSnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			store, db, err := openSnapshotStore(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			list, err := store.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range list {
+				cmd.Printf("height: %d format: %d chunks: %d hash: %X\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func exportSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [height] [format] [archive-file]",
+		Short: "Export a local snapshot to a single archive file",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotID(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			store, db, err := openSnapshotStore(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			file, err := os.Create(args[2])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := snapshots.ExportArchive(store, height, format, file); err != nil {
+				_ = os.Remove(args[2])
+				return fmt.Errorf("failed to export snapshot: %w", err)
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Exported snapshot at height %d format %d to %s\n", height, format, args[2])
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func importSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [archive-file]",
+		Short: "Import a snapshot archive file into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			store, db, err := openSnapshotStore(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := snapshots.ImportArchive(store, file)
+			if err != nil {
+				return fmt.Errorf("failed to import snapshot: %w", err)
+			}
+
+			cmd.Printf("Imported snapshot at height %d format %d\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func restoreSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [height] [format]",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a local snapshot, without any peers.
+
+The snapshot is restored into the application database of the node, which must be empty.
+Only the application state is restored: the Tendermint state and block stores are not
+populated and must be bootstrapped at the snapshot height before the node can be started.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotID(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// restoring over existing state would mix the snapshot with it
+			if version := rootmulti.NewStore(db).LastCommitID().Version; version != 0 {
+				return fmt.Errorf("application database already has a committed version %d, refusing to restore a snapshot into it", version)
+			}
+
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			snapshotApp, ok := app.(interface{ SnapshotManager() *snapshots.Manager })
+			if !ok || snapshotApp.SnapshotManager() == nil {
+				return fmt.Errorf("application does not have a snapshot manager")
+			}
+
+			if err := snapshotApp.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			cmd.Printf("Restored snapshot at height %d format %d\n", height, format)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// openSnapshotStore opens the snapshot store of a node, in the same location as the application.
+// The returned database must be closed by the caller.
+func openSnapshotStore(rootDir string, backendType dbm.BackendType) (*snapshots.Store, dbm.DB, error) {
+	dir := filepath.Join(rootDir, "data", "snapshots")
+	db, err := dbm.NewDB("metadata", backendType, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	store, err := snapshots.NewStore(db, dir)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	return store, db, nil
+}
+
+// parseSnapshotID parses the height and format arguments identifying a snapshot.
+func parseSnapshotID(heightArg, formatArg string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(heightArg, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", heightArg, err)
+	}
+	format, err := strconv.ParseUint(formatArg, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", formatArg, err)
+	}
+	return height, uint32(format), nil
+}
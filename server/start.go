@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -14,6 +15,7 @@ import (
 	abciclient "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmservice "github.com/tendermint/tendermint/libs/service"
 	"github.com/tendermint/tendermint/node"
@@ -54,10 +56,17 @@ const (
 	FlagPruningInterval   = "pruning-interval"
 	FlagIndexEvents       = "index-events"
 	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagReadWriteSetLog   = "rwset-log"
 
 	// state sync-related flags
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval    = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent  = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotFormat      = "state-sync.snapshot-format"
+	FlagStateSyncSnapshotCompressor  = "state-sync.snapshot-compressor"
+	FlagStateSyncSnapshotConcurrency = "state-sync.snapshot-concurrency"
+
+	// telemetry-related flags
+	FlagStateSizeInterval = "telemetry.state-size-interval"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -162,6 +171,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().String(FlagReadWriteSetLog, "", "Log the keys and key ranges read and written by the txs of each block to an output file")
 
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: api must also be enabled.)")
@@ -181,6 +191,11 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotFormat, 2, "State sync snapshot format (2 or 3)")
+	cmd.Flags().String(FlagStateSyncSnapshotCompressor, "zlib", "State sync snapshot compressor of format 3 (zlib|zstd|none)")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotConcurrency, 0, "State sync snapshot sections processed concurrently in format 3 (0 uses the number of CPUs)")
+
+	cmd.Flags().Uint64(FlagStateSizeInterval, 0, "Block interval between the state size telemetry gauges (0 disables them)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -204,6 +219,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	}
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
+	defer closeApp(ctx.Logger, app)
 
 	svr, err := server.NewServer(addr, transport, app)
 	if err != nil {
@@ -267,6 +283,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
+	defer closeApp(ctx.Logger, app)
 
 	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
 	if err != nil {
@@ -457,3 +474,13 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	// wait for signal capture and gracefully return
 	return WaitForQuitSignals()
 }
+
+// closeApp releases the resources held by the app, such as its streaming
+// services, if it implements io.Closer.
+func closeApp(logger tmlog.Logger, app types.Application) {
+	if closer, ok := app.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			logger.Error("failed to close the app", "err", err)
+		}
+	}
+}
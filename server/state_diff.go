@@ -0,0 +1,303 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHeightA = "height-a"
+	flagHeightB = "height-b"
+	flagStores  = "stores"
+	flagMaxKeys = "max-keys"
+)
+
+// NewStateDiffCmd creates a command printing the differences between two versions of the
+// application state, either from two application databases or from the same one.
+func NewStateDiffCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff-state [data-dir-a] [data-dir-b]",
+		Short: "Print the differences between two versions of the application state",
+		Long: `Compare the application state of two data directories, or of two heights of the same data
+directory if only one is given, e.g. to find the keys responsible for diverging app hashes.
+
+The commit hashes of each store are compared first, and only the stores whose hashes differ are
+walked to print their added (+), removed (-) and changed (~) keys. Values are decoded with the
+store decoders the application modules register for simulations, and printed in hex otherwise.
+The nodes must be stopped.
+`,
+		Example: fmt.Sprintf(`$ %[1]s diff-state ~/node-a/data ~/node-b/data --height-a 100 --height-b 100
+$ %[1]s diff-state ~/node/data --height-a 99 --height-b 100 --stores bank,staking`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			backend := GetAppDBBackend(ctx.Viper)
+
+			dbA, err := dbm.NewDB("application", backend, args[0])
+			if err != nil {
+				return err
+			}
+			defer dbA.Close()
+			storeA, err := loadCommittedMultiStore(dbA)
+			if err != nil {
+				return err
+			}
+			storeB := storeA
+			if len(args) == 2 && filepath.Clean(args[1]) != filepath.Clean(args[0]) {
+				dbB, err := dbm.NewDB("application", backend, args[1])
+				if err != nil {
+					return err
+				}
+				defer dbB.Close()
+				if storeB, err = loadCommittedMultiStore(dbB); err != nil {
+					return err
+				}
+			}
+
+			heightA, _ := cmd.Flags().GetInt64(flagHeightA)
+			heightB, _ := cmd.Flags().GetInt64(flagHeightB)
+			if heightA == 0 {
+				heightA = storeA.LastCommitID().Version
+			}
+			if heightB == 0 {
+				heightB = storeB.LastCommitID().Version
+			}
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+			maxKeys, _ := cmd.Flags().GetInt(flagMaxKeys)
+
+			differ := stateDiffer{
+				a:        stateVersion{storeA, heightA},
+				b:        stateVersion{storeB, heightB},
+				decoders: storeDecoders(appCreator, ctx),
+				stores:   stores,
+				maxKeys:  maxKeys,
+			}
+			differences, err := differ.diff(cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			if differences == 0 {
+				cmd.Println("No differences found")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagHeightA, 0, "Height of the first state (0 means latest height)")
+	cmd.Flags().Int64(flagHeightB, 0, "Height of the second state (0 means latest height)")
+	cmd.Flags().StringSlice(flagStores, nil, "Only compare the given stores (default all stores)")
+	cmd.Flags().Int(flagMaxKeys, 100, "Maximum number of differing keys printed per store (0 means unlimited)")
+	return cmd
+}
+
+// loadCommittedMultiStore loads the latest version of all the stores committed to a database.
+func loadCommittedMultiStore(db dbm.DB) (*rootmulti.Store, error) {
+	store := rootmulti.NewStore(db)
+	if err := store.MountCommittedStores(); err != nil {
+		return nil, err
+	}
+	if err := store.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// storeDecoders returns the store decoders registered by the application modules, if the
+// application has a simulation manager.
+func storeDecoders(appCreator types.AppCreator, ctx *Context) sdk.StoreDecoderRegistry {
+	app := appCreator(ctx.Logger, dbm.NewMemDB(), nil, ctx.Viper)
+	simApp, ok := app.(interface {
+		SimulationManager() *module.SimulationManager
+	})
+	if !ok || simApp.SimulationManager() == nil {
+		return nil
+	}
+	return simApp.SimulationManager().StoreDecoders
+}
+
+// stateVersion is a version of the state of a multistore.
+type stateVersion struct {
+	store   *rootmulti.Store
+	version int64
+}
+
+// storeHashes returns the commit hash of each store at the version.
+func (v stateVersion) storeHashes() (map[string][]byte, error) {
+	cInfo, err := v.store.GetCommitInfo(v.version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load version %d: %w", v.version, err)
+	}
+	hashes := make(map[string][]byte, len(cInfo.StoreInfos))
+	for _, storeInfo := range cInfo.StoreInfos {
+		if storeInfo.CommitId.Version != 0 {
+			hashes[storeInfo.Name] = storeInfo.CommitId.Hash
+		}
+	}
+	return hashes, nil
+}
+
+// kvStore returns a store at the version.
+func (v stateVersion) kvStore(name string) (storetypes.KVStore, error) {
+	key, ok := v.store.StoreKeysByName()[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown store %s", name)
+	}
+	iavlStore := v.store.GetCommitKVStore(key).(*iavl.Store)
+	if !iavlStore.VersionExists(v.version) {
+		return nil, fmt.Errorf("version %d of store %s does not exist or was pruned", v.version, name)
+	}
+	return iavlStore.GetImmutable(v.version)
+}
+
+// stateDiffer prints the differences between two versions of the application state.
+type stateDiffer struct {
+	a, b     stateVersion
+	decoders sdk.StoreDecoderRegistry
+	stores   []string // stores to compare, all if empty
+	maxKeys  int      // maximum number of differing keys printed per store, unlimited if 0
+}
+
+// diff prints the differences between the two states and returns the number of differing stores.
+func (d stateDiffer) diff(w io.Writer) (int, error) {
+	hashesA, err := d.a.storeHashes()
+	if err != nil {
+		return 0, err
+	}
+	hashesB, err := d.b.storeHashes()
+	if err != nil {
+		return 0, err
+	}
+
+	names := d.stores
+	if len(names) == 0 {
+		for name := range hashesA {
+			names = append(names, name)
+		}
+		for name := range hashesB {
+			if _, ok := hashesA[name]; !ok {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	differences := 0
+	for _, name := range names {
+		hashA, okA := hashesA[name]
+		hashB, okB := hashesB[name]
+		switch {
+		case !okA && !okB:
+			return differences, fmt.Errorf("unknown store %s", name)
+		case !okB:
+			fmt.Fprintf(w, "store %s: only in A\n", name)
+		case !okA:
+			fmt.Fprintf(w, "store %s: only in B\n", name)
+		case bytes.Equal(hashA, hashB):
+			continue
+		default:
+			fmt.Fprintf(w, "store %s: hash %X != %X\n", name, hashA, hashB)
+			if err := d.diffStore(w, name); err != nil {
+				return differences, err
+			}
+		}
+		differences++
+	}
+	return differences, nil
+}
+
+// diffStore prints the keys which differ in a store between the two states.
+func (d stateDiffer) diffStore(w io.Writer, name string) error {
+	storeA, err := d.a.kvStore(name)
+	if err != nil {
+		return err
+	}
+	storeB, err := d.b.kvStore(name)
+	if err != nil {
+		return err
+	}
+
+	iterA := storeA.Iterator(nil, nil)
+	defer iterA.Close()
+	iterB := storeB.Iterator(nil, nil)
+	defer iterB.Close()
+
+	printed := 0
+	for iterA.Valid() || iterB.Valid() {
+		var kvA, kvB kv.Pair
+		var cmp int
+		switch {
+		case !iterA.Valid():
+			cmp = 1
+		case !iterB.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		var op string
+		switch {
+		case cmp < 0:
+			op = "-"
+			kvA = kv.Pair{Key: iterA.Key(), Value: iterA.Value()}
+			kvB = kv.Pair{Key: kvA.Key}
+			iterA.Next()
+		case cmp > 0:
+			op = "+"
+			kvB = kv.Pair{Key: iterB.Key(), Value: iterB.Value()}
+			kvA = kv.Pair{Key: kvB.Key}
+			iterB.Next()
+		default:
+			kvA = kv.Pair{Key: iterA.Key(), Value: iterA.Value()}
+			kvB = kv.Pair{Key: iterB.Key(), Value: iterB.Value()}
+			iterA.Next()
+			iterB.Next()
+			if bytes.Equal(kvA.Value, kvB.Value) {
+				continue
+			}
+			op = "~"
+		}
+
+		if d.maxKeys > 0 && printed == d.maxKeys {
+			fmt.Fprintf(w, "  ... (more differences omitted)\n")
+			return nil
+		}
+		fmt.Fprintf(w, "  %s %X\n%s", op, kvA.Key, d.decode(name, kvA, kvB))
+		printed++
+	}
+	return nil
+}
+
+// decode formats the values of a differing key with the decoder of the store, falling back to hex
+// if the store has no decoder or it fails to decode the values.
+func (d stateDiffer) decode(name string, kvA, kvB kv.Pair) (decoded string) {
+	hex := fmt.Sprintf("    A: %X\n    B: %X\n", kvA.Value, kvB.Value)
+	decoder, ok := d.decoders[name]
+	if !ok {
+		return hex
+	}
+	// decoders panic on keys they don't know
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = hex
+		}
+	}()
+	return fmt.Sprintf("    %s\n", strings.ReplaceAll(decoder(kvA, kvB), "\n", "\n    "))
+}
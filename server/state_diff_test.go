@@ -0,0 +1,114 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func newDiffTestStore(t *testing.T, writes ...map[string]map[string]string) *rootmulti.Store {
+	db := dbm.NewMemDB()
+	store := rootmulti.NewStore(db)
+	for _, name := range []string{"bank", "mint", "staking"} {
+		store.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+	for _, version := range writes {
+		for name, kvs := range version {
+			kvStore := store.GetStoreByName(name).(storetypes.KVStore)
+			for key, value := range kvs {
+				if value == "" {
+					kvStore.Delete([]byte(key))
+				} else {
+					kvStore.Set([]byte(key), []byte(value))
+				}
+			}
+		}
+		store.Commit()
+	}
+
+	// load the store as the diff command does
+	loaded, err := loadCommittedMultiStore(db)
+	require.NoError(t, err)
+	return loaded
+}
+
+func TestStateDiff(t *testing.T) {
+	initial := map[string]map[string]string{
+		"bank":    {"a": "1", "b": "2", "c": "3"},
+		"mint":    {"m": "1"},
+		"staking": {"s": "1"},
+	}
+	changes := map[string]map[string]string{
+		"bank":    {"a": "", "b": "5", "d": "4"},
+		"staking": {"s": "2"},
+	}
+	storeA := newDiffTestStore(t, initial)
+	storeB := newDiffTestStore(t, initial, changes)
+
+	decoders := sdk.StoreDecoderRegistry{
+		"staking": func(kvA, kvB kv.Pair) string {
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		},
+		"bank": func(kvA, kvB kv.Pair) string {
+			panic("unknown key")
+		},
+	}
+
+	// two databases
+	out := &bytes.Buffer{}
+	differ := stateDiffer{a: stateVersion{storeA, 1}, b: stateVersion{storeB, 2}, decoders: decoders}
+	differences, err := differ.diff(out)
+	require.NoError(t, err)
+	require.Equal(t, 2, differences)
+	require.Regexp(t, `^store bank: hash [0-9A-F]+ != [0-9A-F]+
+  - 61
+    A: 31
+    B: 
+  ~ 62
+    A: 32
+    B: 35
+  \+ 64
+    A: 
+    B: 34
+store staking: hash [0-9A-F]+ != [0-9A-F]+
+  ~ 73
+    1
+    2
+$`, out.String())
+
+	// two versions of the same database, limited to some stores and keys
+	out.Reset()
+	differ = stateDiffer{a: stateVersion{storeB, 1}, b: stateVersion{storeB, 2}, stores: []string{"bank", "mint"}, maxKeys: 1}
+	differences, err = differ.diff(out)
+	require.NoError(t, err)
+	require.Equal(t, 1, differences)
+	require.Regexp(t, `^store bank: hash [0-9A-F]+ != [0-9A-F]+
+  - 61
+    A: 31
+    B: 
+  \.\.\. \(more differences omitted\)
+$`, out.String())
+
+	// identical states
+	differ = stateDiffer{a: stateVersion{storeA, 1}, b: stateVersion{storeB, 1}}
+	differences, err = differ.diff(out)
+	require.NoError(t, err)
+	require.Zero(t, differences)
+
+	// missing versions and stores
+	differ = stateDiffer{a: stateVersion{storeA, 1}, b: stateVersion{storeA, 2}}
+	_, err = differ.diff(out)
+	require.Error(t, err)
+	differ = stateDiffer{a: stateVersion{storeA, 1}, b: stateVersion{storeB, 1}, stores: []string{"gov"}}
+	_, err = differ.diff(out)
+	require.Error(t, err)
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagPrefixLength = "prefix-length"
+	flagDump         = "dump"
+	flagDecode       = "decode"
+	flagLimit        = "limit"
+)
+
+// NewStateSizeCmd creates a command reporting the number of keys and bytes of the application state
+// by store and key prefix, and dumping the entries under a key prefix.
+func NewStateSizeCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-size",
+		Short: "Report the size of the application state by store and key prefix",
+		Long: `Iterate the stores of the application state at a height and report their number of keys and
+bytes, grouped by key prefix. Keys are grouped by the prefixes named by the application modules,
+and otherwise by their first bytes.
+
+With --dump, the entries of a store under a hex-encoded key prefix are printed instead, raw or
+decoded with the store decoders the application modules register for simulations.
+The node must be stopped.
+`,
+		Example: fmt.Sprintf(`$ %[1]s state-size --stores bank,staking
+$ %[1]s state-size --stores bank --dump 02 --decode --limit 10`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			store, err := loadCommittedMultiStore(db)
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = store.LastCommitID().Version
+			}
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+			limit, _ := cmd.Flags().GetInt(flagLimit)
+			if cmd.Flags().Changed(flagDump) {
+				if len(stores) != 1 {
+					return fmt.Errorf("--%s requires a single store in --%s", flagDump, flagStores)
+				}
+				dump, _ := cmd.Flags().GetString(flagDump)
+				prefix, err := hex.DecodeString(dump)
+				if err != nil {
+					return fmt.Errorf("invalid key prefix %q: %w", dump, err)
+				}
+				var decoder func(kvA, kvB kv.Pair) string
+				if decode, _ := cmd.Flags().GetBool(flagDecode); decode {
+					decoder = storeDecoders(appCreator, ctx)[stores[0]]
+				}
+				return dumpEntries(cmd.OutOrStdout(), stateVersion{store, height}, stores[0], prefix, decoder, limit)
+			}
+
+			prefixLength, _ := cmd.Flags().GetInt(flagPrefixLength)
+			prefixes := storePrefixes(appCreator, ctx)
+			sizes, err := store.StateSizes(height, prefixes.PrefixFunc(prefixLength), stores...)
+			if err != nil {
+				return err
+			}
+			return printStateSizes(cmd.OutOrStdout(), sizes, prefixes)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Height of the state (0 means latest height)")
+	cmd.Flags().StringSlice(flagStores, nil, "Only report the given stores (default all stores)")
+	cmd.Flags().Int(flagPrefixLength, 1, "Number of bytes keys are grouped by when no module prefix matches them")
+	cmd.Flags().String(flagDump, "", "Print the entries of the store under the given hex-encoded key prefix")
+	cmd.Flags().Bool(flagDecode, false, "Decode the dumped entries with the store decoder of the module")
+	cmd.Flags().Int(flagLimit, 100, "Maximum number of dumped entries (0 means unlimited)")
+	return cmd
+}
+
+// storePrefixes returns the store key prefix names registered by the application modules, if the
+// application exposes them.
+func storePrefixes(appCreator types.AppCreator, ctx *Context) sdk.StorePrefixRegistry {
+	app := appCreator(ctx.Logger, dbm.NewMemDB(), nil, ctx.Viper)
+	prefixApp, ok := app.(interface {
+		StorePrefixes() sdk.StorePrefixRegistry
+	})
+	if !ok {
+		return nil
+	}
+	return prefixApp.StorePrefixes()
+}
+
+// printStateSizes prints the sizes by store and prefix as a table, with the total of each store.
+func printStateSizes(w io.Writer, sizes []rootmulti.PrefixSize, prefixes sdk.StorePrefixRegistry) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "STORE\tPREFIX\tNAME\tKEYS\tKEY BYTES\tVALUE BYTES\tTOTAL BYTES")
+
+	var storeTotal, total rootmulti.PrefixSize
+	flush := func() {
+		if storeTotal.Store != "" {
+			fmt.Fprintf(tw, "%s\t\ttotal\t%d\t%d\t%d\t%d\n", storeTotal.Store, storeTotal.Keys,
+				storeTotal.KeyBytes, storeTotal.ValueBytes, storeTotal.KeyBytes+storeTotal.ValueBytes)
+		}
+	}
+	for _, size := range sizes {
+		if size.Store != storeTotal.Store {
+			flush()
+			storeTotal = rootmulti.PrefixSize{Store: size.Store}
+		}
+		_, name, _ := prefixes.Lookup(size.Store, size.Prefix)
+		fmt.Fprintf(tw, "%s\t%X\t%s\t%d\t%d\t%d\t%d\n", size.Store, size.Prefix, name, size.Keys,
+			size.KeyBytes, size.ValueBytes, size.KeyBytes+size.ValueBytes)
+		for _, sum := range []*rootmulti.PrefixSize{&storeTotal, &total} {
+			sum.Keys += size.Keys
+			sum.KeyBytes += size.KeyBytes
+			sum.ValueBytes += size.ValueBytes
+		}
+	}
+	flush()
+	fmt.Fprintf(tw, "\t\ttotal\t%d\t%d\t%d\t%d\n", total.Keys, total.KeyBytes, total.ValueBytes,
+		total.KeyBytes+total.ValueBytes)
+	return tw.Flush()
+}
+
+// dumpEntries prints the entries of a store under a key prefix, decoded with the given store decoder
+// if any, and in hex otherwise.
+func dumpEntries(w io.Writer, v stateVersion, name string, prefix []byte, decoder func(kvA, kvB kv.Pair) string, limit int) error {
+	kvStore, err := v.kvStore(name)
+	if err != nil {
+		return err
+	}
+	iter := storetypes.KVStorePrefixIterator(kvStore, prefix)
+	defer iter.Close()
+
+	printed := 0
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && printed == limit {
+			fmt.Fprintf(w, "... (more entries omitted)\n")
+			break
+		}
+		pair := kv.Pair{Key: iter.Key(), Value: iter.Value()}
+		fmt.Fprintf(w, "%X\n  %s\n", pair.Key, strings.ReplaceAll(decodeEntry(decoder, pair), "\n", "\n  "))
+		printed++
+	}
+	return iter.Error()
+}
+
+// decodeEntry formats a value with a store decoder, falling back to hex if there is no decoder or it
+// fails to decode the value. Store decoders format a pair of values, so the same value is passed twice
+// and printed once.
+func decodeEntry(decoder func(kvA, kvB kv.Pair) string, pair kv.Pair) (decoded string) {
+	raw := fmt.Sprintf("%X", pair.Value)
+	if decoder == nil {
+		return raw
+	}
+	// decoders panic on keys they don't know
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = raw
+		}
+	}()
+	decoded = decoder(pair, pair)
+	if half := len(decoded) / 2; len(decoded)%2 == 1 && decoded[half] == '\n' && decoded[:half] == decoded[half+1:] {
+		decoded = decoded[:half]
+	}
+	return decoded
+}
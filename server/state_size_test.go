@@ -0,0 +1,49 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestStateSize(t *testing.T) {
+	store := newDiffTestStore(t, map[string]map[string]string{
+		"bank":    {"a1": "1", "a2": "22", "b": "3"},
+		"staking": {"s": "1"},
+	})
+	prefixes := make(sdk.StorePrefixRegistry)
+	prefixes.Register("bank", []byte("a"), "accounts")
+
+	sizes, err := store.StateSizes(1, prefixes.PrefixFunc(1), "bank", "staking")
+	require.NoError(t, err)
+	out := &bytes.Buffer{}
+	require.NoError(t, printStateSizes(out, sizes, prefixes))
+	require.Equal(t, `STORE    PREFIX  NAME      KEYS  KEY BYTES  VALUE BYTES  TOTAL BYTES
+bank     61      accounts  2     4          3            7
+bank     62                1     1          1            2
+bank             total     3     5          4            9
+staking  73                1     1          1            2
+staking          total     1     1          1            2
+                 total     4     6          5            11
+`, out.String())
+
+	// entries are dumped raw, or decoded with the store decoder
+	out.Reset()
+	require.NoError(t, dumpEntries(out, stateVersion{store, 1}, "bank", []byte("a"), nil, 1))
+	require.Equal(t, "6131\n  31\n... (more entries omitted)\n", out.String())
+
+	decoder := func(kvA, kvB kv.Pair) string {
+		if string(kvA.Key) == "b" {
+			panic("unknown key")
+		}
+		return fmt.Sprintf("value %s\nvalue %s", kvA.Value, kvB.Value)
+	}
+	out.Reset()
+	require.NoError(t, dumpEntries(out, stateVersion{store, 1}, "bank", nil, decoder, 0))
+	require.Equal(t, "6131\n  value 1\n6132\n  value 22\n62\n  33\n", out.String())
+}
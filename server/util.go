@@ -285,6 +285,11 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(defaultNodeHome),
+		NewSnapshotCmd(appCreator, defaultNodeHome),
+		NewPruneCmd(defaultNodeHome),
+		NewStateDiffCmd(appCreator, defaultNodeHome),
+		NewStateSizeCmd(appCreator, defaultNodeHome),
+		NewMigrateDBCmd(defaultNodeHome),
 	)
 }
 
@@ -72,6 +72,17 @@ func TestGetMaccPerms(t *testing.T) {
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
 
+func TestAppConfigMaccPerms(t *testing.T) {
+	app := Setup(t, false)
+
+	perms := app.AccountKeeper.GetModulePermissions()
+	require.Len(t, perms, len(maccPerms))
+	for acc, accPerms := range maccPerms {
+		require.Contains(t, perms, acc)
+		require.ElementsMatch(t, accPerms, perms[acc].GetPermissions(), "module account permissions of %s differ from app.yaml", acc)
+	}
+}
+
 func TestRunMigrations(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCfg := MakeTestEncodingConfig()
@@ -91,7 +102,7 @@ func TestRunMigrations(t *testing.T) {
 	//
 	// The loop below is the same as calling `RegisterServices` on
 	// ModuleManager, except that we skip x/bank.
-	for _, module := range app.mm.Modules {
+	for _, module := range app.ModuleManager.Modules {
 		if module.Name() == banktypes.ModuleName {
 			continue
 		}
@@ -169,7 +180,7 @@ func TestRunMigrations(t *testing.T) {
 			// Run migrations only for bank. That's why we put the initial
 			// version for bank as 1, and for all other modules, we put as
 			// their latest ConsensusVersion.
-			_, err = app.mm.RunMigrations(
+			_, err = app.ModuleManager.RunMigrations(
 				app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}), app.configurator,
 				module.VersionMap{
 					"bank":         1,
@@ -219,11 +230,11 @@ func TestInitGenesisOnMigration(t *testing.T) {
 	mockModule.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(app.appCodec), gomock.Eq(mockDefaultGenesis)).Times(1).Return(nil)
 	mockModule.EXPECT().ConsensusVersion().Times(1).Return(uint64(0))
 
-	app.mm.Modules["mock"] = mockModule
+	app.ModuleManager.Modules["mock"] = mockModule
 
 	// Run migrations only for "mock" module. We exclude it from
 	// the VersionMap to simulate upgrading with a new module.
-	_, err := app.mm.RunMigrations(ctx, app.configurator,
+	_, err := app.ModuleManager.RunMigrations(ctx, app.configurator,
 		module.VersionMap{
 			"bank":         bank.AppModule{}.ConsensusVersion(),
 			"auth":         auth.AppModule{}.ConsensusVersion(),
@@ -263,7 +274,7 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	// make sure the upgrade keeper has version map in state
 	ctx := app.NewContext(false, tmproto.Header{})
 	vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
-	for v, i := range app.mm.Modules {
+	for v, i := range app.ModuleManager.Modules {
 		require.Equal(t, vm[v], i.ConsensusVersion())
 	}
 }
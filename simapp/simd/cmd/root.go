@@ -270,7 +270,17 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
-	return simapp.NewSimApp(
+	var rwSetLog io.Writer
+	var rwSetLogFile *os.File
+	if rwSetLogPath := cast.ToString(appOpts.Get(server.FlagReadWriteSetLog)); rwSetLogPath != "" {
+		rwSetLogFile, err = os.OpenFile(rwSetLogPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+		if err != nil {
+			panic(err)
+		}
+		rwSetLog = rwSetLogFile
+	}
+
+	app := simapp.NewSimApp(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
 		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
@@ -287,7 +297,33 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
+		baseapp.SetSnapshotFormat(
+			cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotFormat)),
+			cast.ToString(appOpts.Get(server.FlagStateSyncSnapshotCompressor)),
+		),
+		baseapp.SetSnapshotConcurrency(cast.ToInt(appOpts.Get(server.FlagStateSyncSnapshotConcurrency))),
+		baseapp.SetStateSizeInterval(cast.ToUint64(appOpts.Get(server.FlagStateSizeInterval))),
+		baseapp.SetReadWriteSetLog(rwSetLog),
 	)
+	if rwSetLogFile != nil {
+		return appWithFile{SimApp: app, file: rwSetLogFile}
+	}
+	return app
+}
+
+// appWithFile is a SimApp which closes a file it writes to when it is closed.
+type appWithFile struct {
+	*simapp.SimApp
+	file *os.File
+}
+
+// Close closes the app, then the file.
+func (a appWithFile) Close() error {
+	err := a.SimApp.Close()
+	if closeErr := a.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
 }
 
 // appExport creates a new simapp (optionally at a given height)
@@ -0,0 +1,161 @@
+package snapshots
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// archiveMetadata is the name of the archive entry containing the serialized snapshot metadata.
+	archiveMetadata = "snapshot"
+	// archiveChunkPrefix is the prefix of the archive entries containing the snapshot chunks.
+	archiveChunkPrefix = "chunks/"
+)
+
+// ExportArchive writes a stored snapshot to a single tar archive, containing the Protobuf-serialized
+// snapshot followed by its binary chunks in order. The chunks are already compressed, so the
+// archive itself is not.
+func ExportArchive(store *Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, err := store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	tw := tar.NewWriter(w)
+	err = tw.WriteHeader(&tar.Header{Name: archiveMetadata, Mode: 0644, Size: int64(len(bz))})
+	if err != nil {
+		return err
+	}
+	if _, err = tw.Write(bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		if err := exportArchiveChunk(store, tw, snapshot, i); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// exportArchiveChunk writes a single snapshot chunk to a tar archive.
+func exportArchiveChunk(store *Store, tw *tar.Writer, snapshot *types.Snapshot, index uint32) error {
+	file, err := os.Open(store.pathChunk(snapshot.Height, snapshot.Format, index))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to load snapshot chunk %v", index)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	err = tw.WriteHeader(&tar.Header{
+		Name: fmt.Sprintf("%v%v", archiveChunkPrefix, index),
+		Mode: 0644,
+		Size: info.Size(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, file)
+	return err
+}
+
+// ImportArchive reads a snapshot archive written by ExportArchive and saves it in the store. The
+// chunks are verified against the archived metadata, and the snapshot is not kept if they differ.
+func ImportArchive(store *Store, r io.Reader) (*types.Snapshot, error) {
+	tr := tar.NewReader(r)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot archive")
+	}
+	if header.Name != archiveMetadata {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "unexpected archive entry %q, expected %q",
+			header.Name, archiveMetadata)
+	}
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+	expected := &types.Snapshot{}
+	if err := expected.Unmarshal(bz); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+
+	chunks := make(chan io.ReadCloser)
+	chErr := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < expected.Chunks; i++ {
+			header, err := tr.Next()
+			if err != nil {
+				chErr <- sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", i)
+				return
+			}
+			if name := archiveChunkPrefix + strconv.FormatUint(uint64(i), 10); header.Name != name {
+				chErr <- sdkerrors.Wrapf(types.ErrInvalidMetadata, "unexpected archive entry %q, expected %q",
+					header.Name, name)
+				return
+			}
+			pr, pw := io.Pipe()
+			chunks <- pr
+			_, err = io.Copy(pw, tr)
+			pw.CloseWithError(err)
+			if err != nil {
+				chErr <- err
+				return
+			}
+		}
+		chErr <- nil
+	}()
+
+	var snapshot *types.Snapshot
+	if expected.Format == types.ParallelFormat {
+		snapshot, err = store.SaveSections(expected.Height, expected.Metadata.Sections,
+			expected.Metadata.Compressor, chunks)
+	} else {
+		snapshot, err = store.Save(expected.Height, expected.Format, chunks)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := <-chErr; err != nil {
+		return nil, discardImport(store, snapshot, err)
+	}
+
+	switch {
+	case snapshot.Chunks != expected.Chunks:
+		err = sdkerrors.Wrapf(types.ErrInvalidMetadata, "archive contains %v chunks, expected %v",
+			snapshot.Chunks, expected.Chunks)
+	case !bytes.Equal(snapshot.Hash, expected.Hash):
+		err = sdkerrors.Wrapf(types.ErrChunkHashMismatch, "snapshot hash %X, expected %X",
+			snapshot.Hash, expected.Hash)
+	}
+	if err != nil {
+		return nil, discardImport(store, snapshot, err)
+	}
+	return snapshot, nil
+}
+
+// discardImport deletes an imported snapshot which failed verification, returning the given error.
+func discardImport(store *Store, snapshot *types.Snapshot, err error) error {
+	if delErr := store.Delete(snapshot.Height, snapshot.Format); delErr != nil {
+		return sdkerrors.Wrapf(err, "failed to delete invalid snapshot: %v", delErr)
+	}
+	return err
+}
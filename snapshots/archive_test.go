@@ -0,0 +1,81 @@
+package snapshots_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func TestArchive(t *testing.T) {
+	source := setupStore(t)
+	expected, err := source.Get(2, 2)
+	require.NoError(t, err)
+
+	// missing snapshots can't be exported
+	require.Error(t, snapshots.ExportArchive(source, 9, 2, io.Discard))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, snapshots.ExportArchive(source, 2, 2, buf))
+	archive := buf.Bytes()
+
+	// the archive is imported into an empty store as an identical snapshot
+	target, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	snapshot, err := snapshots.ImportArchive(target, bytes.NewReader(archive))
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := target.LoadChunk(2, 2, i)
+		require.NoError(t, err)
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		require.Equal(t, []byte{2, 2, byte(i)}, bz)
+	}
+
+	// existing snapshots are not overwritten
+	_, err = snapshots.ImportArchive(source, bytes.NewReader(archive))
+	require.Error(t, err)
+}
+
+func TestArchive_Invalid(t *testing.T) {
+	source := setupStore(t)
+	buf := &bytes.Buffer{}
+	require.NoError(t, snapshots.ExportArchive(source, 2, 2, buf))
+	archive := buf.Bytes()
+
+	testcases := map[string]struct {
+		archive []byte
+		err     error
+	}{
+		"not an archive": {[]byte{1, 2, 3}, nil},
+		"corrupted chunk": {
+			bytes.Replace(archive, []byte{2, 2, 1}, []byte{2, 2, 9}, 1),
+			types.ErrChunkHashMismatch,
+		},
+		"truncated": {archive[:len(archive)-1024-512], nil},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			target, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+			require.NoError(t, err)
+			_, err = snapshots.ImportArchive(target, bytes.NewReader(tc.archive))
+			require.Error(t, err)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			}
+
+			// invalid snapshots are not kept
+			list, err := target.List()
+			require.NoError(t, err)
+			require.Empty(t, list)
+		})
+	}
+}
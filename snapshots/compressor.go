@@ -0,0 +1,111 @@
+package snapshots
+
+import (
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// CompressorZlib is the name of the zlib compressor, used by default
+	CompressorZlib = "zlib"
+	// CompressorZstd is the name of the zstd compressor
+	CompressorZstd = "zstd"
+	// CompressorNone is the name of the compressor leaving sections uncompressed
+	CompressorNone = "none"
+
+	// DefaultCompressor is the compressor used for snapshots in the ParallelFormat by default
+	DefaultCompressor = CompressorZlib
+)
+
+// Compressor compresses and decompresses the sections of snapshots in the ParallelFormat.
+// Snapshots of the same height must be identical across nodes, so the compressed output must
+// be deterministic and must not change without a new compressor name.
+type Compressor interface {
+	// NewWriter returns a writer compressing the data written to it into w.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+	// NewReader returns a reader decompressing the data read from r.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+var (
+	compressorsMtx sync.RWMutex
+	compressors    = map[string]Compressor{
+		CompressorZlib: zlibCompressor{},
+		CompressorZstd: zstdCompressor{},
+		CompressorNone: noneCompressor{},
+	}
+)
+
+// RegisterCompressor registers a compressor under the given name, which is recorded in the
+// metadata of the snapshots it compresses. It panics if a compressor is already registered
+// under the name.
+func RegisterCompressor(name string, compressor Compressor) {
+	compressorsMtx.Lock()
+	defer compressorsMtx.Unlock()
+	if _, ok := compressors[name]; ok {
+		panic(fmt.Sprintf("snapshot compressor %s is already registered", name))
+	}
+	compressors[name] = compressor
+}
+
+// getCompressor returns the compressor registered under the given name.
+func getCompressor(name string) (Compressor, error) {
+	compressorsMtx.RLock()
+	defer compressorsMtx.RUnlock()
+	compressor, ok := compressors[name]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "unknown snapshot compressor %q", name)
+	}
+	return compressor, nil
+}
+
+// zlibCompressor compresses sections with zlib, at the level used by the CurrentFormat
+type zlibCompressor struct{}
+
+func (zlibCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zlib.NewWriterLevel(w, snapshotCompressionLevel)
+}
+
+func (zlibCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return zlib.NewReader(r)
+}
+
+// zstdCompressor compresses sections with zstd. Sections are compressed concurrently, so each
+// of them is compressed by a single goroutine.
+type zstdCompressor struct{}
+
+func (zstdCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+}
+
+func (zstdCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return decoder.IOReadCloser(), nil
+}
+
+// noneCompressor leaves sections uncompressed
+type noneCompressor struct{}
+
+func (noneCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return nopWriteCloser{w}, nil
+}
+
+func (noneCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(r), nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"io"
 	"os"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -135,6 +137,10 @@ func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) er
 	return nil
 }
 
+func (m *mockSnapshotter) SnapshotName() string {
+	return "mock"
+}
+
 func (m *mockSnapshotter) SnapshotFormat() uint32 {
 	return snapshottypes.CurrentFormat
 }
@@ -142,6 +148,68 @@ func (m *mockSnapshotter) SupportedFormats() []uint32 {
 	return []uint32{snapshottypes.CurrentFormat}
 }
 
+// mockSectionSnapshotter is a SectionSnapshotter whose sections contain extension payload items.
+type mockSectionSnapshotter struct {
+	mtx       sync.Mutex
+	sections  map[string][][]byte
+	finalized bool
+}
+
+func (m *mockSectionSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	panic("not implemented")
+}
+
+func (m *mockSectionSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	panic("not implemented")
+}
+
+func (m *mockSectionSnapshotter) SnapshotSections(height uint64) ([]string, error) {
+	names := make([]string, 0, len(m.sections))
+	for name := range m.sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *mockSectionSnapshotter) SnapshotSection(height uint64, section string, protoWriter protoio.Writer) error {
+	for _, item := range m.sections[section] {
+		if err := types.WriteExtensionItem(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockSectionSnapshotter) RestoreSection(height uint64, section string, protoReader protoio.Reader) error {
+	items := [][]byte{}
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		items = append(items, item.GetExtensionPayload().Payload)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.sections == nil {
+		m.sections = make(map[string][][]byte)
+	}
+	m.sections[section] = items
+	return nil
+}
+
+func (m *mockSectionSnapshotter) FinalizeRestore(height uint64) error {
+	m.finalized = true
+	return nil
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
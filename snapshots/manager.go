@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"runtime"
 	"sort"
 	"sync"
 
@@ -51,6 +52,10 @@ type Manager struct {
 	multistore types.Snapshotter
 	extensions map[string]types.ExtensionSnapshotter
 
+	format      uint32 // format of the created snapshots
+	compressor  string // compressor of the created snapshots in the ParallelFormat
+	concurrency int    // number of sections created or restored concurrently in the ParallelFormat
+
 	mtx                sync.Mutex
 	operation          operation
 	chRestore          chan<- io.ReadCloser
@@ -62,10 +67,45 @@ type Manager struct {
 // NewManager creates a new manager.
 func NewManager(store *Store, multistore types.Snapshotter, extensions map[string]types.ExtensionSnapshotter) *Manager {
 	return &Manager{
-		store:      store,
-		multistore: multistore,
-		extensions: extensions,
+		store:       store,
+		multistore:  multistore,
+		extensions:  extensions,
+		format:      types.CurrentFormat,
+		compressor:  DefaultCompressor,
+		concurrency: runtime.NumCPU(),
+	}
+}
+
+// SetFormat sets the format of the snapshots created by the manager, and the compressor of the
+// sections of snapshots in the ParallelFormat. It must be called before the manager is used.
+func (m *Manager) SetFormat(format uint32, compressor string) error {
+	switch format {
+	case types.CurrentFormat:
+	case types.ParallelFormat:
+		if _, ok := m.multistore.(types.SectionSnapshotter); !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "multistore does not support format %v", format)
+		}
+		if _, err := getCompressor(compressor); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", format)
+	}
+
+	m.format = format
+	m.compressor = compressor
+	return nil
+}
+
+// SetConcurrency sets the number of sections created or restored concurrently for snapshots in
+// the ParallelFormat. If zero, the number of CPUs is used. It must be called before the manager
+// is used.
+func (m *Manager) SetConcurrency(concurrency int) {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
 	}
+
+	m.concurrency = concurrency
 }
 
 // RegisterExtensions register extension snapshotters to manager
@@ -152,6 +192,10 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if m.format == types.ParallelFormat {
+		return m.createSections(height)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
@@ -238,9 +282,18 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.CurrentFormat:
+	case types.ParallelFormat:
+		if _, ok := m.multistore.(types.SectionSnapshotter); !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+		}
+	default:
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	if err := validateSections(&snapshot); err != nil {
+		return err
+	}
 	if snapshot.Height == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -259,7 +312,12 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	chDone := make(chan restoreDone, 1)
 
 	go func() {
-		err := m.restoreSnapshot(snapshot, chChunks)
+		var err error
+		if snapshot.Format == types.ParallelFormat {
+			err = m.restoreSections(snapshot, chChunks)
+		} else {
+			err = m.restoreSnapshot(snapshot, chChunks)
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -362,6 +420,43 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
+// RestoreLocalSnapshot restores the application state from a snapshot in the local snapshot
+// store, without going through state sync. The chunks are verified as in RestoreChunk.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, err := m.store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+
+	if err := m.Restore(*snapshot); err != nil {
+		return err
+	}
+	// chunks are not retried, so the restore is ended on any failure
+	defer m.end()
+
+	done := false
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := m.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot chunk %v", i)
+		}
+		done, err = m.RestoreChunk(chunk)
+		if err != nil {
+			return err
+		}
+	}
+	if !done {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore did not complete after the last chunk")
+	}
+	return nil
+}
+
 // IsFormatSupported returns if the snapshotter supports restoration from given format.
 func IsFormatSupported(snapshotter types.ExtensionSnapshotter, format uint32) bool {
 	for _, i := range snapshotter.SupportedFormats() {
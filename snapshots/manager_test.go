@@ -2,13 +2,17 @@ package snapshots_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestManager_List(t *testing.T) {
@@ -210,3 +214,116 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_ParallelFormat(t *testing.T) {
+	for _, compressor := range []string{snapshots.CompressorZlib, snapshots.CompressorZstd, snapshots.CompressorNone} {
+		t.Run(compressor, func(t *testing.T) {
+			store := setupStore(t)
+			source := &mockSectionSnapshotter{sections: map[string][][]byte{
+				"a": {{1, 2, 3}, {4, 5, 6}},
+				"b": {},
+				"c": {{7, 8, 9}},
+			}}
+			extension := &mockSnapshotter{items: [][]byte{{10, 11, 12}}}
+			manager := snapshots.NewManager(store, source, map[string]types.ExtensionSnapshotter{"mock": extension})
+
+			// the format must be known and the compressor registered
+			require.ErrorIs(t, manager.SetFormat(9, compressor), types.ErrUnknownFormat)
+			require.ErrorIs(t, manager.SetFormat(types.ParallelFormat, "unknown"), types.ErrUnknownFormat)
+			require.NoError(t, manager.SetFormat(types.ParallelFormat, compressor))
+			manager.SetConcurrency(2)
+
+			snapshot, err := manager.Create(5)
+			require.NoError(t, err)
+			require.Equal(t, types.ParallelFormat, snapshot.Format)
+			require.Equal(t, compressor, snapshot.Metadata.Compressor)
+			require.Len(t, snapshot.Metadata.Sections, 4)
+			require.Equal(t, "c", snapshot.Metadata.Sections[2].Name)
+			require.True(t, snapshot.Metadata.Sections[3].Extension)
+			require.EqualValues(t, 4, snapshot.Chunks)
+
+			// snapshots of the same height are identical
+			otherStore := setupStore(t)
+			other := snapshots.NewManager(otherStore, source, map[string]types.ExtensionSnapshotter{"mock": extension})
+			require.NoError(t, other.SetFormat(types.ParallelFormat, compressor))
+			otherSnapshot, err := other.Create(5)
+			require.NoError(t, err)
+			require.Equal(t, snapshot, otherSnapshot)
+
+			restore := func(snapshot types.Snapshot) (*mockSectionSnapshotter, *mockSnapshotter, error) {
+				targetSource := &mockSectionSnapshotter{}
+				targetExtension := &mockSnapshotter{}
+				target := snapshots.NewManager(setupStore(t), targetSource, map[string]types.ExtensionSnapshotter{"mock": targetExtension})
+				if err := target.Restore(snapshot); err != nil {
+					return nil, nil, err
+				}
+				for i := uint32(0); i < snapshot.Chunks; i++ {
+					chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+					require.NoError(t, err)
+					done, err := target.RestoreChunk(chunk)
+					if err != nil {
+						return nil, nil, err
+					}
+					require.Equal(t, i == snapshot.Chunks-1, done)
+				}
+				return targetSource, targetExtension, nil
+			}
+
+			targetSource, targetExtension, err := restore(*snapshot)
+			require.NoError(t, err)
+			require.Equal(t, source.sections, targetSource.sections)
+			require.True(t, targetSource.finalized)
+			require.Equal(t, extension.items, targetExtension.items)
+
+			// sections must cover the chunks of the snapshot
+			invalid := *snapshot
+			invalid.Metadata.Sections = snapshot.Metadata.Sections[1:]
+			_, _, err = restore(invalid)
+			require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+			// the hash of each section is verified
+			invalid = *snapshot
+			invalid.Metadata.Sections = make([]*types.SnapshotSection, len(snapshot.Metadata.Sections))
+			for i, section := range snapshot.Metadata.Sections {
+				section := *section
+				invalid.Metadata.Sections[i] = &section
+			}
+			invalid.Metadata.Sections[2].Hash = make([]byte, len(invalid.Metadata.Sections[2].Hash))
+			_, _, err = restore(invalid)
+			require.ErrorIs(t, err, types.ErrSectionHashMismatch)
+		})
+	}
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	store, err := snapshots.NewStore(db.NewMemDB(), dir)
+	require.NoError(t, err)
+	source := &mockSnapshotter{items: [][]byte{{1, 2, 3}, {4, 5, 6}}}
+	snapshot, err := snapshots.NewManager(store, source, nil).Create(5)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target, nil)
+
+	// missing snapshots can't be restored
+	require.Error(t, manager.RestoreLocalSnapshot(6, snapshot.Format))
+
+	require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	require.Equal(t, source.items, target.items)
+
+	// corrupted chunks abort the restore
+	path := filepath.Join(dir, "5", "2", "0")
+	require.NoError(t, os.WriteFile(path, []byte{1, 2, 3}, 0644))
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+	_, err = manager.Create(6)
+	require.NoError(t, err)
+
+	// missing chunks abort the restore as well
+	require.NoError(t, os.Remove(path))
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	_, err = manager.Create(7)
+	require.NoError(t, err)
+}
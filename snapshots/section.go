@@ -0,0 +1,388 @@
+package snapshots
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// sectionBufferSize is the size of the write buffer of each section being created
+const sectionBufferSize = 1 << 20
+
+// createSections creates a snapshot in the ParallelFormat. The sections are created concurrently
+// into temporary chunk files, which are then saved to the store in the order of the sections.
+func (m *Manager) createSections(height uint64) (*types.Snapshot, error) {
+	multistore, ok := m.multistore.(types.SectionSnapshotter)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "multistore does not support format %v", types.ParallelFormat)
+	}
+	compressor, err := getCompressor(m.compressor)
+	if err != nil {
+		return nil, err
+	}
+
+	storeSections, err := multistore.SnapshotSections(height)
+	if err != nil {
+		return nil, err
+	}
+	sections := make([]*types.SnapshotSection, 0, len(storeSections)+len(m.extensions))
+	for _, name := range storeSections {
+		sections = append(sections, &types.SnapshotSection{Name: name})
+	}
+	for _, name := range m.sortedExtensionNames() {
+		sections = append(sections, &types.SnapshotSection{
+			Name:            name,
+			Extension:       true,
+			ExtensionFormat: m.extensions[name].SnapshotFormat(),
+		})
+	}
+
+	dir := m.store.pathTemp(height)
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to remove temporary snapshot directory %q", dir)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		wg      sync.WaitGroup
+		errOnce sync.Once
+		sem     = make(chan struct{}, m.concurrency)
+	)
+	for i, section := range sections {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(section *types.SnapshotSection, dir string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if sectionErr := m.createSection(height, multistore, compressor, section, dir); sectionErr != nil {
+				errOnce.Do(func() { err = sdkerrors.Wrapf(sectionErr, "failed to create snapshot section %s", section.Name) })
+			}
+		}(section, filepath.Join(dir, strconv.Itoa(i)))
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		for i, section := range sections {
+			for chunk := uint32(0); chunk < section.Chunks; chunk++ {
+				ch <- openChunkFile(filepath.Join(dir, strconv.Itoa(i), strconv.FormatUint(uint64(chunk), 10)))
+			}
+		}
+	}()
+	return m.store.SaveSections(height, sections, m.compressor, ch)
+}
+
+// createSection writes a section into chunk files in the given directory, and records its number
+// of chunks and hash.
+func (m *Manager) createSection(
+	height uint64, multistore types.SectionSnapshotter, compressor Compressor, section *types.SnapshotSection, dir string,
+) error {
+	chunkWriter, err := newFileChunkWriter(dir, snapshotChunkSize)
+	if err != nil {
+		return err
+	}
+	defer chunkWriter.Abort()
+	bufWriter := bufio.NewWriterSize(chunkWriter, sectionBufferSize)
+	zWriter, err := compressor.NewWriter(bufWriter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "compressor failure")
+	}
+	hasher := sha256.New()
+	protoWriter := protoio.NewDelimitedWriter(io.MultiWriter(zWriter, hasher))
+
+	if section.Extension {
+		err = m.extensions[section.Name].Snapshot(height, protoWriter)
+	} else {
+		err = multistore.SnapshotSection(height, section.Name, protoWriter)
+	}
+	if err != nil {
+		return err
+	}
+	if err := zWriter.Close(); err != nil {
+		return err
+	}
+	if err := bufWriter.Flush(); err != nil {
+		return err
+	}
+	chunks, err := chunkWriter.Finish()
+	if err != nil {
+		return err
+	}
+
+	section.Chunks = chunks
+	section.Hash = hasher.Sum(nil)
+	return nil
+}
+
+// openChunkFile opens a chunk file, returning a reader failing with the error if it cannot be opened.
+func openChunkFile(path string) io.ReadCloser {
+	file, err := os.Open(path)
+	if err != nil {
+		pr, pw := io.Pipe()
+		pw.CloseWithError(sdkerrors.Wrapf(err, "failed to open snapshot chunk file %q", path))
+		return pr
+	}
+	return file
+}
+
+// restoreSections restores a snapshot in the ParallelFormat. The chunks of each section are
+// dispatched to a goroutine restoring the section, so that sections are restored concurrently
+// while the chunks of the following sections are received. Extension sections are restored once
+// all the store sections have been restored, as extensions may rely on the restored state.
+func (m *Manager) restoreSections(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	multistore, ok := m.multistore.(types.SectionSnapshotter)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "multistore does not support format %v", snapshot.Format)
+	}
+	compressor, err := getCompressor(snapshot.Metadata.Compressor)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg       sync.WaitGroup // waits for all the sections
+		storesWg sync.WaitGroup // waits for the store sections
+		errOnce  sync.Once
+		failed   = make(chan struct{}) // closed once a section failed
+		sem      = make(chan struct{}, m.concurrency)
+	)
+	fail := func(sectionErr error) {
+		errOnce.Do(func() {
+			err = sectionErr
+			close(failed)
+		})
+	}
+
+dispatch:
+	for _, section := range snapshot.Metadata.Sections {
+		select {
+		case sem <- struct{}{}:
+		case <-failed:
+			break dispatch
+		}
+
+		sectionChunks := make(chan io.ReadCloser, chunkBufferSize)
+		wg.Add(1)
+		if !section.Extension {
+			storesWg.Add(1)
+		}
+		go func(section *types.SnapshotSection) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			// drain the chunks of the section if it failed
+			defer DrainChunks(sectionChunks)
+			if section.Extension {
+				storesWg.Wait()
+			} else {
+				defer storesWg.Done()
+			}
+			if sectionErr := m.restoreSection(snapshot.Height, multistore, compressor, section, sectionChunks); sectionErr != nil {
+				fail(sdkerrors.Wrapf(sectionErr, "failed to restore snapshot section %s", section.Name))
+			}
+		}(section)
+
+		for i := uint32(0); i < section.Chunks; i++ {
+			select {
+			case chunk, ok := <-chChunks:
+				if !ok {
+					close(sectionChunks)
+					fail(sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended prematurely"))
+					break dispatch
+				}
+				sectionChunks <- chunk
+			case <-failed:
+				close(sectionChunks)
+				break dispatch
+			}
+		}
+		close(sectionChunks)
+	}
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+
+	return multistore.FinalizeRestore(snapshot.Height)
+}
+
+// restoreSection restores a section from its chunks and verifies its hash.
+func (m *Manager) restoreSection(
+	height uint64, multistore types.SectionSnapshotter, compressor Compressor, section *types.SnapshotSection,
+	chunks <-chan io.ReadCloser,
+) error {
+	chunkReader := NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := compressor.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "compressor failure")
+	}
+	defer zReader.Close()
+	hasher := sha256.New()
+	reader := io.TeeReader(zReader, hasher)
+	protoReader := protoio.NewDelimitedReader(reader, snapshotMaxItemSize)
+
+	if section.Extension {
+		extension, ok := m.extensions[section.Name]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown extension snapshotter %s", section.Name)
+		}
+		if !IsFormatSupported(extension, section.ExtensionFormat) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", section.ExtensionFormat, section.Name)
+		}
+		next, err := extension.Restore(height, section.ExtensionFormat, protoReader)
+		if err != nil {
+			return err
+		}
+		if next.Item != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T", next.Item)
+		}
+	} else if err := multistore.RestoreSection(height, section.Name, protoReader); err != nil {
+		return err
+	}
+
+	// consume the rest of the section, so that it is entirely hashed
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return err
+	}
+	if hash := hasher.Sum(nil); !bytes.Equal(hash, section.Hash) {
+		return sdkerrors.Wrapf(types.ErrSectionHashMismatch, "expected %x, got %x", section.Hash, hash)
+	}
+	return nil
+}
+
+// validateSections validates the sections of a snapshot against its format and chunks.
+func validateSections(snapshot *types.Snapshot) error {
+	sections := snapshot.Metadata.Sections
+	if snapshot.Format != types.ParallelFormat {
+		if len(sections) > 0 || snapshot.Metadata.Compressor != "" {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "format %v snapshot cannot have sections", snapshot.Format)
+		}
+		return nil
+	}
+
+	if _, err := getCompressor(snapshot.Metadata.Compressor); err != nil {
+		return err
+	}
+	chunks := uint32(0)
+	seen := make(map[string]bool, len(sections))
+	extensions := false
+	for _, section := range sections {
+		switch {
+		case section.Chunks == 0:
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "section %s has no chunks", section.Name)
+		case len(section.Hash) != sha256.Size:
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "section %s has an invalid hash", section.Name)
+		case !section.Extension && extensions:
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "store section %s follows an extension section", section.Name)
+		}
+		key := section.Name
+		if section.Extension {
+			key = "extension/" + key
+			extensions = true
+		}
+		if seen[key] {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "duplicated section %s", section.Name)
+		}
+		seen[key] = true
+		chunks += section.Chunks
+	}
+	if chunks != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks, but its sections have %v chunks",
+			snapshot.Chunks, chunks)
+	}
+	return nil
+}
+
+// fileChunkWriter splits the data written to it into chunk files of a fixed size, named by their
+// index, in a directory.
+type fileChunkWriter struct {
+	dir       string
+	chunkSize uint64
+	file      *os.File
+	written   uint64
+	chunks    uint32
+}
+
+// newFileChunkWriter creates a new fileChunkWriter writing into the given directory.
+func newFileChunkWriter(dir string, chunkSize uint64) (*fileChunkWriter, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+	return &fileChunkWriter{dir: dir, chunkSize: chunkSize}, nil
+}
+
+// chunk closes the current chunk file and creates the next one.
+func (w *fileChunkWriter) chunk() error {
+	if w.file != nil {
+		if err := w.file.Close(); err != nil {
+			return err
+		}
+	}
+	file, err := os.Create(filepath.Join(w.dir, strconv.FormatUint(uint64(w.chunks), 10)))
+	if err != nil {
+		return err
+	}
+	w.file = file
+	w.written = 0
+	w.chunks++
+	return nil
+}
+
+// Write implements io.Writer.
+func (w *fileChunkWriter) Write(data []byte) (int, error) {
+	nTotal := 0
+	for len(data) > 0 {
+		if w.file == nil || w.written >= w.chunkSize {
+			if err := w.chunk(); err != nil {
+				return nTotal, err
+			}
+		}
+		writeSize := w.chunkSize - w.written
+		if writeSize > uint64(len(data)) {
+			writeSize = uint64(len(data))
+		}
+		n, err := w.file.Write(data[:writeSize])
+		w.written += uint64(n)
+		nTotal += n
+		if err != nil {
+			return nTotal, err
+		}
+		data = data[writeSize:]
+	}
+	return nTotal, nil
+}
+
+// Finish closes the last chunk file and returns the number of chunks written. A section always
+// has at least one, possibly empty, chunk.
+func (w *fileChunkWriter) Finish() (uint32, error) {
+	if w.file == nil {
+		if err := w.chunk(); err != nil {
+			return 0, err
+		}
+	}
+	err := w.file.Close()
+	w.file = nil
+	return w.chunks, err
+}
+
+// Abort closes the current chunk file, if any.
+func (w *fileChunkWriter) Abort() {
+	if w.file != nil {
+		_ = w.file.Close()
+		w.file = nil
+	}
+}
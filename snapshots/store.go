@@ -222,6 +222,21 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, nil, "", chunks)
+}
+
+// SaveSections saves a snapshot in the ParallelFormat to disk, returning it. The chunks of the
+// given sections must be passed in the order of the sections.
+func (s *Store) SaveSections(
+	height uint64, sections []*types.SnapshotSection, compressor string, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, types.ParallelFormat, sections, compressor, chunks)
+}
+
+// save saves a snapshot with the given sections to disk, returning it.
+func (s *Store) save(
+	height uint64, format uint32, sections []*types.SnapshotSection, compressor string, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -254,6 +269,10 @@ func (s *Store) Save(
 	snapshot := &types.Snapshot{
 		Height: height,
 		Format: format,
+		Metadata: types.Metadata{
+			Sections:   sections,
+			Compressor: compressor,
+		},
 	}
 	index := uint32(0)
 	snapshotHasher := sha256.New()
@@ -289,6 +308,9 @@ func (s *Store) Save(
 		index++
 	}
 	snapshot.Chunks = index
+	if err := validateSections(snapshot); err != nil {
+		return nil, err
+	}
 	snapshot.Hash = snapshotHasher.Sum(nil)
 	return snapshot, s.saveSnapshot(snapshot)
 }
@@ -313,6 +335,11 @@ func (s *Store) pathSnapshot(height uint64, format uint32) string {
 	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
 }
 
+// pathTemp generates the path of the temporary files of a snapshot being created at a height.
+func (s *Store) pathTemp(height uint64) string {
+	return filepath.Join(s.dir, "tmp", strconv.FormatUint(height, 10))
+}
+
 // pathChunk generates a snapshot chunk path.
 func (s *Store) pathChunk(height uint64, format uint32, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
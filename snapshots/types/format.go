@@ -4,3 +4,8 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 2
+
+// ParallelFormat is the format of snapshots split into independently compressed and hashed
+// sections, one per store of the multistore and per extension, which can be created and restored
+// concurrently. The sections are described in the snapshot metadata.
+const ParallelFormat uint32 = 3
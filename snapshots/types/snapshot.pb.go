@@ -103,6 +103,16 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// sections are the sections of a snapshot in the parallel format, in the
+	// order of their chunks.
+	//
+	// Since: cosmos-sdk 0.46
+	Sections []*SnapshotSection `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	// compressor is the name of the compressor of the sections of a snapshot in
+	// the parallel format.
+	//
+	// Since: cosmos-sdk 0.46
+	Compressor string `protobuf:"bytes,3,opt,name=compressor,proto3" json:"compressor,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +155,106 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetSections() []*SnapshotSection {
+	if m != nil {
+		return m.Sections
+	}
+	return nil
+}
+
+func (m *Metadata) GetCompressor() string {
+	if m != nil {
+		return m.Compressor
+	}
+	return ""
+}
+
+// SnapshotSection is an independently compressed and hashed section of a
+// snapshot in the parallel format, containing a store of the multistore or an
+// extension. Each section starts on a new chunk.
+//
+// Since: cosmos-sdk 0.46
+type SnapshotSection struct {
+	// name is the name of the store or the extension.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// extension is true if the section contains an extension.
+	Extension bool `protobuf:"varint,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	// extension_format is the format of the payload of an extension.
+	ExtensionFormat uint32 `protobuf:"varint,3,opt,name=extension_format,json=extensionFormat,proto3" json:"extension_format,omitempty"`
+	// chunks is the number of chunks of the section.
+	Chunks uint32 `protobuf:"varint,4,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// hash is the SHA-256 hash of the uncompressed section.
+	Hash []byte `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SnapshotSection) Reset()         { *m = SnapshotSection{} }
+func (m *SnapshotSection) String() string { return proto.CompactTextString(m) }
+func (*SnapshotSection) ProtoMessage()    {}
+func (*SnapshotSection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+}
+func (m *SnapshotSection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotSection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotSection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotSection.Merge(m, src)
+}
+func (m *SnapshotSection) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotSection) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotSection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotSection proto.InternalMessageInfo
+
+func (m *SnapshotSection) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotSection) GetExtension() bool {
+	if m != nil {
+		return m.Extension
+	}
+	return false
+}
+
+func (m *SnapshotSection) GetExtensionFormat() uint32 {
+	if m != nil {
+		return m.ExtensionFormat
+	}
+	return 0
+}
+
+func (m *SnapshotSection) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *SnapshotSection) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
@@ -163,7 +273,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +404,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +454,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +521,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,7 +573,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +619,7 @@ func (m *SnapshotKVItem) Reset()         { *m = SnapshotKVItem{} }
 func (m *SnapshotKVItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotKVItem) ProtoMessage()    {}
 func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
 }
 func (m *SnapshotKVItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +671,7 @@ func (m *SnapshotSchema) Reset()         { *m = SnapshotSchema{} }
 func (m *SnapshotSchema) String() string { return proto.CompactTextString(m) }
 func (*SnapshotSchema) ProtoMessage()    {}
 func (*SnapshotSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{9}
 }
 func (m *SnapshotSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,6 +710,7 @@ func (m *SnapshotSchema) GetKeys() [][]byte {
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
+	proto.RegisterType((*SnapshotSection)(nil), "cosmos.base.snapshots.v1beta1.SnapshotSection")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem")
@@ -614,44 +725,49 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0xc7, 0xa4, 0x63, 0x83, 0xda, 0x55, 0x41, 0x16, 0x12, 0x6e, 0xb0, 0x90, 0x9a,
-	0x43, 0x6b, 0xd3, 0x50, 0x09, 0xae, 0x04, 0x41, 0x5d, 0x15, 0x04, 0xda, 0xa0, 0x1c, 0xb8, 0x54,
-	0x9b, 0x64, 0x1b, 0x47, 0x8e, 0xb3, 0x51, 0x76, 0x63, 0x91, 0x23, 0x7f, 0xc0, 0xaf, 0xf0, 0x17,
-	0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x8f, 0xa0, 0x5d, 0xdb, 0x69, 0x29, 0x2d, 0xa4, 0xa7, 0xcc,
-	0x4c, 0xde, 0x7b, 0x9e, 0x9d, 0xb7, 0xb3, 0xb0, 0xd7, 0x65, 0x3c, 0x61, 0x3c, 0xe8, 0x10, 0x4e,
-	0x03, 0x3e, 0x22, 0x63, 0x1e, 0x31, 0xc1, 0x83, 0xf4, 0xa0, 0x43, 0x05, 0x39, 0x58, 0x55, 0xfc,
-	0xf1, 0x84, 0x09, 0x86, 0x9e, 0x64, 0x68, 0x5f, 0xa2, 0xfd, 0x15, 0xda, 0xcf, 0xd1, 0x8f, 0xb7,
-	0xfb, 0xac, 0xcf, 0x14, 0x32, 0x90, 0x51, 0x46, 0xf2, 0x7e, 0xe8, 0x50, 0x6d, 0xe5, 0x58, 0xf4,
-	0x08, 0xcc, 0x88, 0x0e, 0xfa, 0x91, 0x70, 0xf4, 0x9a, 0x5e, 0x37, 0x70, 0x9e, 0xc9, 0xfa, 0x19,
-	0x9b, 0x24, 0x44, 0x38, 0xa5, 0x9a, 0x5e, 0xbf, 0x8f, 0xf3, 0x4c, 0xd6, 0xbb, 0xd1, 0x74, 0x14,
-	0x73, 0xa7, 0x9c, 0xd5, 0xb3, 0x0c, 0x21, 0x30, 0x22, 0xc2, 0x23, 0xc7, 0xa8, 0xe9, 0x75, 0x1b,
-	0xab, 0x18, 0x1d, 0x43, 0x35, 0xa1, 0x82, 0xf4, 0x88, 0x20, 0x4e, 0xa5, 0xa6, 0xd7, 0xad, 0xc6,
-	0xae, 0xff, 0xcf, 0x86, 0xfd, 0x0f, 0x39, 0xbc, 0x69, 0x9c, 0xcf, 0x77, 0x34, 0xbc, 0xa2, 0x7b,
-	0xfb, 0x50, 0x2d, 0xfe, 0x43, 0x4f, 0xc1, 0x56, 0x1f, 0x3d, 0x95, 0x1f, 0xa1, 0xdc, 0xd1, 0x6b,
-	0xe5, 0xba, 0x8d, 0x2d, 0x55, 0x0b, 0x55, 0xc9, 0xfb, 0x66, 0x80, 0x5d, 0x1c, 0xf1, 0x58, 0xd0,
-	0x04, 0x85, 0x50, 0xe1, 0x82, 0x4d, 0xa8, 0x3a, 0xa5, 0xd5, 0x78, 0xfe, 0x9f, 0x3e, 0x0a, 0x6e,
-	0x4b, 0x72, 0xa4, 0x40, 0xa8, 0xe1, 0x4c, 0x00, 0x7d, 0x04, 0x63, 0x40, 0xd2, 0xa1, 0x1a, 0x8b,
-	0xd5, 0x08, 0xd6, 0x14, 0x3a, 0x7e, 0xdd, 0x7e, 0x2f, 0x75, 0x9a, 0xd5, 0xc5, 0x7c, 0xc7, 0x90,
-	0x59, 0xa8, 0x61, 0x25, 0x84, 0x3e, 0xc3, 0x06, 0xfd, 0x2a, 0xe8, 0x88, 0x0f, 0xd8, 0x48, 0x0d,
-	0xd5, 0x6a, 0x1c, 0xae, 0xa9, 0xfa, 0xb6, 0xe0, 0xc9, 0xd9, 0x84, 0x1a, 0xbe, 0x14, 0x42, 0x67,
-	0xb0, 0xb5, 0x4a, 0x4e, 0xc7, 0x64, 0x36, 0x64, 0xa4, 0xa7, 0xcc, 0xb1, 0x1a, 0x2f, 0xef, 0xaa,
-	0xfe, 0x29, 0xa3, 0x87, 0x1a, 0xde, 0xa4, 0xd7, 0x6a, 0xe8, 0x08, 0x4a, 0x71, 0x9a, 0xbb, 0xbb,
-	0xbf, 0xa6, 0xf0, 0x49, 0x5b, 0x8d, 0xc2, 0x5c, 0xcc, 0x77, 0x4a, 0x27, 0xed, 0x50, 0xc3, 0xa5,
-	0x38, 0x45, 0x47, 0x60, 0xf2, 0x6e, 0x44, 0x13, 0xe2, 0x98, 0x77, 0x12, 0x6b, 0x29, 0x52, 0xa8,
-	0xe1, 0x9c, 0xde, 0x34, 0xc1, 0x18, 0x08, 0x9a, 0x78, 0xbb, 0xb0, 0xf5, 0x97, 0x8d, 0xf2, 0x9a,
-	0x8e, 0x48, 0x92, 0x5d, 0x83, 0x0d, 0xac, 0x62, 0x6f, 0x08, 0x9b, 0xd7, 0x6d, 0x42, 0x9b, 0x50,
-	0x8e, 0xe9, 0x4c, 0xc1, 0x6c, 0x2c, 0x43, 0xb4, 0x0d, 0x95, 0x94, 0x0c, 0xa7, 0x54, 0x19, 0x6f,
-	0xe3, 0x2c, 0x41, 0x0e, 0xdc, 0x4b, 0xe9, 0x64, 0x65, 0x5d, 0x19, 0x17, 0xe9, 0x95, 0xc5, 0x92,
-	0x53, 0xaf, 0x14, 0x8b, 0xe5, 0xbd, 0x81, 0x87, 0x37, 0xda, 0x77, 0x53, 0x6b, 0xb7, 0x6d, 0xa1,
-	0x77, 0x08, 0xce, 0x6d, 0x2e, 0xc9, 0x96, 0x0a, 0xbf, 0xb3, 0xf6, 0x8b, 0xd4, 0x7b, 0x05, 0x0f,
-	0xfe, 0xb4, 0x60, 0xdd, 0x63, 0x7a, 0xcf, 0x2e, 0x99, 0xd9, 0xbc, 0x65, 0xb7, 0x31, 0x9d, 0x15,
-	0xcb, 0xa7, 0xe2, 0xe6, 0xbb, 0xf3, 0x85, 0xab, 0x5f, 0x2c, 0x5c, 0xfd, 0xd7, 0xc2, 0xd5, 0xbf,
-	0x2f, 0x5d, 0xed, 0x62, 0xe9, 0x6a, 0x3f, 0x97, 0xae, 0xf6, 0x65, 0xaf, 0x3f, 0x10, 0xd1, 0xb4,
-	0xe3, 0x77, 0x59, 0x12, 0xe4, 0x0f, 0x5c, 0xf6, 0xb3, 0xcf, 0x7b, 0xf1, 0x95, 0x67, 0x4e, 0xcc,
-	0xc6, 0x94, 0x77, 0x4c, 0xf5, 0x4e, 0xbd, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb0, 0xd0,
-	0xad, 0x0c, 0x05, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xde, 0x85, 0x05, 0xe9, 0x03, 0x5b, 0x3a, 0xa9, 0x66, 0x63, 0x74, 0x8b, 0x1b, 0x93, 0x62,
+	0xd2, 0x2e, 0x16, 0x9b, 0xe8, 0x55, 0x8c, 0xed, 0xd6, 0x6a, 0x34, 0x53, 0xd3, 0x83, 0x97, 0x66,
+	0xa0, 0x53, 0x96, 0xc0, 0x32, 0x84, 0x99, 0x6e, 0xe4, 0xe8, 0x3f, 0xf0, 0xaa, 0xff, 0xc2, 0x7f,
+	0xd1, 0x63, 0x8f, 0x9e, 0x1a, 0x43, 0xff, 0x88, 0x99, 0x99, 0xdd, 0x85, 0x22, 0x55, 0x7a, 0xe2,
+	0xbd, 0xc7, 0xfb, 0xbe, 0x79, 0xf3, 0x7d, 0xb3, 0x0f, 0x36, 0x5b, 0x8c, 0x87, 0x8c, 0xd7, 0x9a,
+	0x84, 0xd3, 0x1a, 0xef, 0x93, 0x01, 0x0f, 0x98, 0xe0, 0xb5, 0x68, 0xbb, 0x49, 0x05, 0xd9, 0x4e,
+	0x2b, 0xde, 0x60, 0xc8, 0x04, 0x43, 0x8f, 0x74, 0xb7, 0x27, 0xbb, 0xbd, 0xb4, 0xdb, 0x8b, 0xbb,
+	0x1f, 0xac, 0xb5, 0x59, 0x9b, 0xa9, 0xce, 0x9a, 0x8c, 0x34, 0xc8, 0xfd, 0x69, 0x42, 0xe1, 0x30,
+	0xee, 0x45, 0xf7, 0x21, 0x1f, 0xd0, 0x4e, 0x3b, 0x10, 0xb6, 0x59, 0x31, 0xab, 0x16, 0x8e, 0x33,
+	0x59, 0x3f, 0x65, 0xc3, 0x90, 0x08, 0x3b, 0x53, 0x31, 0xab, 0x77, 0x71, 0x9c, 0xc9, 0x7a, 0x2b,
+	0x38, 0xeb, 0x77, 0xb9, 0x9d, 0xd5, 0x75, 0x9d, 0x21, 0x04, 0x56, 0x40, 0x78, 0x60, 0x5b, 0x15,
+	0xb3, 0x5a, 0xc2, 0x2a, 0x46, 0xfb, 0x50, 0x08, 0xa9, 0x20, 0x27, 0x44, 0x10, 0x3b, 0x57, 0x31,
+	0xab, 0xc5, 0xfa, 0x86, 0xf7, 0xcf, 0x81, 0xbd, 0xf7, 0x71, 0x7b, 0xc3, 0x3a, 0xbf, 0x5c, 0x37,
+	0x70, 0x0a, 0x77, 0xbf, 0x9b, 0x50, 0x48, 0xfe, 0x44, 0x8f, 0xa1, 0xa4, 0x4e, 0x3d, 0x96, 0xa7,
+	0x50, 0x6e, 0x9b, 0x95, 0x6c, 0xb5, 0x84, 0x8b, 0xaa, 0xe6, 0xab, 0x12, 0x7a, 0x0b, 0x05, 0x4e,
+	0x5b, 0xa2, 0xc3, 0xfa, 0xdc, 0xce, 0x54, 0xb2, 0xd5, 0x62, 0xdd, 0xfb, 0xcf, 0xd1, 0x89, 0x22,
+	0x87, 0x1a, 0x86, 0x53, 0x3c, 0x72, 0x00, 0x5a, 0x2c, 0x1c, 0x0c, 0x29, 0xe7, 0x6c, 0xa8, 0xae,
+	0xbd, 0x84, 0xa7, 0x2a, 0xee, 0x0f, 0x13, 0x56, 0x66, 0xd0, 0x52, 0x8e, 0x3e, 0x09, 0xa9, 0x12,
+	0x75, 0x09, 0xab, 0x18, 0x3d, 0x84, 0x25, 0xfa, 0x45, 0xd0, 0x3e, 0xef, 0xb0, 0xbe, 0x52, 0xb5,
+	0x80, 0x27, 0x05, 0xf4, 0x14, 0xca, 0x69, 0x72, 0x1c, 0x4b, 0xaf, 0x25, 0x5e, 0x49, 0xeb, 0xbb,
+	0xb3, 0x1e, 0x58, 0x73, 0x3d, 0xc8, 0x4d, 0x3c, 0x70, 0xbf, 0x5a, 0x50, 0x4a, 0x86, 0xdb, 0x17,
+	0x34, 0x44, 0x3e, 0xe4, 0xb8, 0x60, 0x43, 0x3d, 0x5a, 0xb1, 0xfe, 0x6c, 0x51, 0x59, 0x24, 0x46,
+	0x12, 0xf8, 0x06, 0xd6, 0x04, 0xe8, 0x03, 0x58, 0x1d, 0x12, 0xf5, 0xd4, 0x55, 0x8a, 0xf5, 0xda,
+	0x82, 0x44, 0xfb, 0xaf, 0x8e, 0xde, 0x49, 0x9e, 0x46, 0x61, 0x7c, 0xb9, 0x6e, 0xc9, 0xcc, 0x37,
+	0xb0, 0x22, 0x42, 0x9f, 0xa6, 0x05, 0xca, 0x2a, 0xd6, 0x9d, 0x05, 0x59, 0xdf, 0x24, 0x38, 0xf9,
+	0x48, 0x7c, 0x63, 0x5a, 0xd8, 0x53, 0x58, 0x9d, 0x08, 0x3b, 0x20, 0xa3, 0x1e, 0x23, 0x27, 0x4a,
+	0xb8, 0x62, 0xfd, 0xc5, 0x6d, 0xd9, 0x3f, 0x6a, 0xb8, 0x6f, 0xe0, 0x32, 0x9d, 0xa9, 0xa1, 0x3d,
+	0xc8, 0x74, 0xa3, 0xf8, 0x9d, 0x6f, 0x2d, 0x48, 0x7c, 0x70, 0xa4, 0xa4, 0xc8, 0x8f, 0x2f, 0xd7,
+	0x33, 0x07, 0x47, 0xbe, 0x81, 0x33, 0xdd, 0x08, 0xed, 0x41, 0x9e, 0xb7, 0x02, 0x1a, 0x12, 0x3b,
+	0x7f, 0x2b, 0xb2, 0x43, 0x05, 0xf2, 0x0d, 0x1c, 0xc3, 0x1b, 0x79, 0xb0, 0x3a, 0x82, 0x86, 0xee,
+	0x06, 0xac, 0xfe, 0x65, 0xe3, 0xbc, 0x17, 0xea, 0xf6, 0xa0, 0x3c, 0x6b, 0x13, 0x2a, 0x43, 0xb6,
+	0x4b, 0x47, 0xaa, 0xad, 0x84, 0x65, 0x88, 0xd6, 0x20, 0x17, 0x91, 0xde, 0x19, 0x55, 0xc6, 0x97,
+	0xb0, 0x4e, 0x90, 0x0d, 0x77, 0x22, 0x3a, 0x4c, 0xad, 0xcb, 0xe2, 0x24, 0x9d, 0x5a, 0x31, 0x52,
+	0xf5, 0x5c, 0xb2, 0x62, 0xdc, 0xd7, 0x70, 0x6f, 0xae, 0x7d, 0x73, 0x3f, 0x9e, 0x1b, 0xf6, 0x91,
+	0xbb, 0x03, 0xf6, 0x4d, 0x2e, 0xc9, 0x91, 0x12, 0xbf, 0xf5, 0xf8, 0x49, 0xea, 0xbe, 0x84, 0xe5,
+	0xeb, 0x16, 0x2c, 0x7a, 0x4d, 0xf7, 0x09, 0x2c, 0x5f, 0xd7, 0x5b, 0x4e, 0xdb, 0xa5, 0xa3, 0x64,
+	0x0b, 0xa9, 0xb8, 0xb1, 0x7b, 0x3e, 0x76, 0xcc, 0x8b, 0xb1, 0x63, 0xfe, 0x1e, 0x3b, 0xe6, 0xb7,
+	0x2b, 0xc7, 0xb8, 0xb8, 0x72, 0x8c, 0x5f, 0x57, 0x8e, 0xf1, 0x79, 0xb3, 0xdd, 0x11, 0xc1, 0x59,
+	0xd3, 0x6b, 0xb1, 0xb0, 0x16, 0xaf, 0x7a, 0xfd, 0xb3, 0xc5, 0x4f, 0xba, 0x53, 0x0b, 0x5f, 0x8c,
+	0x06, 0x94, 0x37, 0xf3, 0x6a, 0x63, 0x3f, 0xff, 0x33, 0x00, 0x13, 0x65, 0xe0, 0x6a, 0x16, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -729,6 +845,27 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Compressor) > 0 {
+		i -= len(m.Compressor)
+		copy(dAtA[i:], m.Compressor)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Compressor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -741,6 +878,63 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotSection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotSection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExtensionFormat != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.ExtensionFormat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Extension {
+		i--
+		if m.Extension {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1157,6 +1351,42 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.Sections) > 0 {
+		for _, e := range m.Sections {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	l = len(m.Compressor)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotSection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Extension {
+		n += 2
+	}
+	if m.ExtensionFormat != 0 {
+		n += 1 + sovSnapshot(uint64(m.ExtensionFormat))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -1582,6 +1812,246 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sections = append(m.Sections, &SnapshotSection{})
+			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compressor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotSection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotSection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotSection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extension = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionFormat", wireType)
+			}
+			m.ExtensionFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtensionFormat |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
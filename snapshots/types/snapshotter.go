@@ -33,3 +33,23 @@ type ExtensionSnapshotter interface {
 	// SupportedFormats returns a list of formats it can restore from.
 	SupportedFormats() []uint32
 }
+
+// SectionSnapshotter is a Snapshotter whose snapshots can be split into independent sections,
+// which are created and restored concurrently in the ParallelFormat.
+type SectionSnapshotter interface {
+	Snapshotter
+
+	// SnapshotSections returns the names of the sections of a snapshot at the given height.
+	SnapshotSections(height uint64) ([]string, error)
+
+	// SnapshotSection writes the snapshot items of a section into the protobuf writer.
+	// It must be safe to call concurrently for different sections.
+	SnapshotSection(height uint64, section string, protoWriter protoio.Writer) error
+
+	// RestoreSection restores a section from the protobuf items read from the reader.
+	// It must be safe to call concurrently for different sections.
+	RestoreSection(height uint64, section string, protoReader protoio.Reader) error
+
+	// FinalizeRestore completes a restoration once all its sections have been restored.
+	FinalizeRestore(height uint64) error
+}
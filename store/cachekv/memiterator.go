@@ -3,54 +3,152 @@ package cachekv
 import (
 	"bytes"
 
-	dbm "github.com/tendermint/tm-db"
+	"github.com/google/btree"
 
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
-// Iterates over iterKVCache items.
-// if key is nil, means it was deleted.
+const (
+	// btreeDegree is the degree of the btree holding the dirty items of a store.
+	btreeDegree = 32
+	// memIteratorChunkSize is the number of items a memIterator reads from its btree at once.
+	memIteratorChunkSize = 64
+)
+
+// item is a dirty key of a store and its value, which is nil if the key was deleted.
+type item struct {
+	key   []byte
+	value []byte
+}
+
+var _ btree.Item = (*item)(nil)
+
+// Less implements btree.Item.
+func (i *item) Less(than btree.Item) bool {
+	return bytes.Compare(i.key, than.(*item).key) < 0
+}
+
+// Iterates over the items of a btree within a domain.
+// If value is nil, means it was deleted.
 // Implements Iterator.
+//
+// The btree only provides callback traversals, so items are read in chunks, each one starting
+// with a O(log n) lookup after the last item of the previous chunk. The btree must not be
+// modified while the iterator is open, so stores iterate over a (copy-on-write) clone of
+// their btree.
 type memIterator struct {
-	types.Iterator
+	items      *btree.BTree
+	start, end []byte
+	ascending  bool
+
+	chunk []*item
+	pos   int
+}
 
-	lastKey []byte
-	deleted map[string]struct{}
+var _ types.Iterator = (*memIterator)(nil)
+
+func newMemIterator(start, end []byte, items *btree.BTree, ascending bool) *memIterator {
+	mi := &memIterator{
+		items:     items,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+		chunk:     make([]*item, 0, memIteratorChunkSize),
+	}
+	mi.fill(nil)
+	return mi
 }
 
-func newMemIterator(start, end []byte, items *dbm.MemDB, deleted map[string]struct{}, ascending bool) *memIterator {
-	var iter types.Iterator
-	var err error
+// fill reads the next chunk of items following the given key, or the first chunk of the domain
+// if key is nil.
+func (mi *memIterator) fill(after []byte) {
+	mi.chunk = mi.chunk[:0]
+	mi.pos = 0
 
-	if ascending {
-		iter, err = items.Iterator(start, end)
-	} else {
-		iter, err = items.ReverseIterator(start, end)
+	// Items are visited starting from an inclusive pivot, which is skipped if it is the last
+	// item read or the (exclusive) end of the domain.
+	pivot, skip := after, after
+	if after == nil {
+		if mi.ascending {
+			pivot = mi.start
+		} else {
+			pivot, skip = mi.end, mi.end
+		}
+	}
+	visit := func(i btree.Item) bool {
+		it := i.(*item)
+		if skip != nil && bytes.Equal(it.key, skip) {
+			return true
+		}
+		if mi.ascending {
+			if mi.end != nil && bytes.Compare(it.key, mi.end) >= 0 {
+				return false
+			}
+		} else if mi.start != nil && bytes.Compare(it.key, mi.start) < 0 {
+			return false
+		}
+		mi.chunk = append(mi.chunk, it)
+		return len(mi.chunk) < memIteratorChunkSize
 	}
 
-	if err != nil {
-		panic(err)
+	switch {
+	case mi.ascending && pivot == nil:
+		mi.items.Ascend(visit)
+	case mi.ascending:
+		mi.items.AscendGreaterOrEqual(&item{key: pivot}, visit)
+	case pivot == nil:
+		mi.items.Descend(visit)
+	default:
+		mi.items.DescendLessOrEqual(&item{key: pivot}, visit)
 	}
+}
+
+// Domain implements Iterator.
+func (mi *memIterator) Domain() ([]byte, []byte) {
+	return mi.start, mi.end
+}
 
-	return &memIterator{
-		Iterator: iter,
+// Valid implements Iterator.
+func (mi *memIterator) Valid() bool {
+	return mi.pos < len(mi.chunk)
+}
 
-		lastKey: nil,
-		deleted: deleted,
+// Next implements Iterator.
+func (mi *memIterator) Next() {
+	mi.assertValid()
+	mi.pos++
+	// a partial chunk holds the last items of the domain
+	if mi.pos == len(mi.chunk) && len(mi.chunk) == memIteratorChunkSize {
+		mi.fill(mi.chunk[mi.pos-1].key)
 	}
 }
 
+// Key implements Iterator.
+func (mi *memIterator) Key() []byte {
+	mi.assertValid()
+	return mi.chunk[mi.pos].key
+}
+
+// Value implements Iterator.
 func (mi *memIterator) Value() []byte {
-	key := mi.Iterator.Key()
-	// We need to handle the case where deleted is modified and includes our current key
-	// We handle this by maintaining a lastKey object in the iterator.
-	// If the current key is the same as the last key (and last key is not nil / the start)
-	// then we are calling value on the same thing as last time.
-	// Therefore we don't check the mi.deleted to see if this key is included in there.
-	reCallingOnOldLastKey := (mi.lastKey != nil) && bytes.Equal(key, mi.lastKey)
-	if _, ok := mi.deleted[string(key)]; ok && !reCallingOnOldLastKey {
-		return nil
+	mi.assertValid()
+	return mi.chunk[mi.pos].value
+}
+
+// Error implements Iterator.
+func (mi *memIterator) Error() error {
+	return nil
+}
+
+// Close implements Iterator.
+func (mi *memIterator) Close() error {
+	mi.items = nil
+	mi.chunk = nil
+	return nil
+}
+
+func (mi *memIterator) assertValid() {
+	if !mi.Valid() {
+		panic("iterator is invalid")
 	}
-	mi.lastKey = key
-	return mi.Iterator.Value()
 }
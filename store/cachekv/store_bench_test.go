@@ -1,12 +1,14 @@
 package cachekv_test
 
 import (
+	"fmt"
 	"testing"
 
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
 var sink interface{}
@@ -132,6 +134,82 @@ func benchmarkIteratorOnParentWithManyDeletes(b *testing.B, numDeletes int) {
 	}
 }
 
+// Benchmark writing random keys to a store and then iterating over all of them, like
+// genesis imports do.
+func benchmarkWriteThenIterate(b *testing.B, numKeys int) {
+	value := randSlice(32)
+	keys := generateRandomKeys(32, numKeys)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		kvstore := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+		for _, k := range keys {
+			kvstore.Set(k, value)
+		}
+		iter := kvstore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			sink = iter.Key()
+		}
+		iter.Close()
+	}
+}
+
+// Benchmark interleaving writes with iterators over another part of a store, like EndBlocker
+// queues do when they are iterated while other keys are being written.
+func benchmarkInterleavedWriteIterate(b *testing.B, numKeys int) {
+	value := randSlice(32)
+	keys := generateRandomKeys(32, numKeys)
+	for _, k := range keys {
+		k[0] = 1
+	}
+	queue := [][]byte{{0, 1}, {0, 2}, {0, 3}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		kvstore := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+		for _, k := range queue {
+			kvstore.Set(k, value)
+		}
+		for _, k := range keys {
+			kvstore.Set(k, value)
+			iter := kvstore.Iterator([]byte{0}, []byte{1})
+			for ; iter.Valid(); iter.Next() {
+				sink = iter.Key()
+			}
+			iter.Close()
+		}
+	}
+}
+
+// Benchmark writing keys and iterating over them in nested branches of a store.
+func benchmarkNestedWriteThenIterate(b *testing.B, numKeys int) {
+	value := randSlice(32)
+	keys := generateRandomKeys(32, numKeys)
+	const depth = 4
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var kvstore types.CacheKVStore = cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+		for j, k := range keys {
+			if j%(numKeys/depth) == 0 {
+				kvstore = kvstore.CacheWrap().(types.CacheKVStore)
+			}
+			kvstore.Set(k, value)
+		}
+		iter := kvstore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			sink = iter.Key()
+		}
+		iter.Close()
+	}
+}
+
 func BenchmarkBlankParentIteratorNextKeySize32(b *testing.B) {
 	benchmarkBlankParentIteratorNext(b, 32)
 }
@@ -147,3 +225,27 @@ func BenchmarkSetKeySize32(b *testing.B) {
 func BenchmarkIteratorOnParentWith1MDeletes(b *testing.B) {
 	benchmarkIteratorOnParentWithManyDeletes(b, 1_000_000)
 }
+
+func BenchmarkWriteThenIterate(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("keys=%d", n), func(b *testing.B) {
+			benchmarkWriteThenIterate(b, n)
+		})
+	}
+}
+
+func BenchmarkInterleavedWriteIterate(b *testing.B) {
+	for _, n := range []int{1_000, 10_000} {
+		b.Run(fmt.Sprintf("keys=%d", n), func(b *testing.B) {
+			benchmarkInterleavedWriteIterate(b, n)
+		})
+	}
+}
+
+func BenchmarkNestedWriteThenIterate(b *testing.B) {
+	for _, n := range []int{1_000, 10_000} {
+		b.Run(fmt.Sprintf("keys=%d", n), func(b *testing.B) {
+			benchmarkNestedWriteThenIterate(b, n)
+		})
+	}
+}
@@ -291,6 +291,49 @@ func TestCacheKVMergeIteratorChunks(t *testing.T) {
 	assertIterateDomainCheck(t, st, truth, []keyRange{{0, 15}, {25, 35}, {38, 40}, {45, 80}})
 }
 
+func TestCacheKVIteratorWritesDuringIteration(t *testing.T) {
+	st := newCacheKVStore()
+	// enough keys for the cache iterators to read several chunks
+	n := 500
+	for i := 0; i < n; i++ {
+		st.Set(keyFmt(i), valFmt(i))
+	}
+
+	// writes during the iteration are not visible to open iterators, but are to new ones
+	itr := st.Iterator(keyFmt(10), keyFmt(410))
+	i := 10
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, keyFmt(i), itr.Key())
+		require.Equal(t, valFmt(i), itr.Value())
+		st.Delete(keyFmt(i + 1))
+		st.Set(keyFmt(i), valFmt(i+1))
+		i++
+	}
+	require.Equal(t, 410, i)
+	require.NoError(t, itr.Close())
+
+	itr = st.ReverseIterator(keyFmt(10), keyFmt(410))
+	i = 409
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, keyFmt(i), itr.Key())
+		require.Equal(t, valFmt(i+1), itr.Value())
+		i--
+	}
+	require.Equal(t, 9, i)
+	require.NoError(t, itr.Close())
+
+	// writing the store does not disrupt open iterators
+	itr = st.Iterator(nil, nil)
+	st.Write()
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+	require.Equal(t, n-1, count)
+	require.NoError(t, itr.Close())
+	require.False(t, st.Has(keyFmt(410)))
+}
+
 func TestCacheKVMergeIteratorRandom(t *testing.T) {
 	st := newCacheKVStore()
 	truth := dbm.NewMemDB()
@@ -0,0 +1,82 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PrefixSize is the number of keys, and their size in bytes, under a key prefix of a store.
+type PrefixSize struct {
+	Store      string
+	Prefix     []byte
+	Keys       uint64
+	KeyBytes   uint64
+	ValueBytes uint64
+}
+
+// StateSizes iterates the IAVL stores at a committed version and returns the number of keys and their
+// sizes, grouped by store and by the key prefix returned by prefixFn, sorted by store name and prefix.
+// Only the given stores are iterated, or all of them if none is given.
+func (rs *Store) StateSizes(version int64, prefixFn func(store string, key []byte) []byte, stores ...string) ([]PrefixSize, error) {
+	if _, err := getCommitInfo(rs.db, version); err != nil {
+		return nil, err
+	}
+	only := make(map[string]bool, len(stores))
+	for _, name := range stores {
+		if _, ok := rs.keysByName[name]; !ok {
+			return nil, fmt.Errorf("unknown store %s", name)
+		}
+		only[name] = true
+	}
+
+	var sizes []PrefixSize
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		if len(only) > 0 && !only[key.Name()] {
+			continue
+		}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		iavlStore, err := rs.GetCommitKVStore(key).(*iavl.Store).GetImmutable(version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load store %s at version %d: %w", key.Name(), version, err)
+		}
+
+		prefixes := make(map[string]*PrefixSize)
+		iter := iavlStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			prefix := prefixFn(key.Name(), iter.Key())
+			size, ok := prefixes[string(prefix)]
+			if !ok {
+				size = &PrefixSize{Store: key.Name(), Prefix: append([]byte{}, prefix...)}
+				prefixes[string(prefix)] = size
+			}
+			size.Keys++
+			size.KeyBytes += uint64(len(iter.Key()))
+			size.ValueBytes += uint64(len(iter.Value()))
+		}
+		err = iter.Error()
+		iter.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate store %s: %w", key.Name(), err)
+		}
+
+		for _, size := range prefixes {
+			sizes = append(sizes, *size)
+		}
+	}
+
+	sort.Slice(sizes, func(i, j int) bool {
+		if sizes[i].Store != sizes[j].Store {
+			return sizes[i].Store < sizes[j].Store
+		}
+		return bytes.Compare(sizes[i].Prefix, sizes[j].Prefix) < 0
+	})
+	return sizes, nil
+}
@@ -223,6 +223,40 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreParallel(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	sourceStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	sourceManager := snapshots.NewManager(sourceStore, source, nil)
+	require.NoError(t, sourceManager.SetFormat(snapshottypes.ParallelFormat, snapshots.CompressorZstd))
+	snapshot, err := sourceManager.Create(version)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Metadata.Sections, len(source.StoreKeysByName())-1) // transient stores are skipped
+
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetManager := snapshots.NewManager(targetStore, target, nil)
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		_, err = targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() != types.StoreTypeTransient {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -32,6 +32,10 @@ const (
 	latestVersionKey = "s/latest"
 	pruneHeightsKey  = "s/pruneheights"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	// pruneBatchSize is the maximum number of versions deleted from an IAVL store at once by
+	// PruneVersions, bounding the memory used to prune long histories.
+	pruneBatchSize = 1000
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -60,8 +64,9 @@ type Store struct {
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore           = (*Store)(nil)
+	_ types.Queryable                  = (*Store)(nil)
+	_ snapshottypes.SectionSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -108,6 +113,34 @@ func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
+// GetCommitInfo returns the commit info of a committed version, containing the commit ID of each
+// store at that version.
+func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, ver)
+}
+
+// MountCommittedStores mounts an IAVL store for each store of the latest commit, so that an existing
+// database can be loaded without knowing the store keys of the application, e.g. by offline tools.
+// Stores without a committed version, i.e. memory stores, are skipped.
+func (rs *Store) MountCommittedStores() error {
+	version := getLatestVersion(rs.db)
+	if version == 0 {
+		return nil
+	}
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return err
+	}
+
+	for _, storeInfo := range cInfo.StoreInfos {
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+		rs.MountStoreWithDB(types.NewKVStoreKey(storeInfo.Name), types.StoreTypeIAVL, nil)
+	}
+	return nil
+}
+
 // MountStoreWithDB implements CommitMultiStore.
 func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db dbm.DB) {
 	if key == nil {
@@ -466,6 +499,78 @@ func (rs *Store) pruneStores() {
 	rs.pruneHeights = make([]int64, 0)
 }
 
+// PruneVersions deletes, from every mounted IAVL sub-store, the historical versions that the given
+// pruning options would not have retained at the latest version, and returns the deleted versions
+// in ascending order. It is meant to be used offline, to apply a pruning strategy to versions which
+// were committed under a different one.
+func (rs *Store) PruneVersions(pruningOpts types.PruningOptions) ([]int64, error) {
+	if err := pruningOpts.Validate(); err != nil {
+		return nil, err
+	}
+	if pruningOpts.KeepRecent == 0 {
+		return nil, nil
+	}
+
+	// versions below the latest version minus KeepRecent are pruned, as done on Commit
+	latest := getLatestVersion(rs.db)
+	pruneHeight := latest - 1 - int64(pruningOpts.KeepRecent)
+	if pruneHeight <= 0 {
+		return nil, nil
+	}
+
+	pruned := make(map[int64]bool)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+
+		var versions []int64
+		for _, version := range iavlStore.GetAllVersions() {
+			if int64(version) <= pruneHeight {
+				versions = append(versions, int64(version))
+			}
+		}
+		for len(versions) > 0 {
+			batch := versions
+			if len(batch) > pruneBatchSize {
+				batch = batch[:pruneBatchSize]
+			}
+			if err := iavlStore.DeleteVersions(batch...); err != nil {
+				return nil, fmt.Errorf("failed to prune store %s: %w", key.Name(), err)
+			}
+			for _, version := range batch {
+				pruned[version] = true
+			}
+			versions = versions[len(batch):]
+		}
+	}
+
+	// heights pending pruning have now been deleted
+	remaining := make([]int64, 0, len(rs.pruneHeights))
+	for _, height := range rs.pruneHeights {
+		if height > pruneHeight {
+			remaining = append(remaining, height)
+		}
+	}
+	rs.pruneHeights = remaining
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	setPruningHeights(batch, rs.pruneHeights)
+	if err := batch.WriteSync(); err != nil {
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(pruned))
+	for version := range pruned {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
 // CacheWrap implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -669,80 +774,98 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	for _, store := range stores {
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := exportIAVLStore(store.Store, height, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotStore is an IAVL store to snapshot
+type snapshotStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot at the given height, sorted by name.
+func (rs *Store) snapshotStores(height uint64) ([]snapshotStore, error) {
 	if height == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(rs.LastCommitID().Version) {
-		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
+	stores := []snapshotStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
+			stores = append(stores, snapshotStore{name: key.Name(), Store: store})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	return stores, nil
+}
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
-		exporter, err := store.Export(int64(height))
-		if err != nil {
+// exportIAVLStore writes the nodes of an IAVL store at the given height as SnapshotIAVLItems.
+func exportIAVLStore(store *iavl.Store, height uint64, protoWriter protoio.Writer) error {
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
 			return err
 		}
-		defer exporter.Close()
 		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Store{
-				Store: &snapshottypes.SnapshotStoreItem{
-					Name: store.name,
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
 			},
 		})
 		if err != nil {
 			return err
 		}
-
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ExportDone {
-				break
-			} else if err != nil {
-				return err
-			}
-			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_IAVL{
-					IAVL: &snapshottypes.SnapshotIAVLItem{
-						Key:     node.Key,
-						Value:   node.Value,
-						Height:  int32(node.Height),
-						Version: node.Version,
-					},
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
-		exporter.Close()
 	}
-
 	return nil
 }
 
@@ -789,27 +912,8 @@ loop:
 			if importer == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := importIAVLItem(importer, item.IAVL); err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
 
 		default:
@@ -825,8 +929,100 @@ loop:
 		importer.Close()
 	}
 
+	return snapshotItem, rs.FinalizeRestore(height)
+}
+
+// importIAVLItem imports an exported IAVL node.
+func importIAVLItem(importer *iavltree.Importer, item *snapshottypes.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	err := importer.Add(node)
+	if err != nil {
+		return sdkerrors.Wrap(err, "IAVL node import failed")
+	}
+	return nil
+}
+
+// SnapshotSections implements snapshottypes.SectionSnapshotter. Each IAVL store is a section.
+func (rs *Store) SnapshotSections(height uint64) ([]string, error) {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(stores))
+	for i, store := range stores {
+		names[i] = store.name
+	}
+	return names, nil
+}
+
+// SnapshotSection implements snapshottypes.SectionSnapshotter. The section of a store contains
+// the SnapshotIAVLItems of its nodes.
+func (rs *Store) SnapshotSection(height uint64, section string, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+	for _, store := range stores {
+		if store.name == section {
+			return exportIAVLStore(store.Store, height, protoWriter)
+		}
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot unknown store %q", section)
+}
+
+// RestoreSection implements snapshottypes.SectionSnapshotter.
+func (rs *Store) RestoreSection(height uint64, section string, protoReader protoio.Reader) error {
+	store, ok := rs.GetStoreByName(section).(*iavl.Store)
+	if !ok || store == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", section)
+	}
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return sdkerrors.Wrap(err, "import failed")
+	}
+	defer importer.Close()
+
+	for {
+		snapshotItem := snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		item, ok := snapshotItem.Item.(*snapshottypes.SnapshotItem_IAVL)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T in store %q", snapshotItem.Item, section)
+		}
+		if err := importIAVLItem(importer, item.IAVL); err != nil {
+			return err
+		}
+	}
+
+	return sdkerrors.Wrap(importer.Commit(), "IAVL commit failed")
+}
+
+// FinalizeRestore implements snapshottypes.SectionSnapshotter.
+func (rs *Store) FinalizeRestore(height uint64) error {
 	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return snapshotItem, rs.LoadLatestVersion()
+	return rs.LoadLatestVersion()
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
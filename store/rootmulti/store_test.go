@@ -509,6 +509,109 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruneVersions(t *testing.T) {
+	testCases := []struct {
+		name   string
+		po     types.PruningOptions
+		pruned []int64
+	}{
+		{"prune nothing", types.PruneNothing, nil},
+		{"prune everything", types.PruneEverything, []int64{1, 2, 3, 4, 5, 6, 7}},
+		{"keep recent", types.NewPruningOptions(5, 10), []int64{1, 2, 3, 4}},
+		{"keep more than committed", types.NewPruningOptions(20, 10), nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			ms := newMultiStoreWithMounts(db, types.PruneNothing)
+			require.NoError(t, ms.LoadLatestVersion())
+			for i := 0; i < 10; i++ {
+				ms.Commit()
+			}
+
+			ms = newMultiStoreWithMounts(db, types.PruneNothing)
+			require.NoError(t, ms.LoadLatestVersion())
+			pruned, err := ms.PruneVersions(tc.po)
+			require.NoError(t, err)
+			require.Equal(t, tc.pruned, pruned)
+
+			store1 := ms.GetStoreByName("store1").(*iavl.Store)
+			for v := int64(1); v <= 10; v++ {
+				pruned := len(tc.pruned) > 0 && v <= tc.pruned[len(tc.pruned)-1]
+				require.Equal(t, !pruned, store1.VersionExists(v), "version %d", v)
+			}
+
+			// the store keeps committing after pruning
+			require.Equal(t, int64(11), ms.Commit().Version)
+		})
+	}
+
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	_, err := ms.PruneVersions(types.NewPruningOptions(2, 0))
+	require.Error(t, err)
+}
+
+func TestMultiStore_MountCommittedStores(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.MountStoreWithDB(types.NewMemoryStoreKey("mem"), types.StoreTypeMemory, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+	commitID := ms.Commit()
+
+	// an empty database has no stores to mount
+	empty := NewStore(dbm.NewMemDB())
+	require.NoError(t, empty.MountCommittedStores())
+	require.Empty(t, empty.storesParams)
+
+	loaded := NewStore(db)
+	require.NoError(t, loaded.MountCommittedStores())
+	require.NoError(t, loaded.LoadLatestVersion())
+	require.Equal(t, commitID, loaded.LastCommitID())
+	require.Len(t, loaded.StoreKeysByName(), 3)
+	require.Equal(t, []byte("value"), loaded.GetStoreByName("store1").(types.KVStore).Get([]byte("key")))
+}
+
+func TestMultiStore_StateSizes(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	store1 := ms.GetStoreByName("store1").(types.KVStore)
+	store1.Set([]byte("a1"), []byte("value"))
+	store1.Set([]byte("a2"), []byte("v"))
+	store1.Set([]byte("b"), []byte("value"))
+	ms.GetStoreByName("store2").(types.KVStore).Set([]byte("c"), []byte("value"))
+	ms.Commit()
+	store1.Delete([]byte("b"))
+	ms.Commit()
+
+	firstByte := func(_ string, key []byte) []byte { return key[:1] }
+	sizes, err := ms.StateSizes(1, firstByte)
+	require.NoError(t, err)
+	require.Equal(t, []PrefixSize{
+		{Store: "store1", Prefix: []byte("a"), Keys: 2, KeyBytes: 4, ValueBytes: 6},
+		{Store: "store1", Prefix: []byte("b"), Keys: 1, KeyBytes: 1, ValueBytes: 5},
+		{Store: "store2", Prefix: []byte("c"), Keys: 1, KeyBytes: 1, ValueBytes: 5},
+	}, sizes)
+
+	sizes, err = ms.StateSizes(2, firstByte, "store1")
+	require.NoError(t, err)
+	require.Equal(t, []PrefixSize{
+		{Store: "store1", Prefix: []byte("a"), Keys: 2, KeyBytes: 4, ValueBytes: 6},
+	}, sizes)
+
+	_, err = ms.StateSizes(2, firstByte, "unknown")
+	require.Error(t, err)
+
+	_, err = ms.StateSizes(3, firstByte)
+	require.Error(t, err)
+}
+
 func TestMultiStore_PruningRestart(t *testing.T) {
 	db := dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 11))
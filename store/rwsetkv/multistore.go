@@ -0,0 +1,109 @@
+package rwsetkv
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.CacheMultiStore = MultiStore{}
+
+// MultiStore wraps a CacheMultiStore so that the accesses to its KVStores, and
+// to the ones of its branches, are recorded in a Recorder. Accesses served by
+// the cache of a branch are recorded as well, so the recorded keys are the
+// exact keys used by the caller whatever the nesting of its branches.
+type MultiStore struct {
+	parent   types.CacheMultiStore
+	recorder *Recorder
+}
+
+// NewMultiStore returns a MultiStore recording the accesses to the parent
+// CacheMultiStore in the given Recorder.
+func NewMultiStore(parent types.CacheMultiStore, recorder *Recorder) MultiStore {
+	return MultiStore{parent: parent, recorder: recorder}
+}
+
+// Recorder returns the Recorder of the MultiStore.
+func (ms MultiStore) Recorder() *Recorder {
+	return ms.recorder
+}
+
+// GetStoreType implements the MultiStore interface.
+func (ms MultiStore) GetStoreType() types.StoreType {
+	return ms.parent.GetStoreType()
+}
+
+// Write implements the CacheMultiStore interface.
+func (ms MultiStore) Write() {
+	ms.parent.Write()
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (ms MultiStore) CacheWrap() types.CacheWrap {
+	return ms.CacheMultiStore().(types.CacheWrap)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (ms MultiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (ms MultiStore) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements the MultiStore interface. The accesses to the
+// branch are recorded in the same Recorder.
+func (ms MultiStore) CacheMultiStore() types.CacheMultiStore {
+	return NewMultiStore(ms.parent.CacheMultiStore(), ms.recorder)
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface.
+func (ms MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	cms, err := ms.parent.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	return NewMultiStore(cms, ms.recorder), nil
+}
+
+// GetStore implements the MultiStore interface. Only the accesses to KVStores
+// are recorded.
+func (ms MultiStore) GetStore(key types.StoreKey) types.Store {
+	store := ms.parent.GetStore(key)
+	if kvStore, ok := store.(types.KVStore); ok {
+		return NewStore(kvStore, key.Name(), ms.recorder)
+	}
+	return store
+}
+
+// GetKVStore implements the MultiStore interface.
+func (ms MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	return NewStore(ms.parent.GetKVStore(key), key.Name(), ms.recorder)
+}
+
+// TracingEnabled implements the MultiStore interface.
+func (ms MultiStore) TracingEnabled() bool {
+	return ms.parent.TracingEnabled()
+}
+
+// SetTracer implements the MultiStore interface.
+func (ms MultiStore) SetTracer(w io.Writer) types.MultiStore {
+	return NewMultiStore(ms.parent.SetTracer(w).(types.CacheMultiStore), ms.recorder)
+}
+
+// SetTracingContext implements the MultiStore interface.
+func (ms MultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	return NewMultiStore(ms.parent.SetTracingContext(tc).(types.CacheMultiStore), ms.recorder)
+}
+
+// ListeningEnabled implements the MultiStore interface.
+func (ms MultiStore) ListeningEnabled(key types.StoreKey) bool {
+	return ms.parent.ListeningEnabled(key)
+}
+
+// AddListeners implements the MultiStore interface.
+func (ms MultiStore) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	ms.parent.AddListeners(key, listeners)
+}
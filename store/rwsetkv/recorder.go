@@ -0,0 +1,116 @@
+package rwsetkv
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Recorder accumulates the keys and key ranges of the KVStores read and written through the
+// stores wrapping it. It is safe for concurrent use.
+type Recorder struct {
+	mtx    sync.Mutex
+	stores map[string]*storeAccesses
+}
+
+// storeAccesses are the accesses to a single KVStore, keyed by the string conversion of the
+// keys and ranges to deduplicate them.
+type storeAccesses struct {
+	reads  map[string]struct{}
+	ranges map[[2]string]*types.KeyRange
+	writes map[string]struct{}
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{stores: make(map[string]*storeAccesses)}
+}
+
+func (r *Recorder) store(storeKey string) *storeAccesses {
+	s, ok := r.stores[storeKey]
+	if !ok {
+		s = &storeAccesses{
+			reads:  make(map[string]struct{}),
+			ranges: make(map[[2]string]*types.KeyRange),
+			writes: make(map[string]struct{}),
+		}
+		r.stores[storeKey] = s
+	}
+	return s
+}
+
+func (r *Recorder) recordRead(storeKey string, key []byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.store(storeKey).reads[string(key)] = struct{}{}
+}
+
+func (r *Recorder) recordRange(storeKey string, start, end []byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	// nil and empty bounds are both unbounded
+	rng := &types.KeyRange{Start: copyKey(start), End: copyKey(end)}
+	r.store(storeKey).ranges[[2]string{string(start), string(end)}] = rng
+}
+
+func (r *Recorder) recordWrite(storeKey string, key []byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.store(storeKey).writes[string(key)] = struct{}{}
+}
+
+// ReadWriteSet returns the accesses recorded so far, with stores, keys and ranges in a
+// deterministic order.
+func (r *Recorder) ReadWriteSet() *types.ReadWriteSet {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	rwSet := &types.ReadWriteSet{Stores: make([]*types.StoreReadWriteSet, 0, len(r.stores))}
+	for storeKey, s := range r.stores {
+		storeRWSet := &types.StoreReadWriteSet{
+			StoreKey: storeKey,
+			Reads:    sortedKeys(s.reads),
+			Writes:   sortedKeys(s.writes),
+		}
+		for _, rng := range s.ranges {
+			storeRWSet.ReadRanges = append(storeRWSet.ReadRanges, rng)
+		}
+		sort.Slice(storeRWSet.ReadRanges, func(i, j int) bool {
+			a, b := storeRWSet.ReadRanges[i], storeRWSet.ReadRanges[j]
+			if c := bytes.Compare(a.Start, b.Start); c != 0 {
+				return c < 0
+			}
+			return bytes.Compare(a.End, b.End) < 0
+		})
+		rwSet.Stores = append(rwSet.Stores, storeRWSet)
+	}
+	sort.Slice(rwSet.Stores, func(i, j int) bool {
+		return rwSet.Stores[i].StoreKey < rwSet.Stores[j].StoreKey
+	})
+	return rwSet
+}
+
+func sortedKeys(set map[string]struct{}) [][]byte {
+	if len(set) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	res := make([][]byte, len(keys))
+	for i, key := range keys {
+		res[i] = []byte(key)
+	}
+	return res
+}
+
+func copyKey(key []byte) []byte {
+	if len(key) == 0 {
+		return nil
+	}
+	return append([]byte{}, key...)
+}
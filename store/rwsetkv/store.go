@@ -0,0 +1,114 @@
+package rwsetkv
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface, recording the keys read and
+// written and the domains of the iterators in a Recorder before delegating
+// each call to the parent KVStore.
+type Store struct {
+	parent   types.KVStore
+	storeKey string
+	recorder *Recorder
+}
+
+// NewStore returns a reference to a new Store recording the accesses to the
+// parent KVStore under the given store key name.
+func NewStore(parent types.KVStore, storeKey string, recorder *Recorder) *Store {
+	return &Store{parent: parent, storeKey: storeKey, recorder: recorder}
+}
+
+// Get implements the KVStore interface. It records a read and delegates the
+// Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.recorder.recordRead(s.storeKey, key)
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface. It records a read and delegates the
+// Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.recorder.recordRead(s.storeKey, key)
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface. It records a write and delegates the
+// Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	s.recorder.recordWrite(s.storeKey, key)
+	s.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It records a write and delegates
+// the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.recorder.recordWrite(s.storeKey, key)
+	s.parent.Delete(key)
+}
+
+// Iterator implements the KVStore interface. It records a read of the
+// iterator domain and delegates the Iterator call to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.recorder.recordRange(s.storeKey, start, end)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It records a read of the
+// iterator domain and delegates the ReverseIterator call to the parent
+// KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.recorder.recordRange(s.storeKey, start, end)
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. The accesses to the branch are
+// recorded in the same Recorder, including the ones served by the cache of the
+// branch.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return newCacheStore(s.parent, s.storeKey, s.recorder)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return newCacheStore(tracekv.NewStore(s.parent, w, tc), s.storeKey, s.recorder)
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return newCacheStore(listenkv.NewStore(s.parent, storeKey, listeners), s.storeKey, s.recorder)
+}
+
+// cacheStore is a branch of a Store recording the accesses to it before they
+// reach its cache, whose writes are flushed to the parent of the Store.
+type cacheStore struct {
+	*Store
+	cache types.CacheKVStore
+}
+
+var _ types.CacheKVStore = cacheStore{}
+
+func newCacheStore(parent types.KVStore, storeKey string, recorder *Recorder) cacheStore {
+	cache := cachekv.NewStore(parent)
+	return cacheStore{Store: NewStore(cache, storeKey, recorder), cache: cache}
+}
+
+// Write implements the CacheKVStore interface. The writes were recorded when
+// they were made to the branch.
+func (s cacheStore) Write() {
+	s.cache.Write()
+}
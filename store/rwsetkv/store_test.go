@@ -0,0 +1,102 @@
+package rwsetkv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/rwsetkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func newRWSetKVStore(recorder *rwsetkv.Recorder) *rwsetkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	memDB.Set(bz("a"), bz("1"))
+	memDB.Set(bz("b"), bz("2"))
+	return rwsetkv.NewStore(memDB, "store", recorder)
+}
+
+func TestRWSetKVStore(t *testing.T) {
+	recorder := rwsetkv.NewRecorder()
+	store := newRWSetKVStore(recorder)
+
+	require.Equal(t, bz("1"), store.Get(bz("a")))
+	require.False(t, store.Has(bz("c")))
+	require.Equal(t, bz("1"), store.Get(bz("a")))
+	store.Set(bz("d"), bz("4"))
+	store.Delete(bz("b"))
+	store.Set(bz("d"), bz("5"))
+
+	itr := store.Iterator(bz("b"), nil)
+	require.True(t, itr.Valid())
+	require.Equal(t, bz("d"), itr.Key())
+	require.NoError(t, itr.Close())
+	store.ReverseIterator(nil, bz("c")).Close()
+	store.Iterator(bz("b"), nil).Close()
+
+	require.Equal(t, &types.ReadWriteSet{Stores: []*types.StoreReadWriteSet{{
+		StoreKey: "store",
+		Reads:    [][]byte{bz("a"), bz("c")},
+		ReadRanges: []*types.KeyRange{
+			{Start: nil, End: bz("c")},
+			{Start: bz("b"), End: nil},
+		},
+		Writes: [][]byte{bz("b"), bz("d")},
+	}}}, recorder.ReadWriteSet())
+}
+
+func TestRWSetKVStoreCacheWrap(t *testing.T) {
+	recorder := rwsetkv.NewRecorder()
+	store := newRWSetKVStore(recorder)
+
+	cache := store.CacheWrap().(types.KVStore)
+	cache.Set(bz("c"), bz("3"))
+	// served by the cache, and recorded as well
+	require.Equal(t, bz("3"), cache.Get(bz("c")))
+	rwSet := recorder.ReadWriteSet()
+	require.Equal(t, [][]byte{bz("c")}, rwSet.Stores[0].Reads)
+	require.Equal(t, [][]byte{bz("c")}, rwSet.Stores[0].Writes)
+
+	// the writes flushed to the store are not recorded again
+	cache.(types.CacheKVStore).Write()
+	require.Equal(t, rwSet, recorder.ReadWriteSet())
+	require.Equal(t, bz("3"), store.Get(bz("c")))
+}
+
+func TestRWSetMultiStore(t *testing.T) {
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+	rms := rootmulti.NewStore(dbm.NewMemDB())
+	rms.MountStoreWithDB(key1, types.StoreTypeIAVL, nil)
+	rms.MountStoreWithDB(key2, types.StoreTypeIAVL, nil)
+	require.NoError(t, rms.LoadLatestVersion())
+
+	recorder := rwsetkv.NewRecorder()
+	parent := rms.CacheMultiStore()
+	ms := rwsetkv.NewMultiStore(parent, recorder)
+	ms.GetKVStore(key1).Set(bz("a"), bz("1"))
+
+	// accesses to nested branches are recorded, even when served by their cache
+	branch := ms.CacheMultiStore()
+	require.Equal(t, bz("1"), branch.GetKVStore(key1).Get(bz("a")))
+	nested := branch.CacheWrap().(types.CacheMultiStore)
+	nested.GetStore(key2).(types.KVStore).Set(bz("b"), bz("2"))
+	require.Equal(t, bz("2"), nested.GetKVStore(key2).Get(bz("b")))
+	nested.Write()
+	branch.Write()
+	ms.Write()
+	require.Equal(t, bz("2"), parent.GetKVStore(key2).Get(bz("b")))
+
+	require.Equal(t, &types.ReadWriteSet{Stores: []*types.StoreReadWriteSet{
+		{StoreKey: "store1", Reads: [][]byte{bz("a")}, Writes: [][]byte{bz("a")}},
+		{StoreKey: "store2", Reads: [][]byte{bz("b")}, Writes: [][]byte{bz("b")}},
+	}}, recorder.ReadWriteSet())
+
+	// the accesses of the parent are not recorded
+	parent.GetKVStore(key1).Get(bz("c"))
+	require.Len(t, recorder.ReadWriteSet().Stores[0].Reads, 1)
+}
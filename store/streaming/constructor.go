@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +25,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
 	// add more in the future
 )
 
@@ -31,6 +34,8 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "grpc", "g":
+		return GRPC
 	default:
 		return Unknown
 	}
@@ -41,6 +46,8 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case GRPC:
+		return "grpc"
 	default:
 		return "unknown"
 	}
@@ -49,6 +56,7 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -70,6 +78,53 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a gRPC StreamingService
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	config := grpc.DefaultConfig()
+	if address := cast.ToString(opts.Get("streamers.grpc.address")); address != "" {
+		config.Address = address
+	}
+	config.BufferDir = cast.ToString(opts.Get("streamers.grpc.buffer_dir"))
+	if bufferBlocks := cast.ToInt64(opts.Get("streamers.grpc.buffer_blocks")); bufferBlocks != 0 {
+		config.BufferBlocks = bufferBlocks
+	}
+	if subscriberBuffer := cast.ToInt(opts.Get("streamers.grpc.subscriber_buffer")); subscriberBuffer != 0 {
+		config.SubscriberBuffer = subscriberBuffer
+	}
+	config.RequiredSubscribers = cast.ToStringSlice(opts.Get("streamers.grpc.required_subscribers"))
+	config.HaltOnLag = cast.ToBool(opts.Get("streamers.grpc.halt_on_lag"))
+	return grpc.NewStreamingService(config, keys, marshaller)
+}
+
+// NewPluginServiceConstructor returns the streaming.ServiceConstructor function for creating a StreamingService
+// which forwards the ABCI messages and state changes to the out-of-process plugin configured under the provided
+// streamer name
+func NewPluginServiceConstructor(name string) ServiceConstructor {
+	return func(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+		prefix := fmt.Sprintf("streamers.%s.", name)
+		config := plugin.DefaultConfig(name, cast.ToString(opts.Get(prefix+"plugin")))
+		config.Args = cast.ToStringSlice(opts.Get(prefix + "args"))
+		config.Env = cast.ToStringSlice(opts.Get(prefix + "env"))
+		if socketDir := cast.ToString(opts.Get(prefix + "socket_dir")); socketDir != "" {
+			config.SocketDir = socketDir
+		}
+		if callTimeout := cast.ToDuration(opts.Get(prefix + "call_timeout")); callTimeout != 0 {
+			config.CallTimeout = callTimeout
+		}
+		if queueSize := cast.ToInt(opts.Get(prefix + "queue_size")); queueSize != 0 {
+			config.QueueSize = queueSize
+		}
+		if maxRestarts := opts.Get(prefix + "max_restarts"); maxRestarts != nil {
+			config.MaxRestarts = cast.ToInt(maxRestarts)
+		}
+		if restartDelay := cast.ToDuration(opts.Get(prefix + "restart_delay")); restartDelay != 0 {
+			config.RestartDelay = restartDelay
+		}
+		config.HaltOnError = cast.ToBool(opts.Get(prefix + "halt_on_error"))
+		return plugin.NewStreamingService(config, keys)
+	}
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
@@ -98,8 +153,17 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 		if len(exposeStoreKeys) == 0 { // short circuit if we are not exposing anything
 			continue
 		}
-		// get the constructor for this streamer name
-		constructor, err := NewServiceConstructor(streamerName)
+		// get the constructor for this streamer name, streamers configured with a plugin executable are out-of-process
+		// plugins
+		var (
+			constructor ServiceConstructor
+			err         error
+		)
+		if cast.ToString(appOpts.Get(fmt.Sprintf("streamers.%s.plugin", streamerName))) != "" {
+			constructor = NewPluginServiceConstructor(streamerName)
+		} else {
+			constructor, err = NewServiceConstructor(streamerName)
+		}
 		if err != nil {
 			// close any services we may have already spun up before hitting the error on this one
 			for _, activeStreamer := range activeStreamers {
@@ -119,7 +183,14 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
 		// kick off the background streaming service loop
-		streamingService.Stream(wg)
+		if err := streamingService.Stream(wg); err != nil {
+			// close any services we may have already spun up, including this one, before returning the error
+			streamingService.Close()
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+			return nil, nil, err
+		}
 		// add to the list of active streamers
 		activeStreamers = append(activeStreamers, streamingService)
 	}
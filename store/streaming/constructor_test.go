@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +18,10 @@ type fakeOptions struct{}
 
 func (f *fakeOptions) Get(string) interface{} { return nil }
 
+type mapOptions map[string]interface{}
+
+func (m mapOptions) Get(key string) interface{} { return m[key] }
+
 var (
 	mockOptions       = new(fakeOptions)
 	mockKeys          = []types.StoreKey{sdk.NewKVStoreKey("mockKey1"), sdk.NewKVStoreKey("mockKey2")}
@@ -41,3 +47,41 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	serv, err := constructor(mockOptions, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+}
+
+func TestPluginStreamingServiceConstructor(t *testing.T) {
+	constructor := NewPluginServiceConstructor("sink")
+
+	// a plugin requires an executable
+	_, err := constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+
+	opts := mapOptions{
+		"streamers.sink.plugin":       "/usr/local/bin/sink",
+		"streamers.sink.args":         []interface{}{"--verbose"},
+		"streamers.sink.socket_dir":   t.TempDir(),
+		"streamers.sink.call_timeout": "3s",
+		"streamers.sink.max_restarts": -1,
+	}
+	serv, err := constructor(opts, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &plugin.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+}
@@ -0,0 +1,1751 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/streaming/v1beta1/grpc.proto
+
+package grpc
+
+import (
+	context "context"
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/store/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the Streaming/Subscribe RPC method.
+type SubscribeRequest struct {
+	// subscriber_id identifies the subscriber. It is used to track the
+	// subscribers which are required to keep up with the node.
+	SubscriberId string `protobuf:"bytes,1,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"`
+	// start_height is the height to resume streaming from. Blocks from this
+	// height are replayed from the on-disk buffer. If zero, streaming starts at
+	// the next block.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// store_keys filters the state changes sent to the subscriber by the name of
+	// their store key. If empty, the state changes of all exposed stores are sent.
+	StoreKeys []string `protobuf:"bytes,3,rep,name=store_keys,json=storeKeys,proto3" json:"store_keys,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetSubscriberId() string {
+	if m != nil {
+		return m.SubscriberId
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetStoreKeys() []string {
+	if m != nil {
+		return m.StoreKeys
+	}
+	return nil
+}
+
+// BlockMessages contains the ABCI messages and the resulting state changes of
+// a block.
+type BlockMessages struct {
+	Height     int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BeginBlock BeginBlockMessages  `protobuf:"bytes,2,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block"`
+	DeliverTxs []DeliverTxMessages `protobuf:"bytes,3,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs"`
+	EndBlock   EndBlockMessages    `protobuf:"bytes,4,opt,name=end_block,json=endBlock,proto3" json:"end_block"`
+}
+
+func (m *BlockMessages) Reset()         { *m = BlockMessages{} }
+func (m *BlockMessages) String() string { return proto.CompactTextString(m) }
+func (*BlockMessages) ProtoMessage()    {}
+func (*BlockMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{1}
+}
+func (m *BlockMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMessages.Merge(m, src)
+}
+func (m *BlockMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMessages proto.InternalMessageInfo
+
+func (m *BlockMessages) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockMessages) GetBeginBlock() BeginBlockMessages {
+	if m != nil {
+		return m.BeginBlock
+	}
+	return BeginBlockMessages{}
+}
+
+func (m *BlockMessages) GetDeliverTxs() []DeliverTxMessages {
+	if m != nil {
+		return m.DeliverTxs
+	}
+	return nil
+}
+
+func (m *BlockMessages) GetEndBlock() EndBlockMessages {
+	if m != nil {
+		return m.EndBlock
+	}
+	return EndBlockMessages{}
+}
+
+// BeginBlockMessages contains the BeginBlock request and response and the
+// state changes made during BeginBlock.
+type BeginBlockMessages struct {
+	Request      types.RequestBeginBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseBeginBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair    `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *BeginBlockMessages) Reset()         { *m = BeginBlockMessages{} }
+func (m *BeginBlockMessages) String() string { return proto.CompactTextString(m) }
+func (*BeginBlockMessages) ProtoMessage()    {}
+func (*BeginBlockMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{2}
+}
+func (m *BeginBlockMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginBlockMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginBlockMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeginBlockMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginBlockMessages.Merge(m, src)
+}
+func (m *BeginBlockMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginBlockMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginBlockMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginBlockMessages proto.InternalMessageInfo
+
+func (m *BeginBlockMessages) GetRequest() types.RequestBeginBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestBeginBlock{}
+}
+
+func (m *BeginBlockMessages) GetResponse() types.ResponseBeginBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseBeginBlock{}
+}
+
+func (m *BeginBlockMessages) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// DeliverTxMessages contains a DeliverTx request and response and the state
+// changes made by the transaction.
+type DeliverTxMessages struct {
+	Request      types.RequestDeliverTx  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseDeliverTx `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair   `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *DeliverTxMessages) Reset()         { *m = DeliverTxMessages{} }
+func (m *DeliverTxMessages) String() string { return proto.CompactTextString(m) }
+func (*DeliverTxMessages) ProtoMessage()    {}
+func (*DeliverTxMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{3}
+}
+func (m *DeliverTxMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliverTxMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliverTxMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliverTxMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverTxMessages.Merge(m, src)
+}
+func (m *DeliverTxMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliverTxMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverTxMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverTxMessages proto.InternalMessageInfo
+
+func (m *DeliverTxMessages) GetRequest() types.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestDeliverTx{}
+}
+
+func (m *DeliverTxMessages) GetResponse() types.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseDeliverTx{}
+}
+
+func (m *DeliverTxMessages) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// EndBlockMessages contains the EndBlock request and response and the state
+// changes made during EndBlock.
+type EndBlockMessages struct {
+	Request      types.RequestEndBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseEndBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair  `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *EndBlockMessages) Reset()         { *m = EndBlockMessages{} }
+func (m *EndBlockMessages) String() string { return proto.CompactTextString(m) }
+func (*EndBlockMessages) ProtoMessage()    {}
+func (*EndBlockMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_094c5f8f388ea6fc, []int{4}
+}
+func (m *EndBlockMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndBlockMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndBlockMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndBlockMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlockMessages.Merge(m, src)
+}
+func (m *EndBlockMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndBlockMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndBlockMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndBlockMessages proto.InternalMessageInfo
+
+func (m *EndBlockMessages) GetRequest() types.RequestEndBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestEndBlock{}
+}
+
+func (m *EndBlockMessages) GetResponse() types.ResponseEndBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseEndBlock{}
+}
+
+func (m *EndBlockMessages) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "cosmos.base.streaming.v1beta1.SubscribeRequest")
+	proto.RegisterType((*BlockMessages)(nil), "cosmos.base.streaming.v1beta1.BlockMessages")
+	proto.RegisterType((*BeginBlockMessages)(nil), "cosmos.base.streaming.v1beta1.BeginBlockMessages")
+	proto.RegisterType((*DeliverTxMessages)(nil), "cosmos.base.streaming.v1beta1.DeliverTxMessages")
+	proto.RegisterType((*EndBlockMessages)(nil), "cosmos.base.streaming.v1beta1.EndBlockMessages")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/streaming/v1beta1/grpc.proto", fileDescriptor_094c5f8f388ea6fc)
+}
+
+var fileDescriptor_094c5f8f388ea6fc = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xdd, 0x6a, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x6d, 0xa9, 0xdd, 0xd9, 0x04, 0xea, 0x20, 0x12, 0x22, 0x5d, 0x93, 0x2d, 0x48,
+	0x04, 0xdd, 0x6d, 0xe2, 0x0b, 0x68, 0xda, 0x82, 0x12, 0x04, 0xd9, 0x88, 0x8a, 0x37, 0xcb, 0x7e,
+	0x1c, 0x36, 0x43, 0x92, 0x9d, 0x38, 0x33, 0x29, 0xcd, 0x5b, 0xf8, 0x24, 0x3e, 0x47, 0x2f, 0x7b,
+	0xe9, 0x95, 0x48, 0x02, 0x82, 0xe0, 0x43, 0xc8, 0xce, 0x7e, 0xc4, 0x6e, 0x1a, 0x73, 0xd5, 0xab,
+	0x6c, 0xce, 0x9e, 0xff, 0x7f, 0xce, 0xef, 0xcc, 0xd9, 0x83, 0xdb, 0x01, 0x13, 0x13, 0x26, 0x6c,
+	0xdf, 0x13, 0x60, 0x0b, 0xc9, 0xc1, 0x9b, 0xd0, 0x38, 0xb2, 0x2f, 0x3a, 0x3e, 0x48, 0xaf, 0x63,
+	0x47, 0x7c, 0x1a, 0x58, 0x53, 0xce, 0x24, 0x23, 0x47, 0x69, 0xa6, 0x95, 0x64, 0x5a, 0x45, 0xa6,
+	0x95, 0x65, 0x36, 0x1e, 0x49, 0x88, 0x43, 0xe0, 0x13, 0x1a, 0x4b, 0xdb, 0xf3, 0x03, 0x6a, 0xcb,
+	0xf9, 0x14, 0x44, 0xaa, 0x6d, 0x3c, 0xbd, 0x79, 0x0a, 0xe3, 0x50, 0x9c, 0x30, 0xa6, 0x42, 0x42,
+	0x9c, 0x38, 0xa5, 0xa9, 0x0f, 0x22, 0x16, 0x31, 0xf5, 0x68, 0x27, 0x4f, 0x69, 0xd4, 0x9c, 0xe3,
+	0xc3, 0xc1, 0xcc, 0x17, 0x01, 0xa7, 0x3e, 0x38, 0xf0, 0x65, 0x06, 0x42, 0x92, 0x63, 0x5c, 0x13,
+	0x79, 0x8c, 0xbb, 0x34, 0xac, 0xa3, 0x26, 0x6a, 0x6b, 0x4e, 0x75, 0x15, 0x7c, 0x13, 0x92, 0x16,
+	0xae, 0x0a, 0xe9, 0x71, 0xe9, 0x0e, 0x81, 0x46, 0x43, 0x59, 0xdf, 0x69, 0xa2, 0xf6, 0xae, 0xa3,
+	0xab, 0xd8, 0x6b, 0x15, 0x22, 0x47, 0x18, 0xab, 0x92, 0xdc, 0x11, 0xcc, 0x45, 0x7d, 0xb7, 0xb9,
+	0xdb, 0xd6, 0x1c, 0x4d, 0x45, 0xfa, 0x30, 0x17, 0xe6, 0xb7, 0x1d, 0x5c, 0xeb, 0x8d, 0x59, 0x30,
+	0x7a, 0x0b, 0x42, 0x78, 0x11, 0x08, 0xf2, 0x10, 0xef, 0x67, 0x6e, 0x48, 0xb9, 0x65, 0xff, 0xc8,
+	0x27, 0xac, 0xfb, 0x10, 0xd1, 0xd8, 0xf5, 0x93, 0x74, 0x75, 0x94, 0xde, 0xed, 0x58, 0xff, 0xed,
+	0x9b, 0xd5, 0x4b, 0x14, 0x37, 0xfc, 0x7b, 0x7b, 0x57, 0x3f, 0x1e, 0x57, 0x1c, 0xec, 0x17, 0x6f,
+	0xc8, 0x47, 0xac, 0x87, 0x30, 0xa6, 0x17, 0xc0, 0x5d, 0x79, 0x99, 0xd6, 0xa8, 0x77, 0x4f, 0xb6,
+	0x38, 0x9f, 0xa5, 0x8a, 0xf7, 0x97, 0x65, 0xe3, 0x30, 0x7f, 0x21, 0x88, 0x83, 0x35, 0x88, 0xc3,
+	0xac, 0xe0, 0x3d, 0x55, 0xb0, 0xbd, 0xc5, 0xf6, 0x3c, 0x0e, 0x6f, 0x2b, 0xf7, 0x00, 0xb2, 0xb8,
+	0xf9, 0x07, 0x61, 0xb2, 0x4e, 0x45, 0x7a, 0xf8, 0x1e, 0x4f, 0x6f, 0x4e, 0xb5, 0x4d, 0xef, 0x9a,
+	0xd6, 0x6a, 0x64, 0xac, 0x64, 0x64, 0xac, 0xec, 0x66, 0x57, 0xe2, 0xcc, 0x3b, 0x17, 0x92, 0x73,
+	0x7c, 0xc0, 0x41, 0x4c, 0x59, 0x2c, 0x20, 0x6b, 0xef, 0xf1, 0x2d, 0x26, 0x69, 0xc2, 0x9a, 0x4b,
+	0x21, 0x25, 0x7d, 0x5c, 0x13, 0xd2, 0x93, 0xe0, 0x06, 0x43, 0x2f, 0x8e, 0x20, 0x6f, 0xe8, 0x93,
+	0x12, 0x39, 0xe3, 0x50, 0x50, 0x0f, 0xd4, 0x3c, 0x7c, 0x78, 0xe7, 0x51, 0xee, 0x54, 0x95, 0xf8,
+	0x34, 0xd5, 0x9a, 0xbf, 0x11, 0xbe, 0xbf, 0xd6, 0x6a, 0xf2, 0xaa, 0x4c, 0xdb, 0xda, 0x44, 0x5b,
+	0x68, 0xcb, 0xb0, 0x67, 0x6b, 0xb0, 0xe6, 0x46, 0xd8, 0xb2, 0xc9, 0x1d, 0xb1, 0xfe, 0x42, 0xf8,
+	0xb0, 0x7c, 0xff, 0xe4, 0x65, 0x19, 0xb5, 0xb9, 0x09, 0x35, 0x97, 0x96, 0x49, 0x4f, 0xd7, 0x48,
+	0x5b, 0x1b, 0x49, 0x4b, 0x1e, 0x77, 0x03, 0xda, 0x9d, 0x63, 0x6d, 0x90, 0x4f, 0x3e, 0x19, 0x63,
+	0xad, 0x58, 0x3e, 0x64, 0xdb, 0xe7, 0x51, 0x5e, 0x53, 0x8d, 0x67, 0xdb, 0x16, 0xc0, 0xbf, 0xcd,
+	0x3c, 0x41, 0xbd, 0xfe, 0xd5, 0xc2, 0x40, 0xd7, 0x0b, 0x03, 0xfd, 0x5c, 0x18, 0xe8, 0xeb, 0xd2,
+	0xa8, 0x5c, 0x2f, 0x8d, 0xca, 0xf7, 0xa5, 0x51, 0xf9, 0xdc, 0x89, 0xa8, 0x1c, 0xce, 0x7c, 0x2b,
+	0x60, 0x13, 0x3b, 0x5b, 0xa8, 0xe9, 0xcf, 0x73, 0x11, 0x8e, 0xb2, 0xb5, 0xba, 0x5a, 0xe1, 0xc9,
+	0xea, 0xf6, 0xf7, 0xd5, 0xfa, 0x7c, 0xf1, 0x77, 0x00, 0x80, 0x4d, 0xfd, 0x92, 0xe7, 0x05, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamingClient is the client API for Streaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamingClient interface {
+	// Subscribe streams the ABCI messages and state changes of every committed
+	// block, optionally resuming from a past height kept in the on-disk buffer.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Streaming_SubscribeClient, error)
+}
+
+type streamingClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamingClient(cc grpc1.ClientConn) StreamingClient {
+	return &streamingClient{cc}
+}
+
+func (c *streamingClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Streaming_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Streaming_serviceDesc.Streams[0], "/cosmos.base.streaming.v1beta1.Streaming/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Streaming_SubscribeClient interface {
+	Recv() (*BlockMessages, error)
+	grpc.ClientStream
+}
+
+type streamingSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingSubscribeClient) Recv() (*BlockMessages, error) {
+	m := new(BlockMessages)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingServer is the server API for Streaming service.
+type StreamingServer interface {
+	// Subscribe streams the ABCI messages and state changes of every committed
+	// block, optionally resuming from a past height kept in the on-disk buffer.
+	Subscribe(*SubscribeRequest, Streaming_SubscribeServer) error
+}
+
+// UnimplementedStreamingServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamingServer struct {
+}
+
+func (*UnimplementedStreamingServer) Subscribe(req *SubscribeRequest, srv Streaming_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStreamingServer(s grpc1.Server, srv StreamingServer) {
+	s.RegisterService(&_Streaming_serviceDesc, srv)
+}
+
+func _Streaming_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingServer).Subscribe(m, &streamingSubscribeServer{stream})
+}
+
+type Streaming_SubscribeServer interface {
+	Send(*BlockMessages) error
+	grpc.ServerStream
+}
+
+type streamingSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingSubscribeServer) Send(m *BlockMessages) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Streaming_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.streaming.v1beta1.Streaming",
+	HandlerType: (*StreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Streaming_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/streaming/v1beta1/grpc.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreKeys) > 0 {
+		for iNdEx := len(m.StoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StoreKeys[iNdEx])
+			copy(dAtA[i:], m.StoreKeys[iNdEx])
+			i = encodeVarintGrpc(dAtA, i, uint64(len(m.StoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SubscriberId) > 0 {
+		i -= len(m.SubscriberId)
+		copy(dAtA[i:], m.SubscriberId)
+		i = encodeVarintGrpc(dAtA, i, uint64(len(m.SubscriberId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DeliverTxs) > 0 {
+		for iNdEx := len(m.DeliverTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeliverTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.BeginBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeginBlockMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginBlockMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginBlockMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliverTxMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliverTxMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliverTxMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndBlockMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndBlockMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndBlockMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGrpc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGrpc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubscriberId)
+	if l > 0 {
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGrpc(uint64(m.StartHeight))
+	}
+	if len(m.StoreKeys) > 0 {
+		for _, s := range m.StoreKeys {
+			l = len(s)
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGrpc(uint64(m.Height))
+	}
+	l = m.BeginBlock.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	if len(m.DeliverTxs) > 0 {
+		for _, e := range m.DeliverTxs {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	l = m.EndBlock.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	return n
+}
+
+func (m *BeginBlockMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeliverTxMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndBlockMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGrpc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGrpc(x uint64) (n int) {
+	return sovGrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriberId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriberId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKeys = append(m.StoreKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliverTxs = append(m.DeliverTxs, DeliverTxMessages{})
+			if err := m.DeliverTxs[len(m.DeliverTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginBlockMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginBlockMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginBlockMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliverTxMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliverTxMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliverTxMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlockMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlockMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlockMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGrpc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGrpc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGrpc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGrpc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGrpc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGrpc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGrpc = fmt.Errorf("proto: unexpected end of group")
+)
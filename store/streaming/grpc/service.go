@@ -0,0 +1,524 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ baseapp.CommitListener   = &StreamingService{}
+	_ StreamingServer          = &StreamingService{}
+)
+
+const (
+	// DefaultAddress is the default address the gRPC streaming server listens on
+	DefaultAddress = "localhost:9092"
+	// DefaultBufferBlocks is the default number of blocks kept in the on-disk buffer
+	DefaultBufferBlocks = 1000
+	// DefaultSubscriberBuffer is the default number of blocks queued per subscriber
+	DefaultSubscriberBuffer = 100
+
+	bufferFilePrefix = "block-"
+)
+
+// Config defines the configuration of the gRPC streaming service
+type Config struct {
+	// Address is the address the gRPC server listens on
+	Address string
+	// BufferDir is the directory of the on-disk buffer used to resume streaming
+	// from a past height. If empty, subscribers can only stream new blocks.
+	BufferDir string
+	// BufferBlocks is the number of most recent blocks kept in the on-disk buffer
+	BufferBlocks int64
+	// SubscriberBuffer is the number of blocks queued for a subscriber before it
+	// is considered to have fallen behind
+	SubscriberBuffer int
+	// RequiredSubscribers are the ids of the subscribers which must keep up with
+	// the node
+	RequiredSubscribers []string
+	// HaltOnLag gracefully halts the node after the commit of the block a
+	// required subscriber fell behind at
+	HaltOnLag bool
+}
+
+// DefaultConfig returns the default configuration of the gRPC streaming service
+func DefaultConfig() Config {
+	return Config{
+		Address:          DefaultAddress,
+		BufferBlocks:     DefaultBufferBlocks,
+		SubscriberBuffer: DefaultSubscriberBuffer,
+	}
+}
+
+// StreamingService is a concrete implementation of StreamingService that pushes the ABCI messages and the
+// state changes of every block to the subscribers of a gRPC server
+type StreamingService struct {
+	listeners      map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	config         Config                                   // configuration of the service
+	required       map[string]bool                          // ids of the required subscribers
+	codec          codec.BinaryCodec                        // marshaller used for writing blocks to the on-disk buffer
+	stateCache     []*types.StoreKVPair                     // cache the StoreKVPairs in the order they are received
+	stateCacheLock *sync.Mutex                              // mutex for the state cache
+	currentBlock   *BlockMessages                           // the block being streamed
+	endedBlock     *BlockMessages                           // the block waiting for its commit to be published
+
+	mtx          sync.Mutex           // mutex for the subscribers, the latest height and the heights sent to required subscribers
+	subscribers  map[*subscriber]bool // the connected subscribers
+	latestHeight int64                // the height of the latest published block
+	lastSent     map[string]int64     // the height of the last block sent to each required subscriber
+	server       *grpc.Server         // the gRPC server, set while streaming
+}
+
+// subscriber is a client connected to the Subscribe method
+type subscriber struct {
+	id        string
+	storeKeys map[string]bool
+	queue     chan *BlockMessages
+	lagged    chan struct{} // closed when the subscriber falls behind
+	replaying bool          // whether the subscriber is sent buffered blocks instead of its queue
+}
+
+// stateCacheListener is a WriteListener which caches the StoreKVPairs in the StreamingService
+type stateCacheListener struct {
+	service *StreamingService
+}
+
+// OnWrite satisfies the WriteListener interface by caching the StoreKVPair
+func (l stateCacheListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.service.stateCacheLock.Lock()
+	l.service.stateCache = append(l.service.stateCache, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	l.service.stateCacheLock.Unlock()
+	return nil
+}
+
+// NewStreamingService creates a new StreamingService for the provided configuration and storeKeys
+func NewStreamingService(config Config, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
+	if config.SubscriberBuffer <= 0 {
+		return nil, fmt.Errorf("subscriber buffer must be positive: %d", config.SubscriberBuffer)
+	}
+	if config.BufferDir != "" {
+		if config.BufferBlocks <= 0 {
+			return nil, fmt.Errorf("buffer blocks must be positive: %d", config.BufferBlocks)
+		}
+		if err := os.MkdirAll(config.BufferDir, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	required := make(map[string]bool, len(config.RequiredSubscribers))
+	for _, id := range config.RequiredSubscribers {
+		required[id] = true
+	}
+
+	ss := &StreamingService{
+		config:         config,
+		required:       required,
+		codec:          c,
+		stateCacheLock: new(sync.Mutex),
+		subscribers:    make(map[*subscriber]bool),
+		lastSent:       make(map[string]int64),
+	}
+
+	// the blocks buffered before a restart can still be resumed from
+	if config.BufferDir != "" {
+		latestHeight, err := ss.latestBufferedHeight()
+		if err != nil {
+			return nil, err
+		}
+		ss.latestHeight = latestHeight
+	}
+
+	listener := stateCacheListener{service: ss}
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		listeners[key] = append(listeners[key], listener)
+	}
+	ss.listeners = listeners
+
+	return ss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return gss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It starts a new block with the received BeginBlock request and response and the resulting state changes
+func (gss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.currentBlock = &BlockMessages{
+		Height: req.Header.Height,
+		BeginBlock: BeginBlockMessages{
+			Request:      req,
+			Response:     res,
+			StateChanges: gss.flushStateCache(),
+		},
+	}
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It adds the received DeliverTx request and response and the resulting state changes to the current block
+func (gss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if gss.currentBlock == nil {
+		return errors.New("received DeliverTx messages before BeginBlock messages")
+	}
+	gss.currentBlock.DeliverTxs = append(gss.currentBlock.DeliverTxs, DeliverTxMessages{
+		Request:      req,
+		Response:     res,
+		StateChanges: gss.flushStateCache(),
+	})
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It completes the current block with the received EndBlock request and response and the resulting state changes
+func (gss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if gss.currentBlock == nil {
+		return errors.New("received EndBlock messages before BeginBlock messages")
+	}
+	block := gss.currentBlock
+	gss.currentBlock = nil
+	block.EndBlock = EndBlockMessages{
+		Request:      req,
+		Response:     res,
+		StateChanges: gss.flushStateCache(),
+	}
+	gss.endedBlock = block
+	return nil
+}
+
+// ListenCommit satisfies the baseapp.CommitListener interface
+// It writes the completed block to the on-disk buffer and publishes it to the subscribers once it is committed
+func (gss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	if gss.endedBlock == nil {
+		return errors.New("received Commit messages before EndBlock messages")
+	}
+	block := gss.endedBlock
+	gss.endedBlock = nil
+
+	if err := gss.writeBuffer(block); err != nil {
+		return err
+	}
+	return gss.publish(block)
+}
+
+// flushStateCache returns the cached state changes and resets the cache
+func (gss *StreamingService) flushStateCache() []*types.StoreKVPair {
+	gss.stateCacheLock.Lock()
+	defer gss.stateCacheLock.Unlock()
+	stateChanges := gss.stateCache
+	gss.stateCache = nil
+	return stateChanges
+}
+
+// publish sends the block to every subscriber. A subscriber whose queue is full has fallen behind and is
+// disconnected. If it is required and the service is configured to halt the node in that case, an error wrapping
+// baseapp.ErrHaltNode is returned so that the node is halted once the block is committed.
+func (gss *StreamingService) publish(block *BlockMessages) error {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	gss.latestHeight = block.Height
+	connected := make(map[string]bool, len(gss.subscribers))
+	var lagging []string
+	for sub := range gss.subscribers {
+		// a replaying subscriber reads the block from the on-disk buffer once it is done with the previous ones
+		if sub.replaying {
+			connected[sub.id] = true
+			continue
+		}
+		select {
+		case sub.queue <- block:
+			connected[sub.id] = true
+		default:
+			if gss.required[sub.id] {
+				lagging = append(lagging, sub.id)
+			}
+			close(sub.lagged)
+			delete(gss.subscribers, sub)
+		}
+	}
+
+	if !gss.config.HaltOnLag {
+		return nil
+	}
+
+	// a required subscriber which is disconnected falls behind once the blocks it has not received yet are
+	// pruned from the on-disk buffer
+	for id, height := range gss.lastSent {
+		if !connected[id] && block.Height-height > gss.bufferBlocks() {
+			lagging = append(lagging, id)
+		}
+	}
+	if len(lagging) > 0 {
+		sort.Strings(lagging)
+		return fmt.Errorf("required streaming subscribers %s fell behind at height %d: %w",
+			strings.Join(lagging, ", "), block.Height, baseapp.ErrHaltNode)
+	}
+
+	return nil
+}
+
+// bufferBlocks returns the number of blocks a disconnected subscriber can resume from
+func (gss *StreamingService) bufferBlocks() int64 {
+	if gss.config.BufferDir == "" {
+		return 0
+	}
+	return gss.config.BufferBlocks
+}
+
+// Subscribe satisfies the StreamingServer interface
+// It replays the requested blocks from the on-disk buffer, then streams every new block to the subscriber
+func (gss *StreamingService) Subscribe(req *SubscribeRequest, stream Streaming_SubscribeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StartHeight < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid start height %d", req.StartHeight)
+	}
+
+	sub := &subscriber{
+		id:     req.SubscriberId,
+		queue:  make(chan *BlockMessages, gss.config.SubscriberBuffer),
+		lagged: make(chan struct{}),
+	}
+	if len(req.StoreKeys) > 0 {
+		sub.storeKeys = make(map[string]bool, len(req.StoreKeys))
+		for _, key := range req.StoreKeys {
+			sub.storeKeys[key] = true
+		}
+	}
+
+	// register the subscriber before replaying the buffer, so that no block is missed in between. While it is
+	// replaying, the subscriber is sent the blocks published in the meantime from the buffer as well, so that
+	// the replay is not limited by the size of its queue.
+	gss.mtx.Lock()
+	latestHeight := gss.latestHeight
+	sub.replaying = req.StartHeight > 0 && req.StartHeight <= latestHeight
+	gss.subscribers[sub] = true
+	gss.mtx.Unlock()
+	defer gss.unsubscribe(sub)
+
+	if sub.replaying {
+		if err := gss.replay(sub, stream, req.StartHeight, latestHeight); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case block := <-sub.queue:
+			if block.Height < req.StartHeight {
+				continue
+			}
+			if err := gss.send(sub, stream, block); err != nil {
+				return err
+			}
+		case <-sub.lagged:
+			return status.Error(codes.ResourceExhausted, "subscriber fell behind, resume from the last received height")
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// replay sends the buffered blocks from the start height to the subscriber, until it has caught up with the
+// latest published block
+func (gss *StreamingService) replay(sub *subscriber, stream Streaming_SubscribeServer, height, latestHeight int64) error {
+	for {
+		if height <= latestHeight-gss.bufferBlocks() {
+			return status.Errorf(codes.OutOfRange, "height %d is not available in the buffer", height)
+		}
+		for ; height <= latestHeight; height++ {
+			block, err := gss.readBuffer(height)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to read height %d from the buffer: %s", height, err)
+			}
+			if err := gss.send(sub, stream, block); err != nil {
+				return err
+			}
+		}
+
+		gss.mtx.Lock()
+		latestHeight = gss.latestHeight
+		if latestHeight < height {
+			// the next blocks are published to the queue of the subscriber
+			sub.replaying = false
+			gss.mtx.Unlock()
+			return nil
+		}
+		gss.mtx.Unlock()
+	}
+}
+
+// send sends the block filtered by the store keys of the subscriber and records the height sent to required
+// subscribers
+func (gss *StreamingService) send(sub *subscriber, stream Streaming_SubscribeServer, block *BlockMessages) error {
+	if err := stream.Send(sub.filter(block)); err != nil {
+		return err
+	}
+	if gss.required[sub.id] {
+		gss.mtx.Lock()
+		gss.lastSent[sub.id] = block.Height
+		gss.mtx.Unlock()
+	}
+	return nil
+}
+
+// unsubscribe removes the subscriber from the subscribers of the service
+func (gss *StreamingService) unsubscribe(sub *subscriber) {
+	gss.mtx.Lock()
+	delete(gss.subscribers, sub)
+	gss.mtx.Unlock()
+}
+
+// filter returns the block with only the state changes of the store keys of the subscriber
+func (sub *subscriber) filter(block *BlockMessages) *BlockMessages {
+	if sub.storeKeys == nil {
+		return block
+	}
+
+	filtered := *block
+	filtered.BeginBlock.StateChanges = sub.filterStateChanges(block.BeginBlock.StateChanges)
+	filtered.DeliverTxs = make([]DeliverTxMessages, len(block.DeliverTxs))
+	for i, tx := range block.DeliverTxs {
+		filtered.DeliverTxs[i] = tx
+		filtered.DeliverTxs[i].StateChanges = sub.filterStateChanges(tx.StateChanges)
+	}
+	filtered.EndBlock.StateChanges = sub.filterStateChanges(block.EndBlock.StateChanges)
+
+	return &filtered
+}
+
+func (sub *subscriber) filterStateChanges(stateChanges []*types.StoreKVPair) []*types.StoreKVPair {
+	var filtered []*types.StoreKVPair
+	for _, kvPair := range stateChanges {
+		if sub.storeKeys[kvPair.StoreKey] {
+			filtered = append(filtered, kvPair)
+		}
+	}
+	return filtered
+}
+
+// writeBuffer writes the block to the on-disk buffer and prunes the blocks which fell out of it
+func (gss *StreamingService) writeBuffer(block *BlockMessages) error {
+	if gss.config.BufferDir == "" {
+		return nil
+	}
+
+	bz, err := gss.codec.Marshal(block)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(gss.bufferFile(block.Height), bz, 0600); err != nil {
+		return err
+	}
+
+	pruneHeight := block.Height - gss.config.BufferBlocks
+	if pruneHeight > 0 {
+		if err := os.Remove(gss.bufferFile(pruneHeight)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// readBuffer reads the block at the given height from the on-disk buffer
+func (gss *StreamingService) readBuffer(height int64) (*BlockMessages, error) {
+	bz, err := ioutil.ReadFile(gss.bufferFile(height))
+	if err != nil {
+		return nil, err
+	}
+	block := new(BlockMessages)
+	if err := gss.codec.Unmarshal(bz, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (gss *StreamingService) bufferFile(height int64) string {
+	return filepath.Join(gss.config.BufferDir, fmt.Sprintf("%s%d", bufferFilePrefix, height))
+}
+
+// latestBufferedHeight returns the height of the latest block in the on-disk buffer, or 0 if it is empty
+func (gss *StreamingService) latestBufferedHeight() (int64, error) {
+	entries, err := os.ReadDir(gss.config.BufferDir)
+	if err != nil {
+		return 0, err
+	}
+	var latestHeight int64
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), bufferFilePrefix) {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimPrefix(entry.Name(), bufferFilePrefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		if height > latestHeight {
+			latestHeight = height
+		}
+	}
+	return latestHeight, nil
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It starts the gRPC server which serves the subscribers in a goroutine
+// returns an error if it is called twice
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if gss.server != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	listener, err := net.Listen("tcp", gss.config.Address)
+	if err != nil {
+		return err
+	}
+	gss.server = grpc.NewServer()
+	RegisterStreamingServer(gss.server, gss)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Serve returns once the server is stopped on Close
+		_ = gss.server.Serve(listener)
+	}()
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (gss *StreamingService) Close() error {
+	if gss.server == nil {
+		return nil
+	}
+	gss.server.Stop()
+	gss.server = nil
+	return nil
+}
@@ -0,0 +1,270 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+	emptyContext      = sdk.Context{}
+
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+)
+
+func newTestService(t *testing.T, config Config) *StreamingService {
+	ss, err := NewStreamingService(config, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.NoError(t, err)
+	return ss
+}
+
+// streamBlock simulates the execution of a block with a single transaction writing to both stores
+func streamBlock(t *testing.T, ss *StreamingService, height int64) {
+	endBlock(t, ss, height)
+	require.NoError(t, ss.ListenCommit(emptyContext, abci.ResponseCommit{}))
+}
+
+// endBlock streams the ABCI messages and state changes of a block up to its EndBlock
+func endBlock(t *testing.T, ss *StreamingService, height int64) {
+	listener1 := ss.Listeners()[mockStoreKey1][0]
+	listener2 := ss.Listeners()[mockStoreKey2][0]
+
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, []byte("begin"), []byte{1}, false))
+	require.NoError(t, ss.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, []byte("tx1"), []byte{2}, false))
+	require.NoError(t, listener2.OnWrite(mockStoreKey2, []byte("tx2"), nil, true))
+	require.NoError(t, ss.ListenDeliverTx(emptyContext, abci.RequestDeliverTx{Tx: []byte{byte(height)}}, abci.ResponseDeliverTx{}))
+
+	require.NoError(t, listener2.OnWrite(mockStoreKey2, []byte("end"), []byte{3}, false))
+	require.NoError(t, ss.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+}
+
+// blockingStream is a Streaming_SubscribeServer handing the sent blocks over to an unbuffered channel
+type blockingStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *BlockMessages
+}
+
+func (s *blockingStream) Send(block *BlockMessages) error {
+	select {
+	case s.sent <- block:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *blockingStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestNewStreamingService(t *testing.T) {
+	config := DefaultConfig()
+	config.SubscriberBuffer = 0
+	_, err := NewStreamingService(config, nil, testMarshaller)
+	require.Error(t, err)
+
+	config = DefaultConfig()
+	config.BufferDir = t.TempDir()
+	config.BufferBlocks = 0
+	_, err = NewStreamingService(config, nil, testMarshaller)
+	require.Error(t, err)
+}
+
+func TestListenHooks(t *testing.T) {
+	ss := newTestService(t, DefaultConfig())
+
+	require.Error(t, ss.ListenDeliverTx(emptyContext, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}))
+	require.Error(t, ss.ListenEndBlock(emptyContext, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.Error(t, ss.ListenCommit(emptyContext, abci.ResponseCommit{}))
+
+	sub := &subscriber{queue: make(chan *BlockMessages, 1), lagged: make(chan struct{})}
+	ss.subscribers[sub] = true
+
+	// the block is only published once it is committed
+	require.NoError(t, ss.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, ss.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+	require.Empty(t, sub.queue)
+	require.NoError(t, ss.ListenCommit(emptyContext, abci.ResponseCommit{}))
+	<-sub.queue
+
+	streamBlock(t, ss, 1)
+
+	block := <-sub.queue
+	require.Equal(t, int64(1), block.Height)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: mockStoreKey1.Name(), Key: []byte("begin"), Value: []byte{1}}}, block.BeginBlock.StateChanges)
+	require.Len(t, block.DeliverTxs, 1)
+	require.Equal(t, []byte{1}, block.DeliverTxs[0].Request.Tx)
+	require.Len(t, block.DeliverTxs[0].StateChanges, 2)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: mockStoreKey2.Name(), Key: []byte("end"), Value: []byte{3}}}, block.EndBlock.StateChanges)
+
+	// filtering by store key only keeps the state changes of the requested stores
+	sub.storeKeys = map[string]bool{mockStoreKey2.Name(): true}
+	filtered := sub.filter(block)
+	require.Empty(t, filtered.BeginBlock.StateChanges)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: mockStoreKey2.Name(), Key: []byte("tx2"), Delete: true}}, filtered.DeliverTxs[0].StateChanges)
+	require.Len(t, filtered.EndBlock.StateChanges, 1)
+	require.Len(t, block.DeliverTxs[0].StateChanges, 2)
+}
+
+func TestBuffer(t *testing.T) {
+	config := DefaultConfig()
+	config.BufferDir = t.TempDir()
+	config.BufferBlocks = 2
+	ss := newTestService(t, config)
+
+	for height := int64(1); height <= 3; height++ {
+		streamBlock(t, ss, height)
+	}
+
+	// the first block has been pruned from the buffer
+	_, err := os.Stat(ss.bufferFile(1))
+	require.True(t, os.IsNotExist(err))
+	for height := int64(2); height <= 3; height++ {
+		block, err := ss.readBuffer(height)
+		require.NoError(t, err)
+		require.Equal(t, height, block.Height)
+		require.Equal(t, height, block.EndBlock.Request.Height)
+	}
+
+	// the latest buffered height is restored on restart
+	ss = newTestService(t, config)
+	require.Equal(t, int64(3), ss.latestHeight)
+}
+
+func TestLaggingSubscriber(t *testing.T) {
+	config := DefaultConfig()
+	config.SubscriberBuffer = 1
+	config.RequiredSubscribers = []string{"required"}
+	ss := newTestService(t, config)
+
+	// an optional subscriber falling behind is disconnected
+	sub := &subscriber{id: "optional", queue: make(chan *BlockMessages, 1), lagged: make(chan struct{})}
+	ss.subscribers[sub] = true
+	streamBlock(t, ss, 1)
+	streamBlock(t, ss, 2)
+	require.NotContains(t, ss.subscribers, sub)
+	_, open := <-sub.lagged
+	require.False(t, open)
+
+	// a required subscriber falling behind is disconnected and requests to halt the node
+	ss.config.HaltOnLag = true
+	sub = &subscriber{id: "required", queue: make(chan *BlockMessages, 1), lagged: make(chan struct{})}
+	ss.subscribers[sub] = true
+	streamBlock(t, ss, 3)
+	endBlock(t, ss, 4)
+	require.ErrorIs(t, ss.ListenCommit(emptyContext, abci.ResponseCommit{}), baseapp.ErrHaltNode)
+	require.NotContains(t, ss.subscribers, sub)
+	_, open = <-sub.lagged
+	require.False(t, open)
+}
+
+func TestReplayLongerThanSubscriberBuffer(t *testing.T) {
+	config := DefaultConfig()
+	config.BufferDir = t.TempDir()
+	config.SubscriberBuffer = 1
+	config.RequiredSubscribers = []string{"required"}
+	config.HaltOnLag = true
+	ss := newTestService(t, config)
+
+	for height := int64(1); height <= 5; height++ {
+		streamBlock(t, ss, height)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &blockingStream{ctx: ctx, sent: make(chan *BlockMessages)}
+	done := make(chan error)
+	go func() { done <- ss.Subscribe(&SubscribeRequest{SubscriberId: "required", StartHeight: 1}, stream) }()
+
+	// the blocks committed during the replay neither overflow the queue nor halt the node
+	require.Equal(t, int64(1), (<-stream.sent).Height)
+	for height := int64(6); height <= 8; height++ {
+		streamBlock(t, ss, height)
+	}
+	for height := int64(2); height <= 8; height++ {
+		require.Equal(t, height, (<-stream.sent).Height)
+	}
+
+	// once caught up, the subscriber is sent the committed blocks from its queue
+	streamBlock(t, ss, 9)
+	require.Equal(t, int64(9), (<-stream.sent).Height)
+
+	cancel()
+	require.Error(t, <-done)
+}
+
+func TestSubscribe(t *testing.T) {
+	config := DefaultConfig()
+	config.BufferDir = t.TempDir()
+	ss := newTestService(t, config)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	RegisterStreamingServer(server, ss)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := NewStreamingClient(conn)
+
+	for height := int64(1); height <= 2; height++ {
+		streamBlock(t, ss, height)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.Subscribe(ctx, &SubscribeRequest{SubscriberId: "test", StartHeight: 2, StoreKeys: []string{mockStoreKey1.Name()}})
+	require.NoError(t, err)
+
+	// the second block is replayed from the buffer
+	block, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), block.Height)
+	require.Len(t, block.BeginBlock.StateChanges, 1)
+	require.Len(t, block.DeliverTxs[0].StateChanges, 1)
+	require.Empty(t, block.EndBlock.StateChanges)
+
+	// the subscriber is registered before the replay, so new blocks follow
+	streamBlock(t, ss, 3)
+	block, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), block.Height)
+
+	// any height still in the buffer can be resumed from
+	stream, err = client.Subscribe(ctx, &SubscribeRequest{StartHeight: 1})
+	require.NoError(t, err)
+	block, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+
+	// heights pruned from the buffer cannot be resumed from
+	ss.config.BufferBlocks = 1
+	stream, err = client.Subscribe(ctx, &SubscribeRequest{StartHeight: 1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+}
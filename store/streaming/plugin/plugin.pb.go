@@ -0,0 +1,1978 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/streaming/plugin/v1beta1/plugin.proto
+
+package plugin
+
+import (
+	context "context"
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/store/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ListenBeginBlockRequest is the request type for the
+// ABCIListenerService/ListenBeginBlock RPC method.
+type ListenBeginBlockRequest struct {
+	Request      types.RequestBeginBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseBeginBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair    `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *ListenBeginBlockRequest) Reset()         { *m = ListenBeginBlockRequest{} }
+func (m *ListenBeginBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenBeginBlockRequest) ProtoMessage()    {}
+func (*ListenBeginBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{0}
+}
+func (m *ListenBeginBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenBeginBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenBeginBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenBeginBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenBeginBlockRequest.Merge(m, src)
+}
+func (m *ListenBeginBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenBeginBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenBeginBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenBeginBlockRequest proto.InternalMessageInfo
+
+func (m *ListenBeginBlockRequest) GetRequest() types.RequestBeginBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestBeginBlock{}
+}
+
+func (m *ListenBeginBlockRequest) GetResponse() types.ResponseBeginBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseBeginBlock{}
+}
+
+func (m *ListenBeginBlockRequest) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// ListenBeginBlockResponse is the response type for the
+// ABCIListenerService/ListenBeginBlock RPC method.
+type ListenBeginBlockResponse struct {
+}
+
+func (m *ListenBeginBlockResponse) Reset()         { *m = ListenBeginBlockResponse{} }
+func (m *ListenBeginBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenBeginBlockResponse) ProtoMessage()    {}
+func (*ListenBeginBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{1}
+}
+func (m *ListenBeginBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenBeginBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenBeginBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenBeginBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenBeginBlockResponse.Merge(m, src)
+}
+func (m *ListenBeginBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenBeginBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenBeginBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenBeginBlockResponse proto.InternalMessageInfo
+
+// ListenDeliverTxRequest is the request type for the
+// ABCIListenerService/ListenDeliverTx RPC method.
+type ListenDeliverTxRequest struct {
+	BlockHeight  int64                   `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Request      types.RequestDeliverTx  `protobuf:"bytes,2,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseDeliverTx `protobuf:"bytes,3,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair   `protobuf:"bytes,4,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *ListenDeliverTxRequest) Reset()         { *m = ListenDeliverTxRequest{} }
+func (m *ListenDeliverTxRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenDeliverTxRequest) ProtoMessage()    {}
+func (*ListenDeliverTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{2}
+}
+func (m *ListenDeliverTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenDeliverTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenDeliverTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenDeliverTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenDeliverTxRequest.Merge(m, src)
+}
+func (m *ListenDeliverTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenDeliverTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenDeliverTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenDeliverTxRequest proto.InternalMessageInfo
+
+func (m *ListenDeliverTxRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListenDeliverTxRequest) GetRequest() types.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestDeliverTx{}
+}
+
+func (m *ListenDeliverTxRequest) GetResponse() types.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseDeliverTx{}
+}
+
+func (m *ListenDeliverTxRequest) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// ListenDeliverTxResponse is the response type for the
+// ABCIListenerService/ListenDeliverTx RPC method.
+type ListenDeliverTxResponse struct {
+}
+
+func (m *ListenDeliverTxResponse) Reset()         { *m = ListenDeliverTxResponse{} }
+func (m *ListenDeliverTxResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenDeliverTxResponse) ProtoMessage()    {}
+func (*ListenDeliverTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{3}
+}
+func (m *ListenDeliverTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenDeliverTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenDeliverTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenDeliverTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenDeliverTxResponse.Merge(m, src)
+}
+func (m *ListenDeliverTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenDeliverTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenDeliverTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenDeliverTxResponse proto.InternalMessageInfo
+
+// ListenEndBlockRequest is the request type for the
+// ABCIListenerService/ListenEndBlock RPC method.
+type ListenEndBlockRequest struct {
+	Request      types.RequestEndBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response     types.ResponseEndBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	StateChanges []*types1.StoreKVPair  `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *ListenEndBlockRequest) Reset()         { *m = ListenEndBlockRequest{} }
+func (m *ListenEndBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenEndBlockRequest) ProtoMessage()    {}
+func (*ListenEndBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{4}
+}
+func (m *ListenEndBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenEndBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenEndBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenEndBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenEndBlockRequest.Merge(m, src)
+}
+func (m *ListenEndBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenEndBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenEndBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenEndBlockRequest proto.InternalMessageInfo
+
+func (m *ListenEndBlockRequest) GetRequest() types.RequestEndBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestEndBlock{}
+}
+
+func (m *ListenEndBlockRequest) GetResponse() types.ResponseEndBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseEndBlock{}
+}
+
+func (m *ListenEndBlockRequest) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// ListenEndBlockResponse is the response type for the
+// ABCIListenerService/ListenEndBlock RPC method.
+type ListenEndBlockResponse struct {
+}
+
+func (m *ListenEndBlockResponse) Reset()         { *m = ListenEndBlockResponse{} }
+func (m *ListenEndBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenEndBlockResponse) ProtoMessage()    {}
+func (*ListenEndBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{5}
+}
+func (m *ListenEndBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenEndBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenEndBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenEndBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenEndBlockResponse.Merge(m, src)
+}
+func (m *ListenEndBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenEndBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenEndBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenEndBlockResponse proto.InternalMessageInfo
+
+// ListenCommitRequest is the request type for the
+// ABCIListenerService/ListenCommit RPC method.
+type ListenCommitRequest struct {
+	BlockHeight int64                `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Response    types.ResponseCommit `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+}
+
+func (m *ListenCommitRequest) Reset()         { *m = ListenCommitRequest{} }
+func (m *ListenCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenCommitRequest) ProtoMessage()    {}
+func (*ListenCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{6}
+}
+func (m *ListenCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenCommitRequest.Merge(m, src)
+}
+func (m *ListenCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenCommitRequest proto.InternalMessageInfo
+
+func (m *ListenCommitRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListenCommitRequest) GetResponse() types.ResponseCommit {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseCommit{}
+}
+
+// ListenCommitResponse is the response type for the
+// ABCIListenerService/ListenCommit RPC method.
+type ListenCommitResponse struct {
+}
+
+func (m *ListenCommitResponse) Reset()         { *m = ListenCommitResponse{} }
+func (m *ListenCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenCommitResponse) ProtoMessage()    {}
+func (*ListenCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb08f2d27cad903, []int{7}
+}
+func (m *ListenCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenCommitResponse.Merge(m, src)
+}
+func (m *ListenCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenCommitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ListenBeginBlockRequest)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenBeginBlockRequest")
+	proto.RegisterType((*ListenBeginBlockResponse)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenBeginBlockResponse")
+	proto.RegisterType((*ListenDeliverTxRequest)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenDeliverTxRequest")
+	proto.RegisterType((*ListenDeliverTxResponse)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenDeliverTxResponse")
+	proto.RegisterType((*ListenEndBlockRequest)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenEndBlockRequest")
+	proto.RegisterType((*ListenEndBlockResponse)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenEndBlockResponse")
+	proto.RegisterType((*ListenCommitRequest)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenCommitRequest")
+	proto.RegisterType((*ListenCommitResponse)(nil), "cosmos.base.streaming.plugin.v1beta1.ListenCommitResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/streaming/plugin/v1beta1/plugin.proto", fileDescriptor_8eb08f2d27cad903)
+}
+
+var fileDescriptor_8eb08f2d27cad903 = []byte{
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x75, 0x02, 0xe4, 0x95, 0x1f, 0xca, 0xc6, 0x28, 0x41, 0xca, 0xda, 0x82, 0xd0,
+	0x38, 0xe0, 0xa8, 0xdd, 0x09, 0xd8, 0x10, 0x6b, 0x37, 0x09, 0x34, 0x90, 0x50, 0x87, 0x38, 0x70,
+	0x99, 0x92, 0xf4, 0x29, 0xb5, 0xd6, 0xc4, 0xc5, 0x76, 0x2b, 0x10, 0x67, 0x4e, 0x48, 0x88, 0xfd,
+	0x57, 0x3b, 0xf6, 0xc8, 0x09, 0xa1, 0xf6, 0xc8, 0x89, 0xff, 0x00, 0x35, 0x76, 0xda, 0xc6, 0x25,
+	0x88, 0x16, 0x71, 0x8a, 0x63, 0xbf, 0xef, 0xd7, 0x7e, 0x1f, 0x3f, 0xdb, 0xa8, 0xea, 0x53, 0x1e,
+	0x52, 0xee, 0x78, 0x2e, 0x07, 0x87, 0x0b, 0x06, 0x6e, 0x48, 0xa2, 0xc0, 0xe9, 0x76, 0x7a, 0x01,
+	0x89, 0x9c, 0x7e, 0xd5, 0x03, 0xe1, 0x56, 0xd5, 0x2f, 0xee, 0x32, 0x2a, 0xa8, 0x79, 0x47, 0x4a,
+	0xf0, 0x58, 0x82, 0x27, 0x12, 0xac, 0x62, 0x94, 0xc4, 0xba, 0x25, 0x20, 0x6a, 0x01, 0x0b, 0x49,
+	0x24, 0x1c, 0xd7, 0xf3, 0x89, 0x23, 0xde, 0x77, 0x81, 0x4b, 0x0b, 0xeb, 0x5e, 0x7a, 0x56, 0xca,
+	0x60, 0x32, 0x55, 0x87, 0x70, 0x01, 0x51, 0x6c, 0x18, 0x87, 0x6e, 0x04, 0x34, 0xa0, 0x71, 0xd3,
+	0x19, 0xb7, 0x64, 0x6f, 0xe5, 0xa7, 0x81, 0x6e, 0x3c, 0x8f, 0x23, 0xeb, 0x10, 0x90, 0xa8, 0xde,
+	0xa1, 0xfe, 0x69, 0x13, 0xde, 0xf6, 0x80, 0x0b, 0xb3, 0x8e, 0x2e, 0x32, 0xd9, 0x2c, 0x1a, 0x25,
+	0x63, 0x7b, 0xad, 0x56, 0xc1, 0xd3, 0xb5, 0xe0, 0xf1, 0x5a, 0xb0, 0x0a, 0x9d, 0x6a, 0xeb, 0xab,
+	0xe7, 0xdf, 0xb6, 0x72, 0xcd, 0x44, 0x68, 0x1e, 0xa2, 0x4b, 0x0c, 0x78, 0x97, 0x46, 0x1c, 0x8a,
+	0x2b, 0xb1, 0xc9, 0xed, 0xdf, 0x98, 0xc8, 0x80, 0x39, 0x97, 0x89, 0xd4, 0x3c, 0x42, 0x97, 0xb9,
+	0x70, 0x05, 0x9c, 0xf8, 0x6d, 0x37, 0x0a, 0x80, 0x17, 0xf3, 0xa5, 0xfc, 0xf6, 0x5a, 0xed, 0x2e,
+	0x4e, 0x23, 0xa4, 0x0c, 0x12, 0x6e, 0xf8, 0x78, 0xfc, 0x77, 0xf4, 0xfa, 0xa5, 0x4b, 0x58, 0xb3,
+	0x10, 0x8b, 0x1b, 0x52, 0x5b, 0xb1, 0x50, 0x71, 0x3e, 0x65, 0x39, 0x51, 0xe5, 0x6c, 0x05, 0x6d,
+	0xca, 0xc1, 0x03, 0xe8, 0x90, 0x3e, 0xb0, 0x57, 0xef, 0x12, 0x1c, 0x65, 0x54, 0xf0, 0xc6, 0xb1,
+	0x27, 0x6d, 0x20, 0x41, 0x5b, 0x32, 0xc9, 0x37, 0xd7, 0xe2, 0xbe, 0xa7, 0x71, 0x97, 0xb9, 0x3f,
+	0x25, 0x26, 0x93, 0x2d, 0x67, 0x11, 0x9b, 0xb8, 0xeb, 0xc0, 0x0e, 0x66, 0x80, 0xe5, 0x33, 0xa9,
+	0xcb, 0x00, 0xdd, 0xe4, 0x0f, 0xbc, 0x56, 0xff, 0x81, 0xd7, 0xcd, 0xa4, 0x44, 0x66, 0x90, 0x28,
+	0x5c, 0x3f, 0x0c, 0x74, 0x5d, 0x8e, 0x1d, 0x46, 0xad, 0x54, 0xf1, 0x3c, 0xd1, 0x8b, 0xa7, 0x94,
+	0x85, 0x22, 0x51, 0xea, 0x24, 0x1a, 0x73, 0xa5, 0x53, 0xce, 0x24, 0xa1, 0x79, 0xfc, 0xa7, 0xc2,
+	0x29, 0x26, 0xb5, 0x31, 0x4d, 0x56, 0x71, 0xf8, 0x80, 0xd6, 0xe5, 0x48, 0x83, 0x86, 0x21, 0x11,
+	0x0b, 0x95, 0x8c, 0x9e, 0xe5, 0x56, 0x66, 0x96, 0xd2, 0x5c, 0xcf, 0xb1, 0xb2, 0x89, 0x36, 0xd2,
+	0x93, 0xcb, 0xfe, 0xda, 0x60, 0x15, 0xad, 0xef, 0xd7, 0x1b, 0xcf, 0xe4, 0x20, 0xb0, 0x63, 0x60,
+	0x7d, 0xe2, 0x83, 0x79, 0x66, 0xa0, 0x6b, 0xfa, 0x01, 0x30, 0xf7, 0xf0, 0xdf, 0xdc, 0x46, 0x38,
+	0xe3, 0xae, 0xb0, 0x1e, 0x2f, 0x2b, 0x57, 0xfb, 0xf4, 0xd9, 0x40, 0x57, 0xb5, 0x22, 0x33, 0x77,
+	0x17, 0xf1, 0xd4, 0x8f, 0xab, 0xb5, 0xb7, 0xa4, 0x5a, 0x2d, 0xe8, 0x93, 0x81, 0xae, 0xa4, 0x37,
+	0xdb, 0x7c, 0xb4, 0x88, 0xa3, 0x76, 0x1e, 0xac, 0xdd, 0xe5, 0xc4, 0x6a, 0x35, 0x1f, 0x0d, 0x54,
+	0x98, 0xdd, 0x63, 0xf3, 0xc1, 0x22, 0x76, 0xa9, 0xa2, 0xb4, 0x1e, 0x2e, 0x23, 0x55, 0x37, 0xf4,
+	0x8b, 0xf3, 0xa1, 0x6d, 0x0c, 0x86, 0xb6, 0xf1, 0x7d, 0x68, 0x1b, 0x5f, 0x46, 0x76, 0x6e, 0x30,
+	0xb2, 0x73, 0x5f, 0x47, 0x76, 0xee, 0xcd, 0x4e, 0x40, 0x44, 0xbb, 0xe7, 0x61, 0x9f, 0x86, 0x8e,
+	0x7a, 0x94, 0xe4, 0xe7, 0x3e, 0x6f, 0x9d, 0xaa, 0xa7, 0x49, 0x7f, 0x16, 0xbd, 0x0b, 0xf1, 0x23,
+	0xb4, 0xf3, 0x6b, 0x00, 0xa8, 0x5a, 0xeb, 0xb9, 0x3d, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ABCIListenerServiceClient is the client API for ABCIListenerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ABCIListenerServiceClient interface {
+	// ListenBeginBlock is called with the BeginBlock messages and the state
+	// changes made during BeginBlock.
+	ListenBeginBlock(ctx context.Context, in *ListenBeginBlockRequest, opts ...grpc.CallOption) (*ListenBeginBlockResponse, error)
+	// ListenDeliverTx is called with the messages and the state changes of each
+	// transaction of the block.
+	ListenDeliverTx(ctx context.Context, in *ListenDeliverTxRequest, opts ...grpc.CallOption) (*ListenDeliverTxResponse, error)
+	// ListenEndBlock is called with the EndBlock messages and the state changes
+	// made during EndBlock.
+	ListenEndBlock(ctx context.Context, in *ListenEndBlockRequest, opts ...grpc.CallOption) (*ListenEndBlockResponse, error)
+	// ListenCommit is called once the block has been committed.
+	ListenCommit(ctx context.Context, in *ListenCommitRequest, opts ...grpc.CallOption) (*ListenCommitResponse, error)
+}
+
+type aBCIListenerServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewABCIListenerServiceClient(cc grpc1.ClientConn) ABCIListenerServiceClient {
+	return &aBCIListenerServiceClient{cc}
+}
+
+func (c *aBCIListenerServiceClient) ListenBeginBlock(ctx context.Context, in *ListenBeginBlockRequest, opts ...grpc.CallOption) (*ListenBeginBlockResponse, error) {
+	out := new(ListenBeginBlockResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenBeginBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIListenerServiceClient) ListenDeliverTx(ctx context.Context, in *ListenDeliverTxRequest, opts ...grpc.CallOption) (*ListenDeliverTxResponse, error) {
+	out := new(ListenDeliverTxResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenDeliverTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIListenerServiceClient) ListenEndBlock(ctx context.Context, in *ListenEndBlockRequest, opts ...grpc.CallOption) (*ListenEndBlockResponse, error) {
+	out := new(ListenEndBlockResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenEndBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBCIListenerServiceClient) ListenCommit(ctx context.Context, in *ListenCommitRequest, opts ...grpc.CallOption) (*ListenCommitResponse, error) {
+	out := new(ListenCommitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ABCIListenerServiceServer is the server API for ABCIListenerService service.
+type ABCIListenerServiceServer interface {
+	// ListenBeginBlock is called with the BeginBlock messages and the state
+	// changes made during BeginBlock.
+	ListenBeginBlock(context.Context, *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error)
+	// ListenDeliverTx is called with the messages and the state changes of each
+	// transaction of the block.
+	ListenDeliverTx(context.Context, *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error)
+	// ListenEndBlock is called with the EndBlock messages and the state changes
+	// made during EndBlock.
+	ListenEndBlock(context.Context, *ListenEndBlockRequest) (*ListenEndBlockResponse, error)
+	// ListenCommit is called once the block has been committed.
+	ListenCommit(context.Context, *ListenCommitRequest) (*ListenCommitResponse, error)
+}
+
+// UnimplementedABCIListenerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedABCIListenerServiceServer struct {
+}
+
+func (*UnimplementedABCIListenerServiceServer) ListenBeginBlock(ctx context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenBeginBlock not implemented")
+}
+func (*UnimplementedABCIListenerServiceServer) ListenDeliverTx(ctx context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenDeliverTx not implemented")
+}
+func (*UnimplementedABCIListenerServiceServer) ListenEndBlock(ctx context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenEndBlock not implemented")
+}
+func (*UnimplementedABCIListenerServiceServer) ListenCommit(ctx context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenCommit not implemented")
+}
+
+func RegisterABCIListenerServiceServer(s grpc1.Server, srv ABCIListenerServiceServer) {
+	s.RegisterService(&_ABCIListenerService_serviceDesc, srv)
+}
+
+func _ABCIListenerService_ListenBeginBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenBeginBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIListenerServiceServer).ListenBeginBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenBeginBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIListenerServiceServer).ListenBeginBlock(ctx, req.(*ListenBeginBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIListenerService_ListenDeliverTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenDeliverTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIListenerServiceServer).ListenDeliverTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenDeliverTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIListenerServiceServer).ListenDeliverTx(ctx, req.(*ListenDeliverTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIListenerService_ListenEndBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenEndBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIListenerServiceServer).ListenEndBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenEndBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIListenerServiceServer).ListenEndBlock(ctx, req.(*ListenEndBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABCIListenerService_ListenCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIListenerServiceServer).ListenCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.streaming.plugin.v1beta1.ABCIListenerService/ListenCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIListenerServiceServer).ListenCommit(ctx, req.(*ListenCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ABCIListenerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.streaming.plugin.v1beta1.ABCIListenerService",
+	HandlerType: (*ABCIListenerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListenBeginBlock",
+			Handler:    _ABCIListenerService_ListenBeginBlock_Handler,
+		},
+		{
+			MethodName: "ListenDeliverTx",
+			Handler:    _ABCIListenerService_ListenDeliverTx_Handler,
+		},
+		{
+			MethodName: "ListenEndBlock",
+			Handler:    _ABCIListenerService_ListenEndBlock_Handler,
+		},
+		{
+			MethodName: "ListenCommit",
+			Handler:    _ABCIListenerService_ListenCommit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/streaming/plugin/v1beta1/plugin.proto",
+}
+
+func (m *ListenBeginBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenBeginBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenBeginBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlugin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlugin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlugin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenBeginBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenBeginBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenBeginBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenDeliverTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenDeliverTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenDeliverTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlugin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlugin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlugin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintPlugin(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenDeliverTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenDeliverTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenDeliverTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenEndBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenEndBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenEndBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlugin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlugin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlugin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenEndBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenEndBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenEndBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlugin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintPlugin(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPlugin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPlugin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListenBeginBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovPlugin(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovPlugin(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovPlugin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenBeginBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListenDeliverTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovPlugin(uint64(m.BlockHeight))
+	}
+	l = m.Request.Size()
+	n += 1 + l + sovPlugin(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovPlugin(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovPlugin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenDeliverTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListenEndBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovPlugin(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovPlugin(uint64(l))
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovPlugin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenEndBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListenCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovPlugin(uint64(m.BlockHeight))
+	}
+	l = m.Response.Size()
+	n += 1 + l + sovPlugin(uint64(l))
+	return n
+}
+
+func (m *ListenCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPlugin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPlugin(x uint64) (n int) {
+	return sovPlugin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListenBeginBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenBeginBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenBeginBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenBeginBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenBeginBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenBeginBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenDeliverTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenDeliverTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenDeliverTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenDeliverTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenDeliverTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenDeliverTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenEndBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenEndBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenEndBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenEndBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenEndBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenEndBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPlugin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPlugin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPlugin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPlugin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPlugin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPlugin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPlugin = fmt.Errorf("proto: unexpected end of group")
+)
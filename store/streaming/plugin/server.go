@@ -0,0 +1,47 @@
+package plugin
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+)
+
+// SocketEnv is the environment variable holding the path of the socket a plugin must serve the
+// ABCIListenerService on. It is set by the node when launching the plugin.
+const SocketEnv = "COSMOS_SDK_STREAMING_PLUGIN_SOCKET"
+
+// Serve is called by the main function of a plugin executable to serve its ABCIListenerService implementation
+// on the socket provided by the node. It returns once the node stops the plugin with an interrupt or terminate
+// signal.
+func Serve(srv ABCIListenerServiceServer) error {
+	socket := os.Getenv(SocketEnv)
+	if socket == "" {
+		return fmt.Errorf("%s is not set, the plugin must be launched by the node", SocketEnv)
+	}
+	// remove the socket of a previous run of the plugin
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	RegisterABCIListenerServiceServer(server, srv)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+	go func() {
+		if _, ok := <-sigs; ok {
+			server.GracefulStop()
+		}
+	}()
+
+	return server.Serve(listener)
+}
@@ -0,0 +1,463 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ baseapp.CommitListener   = &StreamingService{}
+)
+
+const (
+	// DefaultCallTimeout is the default time a message is retried for until the plugin handles it
+	DefaultCallTimeout = 10 * time.Second
+	// DefaultQueueSize is the default number of messages queued for the plugin
+	DefaultQueueSize = 10000
+	// DefaultMaxRestarts is the default number of times the plugin is restarted after exiting
+	DefaultMaxRestarts = 5
+	// DefaultRestartDelay is the default delay before restarting the plugin
+	DefaultRestartDelay = time.Second
+	// killTimeout is the time the plugin is given to exit gracefully when the service is closed
+	killTimeout = 5 * time.Second
+)
+
+// Config defines the configuration of an out-of-process streaming plugin
+type Config struct {
+	// Name is the name of the streamer in the app configuration, used to name the socket of the plugin
+	Name string
+	// Command is the path of the plugin executable
+	Command string
+	// Args are the arguments the plugin executable is launched with
+	Args []string
+	// Env are additional environment variables, in the form "KEY=value", the plugin is launched with
+	Env []string
+	// SocketDir is the directory in which the socket the plugin serves on is created
+	SocketDir string
+	// CallTimeout is the time a message is retried for until the plugin handles it, including the time it takes the
+	// plugin to restart
+	CallTimeout time.Duration
+	// QueueSize is the number of messages queued for the plugin before it is considered to have fallen behind
+	QueueSize int
+	// MaxRestarts is the number of times the plugin is restarted after exiting. A negative value restarts it
+	// indefinitely.
+	MaxRestarts int
+	// RestartDelay is the delay before restarting the plugin
+	RestartDelay time.Duration
+	// HaltOnError gracefully halts the node after the commit of the block the plugin failed to handle a message of,
+	// instead of only logging the error
+	HaltOnError bool
+}
+
+// DefaultConfig returns the default configuration of the streaming plugin with the given name and executable
+func DefaultConfig(name, command string) Config {
+	return Config{
+		Name:         name,
+		Command:      command,
+		SocketDir:    os.TempDir(),
+		CallTimeout:  DefaultCallTimeout,
+		QueueSize:    DefaultQueueSize,
+		MaxRestarts:  DefaultMaxRestarts,
+		RestartDelay: DefaultRestartDelay,
+	}
+}
+
+// StreamingService is a concrete implementation of StreamingService that forwards the ABCI messages and the state
+// changes of every block to an out-of-process plugin, which it launches and supervises
+type StreamingService struct {
+	listeners      map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	config         Config                                   // configuration of the plugin
+	socket         string                                   // path of the socket the plugin serves on
+	stateCache     []*types.StoreKVPair                     // cache the StoreKVPairs in the order they are received
+	stateCacheLock *sync.Mutex                              // mutex for the state cache
+	blockHeight    int64                                    // height of the block being streamed
+	halting        bool                                     // set once a failure requested to halt the node
+
+	mtx     sync.Mutex                // mutex for the connection, the plugin process, the queue and the supervision state
+	conn    *grpc.ClientConn          // connection to the plugin, set while streaming
+	client  ABCIListenerServiceClient // client of the plugin, set while streaming
+	cmd     *exec.Cmd                 // the running plugin process
+	failed  error                     // set once the plugin exited more often than it may be restarted
+	queue   chan message              // the messages waiting to be delivered, set while streaming
+	failure error                     // the first delivery failure which has not been reported yet
+	quit    chan struct{}             // closed when the service is closed
+	cancel  context.CancelFunc        // cancels the deliveries when the service is closed
+}
+
+// message is an ABCI message queued for delivery to the plugin
+type message struct {
+	name   string // name of the ABCI message, used in errors
+	height int64  // height of the block of the message
+	send   func(ctx context.Context, client ABCIListenerServiceClient) error
+}
+
+// stateCacheListener is a WriteListener which caches the StoreKVPairs in the StreamingService
+type stateCacheListener struct {
+	service *StreamingService
+}
+
+// OnWrite satisfies the WriteListener interface by caching the StoreKVPair
+func (l stateCacheListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.service.stateCacheLock.Lock()
+	l.service.stateCache = append(l.service.stateCache, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	l.service.stateCacheLock.Unlock()
+	return nil
+}
+
+// NewStreamingService creates a new StreamingService for the provided plugin configuration and storeKeys
+// The plugin is launched when the service starts streaming
+func NewStreamingService(config Config, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if config.Name == "" {
+		return nil, errors.New("streaming plugin name cannot be empty")
+	}
+	if config.Command == "" {
+		return nil, fmt.Errorf("streaming plugin %s has no executable", config.Name)
+	}
+	if config.CallTimeout <= 0 {
+		return nil, fmt.Errorf("streaming plugin call timeout must be positive: %s", config.CallTimeout)
+	}
+	if config.QueueSize <= 0 {
+		return nil, fmt.Errorf("streaming plugin queue size must be positive: %d", config.QueueSize)
+	}
+	if err := os.MkdirAll(config.SocketDir, 0700); err != nil {
+		return nil, err
+	}
+
+	ps := &StreamingService{
+		config:         config,
+		socket:         filepath.Join(config.SocketDir, fmt.Sprintf("streaming-plugin-%s-%d.sock", config.Name, os.Getpid())),
+		stateCacheLock: new(sync.Mutex),
+	}
+
+	listener := stateCacheListener{service: ps}
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		listeners[key] = append(listeners[key], listener)
+	}
+	ps.listeners = listeners
+
+	return ps, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (ps *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return ps.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It queues the received BeginBlock request and response and the resulting state changes for the plugin
+func (ps *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	ps.blockHeight = req.Header.Height
+	msg := &ListenBeginBlockRequest{
+		Request:      req,
+		Response:     res,
+		StateChanges: ps.flushStateCache(),
+	}
+	return ps.enqueue("BeginBlock", func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenBeginBlock(ctx, msg, grpc.WaitForReady(true))
+		return err
+	})
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It queues the received DeliverTx request and response and the resulting state changes for the plugin
+func (ps *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	msg := &ListenDeliverTxRequest{
+		BlockHeight:  ps.blockHeight,
+		Request:      req,
+		Response:     res,
+		StateChanges: ps.flushStateCache(),
+	}
+	return ps.enqueue("DeliverTx", func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenDeliverTx(ctx, msg, grpc.WaitForReady(true))
+		return err
+	})
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It queues the received EndBlock request and response and the resulting state changes for the plugin
+func (ps *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	msg := &ListenEndBlockRequest{
+		Request:      req,
+		Response:     res,
+		StateChanges: ps.flushStateCache(),
+	}
+	return ps.enqueue("EndBlock", func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenEndBlock(ctx, msg, grpc.WaitForReady(true))
+		return err
+	})
+}
+
+// ListenCommit satisfies the baseapp.CommitListener interface
+// It queues the received Commit response for the plugin. If the service is configured to halt on errors and a
+// message could not be delivered, it returns an error wrapping baseapp.ErrHaltNode so that the node is halted.
+func (ps *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	msg := &ListenCommitRequest{
+		BlockHeight: ps.blockHeight,
+		Response:    res,
+	}
+	err := ps.enqueue("Commit", func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenCommit(ctx, msg, grpc.WaitForReady(true))
+		return err
+	})
+	if err == nil && ps.halting {
+		err = fmt.Errorf("streaming plugin %s failed to handle messages: %w", ps.config.Name, baseapp.ErrHaltNode)
+	}
+	return err
+}
+
+// enqueue queues a message for the plugin without waiting for it to be delivered. It returns the failure of the
+// deliveries since the previous message, or an error if the plugin fell so far behind that its queue is full, in
+// which case the message is dropped. If the service is configured to halt on errors, the error wraps
+// baseapp.ErrHaltNode.
+func (ps *StreamingService) enqueue(name string, send func(ctx context.Context, client ABCIListenerServiceClient) error) error {
+	ps.mtx.Lock()
+	queue := ps.queue
+	err := ps.failure
+	ps.failure = nil
+	ps.mtx.Unlock()
+
+	if queue == nil {
+		return fmt.Errorf("streaming plugin %s is not running", ps.config.Name)
+	}
+
+	select {
+	case queue <- message{name: name, height: ps.blockHeight, send: send}:
+	default:
+		if err == nil {
+			err = fmt.Errorf("streaming plugin %s fell behind, dropped %s messages at height %d", ps.config.Name, name, ps.blockHeight)
+		}
+	}
+
+	if err != nil && ps.config.HaltOnError {
+		ps.halting = true
+		err = fmt.Errorf("%v: %w", err, baseapp.ErrHaltNode)
+	}
+	return err
+}
+
+// deliver delivers the queued messages to the plugin in order with the configured timeout, until the service is
+// closed. Calls interrupted by the plugin exiting are retried once it is restarted, so a plugin may receive a message
+// more than once.
+func (ps *StreamingService) deliver(ctx context.Context, queue <-chan message) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-queue:
+			callCtx, cancel := context.WithTimeout(ctx, ps.config.CallTimeout)
+			err := ps.callWithRetry(callCtx, msg.send)
+			cancel()
+			if err == nil || ctx.Err() != nil {
+				continue
+			}
+
+			// only the first failure is kept until it is reported
+			ps.mtx.Lock()
+			if ps.failure == nil {
+				ps.failure = fmt.Errorf("streaming plugin %s failed to handle %s messages at height %d: %w", ps.config.Name, msg.name, msg.height, err)
+			}
+			ps.mtx.Unlock()
+		}
+	}
+}
+
+// callWithRetry calls the plugin until the call succeeds, fails for another reason than the plugin being unavailable
+// or the context expires
+func (ps *StreamingService) callWithRetry(ctx context.Context, fn func(ctx context.Context, client ABCIListenerServiceClient) error) error {
+	for {
+		client, err := ps.runningClient()
+		if err != nil {
+			return err
+		}
+		if err = fn(ctx, client); status.Code(err) != codes.Unavailable {
+			return err
+		}
+
+		// wait for the plugin to be restarted
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(ps.config.RestartDelay):
+		}
+	}
+}
+
+// runningClient returns the client of the plugin, or an error if the plugin is not running and will not be restarted
+func (ps *StreamingService) runningClient() (ABCIListenerServiceClient, error) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if ps.client == nil {
+		return nil, errors.New("the plugin is not running")
+	}
+	if ps.failed != nil {
+		return nil, ps.failed
+	}
+	return ps.client, nil
+}
+
+// flushStateCache returns the cached state changes and resets the cache
+func (ps *StreamingService) flushStateCache() []*types.StoreKVPair {
+	ps.stateCacheLock.Lock()
+	defer ps.stateCacheLock.Unlock()
+	stateChanges := ps.stateCache
+	ps.stateCache = nil
+	return stateChanges
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It launches the plugin and supervises it in a goroutine, restarting it when it exits, and delivers the queued
+// messages to it in another goroutine
+// returns an error if it is called twice or if the plugin cannot be launched
+func (ps *StreamingService) Stream(wg *sync.WaitGroup) error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if ps.client != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+
+	if err := os.Remove(ps.socket); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	cmd, err := ps.start()
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(ps.socket,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}),
+	)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		return err
+	}
+
+	ps.cmd = cmd
+	ps.conn = conn
+	ps.client = NewABCIListenerServiceClient(conn)
+	ps.failed = nil
+	ps.failure = nil
+	ps.queue = make(chan message, ps.config.QueueSize)
+	ps.quit = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	ps.cancel = cancel
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		ps.supervise(cmd, ps.quit)
+	}()
+	go func(queue <-chan message) {
+		defer wg.Done()
+		ps.deliver(ctx, queue)
+	}(ps.queue)
+	return nil
+}
+
+// start launches the plugin executable
+func (ps *StreamingService) start() (*exec.Cmd, error) {
+	cmd := exec.Command(ps.config.Command, ps.config.Args...) //nolint:gosec
+	cmd.Env = append(append(os.Environ(), ps.config.Env...), fmt.Sprintf("%s=%s", SocketEnv, ps.socket))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to launch streaming plugin %s: %w", ps.config.Name, err)
+	}
+	return cmd, nil
+}
+
+// supervise waits for the plugin to exit and restarts it, until the service is closed or the plugin exited more
+// often than it may be restarted
+func (ps *StreamingService) supervise(cmd *exec.Cmd, quit chan struct{}) {
+	for restarts := 0; ; restarts++ {
+		err := cmd.Wait()
+
+		select {
+		case <-quit:
+			return
+		default:
+		}
+
+		if ps.config.MaxRestarts >= 0 && restarts >= ps.config.MaxRestarts {
+			ps.mtx.Lock()
+			ps.failed = fmt.Errorf("the plugin exited after %d restarts: %v", restarts, err)
+			ps.mtx.Unlock()
+			return
+		}
+
+		select {
+		case <-quit:
+			return
+		case <-time.After(ps.config.RestartDelay):
+		}
+
+		ps.mtx.Lock()
+		// the service may have been closed while waiting for the lock
+		select {
+		case <-quit:
+			ps.mtx.Unlock()
+			return
+		default:
+		}
+		cmd, err = ps.start()
+		if err != nil {
+			ps.failed = err
+			ps.mtx.Unlock()
+			return
+		}
+		ps.cmd = cmd
+		ps.mtx.Unlock()
+	}
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It stops the plugin, killing it if it does not exit in time. The messages which are still queued are dropped.
+func (ps *StreamingService) Close() error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if ps.client == nil {
+		return nil
+	}
+
+	close(ps.quit)
+	ps.cancel()
+	// signalling a process which already exited fails without effect
+	process := ps.cmd.Process
+	if err := process.Signal(os.Interrupt); err == nil {
+		time.AfterFunc(killTimeout, func() { _ = process.Kill() })
+	}
+
+	err := ps.conn.Close()
+	ps.conn = nil
+	ps.client = nil
+	ps.cmd = nil
+	ps.queue = nil
+	return err
+}
@@ -0,0 +1,224 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// helperOutputEnv is the environment variable holding the file the helper plugin records the received messages to
+const helperOutputEnv = "STREAMING_PLUGIN_TEST_OUTPUT"
+
+var (
+	emptyContext = sdk.Context{}
+	mockStoreKey = sdk.NewKVStoreKey("mockStore")
+)
+
+// recordingPlugin is an ABCIListenerServiceServer which records a line per received message to a file
+type recordingPlugin struct {
+	output string
+}
+
+func (p recordingPlugin) record(format string, args ...interface{}) error {
+	f, err := os.OpenFile(p.output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = fmt.Fprintf(f, format+"\n", args...)
+	return err
+}
+
+func (p recordingPlugin) ListenBeginBlock(_ context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	return &ListenBeginBlockResponse{}, p.record("begin %d %d", req.Request.Header.Height, len(req.StateChanges))
+}
+
+func (p recordingPlugin) ListenDeliverTx(_ context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	return &ListenDeliverTxResponse{}, p.record("tx %d %d", req.BlockHeight, len(req.StateChanges))
+}
+
+func (p recordingPlugin) ListenEndBlock(_ context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	return &ListenEndBlockResponse{}, p.record("end %d %d", req.Request.Height, len(req.StateChanges))
+}
+
+func (p recordingPlugin) ListenCommit(_ context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	return &ListenCommitResponse{}, p.record("commit %d %X", req.BlockHeight, req.Response.Data)
+}
+
+// TestHelperPlugin is not a real test, it is the plugin executable launched by the tests of the StreamingService
+func TestHelperPlugin(t *testing.T) {
+	output := os.Getenv(helperOutputEnv)
+	if output == "" {
+		return
+	}
+	if err := Serve(recordingPlugin{output: output}); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func newTestService(t *testing.T, modify func(*Config)) (*StreamingService, string) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "output")
+	config := DefaultConfig("test", os.Args[0])
+	config.Args = []string{"-test.run=TestHelperPlugin"}
+	config.Env = []string{fmt.Sprintf("%s=%s", helperOutputEnv, output)}
+	config.SocketDir = dir
+	config.RestartDelay = 10 * time.Millisecond
+	if modify != nil {
+		modify(&config)
+	}
+	ps, err := NewStreamingService(config, []types.StoreKey{mockStoreKey})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ps.Close() })
+	return ps, output
+}
+
+// streamBlock simulates the execution and commit of a block with a single transaction
+func streamBlock(ps *StreamingService, height int64) error {
+	listener := ps.Listeners()[mockStoreKey][0]
+	if err := listener.OnWrite(mockStoreKey, []byte("begin"), []byte{1}, false); err != nil {
+		return err
+	}
+	if err := ps.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}); err != nil {
+		return err
+	}
+	for _, key := range []string{"tx1", "tx2"} {
+		if err := listener.OnWrite(mockStoreKey, []byte(key), nil, true); err != nil {
+			return err
+		}
+	}
+	if err := ps.ListenDeliverTx(emptyContext, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}); err != nil {
+		return err
+	}
+	if err := ps.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}); err != nil {
+		return err
+	}
+	return ps.ListenCommit(emptyContext, abci.ResponseCommit{Data: []byte{byte(height)}})
+}
+
+// waitForOutput waits until the plugin recorded n messages and returns them
+func waitForOutput(t *testing.T, output string, n int) []string {
+	var lines []string
+	require.Eventually(t, func() bool {
+		bz, err := ioutil.ReadFile(output)
+		if err != nil {
+			return false
+		}
+		lines = strings.Split(strings.TrimSpace(string(bz)), "\n")
+		return len(lines) >= n
+	}, 5*time.Second, 10*time.Millisecond)
+	return lines
+}
+
+func TestNewStreamingService(t *testing.T) {
+	_, err := NewStreamingService(DefaultConfig("", "plugin"), nil)
+	require.Error(t, err)
+
+	_, err = NewStreamingService(DefaultConfig("test", ""), nil)
+	require.Error(t, err)
+
+	config := DefaultConfig("test", "plugin")
+	config.CallTimeout = 0
+	_, err = NewStreamingService(config, nil)
+	require.Error(t, err)
+
+	config = DefaultConfig("test", "plugin")
+	config.QueueSize = 0
+	_, err = NewStreamingService(config, nil)
+	require.Error(t, err)
+}
+
+func TestPluginStreamingService(t *testing.T) {
+	ps, output := newTestService(t, nil)
+
+	// the plugin is not running before streaming
+	require.Error(t, streamBlock(ps, 1))
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, ps.Stream(wg))
+	require.Error(t, ps.Stream(wg))
+
+	require.NoError(t, streamBlock(ps, 1))
+	require.Equal(t, []string{"begin 1 1", "tx 1 2", "end 1 0", "commit 1 01"}, waitForOutput(t, output, 4))
+
+	// the plugin is restarted after it exits
+	ps.mtx.Lock()
+	require.NoError(t, ps.cmd.Process.Kill())
+	ps.mtx.Unlock()
+	require.NoError(t, streamBlock(ps, 2))
+	require.Equal(t, []string{"begin 2 1", "tx 2 2", "end 2 0", "commit 2 02"}, waitForOutput(t, output, 8)[4:])
+
+	require.NoError(t, ps.Close())
+	wg.Wait()
+	require.Error(t, streamBlock(ps, 3))
+}
+
+func TestPluginMaxRestarts(t *testing.T) {
+	ps, _ := newTestService(t, func(config *Config) {
+		config.MaxRestarts = 0
+		config.HaltOnError = true
+		// a call may wait for the connection to the exited plugin until it times out
+		config.CallTimeout = time.Second
+	})
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, ps.Stream(wg))
+	require.NoError(t, streamBlock(ps, 1))
+
+	// the plugin is not restarted and the node halts on the next block
+	ps.mtx.Lock()
+	require.NoError(t, ps.cmd.Process.Kill())
+	ps.mtx.Unlock()
+	require.Eventually(t, func() bool {
+		_, err := ps.runningClient()
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the failed deliveries are reported asynchronously
+	height := int64(2)
+	require.Eventually(t, func() bool {
+		err := streamBlock(ps, height)
+		height++
+		return errors.Is(err, baseapp.ErrHaltNode)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, ps.ListenCommit(emptyContext, abci.ResponseCommit{}), baseapp.ErrHaltNode)
+
+	require.NoError(t, ps.Close())
+	wg.Wait()
+}
+
+func TestPluginQueueFull(t *testing.T) {
+	ps, _ := newTestService(t, func(config *Config) {
+		config.QueueSize = 1
+	})
+
+	// a plugin whose queue is full has fallen behind and misses the next messages, without blocking the node
+	ps.queue = make(chan message, ps.config.QueueSize)
+	require.NoError(t, ps.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	require.Error(t, ps.ListenDeliverTx(emptyContext, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}))
+	require.Len(t, ps.queue, 1)
+}
+
+func TestPluginLaunchFailure(t *testing.T) {
+	ps, _ := newTestService(t, func(config *Config) {
+		config.Command = filepath.Join(config.SocketDir, "missing")
+	})
+	require.Error(t, ps.Stream(new(sync.WaitGroup)))
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+)
+
+// Conflicts returns whether the transactions of the two read/write sets
+// contend on state, that is if a key written by one of them is read, in a
+// read range of, or written by the other. Transactions that don't conflict
+// can be executed in any order with the same result. Both sets must be
+// sorted, as returned by rwsetkv.Recorder.
+func (rws *ReadWriteSet) Conflicts(other *ReadWriteSet) bool {
+	stores := make(map[string]*StoreReadWriteSet, len(other.GetStores()))
+	for _, s := range other.GetStores() {
+		stores[s.StoreKey] = s
+	}
+	for _, s := range rws.GetStores() {
+		if o, ok := stores[s.StoreKey]; ok && (s.overwrites(o) || o.overwrites(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// overwrites returns whether a key written in s is accessed in other.
+func (s *StoreReadWriteSet) overwrites(other *StoreReadWriteSet) bool {
+	for _, key := range s.Writes {
+		if containsKey(other.Reads, key) || containsKey(other.Writes, key) {
+			return true
+		}
+		for _, rng := range other.ReadRanges {
+			if rng.Contains(key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Contains returns whether the key is within the range.
+func (r *KeyRange) Contains(key []byte) bool {
+	return (len(r.Start) == 0 || bytes.Compare(key, r.Start) >= 0) &&
+		(len(r.End) == 0 || bytes.Compare(key, r.End) < 0)
+}
+
+func containsKey(sorted [][]byte, key []byte) bool {
+	i := sort.Search(len(sorted), func(i int) bool { return bytes.Compare(sorted[i], key) >= 0 })
+	return i < len(sorted) && bytes.Equal(sorted[i], key)
+}
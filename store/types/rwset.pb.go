@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/store/v1beta1/rwset.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReadWriteSet is the set of keys and key ranges of the KVStores read and
+// written by a transaction.
+//
+// Since: cosmos-sdk 0.46
+type ReadWriteSet struct {
+	// stores are the accesses to each KVStore, sorted by store key.
+	Stores []*StoreReadWriteSet `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (m *ReadWriteSet) Reset()         { *m = ReadWriteSet{} }
+func (m *ReadWriteSet) String() string { return proto.CompactTextString(m) }
+func (*ReadWriteSet) ProtoMessage()    {}
+func (*ReadWriteSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f2232e41470877, []int{0}
+}
+func (m *ReadWriteSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadWriteSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadWriteSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadWriteSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadWriteSet.Merge(m, src)
+}
+func (m *ReadWriteSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadWriteSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadWriteSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadWriteSet proto.InternalMessageInfo
+
+func (m *ReadWriteSet) GetStores() []*StoreReadWriteSet {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// StoreReadWriteSet is the set of keys and key ranges of a KVStore read and
+// written by a transaction. Keys and ranges are sorted and unique.
+//
+// Since: cosmos-sdk 0.46
+type StoreReadWriteSet struct {
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// reads are the keys read with Get or Has.
+	Reads [][]byte `protobuf:"bytes,2,rep,name=reads,proto3" json:"reads,omitempty"`
+	// read_ranges are the domains of the iterators.
+	ReadRanges []*KeyRange `protobuf:"bytes,3,rep,name=read_ranges,json=readRanges,proto3" json:"read_ranges,omitempty"`
+	// writes are the keys set or deleted.
+	Writes [][]byte `protobuf:"bytes,4,rep,name=writes,proto3" json:"writes,omitempty"`
+}
+
+func (m *StoreReadWriteSet) Reset()         { *m = StoreReadWriteSet{} }
+func (m *StoreReadWriteSet) String() string { return proto.CompactTextString(m) }
+func (*StoreReadWriteSet) ProtoMessage()    {}
+func (*StoreReadWriteSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f2232e41470877, []int{1}
+}
+func (m *StoreReadWriteSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreReadWriteSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreReadWriteSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreReadWriteSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreReadWriteSet.Merge(m, src)
+}
+func (m *StoreReadWriteSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreReadWriteSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreReadWriteSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreReadWriteSet proto.InternalMessageInfo
+
+func (m *StoreReadWriteSet) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreReadWriteSet) GetReads() [][]byte {
+	if m != nil {
+		return m.Reads
+	}
+	return nil
+}
+
+func (m *StoreReadWriteSet) GetReadRanges() []*KeyRange {
+	if m != nil {
+		return m.ReadRanges
+	}
+	return nil
+}
+
+func (m *StoreReadWriteSet) GetWrites() [][]byte {
+	if m != nil {
+		return m.Writes
+	}
+	return nil
+}
+
+// KeyRange is the key domain [start, end) of an iterator. An empty start or
+// end leaves the domain unbounded on that side.
+//
+// Since: cosmos-sdk 0.46
+type KeyRange struct {
+	Start []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   []byte `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *KeyRange) Reset()         { *m = KeyRange{} }
+func (m *KeyRange) String() string { return proto.CompactTextString(m) }
+func (*KeyRange) ProtoMessage()    {}
+func (*KeyRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f2232e41470877, []int{2}
+}
+func (m *KeyRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRange.Merge(m, src)
+}
+func (m *KeyRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRange proto.InternalMessageInfo
+
+func (m *KeyRange) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *KeyRange) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ReadWriteSet)(nil), "cosmos.base.store.v1beta1.ReadWriteSet")
+	proto.RegisterType((*StoreReadWriteSet)(nil), "cosmos.base.store.v1beta1.StoreReadWriteSet")
+	proto.RegisterType((*KeyRange)(nil), "cosmos.base.store.v1beta1.KeyRange")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/store/v1beta1/rwset.proto", fileDescriptor_f6f2232e41470877)
+}
+
+var fileDescriptor_f6f2232e41470877 = []byte{
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4a, 0x03, 0x31,
+	0x14, 0x86, 0x9b, 0x8e, 0x96, 0x36, 0xed, 0x42, 0x83, 0x48, 0x44, 0x08, 0xa5, 0x22, 0xcc, 0x42,
+	0x33, 0xb4, 0xde, 0xa0, 0x74, 0xd7, 0x5d, 0x2a, 0x08, 0x6e, 0x4a, 0xa6, 0xf3, 0xa8, 0xa5, 0xb4,
+	0x29, 0x79, 0xd1, 0x32, 0xb7, 0xf0, 0x10, 0x1e, 0xc6, 0x65, 0x97, 0x2e, 0x65, 0xe6, 0x22, 0x92,
+	0xcc, 0x08, 0x82, 0xe8, 0x6a, 0xde, 0xff, 0xde, 0xf7, 0x4f, 0x7e, 0xf8, 0xe9, 0xf5, 0xc2, 0xe0,
+	0xc6, 0x60, 0x92, 0x6a, 0x84, 0x04, 0x9d, 0xb1, 0x90, 0xbc, 0x0c, 0x53, 0x70, 0x7a, 0x98, 0xd8,
+	0x3d, 0x82, 0x93, 0x3b, 0x6b, 0x9c, 0x61, 0x17, 0x15, 0x26, 0x3d, 0x26, 0x03, 0x26, 0x6b, 0x6c,
+	0x70, 0x4f, 0x7b, 0x0a, 0x74, 0xf6, 0x60, 0x57, 0x0e, 0x66, 0xe0, 0xd8, 0x84, 0xb6, 0x02, 0x80,
+	0x9c, 0xf4, 0xa3, 0xb8, 0x3b, 0xba, 0x91, 0x7f, 0x7a, 0xe5, 0xcc, 0xab, 0x9f, 0x6e, 0x55, 0x7b,
+	0x07, 0x6f, 0x84, 0x9e, 0xfe, 0xba, 0xb2, 0x4b, 0xda, 0x09, 0xf7, 0xf9, 0x1a, 0x72, 0x4e, 0xfa,
+	0x24, 0xee, 0xa8, 0x76, 0x58, 0x4c, 0x21, 0x67, 0x67, 0xf4, 0xd8, 0x82, 0xce, 0x90, 0x37, 0xfb,
+	0x51, 0xdc, 0x53, 0x95, 0x60, 0x13, 0xda, 0xf5, 0xc3, 0xdc, 0xea, 0xed, 0x12, 0x90, 0x47, 0x21,
+	0xd3, 0xd5, 0x3f, 0x99, 0xa6, 0x90, 0x2b, 0xcf, 0x2a, 0xea, 0x7d, 0x61, 0x44, 0x76, 0x4e, 0x5b,
+	0x7b, 0x1f, 0x02, 0xf9, 0x51, 0xf8, 0x79, 0xad, 0x06, 0x23, 0xda, 0xfe, 0xe6, 0xfd, 0xfb, 0xe8,
+	0xb4, 0x75, 0x21, 0x58, 0x4f, 0x55, 0x82, 0x9d, 0xd0, 0x08, 0xb6, 0x19, 0x6f, 0x86, 0x9d, 0x1f,
+	0xc7, 0xe3, 0xf7, 0x42, 0x90, 0x43, 0x21, 0xc8, 0x67, 0x21, 0xc8, 0x6b, 0x29, 0x1a, 0x87, 0x52,
+	0x34, 0x3e, 0x4a, 0xd1, 0x78, 0x8c, 0x97, 0x2b, 0xf7, 0xf4, 0x9c, 0xca, 0x85, 0xd9, 0x24, 0x75,
+	0x2f, 0xd5, 0xe7, 0x16, 0xb3, 0x75, 0xdd, 0x8e, 0xcb, 0x77, 0x80, 0x69, 0x2b, 0xd4, 0x72, 0xf7,
+	0x35, 0x00, 0x82, 0x6a, 0x92, 0x84, 0xbf, 0x01, 0x00, 0x00,
+}
+
+func (m *ReadWriteSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadWriteSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadWriteSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRwset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreReadWriteSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreReadWriteSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreReadWriteSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Writes) > 0 {
+		for iNdEx := len(m.Writes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Writes[iNdEx])
+			copy(dAtA[i:], m.Writes[iNdEx])
+			i = encodeVarintRwset(dAtA, i, uint64(len(m.Writes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReadRanges) > 0 {
+		for iNdEx := len(m.ReadRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReadRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRwset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reads) > 0 {
+		for iNdEx := len(m.Reads) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reads[iNdEx])
+			copy(dAtA[i:], m.Reads[iNdEx])
+			i = encodeVarintRwset(dAtA, i, uint64(len(m.Reads[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintRwset(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintRwset(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintRwset(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRwset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRwset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReadWriteSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovRwset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreReadWriteSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovRwset(uint64(l))
+	}
+	if len(m.Reads) > 0 {
+		for _, b := range m.Reads {
+			l = len(b)
+			n += 1 + l + sovRwset(uint64(l))
+		}
+	}
+	if len(m.ReadRanges) > 0 {
+		for _, e := range m.ReadRanges {
+			l = e.Size()
+			n += 1 + l + sovRwset(uint64(l))
+		}
+	}
+	if len(m.Writes) > 0 {
+		for _, b := range m.Writes {
+			l = len(b)
+			n += 1 + l + sovRwset(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovRwset(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovRwset(uint64(l))
+	}
+	return n
+}
+
+func sovRwset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRwset(x uint64) (n int) {
+	return sovRwset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReadWriteSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRwset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadWriteSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadWriteSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRwset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, &StoreReadWriteSet{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRwset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreReadWriteSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRwset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreReadWriteSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreReadWriteSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRwset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRwset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reads = append(m.Reads, make([]byte, postIndex-iNdEx))
+			copy(m.Reads[len(m.Reads)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRwset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadRanges = append(m.ReadRanges, &KeyRange{})
+			if err := m.ReadRanges[len(m.ReadRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRwset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writes = append(m.Writes, make([]byte, postIndex-iNdEx))
+			copy(m.Writes[len(m.Writes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRwset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRwset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRwset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRwset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRwset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRwset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRwset(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRwset
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRwset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRwset
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRwset
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRwset
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRwset        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRwset          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRwset = fmt.Errorf("proto: unexpected end of group")
+)
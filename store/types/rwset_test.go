@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWriteSetConflicts(t *testing.T) {
+	rwSet := func(storeKey string, reads, writes []string, ranges ...[2]string) *ReadWriteSet {
+		s := &StoreReadWriteSet{StoreKey: storeKey}
+		for _, key := range reads {
+			s.Reads = append(s.Reads, []byte(key))
+		}
+		for _, key := range writes {
+			s.Writes = append(s.Writes, []byte(key))
+		}
+		for _, rng := range ranges {
+			s.ReadRanges = append(s.ReadRanges, &KeyRange{Start: []byte(rng[0]), End: []byte(rng[1])})
+		}
+		return &ReadWriteSet{Stores: []*StoreReadWriteSet{s}}
+	}
+
+	testCases := []struct {
+		name      string
+		a, b      *ReadWriteSet
+		conflicts bool
+	}{
+		{"empty", &ReadWriteSet{}, rwSet("bank", []string{"a"}, []string{"a"}), false},
+		{"reads only", rwSet("bank", []string{"a", "b"}, nil), rwSet("bank", []string{"b"}, nil), false},
+		{"read after write", rwSet("bank", nil, []string{"b"}), rwSet("bank", []string{"a", "b"}, nil), true},
+		{"write after read", rwSet("bank", []string{"a", "b"}, nil), rwSet("bank", nil, []string{"b"}), true},
+		{"write after write", rwSet("bank", nil, []string{"a"}), rwSet("bank", nil, []string{"a"}), true},
+		{"other store", rwSet("bank", nil, []string{"a"}), rwSet("staking", []string{"a"}, nil), false},
+		{"in range", rwSet("bank", nil, []string{"b"}), rwSet("bank", nil, nil, [2]string{"a", "c"}), true},
+		{"range end", rwSet("bank", nil, []string{"c"}), rwSet("bank", nil, nil, [2]string{"a", "c"}), false},
+		{"unbounded range", rwSet("bank", nil, []string{"z"}), rwSet("bank", nil, nil, [2]string{"a", ""}), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.conflicts, tc.a.Conflicts(tc.b))
+			require.Equal(t, tc.conflicts, tc.b.Conflicts(tc.a))
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package multi
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	prefixdb "github.com/cosmos/cosmos-sdk/db/prefix"
+	util "github.com/cosmos/cosmos-sdk/internal"
+)
+
+var (
+	// Archive DB prefixes
+	archiveStatePrefix           = []byte{0} // Prefix for archived state storage
+	archiveStateCommitmentPrefix = []byte{1} // Prefix for archived state commitment, if stored in a separate DB
+)
+
+// Subdomains of the changed keys recorded under changesPrefix
+const (
+	changedState byte = iota
+	changedStateCommitment
+)
+
+// Keys written to the state and state commitment DBs by a version.
+type versionChanges struct {
+	state, stateCommitment map[string]struct{}
+}
+
+// Returns the prefix of the changes recorded for a version under changesPrefix.
+func versionChangesPrefix(version uint64) []byte {
+	ret := make([]byte, len(changesPrefix)+8)
+	copy(ret, changesPrefix)
+	binary.BigEndian.PutUint64(ret[len(changesPrefix):], version)
+	return ret
+}
+
+// Returns the key recording that a version changed a key of a subdomain.
+func changeKey(version uint64, subdomain byte, key []byte) []byte {
+	return append(append(versionChangesPrefix(version), subdomain), key...)
+}
+
+// Returns the writer of the working version which bypasses the change recorder, used to maintain the
+// recorded changes themselves.
+func (s *Store) changesWriter() dbm.DBReadWriter {
+	if recorder, ok := s.stateTxn.(changeRecorder); ok {
+		return recorder.DBReadWriter
+	}
+	return s.stateTxn
+}
+
+// Returns the first version whose changes are recorded, or 0 if none is.
+func (s *Store) changesFrom() (uint64, error) {
+	bz, err := s.stateTxn.Get(changesFromKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// Records that the changes are recorded from a version on.
+func (s *Store) setChangesFrom(version uint64) error {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	return s.changesWriter().Set(changesFromKey, bz)
+}
+
+// Saves the changes of the working version under the target version ID, in the same transaction,
+// so that they survive restarts until the version is archived. If versions are not pruned, their
+// changes need not be kept, and are only recorded from the next version on.
+func (s *Store) saveChanges(target uint64) error {
+	if s.Pruning.Interval == 0 {
+		return s.setChangesFrom(target + 1)
+	}
+	writer := s.changesWriter()
+	for subdomain, changed := range map[byte]map[string]struct{}{
+		changedState:           s.pendingChanges.state,
+		changedStateCommitment: s.pendingChanges.stateCommitment,
+	} {
+		for key := range changed {
+			if err := writer.Set(changeKey(target, subdomain, []byte(key)), []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Wraps a DB transaction to record the keys it writes.
+type changeRecorder struct {
+	dbm.DBReadWriter
+	changed map[string]struct{}
+}
+
+func (r changeRecorder) Set(key, value []byte) error {
+	r.changed[string(key)] = struct{}{}
+	return r.DBReadWriter.Set(key, value)
+}
+
+func (r changeRecorder) Delete(key []byte) error {
+	r.changed[string(key)] = struct{}{}
+	return r.DBReadWriter.Delete(key)
+}
+
+// Starts recording the changes of the working version written through the given transactions.
+func (s *Store) recordChanges(stateTxn, stateCommitmentTxn dbm.DBReadWriter) (dbm.DBReadWriter, dbm.DBReadWriter) {
+	s.pendingChanges = versionChanges{state: map[string]struct{}{}, stateCommitment: map[string]struct{}{}}
+	stateRecorder := changeRecorder{stateTxn, s.pendingChanges.state}
+	if s.StateCommitmentDB == nil {
+		return stateRecorder, stateRecorder
+	}
+	return stateRecorder, changeRecorder{stateCommitmentTxn, s.pendingChanges.stateCommitment}
+}
+
+// Returns the keys written since the last archived version up to a version, or false if
+// some of these versions were saved before their changes were recorded.
+func (s *Store) changesSince(lastArchived, version uint64) (ret versionChanges, ok bool, err error) {
+	from, err := s.changesFrom()
+	if err != nil || from == 0 || from > lastArchived+1 {
+		return
+	}
+	iter, err := s.stateTxn.Iterator(versionChangesPrefix(lastArchived+1), versionChangesPrefix(version+1))
+	if err != nil {
+		return
+	}
+	defer func() { err = util.CombineErrors(err, iter.Close(), "iter.Close also failed") }()
+
+	ret = versionChanges{state: map[string]struct{}{}, stateCommitment: map[string]struct{}{}}
+	for iter.Next() {
+		key := iter.Key()[len(changesPrefix)+8:]
+		switch key[0] {
+		case changedState:
+			ret.state[string(key[1:])] = struct{}{}
+		case changedStateCommitment:
+			ret.stateCommitment[string(key[1:])] = struct{}{}
+		}
+	}
+	return ret, true, iter.Error()
+}
+
+// Deletes the recorded changes of the versions up to an archived version.
+func (s *Store) deleteChanges(version uint64) (err error) {
+	iter, err := s.stateTxn.Iterator(versionChangesPrefix(0), versionChangesPrefix(version+1))
+	if err != nil {
+		return
+	}
+	var keys [][]byte
+	for iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err = util.CombineErrors(iter.Error(), iter.Close(), "iter.Close also failed"); err != nil {
+		return
+	}
+	writer := s.changesWriter()
+	for _, key := range keys {
+		if err = writer.Delete(key); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Copies a version about to be pruned to the archive DB, under the same version ID.
+// Only the changes since the last archived version are written, so that backends which store
+// versions as diffs (e.g. badger) keep the archive compact. These are the keys written by the
+// versions committed since, which are saved along with each version, or else the differences found
+// by comparing the whole state with the archive, which is only needed for the first version archived
+// after the archive is enabled.
+// Versions already archived, or older than the last archived version, are skipped.
+func (s *Store) archiveVersion(version uint64) (err error) {
+	versions, err := s.ArchiveDB.Versions()
+	if err != nil {
+		return
+	}
+	var lastArchived uint64
+	if versions.Count() != 0 {
+		lastArchived = versions.Last()
+	}
+	if version <= lastArchived {
+		return
+	}
+	changes, incremental, err := s.changesSince(lastArchived, version)
+	if err != nil {
+		return
+	}
+
+	// The working state of the archive holds the contents of the last archived version
+	archived := s.ArchiveDB.Reader()
+	defer func() {
+		err = util.CombineErrors(err, archived.Discard(), "archived.Discard also failed")
+	}()
+	writer := s.ArchiveDB.Writer()
+	defer func() {
+		if err != nil {
+			err = util.CombineErrors(err, writer.Discard(), "writer.Discard also failed")
+		}
+	}()
+
+	stateView, err := s.stateDB.ReaderAt(version)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = util.CombineErrors(err, stateView.Discard(), "stateView.Discard also failed")
+	}()
+	if incremental {
+		err = archiveChanges(stateView, writer, archiveStatePrefix, changes.state)
+	} else {
+		err = syncArchive(stateView, archived, writer, archiveStatePrefix, changesPrefix)
+	}
+	if err != nil {
+		return
+	}
+	if s.StateCommitmentDB != nil {
+		var stateCommitmentView dbm.DBReader
+		stateCommitmentView, err = s.StateCommitmentDB.ReaderAt(version)
+		if err != nil {
+			return
+		}
+		defer func() {
+			err = util.CombineErrors(err, stateCommitmentView.Discard(), "stateCommitmentView.Discard also failed")
+		}()
+		if incremental {
+			err = archiveChanges(stateCommitmentView, writer, archiveStateCommitmentPrefix, changes.stateCommitment)
+		} else {
+			err = syncArchive(stateCommitmentView, archived, writer, archiveStateCommitmentPrefix, nil)
+		}
+		if err != nil {
+			return
+		}
+	}
+
+	if err = writer.Commit(); err != nil {
+		return
+	}
+	if err = s.ArchiveDB.SaveVersion(version); err != nil {
+		return
+	}
+	return s.deleteChanges(version)
+}
+
+// Writes to an archive subdomain the source values of the changed keys.
+func archiveChanges(source dbm.DBReader, writer dbm.DBWriter, prefix []byte, changed map[string]struct{}) (err error) {
+	out := prefixdb.NewPrefixWriter(writer, prefix)
+	for key := range changed {
+		var value []byte
+		value, err = source.Get([]byte(key))
+		if err != nil {
+			return
+		}
+		if value == nil {
+			err = out.Delete([]byte(key))
+		} else {
+			err = out.Set([]byte(key), value)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Writes to an archive subdomain the differences between the source contents and the archived ones,
+// by merging their sorted iterators. Source keys under the excluded prefix, if any, are not archived.
+func syncArchive(source, archive dbm.DBReader, writer dbm.DBWriter, prefix, exclude []byte) (err error) {
+	src, err := source.Iterator(nil, nil)
+	if err != nil {
+		return
+	}
+	defer func() { err = util.CombineErrors(err, src.Close(), "src.Close also failed") }()
+	dst, err := prefixdb.NewPrefixReader(archive, prefix).Iterator(nil, nil)
+	if err != nil {
+		return
+	}
+	defer func() { err = util.CombineErrors(err, dst.Close(), "dst.Close also failed") }()
+	out := prefixdb.NewPrefixWriter(writer, prefix)
+
+	srcValid, dstValid := src.Next(), dst.Next()
+	for srcValid || dstValid {
+		if srcValid && len(exclude) != 0 && bytes.HasPrefix(src.Key(), exclude) {
+			srcValid = src.Next()
+			continue
+		}
+		var cmp int
+		switch {
+		case !dstValid:
+			cmp = -1
+		case !srcValid:
+			cmp = 1
+		default:
+			cmp = bytes.Compare(src.Key(), dst.Key())
+		}
+		switch {
+		case cmp < 0: // added
+			err = out.Set(src.Key(), src.Value())
+			srcValid = src.Next()
+		case cmp > 0: // deleted
+			err = out.Delete(dst.Key())
+			dstValid = dst.Next()
+		default: // possibly updated
+			if !bytes.Equal(src.Value(), dst.Value()) {
+				err = out.Set(src.Key(), src.Value())
+			}
+			srcValid, dstValid = src.Next(), dst.Next()
+		}
+		if err != nil {
+			return
+		}
+	}
+	if err = src.Error(); err != nil {
+		return
+	}
+	return dst.Error()
+}
+
+// Opens readers of the state and state commitment of an archived version.
+func (s *Store) archivedReadersAt(version uint64) (stateView, stateCommitmentView dbm.DBReader, err error) {
+	archived, err := s.ArchiveDB.ReaderAt(version)
+	if err != nil {
+		return
+	}
+	stateView = prefixdb.NewPrefixReader(archived, archiveStatePrefix)
+	stateCommitmentView = stateView
+	if s.StateCommitmentDB != nil {
+		stateCommitmentView = prefixdb.NewPrefixReader(archived, archiveStateCommitmentPrefix)
+	}
+	return
+}
@@ -15,5 +15,12 @@
 // Each substore's SC is allocated as an independent SMT, and query proofs contain two components: a proof
 // of a key's (non)existence within the substore SMT, and a proof of the substore's existence within the
 // MultiStore (using the Merkle map proof spec (TendermintSpec)).
+//
+// If the MultiStore is configured with an ArchiveDB, versions are copied there before being pruned, so
+// that historical versions remain available without being kept in the main DBs. Each archived version
+// is saved under the same version ID in the archive, which only receives the changes since the last
+// archived version. These are the keys written by each version, which are saved along with the version
+// until it is archived, so that they survive restarts. Views of versions no longer in the main DBs are read
+// from the archive on demand.
 
 package multi
@@ -1,13 +1,16 @@
 package multi
 
 import (
+	"bytes"
 	"crypto/sha256"
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	types "github.com/cosmos/cosmos-sdk/store/v2alpha1"
 	"github.com/cosmos/cosmos-sdk/store/v2alpha1/smt"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // DefaultProofRuntime returns a ProofRuntime supporting SMT and simple merkle proofs.
@@ -30,6 +33,18 @@ func proveKey(s *smt.Store, key []byte) (*tmcrypto.ProofOps, error) {
 	return &ret, nil
 }
 
+// Prove commitment of many keys within an smt store in a single batch proof and return ProofOps.
+// The op has no key, as it proves all of them.
+func proveKeys(s *smt.Store, keys [][]byte) (*tmcrypto.ProofOps, error) {
+	var ret tmcrypto.ProofOps
+	keysProof, err := s.GetBatchProofICS23(keys)
+	if err != nil {
+		return nil, err
+	}
+	ret.Ops = append(ret.Ops, types.NewSmtCommitmentOp(nil, keysProof).ProofOp())
+	return &ret, nil
+}
+
 // GetProof returns ProofOps containing: a proof for the given key within this substore;
 // and a proof of the substore's existence within the MultiStore.
 func (s *viewSubstore) GetProof(key []byte) (*tmcrypto.ProofOps, error) {
@@ -37,8 +52,21 @@ func (s *viewSubstore) GetProof(key []byte) (*tmcrypto.ProofOps, error) {
 	if err != nil {
 		return nil, err
 	}
+	return s.appendStoreProof(ret)
+}
 
-	// Prove commitment of substore within root store
+// GetBatchProof returns ProofOps containing: a batch proof for the given keys within this substore;
+// and a proof of the substore's existence within the MultiStore.
+func (s *viewSubstore) GetBatchProof(keys [][]byte) (*tmcrypto.ProofOps, error) {
+	ret, err := proveKeys(s.stateCommitmentStore, keys)
+	if err != nil {
+		return nil, err
+	}
+	return s.appendStoreProof(ret)
+}
+
+// Prove commitment of substore within root store
+func (s *viewSubstore) appendStoreProof(ret *tmcrypto.ProofOps) (*tmcrypto.ProofOps, error) {
 	storeHashes, err := s.root.getMerkleRoots()
 	if err != nil {
 		return nil, err
@@ -50,3 +78,43 @@ func (s *viewSubstore) GetProof(key []byte) (*tmcrypto.ProofOps, error) {
 	ret.Ops = append(ret.Ops, storeProof)
 	return ret, nil
 }
+
+// VerifyBatchProof verifies the ProofOps of a multi-key query of a substore against the root hash of
+// the MultiStore. Each key of items must be proven to have its value, or to be absent if the value
+// is nil.
+func VerifyBatchProof(proofOps *tmcrypto.ProofOps, root []byte, storeName string, items map[string][]byte) error {
+	if proofOps == nil || len(proofOps.Ops) != 2 {
+		return sdkerrors.Wrap(storetypes.ErrInvalidProof, "batch proof must have 2 ops")
+	}
+	keysOp, err := types.CommitmentOpDecoder(proofOps.Ops[0])
+	if err != nil {
+		return err
+	}
+	keysProof := keysOp.(storetypes.CommitmentOp)
+	if keysProof.Type != types.ProofOpSMTCommitment {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "unexpected batch proof type %s", keysProof.Type)
+	}
+	storeRoot, err := keysProof.Proof.Calculate()
+	if err != nil {
+		return sdkerrors.Wrap(storetypes.ErrInvalidProof, err.Error())
+	}
+	if err = smt.VerifyBatchProofICS23(storeRoot, keysProof.Proof, items); err != nil {
+		return sdkerrors.Wrap(storetypes.ErrInvalidProof, err.Error())
+	}
+
+	storeOp, err := types.CommitmentOpDecoder(proofOps.Ops[1])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(storeOp.GetKey(), []byte(storeName)) {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "store proof is for %s instead of %s", storeOp.GetKey(), storeName)
+	}
+	roots, err := storeOp.Run([][]byte{storeRoot})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(roots[0], root) {
+		return sdkerrors.Wrapf(storetypes.ErrInvalidProof, "calculated root %X does not match %X", roots[0], root)
+	}
+	return nil
+}
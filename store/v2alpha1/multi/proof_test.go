@@ -6,9 +6,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/store/v2alpha1/smt"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
 // We hash keys produce SMT paths, so reflect that here
@@ -123,3 +126,70 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.ProofOps, cid.Hash, keyPath("/store1/", "MYABSENTKEY"), []byte(""))
 	require.Error(t, err)
 }
+
+func TestVerifyMultiStoreBatchQueryProof(t *testing.T) {
+	db := memdb.NewDB()
+	store, err := NewStore(db, simpleStoreConfig(t))
+	require.NoError(t, err)
+
+	substore := store.GetKVStore(skey_1)
+	substore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+	substore.Set([]byte("MYKEY2"), []byte("MYVALUE2"))
+	substore.Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	cid := store.Commit()
+
+	query := kv.Pairs{Pairs: []kv.Pair{
+		{Key: []byte("MYKEY1")}, {Key: []byte("MYKEY2")}, {Key: []byte("MYABSENTKEY")},
+	}}
+	bz, err := query.Marshal()
+	require.NoError(t, err)
+	res := store.Query(abci.RequestQuery{
+		Path:  "/store1/keys", // required path to get key/values+proof
+		Data:  bz,
+		Prove: true,
+	})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.NotNil(t, res.ProofOps)
+
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Len(t, pairs.Pairs, 3)
+	items := map[string][]byte{}
+	for _, pair := range pairs.Pairs {
+		items[string(pair.Key)] = pair.Value
+	}
+	require.Equal(t, map[string][]byte{
+		"MYKEY1":      []byte("MYVALUE1"),
+		"MYKEY2":      []byte("MYVALUE2"),
+		"MYABSENTKEY": nil,
+	}, items)
+
+	// Verify good proof.
+	require.NoError(t, VerifyBatchProof(res.ProofOps, cid.Hash, "store1", items))
+	require.NoError(t, VerifyBatchProof(res.ProofOps, cid.Hash, "store1", map[string][]byte{"MYKEY2": []byte("MYVALUE2")}))
+
+	// Fail to verify bad proofs.
+	for name, items := range map[string]map[string][]byte{
+		"value":     {"MYKEY1": []byte("MYVALUE_NOT")},
+		"absence":   {"MYKEY1": nil},
+		"existence": {"MYABSENTKEY": []byte("MYVALUE1")},
+		"unproven":  {"OTHERKEY": []byte("OTHERVALUE")},
+	} {
+		require.ErrorIs(t, VerifyBatchProof(res.ProofOps, cid.Hash, "store1", items), types.ErrInvalidProof, name)
+	}
+	require.Error(t, VerifyBatchProof(res.ProofOps, cid.Hash, "store2", items))
+	require.Error(t, VerifyBatchProof(res.ProofOps, []byte("BADROOT"), "store1", items))
+	require.Error(t, VerifyBatchProof(&tmcrypto.ProofOps{Ops: res.ProofOps.Ops[:1]}, cid.Hash, "store1", items))
+
+	// Values are returned without proofs too.
+	res = store.Query(abci.RequestQuery{Path: "/store1/keys", Data: bz})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Nil(t, res.ProofOps)
+	pairs = kv.Pairs{}
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Len(t, pairs.Pairs, 3)
+
+	// Malformed keys are rejected.
+	res = store.Query(abci.RequestQuery{Path: "/store1/keys", Data: []byte("MYKEY1"), Prove: true})
+	require.NotEqual(t, uint32(0), res.Code)
+}
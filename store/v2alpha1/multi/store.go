@@ -38,6 +38,10 @@ var (
 	merkleRootKey = []byte{0} // Key for root hash of namespace tree
 	schemaPrefix  = []byte{1} // Prefix for store keys (namespaces)
 	contentPrefix = []byte{2} // Prefix for store contents
+	changesPrefix = []byte{3} // Prefix for the keys changed by the versions not archived yet
+
+	// Key of the first version whose changes are recorded under changesPrefix
+	changesFromKey = changesPrefix
 
 	// Per-substore prefixes
 	substoreMerkleRootKey = []byte{0} // Key for root hashes of Merkle trees
@@ -62,6 +66,9 @@ type StoreConfig struct {
 	// The backing DB to use for the state commitment Merkle tree data.
 	// If nil, Merkle data is stored in the state storage DB under a separate prefix.
 	StateCommitmentDB dbm.DBConnection
+	// The backing DB to move pruned versions to, so they can still be queried.
+	// If nil, pruned versions are deleted.
+	ArchiveDB dbm.DBConnection
 
 	prefixRegistry
 	PersistentCache types.MultiStorePersistentCache
@@ -85,6 +92,10 @@ type Store struct {
 	stateTxn           dbm.DBReadWriter
 	StateCommitmentDB  dbm.DBConnection
 	stateCommitmentTxn dbm.DBReadWriter
+	ArchiveDB          dbm.DBConnection
+
+	// Keys written by the working version, recorded when archiving
+	pendingChanges versionChanges
 
 	schema StoreSchema
 	mem    *mem.Store
@@ -257,12 +268,20 @@ func NewStore(db dbm.DBConnection, opts StoreConfig) (ret *Store, err error) {
 		}
 		stateCommitmentTxn = opts.StateCommitmentDB.ReadWriter()
 	}
+	if opts.ArchiveDB != nil {
+		// Discard any version only partially archived
+		err = opts.ArchiveDB.Revert()
+		if err != nil {
+			return
+		}
+	}
 
 	ret = &Store{
 		stateDB:            db,
 		stateTxn:           stateTxn,
 		StateCommitmentDB:  opts.StateCommitmentDB,
 		stateCommitmentTxn: stateCommitmentTxn,
+		ArchiveDB:          opts.ArchiveDB,
 		mem:                mem.NewStore(),
 		tran:               transient.NewStore(),
 
@@ -274,6 +293,30 @@ func NewStore(db dbm.DBConnection, opts StoreConfig) (ret *Store, err error) {
 		Pruning:        opts.Pruning,
 		InitialVersion: opts.InitialVersion,
 	}
+	if ret.ArchiveDB != nil {
+		ret.stateTxn, ret.stateCommitmentTxn = ret.recordChanges(stateTxn, stateCommitmentTxn)
+		// The changes of the versions saved without an archive are unknown
+		var from uint64
+		if from, err = ret.changesFrom(); err != nil {
+			return
+		}
+		if from == 0 {
+			if err = ret.setChangesFrom(versions.Last() + 1); err != nil {
+				return
+			}
+		}
+	} else {
+		// Versions saved from now on are not recorded, so recorded changes would be incomplete
+		var has bool
+		if has, err = stateTxn.Has(changesFromKey); err != nil {
+			return
+		}
+		if has {
+			if err = stateTxn.Delete(changesFromKey); err != nil {
+				return
+			}
+		}
+	}
 
 	// Now load the substore schema
 	schemaView := prefixdb.NewPrefixReader(ret.stateDB.Reader(), schemaPrefix)
@@ -547,6 +590,11 @@ func (s *Store) Commit() types.CommitID {
 		firstPrunable := lastPrunable - int64(s.Pruning.Interval)
 
 		for version := firstPrunable; version <= lastPrunable; version++ {
+			if s.ArchiveDB != nil && version > 0 && versions.Exists(uint64(version)) {
+				if err = s.archiveVersion(uint64(version)); err != nil {
+					panic(err)
+				}
+			}
 			s.stateDB.DeleteVersion(uint64(version))
 
 			if s.StateCommitmentDB != nil {
@@ -592,6 +640,11 @@ func (s *Store) commit(target uint64) (id *types.CommitID, err error) {
 	if err = s.stateTxn.Set(merkleRootKey, rootHash); err != nil {
 		return
 	}
+	if s.ArchiveDB != nil {
+		if err = s.saveChanges(target); err != nil {
+			return
+		}
+	}
 	if err = s.stateTxn.Commit(); err != nil {
 		return
 	}
@@ -641,6 +694,9 @@ func (s *Store) commit(target uint64) (id *types.CommitID, err error) {
 		stateCommitmentTxn = s.StateCommitmentDB.ReadWriter()
 	}
 
+	if s.ArchiveDB != nil {
+		stateTxn, stateCommitmentTxn = s.recordChanges(stateTxn, stateCommitmentTxn)
+	}
 	s.stateTxn = stateTxn
 	s.stateCommitmentTxn = stateCommitmentTxn
 	// the state of all live substores must be refreshed
@@ -772,6 +828,33 @@ func (rs *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 			return sdkerrors.QueryResult(fmt.Errorf("Merkle proof creation failed for key: %v", res.Key), false) //nolint: stylecheck // proper name
 		}
 
+	case "/keys":
+		res.Key = req.Data // data holds the KV pairs of the keys, without values
+
+		var pairs kv.Pairs
+		if err := pairs.Unmarshal(req.Data); err != nil {
+			return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "failed to unmarshal keys"), false)
+		}
+		keys := make([][]byte, len(pairs.Pairs))
+		for i, pair := range pairs.Pairs {
+			keys[i] = pair.Key
+			pairs.Pairs[i].Value = substore.Get(pair.Key)
+		}
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+		res.Value = bz
+		if !req.Prove {
+			break
+		}
+		// all keys are proven by a single batch proof
+		res.ProofOps, err = substore.GetBatchProof(keys)
+		if err != nil {
+			return sdkerrors.QueryResult(fmt.Errorf("Merkle proof creation failed for keys: %w", err), false) //nolint: stylecheck // proper name
+		}
+
 	case "/subspace":
 		res.Key = req.Data // data holds the subspace prefix
 
@@ -2,12 +2,14 @@ package multi
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -470,6 +472,118 @@ func TestPruning(t *testing.T) {
 	}
 }
 
+func TestArchive(t *testing.T) {
+	for _, separateSC := range []bool{false, true} {
+		db, scDB, archiveDB := memdb.NewDB(), memdb.NewDB(), memdb.NewDB()
+		opts := simpleStoreConfig(t)
+		opts.Pruning = types.PruningOptions{2, 5}
+		opts.ArchiveDB = archiveDB
+		if separateSC {
+			opts.StateCommitmentDB = scDB
+		}
+		store, err := NewStore(db, opts)
+		require.NoError(t, err)
+
+		// each version adds a key, updates the previous one and deletes the one before
+		hashes := map[int64][]byte{}
+		commitVersions := func(from, to byte) {
+			s1 := store.GetKVStore(skey_1)
+			for i := from; i <= to; i++ {
+				s1.Set([]byte{i}, []byte{i})
+				if i > 1 {
+					s1.Set([]byte{i - 1}, []byte{i})
+				}
+				if i > 2 {
+					s1.Delete([]byte{i - 2})
+				}
+				cid := store.Commit()
+				hashes[cid.Version] = cid.Hash
+			}
+		}
+		commitVersions(1, 20)
+
+		// pruned versions are moved to the archive
+		versions, err := db.Versions()
+		require.NoError(t, err)
+		archived, err := archiveDB.Versions()
+		require.NoError(t, err)
+		for v := uint64(1); v <= 20; v++ {
+			require.NotEqual(t, versions.Exists(v), archived.Exists(v), "version %d", v)
+		}
+		require.Equal(t, uint64(17), archived.Last())
+
+		// the changes of archived versions are deleted
+		changes, incremental, err := store.changesSince(0, 17)
+		require.NoError(t, err)
+		require.True(t, incremental)
+		require.Empty(t, changes.state)
+		require.Empty(t, changes.stateCommitment)
+
+		// the changes of the versions not archived yet are saved with them, and archived incrementally
+		// after reopening the store
+		require.NoError(t, store.Close())
+		store, err = NewStore(db, opts)
+		require.NoError(t, err)
+		changes, incremental, err = store.changesSince(17, 20)
+		require.NoError(t, err)
+		require.True(t, incremental)
+		require.NotEmpty(t, changes.state)
+		commitVersions(21, 30)
+		archived, err = archiveDB.Versions()
+		require.NoError(t, err)
+		require.Equal(t, uint64(27), archived.Last())
+
+		// all versions can be read and queried with proofs, including after reopening the store
+		require.NoError(t, store.Close())
+		store, err = NewStore(db, opts)
+		require.NoError(t, err)
+		for v := int64(1); v <= 30; v++ {
+			view, err := store.GetVersion(v)
+			require.NoError(t, err)
+			var pairs []kv.Pair
+			iter := view.GetKVStore(skey_1).Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				pairs = append(pairs, kv.Pair{Key: iter.Key(), Value: iter.Value()})
+			}
+			require.NoError(t, iter.Close())
+			expected := []kv.Pair{{Key: []byte{byte(v)}, Value: []byte{byte(v)}}}
+			if v > 1 {
+				expected = append([]kv.Pair{{Key: []byte{byte(v - 1)}, Value: []byte{byte(v)}}}, expected...)
+			}
+			require.Equal(t, expected, pairs, "version %d", v)
+
+			res := store.Query(abci.RequestQuery{
+				Path:   queryPath(skey_1, "/key"),
+				Data:   []byte{byte(v)},
+				Height: v,
+				Prove:  true,
+			})
+			require.Equal(t, uint32(0), res.Code, res.Log)
+			hashed := sha256.Sum256([]byte{byte(v)})
+			path := merkle.KeyPath{}.AppendKey([]byte("store1"), merkle.KeyEncodingURL).AppendKey(hashed[:], merkle.KeyEncodingHex)
+			require.NoError(t, DefaultProofRuntime().VerifyValue(res.ProofOps, hashes[v], path.String(), []byte{byte(v)}))
+		}
+
+		_, err = store.GetVersion(31)
+		require.Error(t, err)
+
+		// the changes of versions saved without the archive are unknown
+		require.NoError(t, store.Close())
+		opts.ArchiveDB = nil
+		store, err = NewStore(db, opts)
+		require.NoError(t, err)
+		commitVersions(31, 31)
+		require.NoError(t, store.Close())
+		opts.ArchiveDB = archiveDB
+		store, err = NewStore(db, opts)
+		require.NoError(t, err)
+		_, incremental, err = store.changesSince(27, 31)
+		require.NoError(t, err)
+		require.False(t, incremental)
+		require.NoError(t, store.Close())
+	}
+}
+
 func queryPath(skey types.StoreKey, endp string) string { return "/" + skey.Name() + endp }
 
 func TestQuery(t *testing.T) {
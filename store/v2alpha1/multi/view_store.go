@@ -101,6 +101,10 @@ func (s *viewStore) getMerkleRoots() (ret map[string][]byte, err error) {
 
 func (store *Store) getView(version int64) (ret *viewStore, err error) {
 	stateView, err := store.stateDB.ReaderAt(uint64(version))
+	if errors.Is(err, dbm.ErrVersionDoesNotExist) && store.ArchiveDB != nil {
+		// Pruned versions are loaded from the archive when queried
+		return store.getArchivedView(version)
+	}
 	if err != nil {
 		return
 	}
@@ -122,6 +126,25 @@ func (store *Store) getView(version int64) (ret *viewStore, err error) {
 			}
 		}()
 	}
+	return newViewStore(stateView, stateCommitmentView)
+}
+
+// Returns a view of a version moved to the archive DB
+func (store *Store) getArchivedView(version int64) (ret *viewStore, err error) {
+	stateView, stateCommitmentView, err := store.archivedReadersAt(uint64(version))
+	if err != nil {
+		return
+	}
+	defer func() {
+		// Both views share the archive reader
+		if err != nil {
+			err = util.CombineErrors(err, stateView.Discard(), "stateView.Discard also failed")
+		}
+	}()
+	return newViewStore(stateView, stateCommitmentView)
+}
+
+func newViewStore(stateView, stateCommitmentView dbm.DBReader) (ret *viewStore, err error) {
 	// Now read this version's schema
 	schemaView := prefixdb.NewPrefixReader(stateView, schemaPrefix)
 	defer func() {
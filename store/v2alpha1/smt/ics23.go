@@ -4,7 +4,9 @@
 package smt
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 
 	dbm "github.com/cosmos/cosmos-sdk/db"
@@ -47,6 +49,60 @@ func createIcs23Proof(store *Store, key []byte) (*ics23.CommitmentProof, error)
 	return ret, nil
 }
 
+// createIcs23BatchProof proves the membership or non-membership of each key in a single batch proof,
+// compressed so that the inner nodes shared by the paths of the keys are stored once.
+func createIcs23BatchProof(store *Store, keys [][]byte) (*ics23.CommitmentProof, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no keys to prove")
+	}
+	proofs := make([]*ics23.CommitmentProof, 0, len(keys))
+	proven := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if len(key) == 0 {
+			return nil, errKeyEmpty
+		}
+		if proven[string(key)] {
+			continue
+		}
+		proven[string(key)] = true
+		proof, err := createIcs23Proof(store, key)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+	return ics23.CombineProofs(proofs)
+}
+
+// VerifyBatchProofICS23 verifies a batch proof against the root of a store. Each key of items must
+// be proven to have its value, or to be absent if the value is nil. Keys are the preimages of the
+// paths in the tree.
+func VerifyBatchProofICS23(root []byte, proof *ics23.CommitmentProof, items map[string][]byte) error {
+	if len(items) == 0 {
+		return errors.New("no keys to verify")
+	}
+	// decompress once for all keys
+	proof = ics23.Decompress(proof)
+	calculated, err := proof.Calculate()
+	if err != nil {
+		return fmt.Errorf("could not calculate root for proof: %w", err)
+	}
+	if !bytes.Equal(calculated, root) {
+		return fmt.Errorf("proof root %X does not match %X", calculated, root)
+	}
+	for key, value := range items {
+		path := sha256.Sum256([]byte(key))
+		if value == nil {
+			if !ics23.VerifyNonMembership(ics23.SmtSpec, root, proof, path[:]) {
+				return fmt.Errorf("proof did not verify absence of key %X", key)
+			}
+		} else if !ics23.VerifyMembership(ics23.SmtSpec, root, proof, path[:], value) {
+			return fmt.Errorf("proof did not verify existence of key %X with value %X", key, value)
+		}
+	}
+	return nil
+}
+
 func toNonExistenceProof(store *Store, path [32]byte) (*ics23.NonExistenceProof, error) {
 	// Seek to our neighbors via the backing DB
 	getNext := func(it dbm.Iterator) (*ics23.ExistenceProof, error) {
@@ -2,6 +2,7 @@ package smt_test
 
 import (
 	"crypto/sha256"
+	"fmt"
 	"testing"
 
 	ics23 "github.com/confio/ics23/go"
@@ -106,3 +107,52 @@ func TestProofICS23(t *testing.T) {
 	badNonexist.Key = key10
 	assert.Error(t, badNonexist.Verify(ics23.SmtSpec, s.Root(), path10[:]))
 }
+
+func TestBatchProofICS23(t *testing.T) {
+	s := store.NewStore(memdb.NewDB().ReadWriter())
+	for i := 0; i < 20; i++ {
+		s.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	items := map[string][]byte{
+		"key1":    []byte("value1"),
+		"key7":    []byte("value7"),
+		"key13":   []byte("value13"),
+		"absent1": nil,
+		"absent2": nil,
+	}
+	keys := make([][]byte, 0, len(items))
+	for key := range items {
+		keys = append(keys, []byte(key))
+	}
+
+	proof, err := s.GetBatchProofICS23(append(keys, []byte("key1")))
+	assert.NoError(t, err)
+	assert.True(t, ics23.IsCompressed(proof))
+	assert.Len(t, ics23.Decompress(proof).GetBatch().Entries, len(items))
+	assert.NoError(t, store.VerifyBatchProofICS23(s.Root(), proof, items))
+
+	// shared inner nodes are stored once
+	var separate int
+	for _, key := range keys {
+		single, err := s.GetProofICS23(key)
+		assert.NoError(t, err)
+		separate += single.Size()
+	}
+	assert.Less(t, proof.Size(), separate)
+
+	// keys not covered by the proof, or with other values, fail to verify
+	assert.Error(t, store.VerifyBatchProofICS23(s.Root(), proof, map[string][]byte{"key2": []byte("value2")}))
+	assert.Error(t, store.VerifyBatchProofICS23(s.Root(), proof, map[string][]byte{"key1": []byte("value2")}))
+	assert.Error(t, store.VerifyBatchProofICS23(s.Root(), proof, map[string][]byte{"key1": nil}))
+	assert.Error(t, store.VerifyBatchProofICS23(s.Root(), proof, map[string][]byte{"absent1": []byte("value1")}))
+	assert.Error(t, store.VerifyBatchProofICS23(s.Root(), proof, nil))
+
+	// the proof is for the root of the tree when it was created
+	s.Set([]byte("key1"), []byte("changed"))
+	assert.Error(t, store.VerifyBatchProofICS23(s.Root(), proof, items))
+
+	_, err = s.GetBatchProofICS23(nil)
+	assert.Error(t, err)
+	_, err = s.GetBatchProofICS23([][]byte{[]byte("key1"), nil})
+	assert.Error(t, err)
+}
@@ -77,6 +77,12 @@ func (s *Store) GetProofICS23(key []byte) (*ics23.CommitmentProof, error) {
 	return createIcs23Proof(s, key)
 }
 
+// GetBatchProofICS23 returns a single compressed ICS-23 batch proof of the membership or
+// non-membership of each of the keys.
+func (s *Store) GetBatchProofICS23(keys [][]byte) (*ics23.CommitmentProof, error) {
+	return createIcs23BatchProof(s, keys)
+}
+
 func (s *Store) Root() []byte { return s.tree.Root() }
 
 // BasicKVStore interface below:
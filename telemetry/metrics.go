@@ -52,6 +52,11 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// StateSizeInterval, when positive, defines the block interval between the
+	// measurements of the number of keys and bytes of the application state by
+	// store and key prefix. The measurement iterates the whole state.
+	StateSizeInterval uint64 `mapstructure:"state-size-interval"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/cosmos-sdk/store/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types1 "github.com/tendermint/tendermint/abci/types"
@@ -375,6 +376,12 @@ var xxx_messageInfo_Result proto.InternalMessageInfo
 type SimulationResponse struct {
 	GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3,embedded=gas_info" json:"gas_info"`
 	Result  *Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// read_write_set contains the keys and key ranges of the KVStores read and
+	// written by the transaction. It is only set when the node logs the
+	// read/write sets of the transactions it delivers.
+	//
+	// Since: cosmos-sdk 0.46
+	ReadWriteSet *types2.ReadWriteSet `protobuf:"bytes,3,opt,name=read_write_set,json=readWriteSet,proto3" json:"read_write_set,omitempty"`
 }
 
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
@@ -416,6 +423,13 @@ func (m *SimulationResponse) GetResult() *Result {
 	return nil
 }
 
+func (m *SimulationResponse) GetReadWriteSet() *types2.ReadWriteSet {
+	if m != nil {
+		return m.ReadWriteSet
+	}
+	return nil
+}
+
 // MsgData defines the data returned in a Result object during message
 // execution.
 //
@@ -637,64 +651,67 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0xdb, 0x75, 0x3c, 0x8e, 0x29, 0x1a, 0x45, 0xe9, 0xa4, 0x80, 0x6d, 0xdc, 0x22,
-	0x59, 0x48, 0xac, 0xd5, 0xb4, 0x42, 0xb4, 0xa7, 0xd6, 0xe1, 0x2b, 0x52, 0xcb, 0x61, 0xe3, 0x0a,
-	0x89, 0x8b, 0x35, 0xb6, 0xa7, 0xe3, 0x55, 0xbd, 0x3b, 0xd6, 0xce, 0x6c, 0xb2, 0xb9, 0x71, 0x83,
-	0x23, 0x27, 0xce, 0x5c, 0xe1, 0x2f, 0xe9, 0x81, 0x43, 0x8e, 0x3d, 0x54, 0x01, 0x92, 0x1b, 0x7f,
-	0x05, 0x7a, 0x6f, 0xc6, 0x1f, 0x25, 0x75, 0xd5, 0x93, 0xdf, 0xfc, 0xde, 0x87, 0xdf, 0xfb, 0xbd,
-	0xdf, 0xce, 0x90, 0x5b, 0x63, 0xa5, 0x13, 0xa5, 0x7b, 0x23, 0xae, 0x45, 0x8f, 0x8f, 0xc6, 0x71,
-	0xef, 0xf8, 0xce, 0x48, 0x18, 0x7e, 0x07, 0x0f, 0xe1, 0x3c, 0x53, 0x46, 0x51, 0x66, 0x83, 0x42,
-	0x08, 0x0a, 0x11, 0x77, 0x41, 0x37, 0x77, 0xa4, 0x92, 0x0a, 0x83, 0x7a, 0x60, 0xd9, 0xf8, 0x9b,
-	0x1f, 0x18, 0x91, 0x4e, 0x44, 0x96, 0xc4, 0xa9, 0xb1, 0x35, 0xcd, 0xe9, 0x5c, 0x68, 0xe7, 0xdc,
-	0x93, 0x4a, 0xc9, 0x99, 0xe8, 0xe1, 0x69, 0x94, 0x3f, 0xeb, 0xf1, 0xf4, 0xd4, 0xba, 0x3a, 0x7f,
-	0x56, 0x08, 0x19, 0x14, 0x91, 0xd0, 0x73, 0x95, 0x6a, 0x41, 0x77, 0x49, 0x30, 0x15, 0xb1, 0x9c,
-	0x1a, 0xe6, 0xb5, 0xbd, 0x6e, 0x25, 0x72, 0x27, 0xda, 0x21, 0x81, 0x29, 0xa6, 0x5c, 0x4f, 0x59,
-	0xb9, 0xed, 0x75, 0x6b, 0x7d, 0x72, 0x71, 0xde, 0x0a, 0x06, 0xc5, 0xb7, 0x5c, 0x4f, 0x23, 0xe7,
-	0xa1, 0x1f, 0x92, 0xda, 0x58, 0x4d, 0x84, 0x9e, 0xf3, 0xb1, 0x60, 0x15, 0x08, 0x8b, 0x56, 0x00,
-	0xa5, 0xc4, 0x87, 0x03, 0xf3, 0xdb, 0x5e, 0xb7, 0x11, 0xa1, 0x0d, 0xd8, 0x84, 0x1b, 0xce, 0xae,
-	0x61, 0x30, 0xda, 0xf4, 0x06, 0xa9, 0x66, 0xfc, 0x64, 0x38, 0x53, 0x92, 0x05, 0x08, 0x07, 0x19,
-	0x3f, 0x79, 0xac, 0x24, 0x7d, 0x4a, 0xfc, 0x99, 0x92, 0x9a, 0x55, 0xdb, 0x95, 0x6e, 0x7d, 0xbf,
-	0x1b, 0x6e, 0x22, 0x28, 0x7c, 0xd4, 0x3f, 0x38, 0x7c, 0x22, 0xb4, 0xe6, 0x52, 0x3c, 0x56, 0xb2,
-	0x7f, 0xe3, 0xc5, 0x79, 0xab, 0xf4, 0xc7, 0x5f, 0xad, 0xeb, 0xaf, 0xe3, 0x3a, 0xc2, 0x72, 0xd0,
-	0x43, 0x9c, 0x3e, 0x53, 0x6c, 0xcb, 0xf6, 0x00, 0x36, 0xfd, 0x88, 0x10, 0xc9, 0xf5, 0xf0, 0x84,
-	0xa7, 0x46, 0x4c, 0x58, 0x0d, 0x99, 0xa8, 0x49, 0xae, 0xbf, 0x47, 0x80, 0xee, 0x91, 0x2d, 0x70,
-	0xe7, 0x5a, 0x4c, 0x18, 0x41, 0x67, 0x55, 0x72, 0xfd, 0x54, 0x8b, 0x09, 0xbd, 0x4d, 0xca, 0xa6,
-	0x60, 0xf5, 0xb6, 0xd7, 0xad, 0xef, 0xef, 0x84, 0x96, 0xf6, 0x70, 0x41, 0x7b, 0xf8, 0x28, 0x3d,
-	0x8d, 0xca, 0xa6, 0x00, 0xa6, 0x4c, 0x9c, 0x08, 0x6d, 0x78, 0x32, 0x67, 0xdb, 0x96, 0xa9, 0x25,
-	0x40, 0xef, 0x91, 0x40, 0x1c, 0x8b, 0xd4, 0x68, 0xd6, 0xc0, 0x51, 0x77, 0xc3, 0xd5, 0x6e, 0xed,
-	0xa4, 0x5f, 0x81, 0xbb, 0xef, 0xc3, 0x60, 0x91, 0x8b, 0x7d, 0xe0, 0xff, 0xfc, 0x5b, 0xab, 0xd4,
-	0xf9, 0xdd, 0x23, 0xef, 0xbd, 0x3e, 0x27, 0xfd, 0x94, 0xd4, 0x12, 0x2d, 0x87, 0x71, 0x3a, 0x11,
-	0x05, 0x6e, 0xb5, 0xd1, 0x6f, 0xfc, 0x7b, 0xde, 0x5a, 0x81, 0xd1, 0x56, 0xa2, 0xe5, 0x21, 0x58,
-	0xf4, 0x7d, 0x52, 0x01, 0xe2, 0x71, 0xc7, 0x11, 0x98, 0xf4, 0x68, 0xd9, 0x4c, 0x05, 0x9b, 0xf9,
-	0x64, 0x33, 0xef, 0x47, 0x26, 0x8b, 0x53, 0x69, 0x7b, 0xdb, 0x71, 0xa4, 0x6f, 0xaf, 0x81, 0x7a,
-	0xd5, 0xeb, 0x8f, 0xaf, 0xda, 0x5e, 0x27, 0x23, 0xf5, 0x35, 0x2f, 0x2c, 0x02, 0x34, 0x8b, 0x2d,
-	0xd6, 0x22, 0xb4, 0xe9, 0x21, 0x21, 0xdc, 0x98, 0x2c, 0x1e, 0xe5, 0x46, 0x68, 0x56, 0xc6, 0x0e,
-	0x6e, 0xbd, 0x65, 0xf3, 0x8b, 0x58, 0xc7, 0xcd, 0x5a, 0xb2, 0xfb, 0xcf, 0xbb, 0xa4, 0xb6, 0x0c,
-	0x82, 0x69, 0x9f, 0x8b, 0x53, 0xf7, 0x87, 0x60, 0xd2, 0x1d, 0x72, 0xed, 0x98, 0xcf, 0x72, 0xe1,
-	0x18, 0xb0, 0x87, 0xce, 0x01, 0xa9, 0x7e, 0xc3, 0xf5, 0xe1, 0x55, 0x65, 0x40, 0xa6, 0xbf, 0x49,
-	0x19, 0x65, 0x74, 0x2e, 0x94, 0x01, 0x9b, 0x09, 0x22, 0xa1, 0xf3, 0x99, 0xa1, 0xbb, 0x4e, 0xf6,
-	0x90, 0xbe, 0xdd, 0x2f, 0x33, 0xcf, 0x49, 0xff, 0x2a, 0xfb, 0xf7, 0xfe, 0xc7, 0xfe, 0x3b, 0x49,
-	0x81, 0xde, 0x27, 0x0d, 0x58, 0x6e, 0xe6, 0x3e, 0x6a, 0xcd, 0x7c, 0x4c, 0x7e, 0xb3, 0x1e, 0xb7,
-	0x13, 0x2d, 0x17, 0x9f, 0xff, 0x42, 0x45, 0xbf, 0x7a, 0x84, 0x1e, 0xc5, 0x49, 0x3e, 0xe3, 0x26,
-	0x56, 0xe9, 0xf2, 0x72, 0xf8, 0xda, 0x4e, 0x87, 0x9f, 0x8b, 0x87, 0x12, 0xff, 0x78, 0xf3, 0x2e,
-	0x1c, 0x63, 0xfd, 0x2d, 0x68, 0xed, 0xec, 0xbc, 0xe5, 0x21, 0x15, 0x48, 0xe2, 0x17, 0x24, 0xc8,
-	0x90, 0x09, 0x1c, 0xb5, 0xbe, 0xdf, 0xde, 0x5c, 0xc5, 0x32, 0x16, 0xb9, 0xf8, 0xce, 0x43, 0x52,
-	0x7d, 0xa2, 0xe5, 0x97, 0x40, 0xd6, 0x1e, 0x01, 0xd9, 0x0e, 0xd7, 0x24, 0x53, 0x4d, 0xb4, 0x1c,
-	0x80, 0x6a, 0x16, 0xd7, 0x0a, 0x54, 0xdf, 0xb6, 0xdc, 0x3e, 0x08, 0x60, 0xfd, 0xcc, 0xeb, 0xfc,
-	0xe4, 0x91, 0xda, 0xa0, 0x58, 0x14, 0xb9, 0xbf, 0xdc, 0x44, 0xe5, 0xed, 0xd3, 0xb8, 0x84, 0xb5,
-	0x65, 0x5d, 0x21, 0xb9, 0xfc, 0xee, 0x24, 0xa3, 0x14, 0x5f, 0x79, 0xe4, 0xfa, 0x91, 0xe0, 0xd9,
-	0x78, 0x3a, 0x28, 0xb4, 0x53, 0x46, 0x8b, 0xd4, 0x8d, 0x32, 0x7c, 0x36, 0x1c, 0xab, 0x3c, 0x35,
-	0x4e, 0x5f, 0x04, 0xa1, 0x03, 0x40, 0x40, 0xa0, 0xd6, 0x65, 0xd5, 0x65, 0x0f, 0x90, 0x36, 0xe7,
-	0x52, 0x0c, 0xd3, 0x3c, 0x19, 0x89, 0x0c, 0xef, 0x5e, 0x3f, 0x22, 0x00, 0x7d, 0x87, 0x08, 0xc8,
-	0x16, 0x03, 0xb0, 0x12, 0x5e, 0xc1, 0x7e, 0x54, 0x03, 0x64, 0x00, 0x00, 0x54, 0x9d, 0xc5, 0x49,
-	0x6c, 0xf0, 0x22, 0xf6, 0x23, 0x7b, 0xa0, 0x9f, 0x93, 0x8a, 0x29, 0x34, 0x0b, 0x70, 0xae, 0xdb,
-	0x9b, 0xb9, 0x59, 0x3d, 0x1f, 0x11, 0x24, 0xd8, 0xf1, 0xfa, 0x0f, 0x5f, 0xfe, 0xd3, 0x2c, 0xbd,
-	0xb8, 0x68, 0x7a, 0x67, 0x17, 0x4d, 0xef, 0xef, 0x8b, 0xa6, 0xf7, 0xcb, 0x65, 0xb3, 0x74, 0x76,
-	0xd9, 0x2c, 0xbd, 0xbc, 0x6c, 0x96, 0x7e, 0xe8, 0xc8, 0xd8, 0x4c, 0xf3, 0x51, 0x38, 0x56, 0x49,
-	0xcf, 0x3d, 0x87, 0xf6, 0xe7, 0x33, 0x3d, 0x79, 0x6e, 0xdf, 0xae, 0x51, 0x80, 0x14, 0xde, 0xfd,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xf1, 0x8e, 0x98, 0x30, 0x07, 0x00, 0x00,
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x8e, 0x1a, 0x47,
+	0x10, 0x66, 0x60, 0x3c, 0x2c, 0x0d, 0xd8, 0x51, 0x6b, 0xb5, 0x9e, 0x75, 0x12, 0x20, 0xd8, 0x56,
+	0x50, 0xa4, 0x0c, 0xf2, 0xda, 0x8a, 0x62, 0x9f, 0x6c, 0x36, 0x7f, 0x2b, 0x79, 0x73, 0x18, 0xb0,
+	0x2c, 0xe5, 0x82, 0x1a, 0xa6, 0xdd, 0x8c, 0xcc, 0x4c, 0xa3, 0xe9, 0x66, 0x81, 0x5b, 0x6e, 0xc9,
+	0x31, 0x8f, 0x90, 0x6b, 0xf2, 0x24, 0x3e, 0xe4, 0xb0, 0x47, 0x1f, 0xac, 0x4d, 0xb2, 0xab, 0x5c,
+	0xf2, 0x14, 0x51, 0x55, 0x37, 0x3f, 0x9b, 0x15, 0xd6, 0x9e, 0xa8, 0xfa, 0xaa, 0xba, 0xa8, 0xfa,
+	0xea, 0xeb, 0x1e, 0x72, 0x77, 0x28, 0x55, 0x22, 0x55, 0x7b, 0xc0, 0x14, 0x6f, 0xb3, 0xc1, 0x30,
+	0x6e, 0x9f, 0x3c, 0x18, 0x70, 0xcd, 0x1e, 0xa0, 0x13, 0x4c, 0x32, 0xa9, 0x25, 0xf5, 0x4d, 0x52,
+	0x00, 0x49, 0x01, 0xe2, 0x36, 0xe9, 0xce, 0xae, 0x90, 0x42, 0x62, 0x52, 0x1b, 0x2c, 0x93, 0x7f,
+	0xe7, 0x43, 0xcd, 0xd3, 0x88, 0x67, 0x49, 0x9c, 0x6a, 0x53, 0x53, 0x2f, 0x26, 0x5c, 0xd9, 0xe0,
+	0xbe, 0x90, 0x52, 0x8c, 0x79, 0x1b, 0xbd, 0xc1, 0xf4, 0x55, 0x9b, 0xa5, 0x0b, 0x1b, 0xba, 0xbf,
+	0xd9, 0x8c, 0xd2, 0x32, 0xe3, 0xab, 0x6e, 0xb2, 0x99, 0xe2, 0xda, 0xa4, 0x35, 0xff, 0x28, 0x10,
+	0xd2, 0x9b, 0x87, 0x5c, 0x4d, 0x64, 0xaa, 0x38, 0xdd, 0x23, 0xde, 0x88, 0xc7, 0x62, 0xa4, 0x7d,
+	0xa7, 0xe1, 0xb4, 0x0a, 0xa1, 0xf5, 0x68, 0x93, 0x78, 0x7a, 0x3e, 0x62, 0x6a, 0xe4, 0xe7, 0x1b,
+	0x4e, 0xab, 0xd4, 0x21, 0xe7, 0x67, 0x75, 0xaf, 0x37, 0xff, 0x8e, 0xa9, 0x51, 0x68, 0x23, 0xf4,
+	0x23, 0x52, 0x1a, 0xca, 0x88, 0xab, 0x09, 0x1b, 0x72, 0xbf, 0x00, 0x69, 0xe1, 0x1a, 0xa0, 0x94,
+	0xb8, 0xe0, 0xf8, 0x6e, 0xc3, 0x69, 0x55, 0x43, 0xb4, 0x01, 0x8b, 0x98, 0x66, 0xfe, 0x0d, 0x4c,
+	0x46, 0x9b, 0xde, 0x26, 0xc5, 0x8c, 0xcd, 0xfa, 0x63, 0x29, 0x7c, 0x0f, 0x61, 0x2f, 0x63, 0xb3,
+	0xe7, 0x52, 0xd0, 0x17, 0xc4, 0x1d, 0x4b, 0xa1, 0xfc, 0x62, 0xa3, 0xd0, 0x2a, 0x1f, 0xb4, 0x82,
+	0x6d, 0x3c, 0x06, 0xcf, 0x3a, 0x87, 0x47, 0xc7, 0x5c, 0x29, 0x26, 0xf8, 0x73, 0x29, 0x3a, 0xb7,
+	0xdf, 0x9c, 0xd5, 0x73, 0xbf, 0xff, 0x59, 0xbf, 0x75, 0x19, 0x57, 0x21, 0x96, 0x83, 0x1e, 0xe2,
+	0xf4, 0x95, 0xf4, 0x77, 0x4c, 0x0f, 0x60, 0xd3, 0x8f, 0x09, 0x11, 0x4c, 0xf5, 0x67, 0x2c, 0xd5,
+	0x3c, 0xf2, 0x4b, 0xc8, 0x44, 0x49, 0x30, 0xf5, 0x12, 0x01, 0xba, 0x4f, 0x76, 0x20, 0x3c, 0x55,
+	0x3c, 0xf2, 0x09, 0x06, 0x8b, 0x82, 0xa9, 0x17, 0x8a, 0x47, 0xf4, 0x1e, 0xc9, 0xeb, 0xb9, 0x5f,
+	0x6e, 0x38, 0xad, 0xf2, 0xc1, 0x6e, 0x60, 0xb6, 0x13, 0x2c, 0xb7, 0x13, 0x3c, 0x4b, 0x17, 0x61,
+	0x5e, 0xcf, 0x81, 0x29, 0x1d, 0x27, 0x5c, 0x69, 0x96, 0x4c, 0xfc, 0x8a, 0x61, 0x6a, 0x05, 0xd0,
+	0x47, 0xc4, 0xe3, 0x27, 0x3c, 0xd5, 0xca, 0xaf, 0xe2, 0xa8, 0x7b, 0xc1, 0x5a, 0x02, 0x66, 0xd2,
+	0xaf, 0x21, 0xdc, 0x71, 0x61, 0xb0, 0xd0, 0xe6, 0x3e, 0x71, 0x7f, 0xfe, 0xb5, 0x9e, 0x6b, 0xfe,
+	0xe6, 0x90, 0x9b, 0x97, 0xe7, 0xa4, 0x9f, 0x91, 0x52, 0xa2, 0x44, 0x3f, 0x4e, 0x23, 0x3e, 0xc7,
+	0xad, 0x56, 0x3b, 0xd5, 0x7f, 0xcf, 0xea, 0x6b, 0x30, 0xdc, 0x49, 0x94, 0x38, 0x02, 0x8b, 0x7e,
+	0x40, 0x0a, 0x40, 0x3c, 0xee, 0x38, 0x04, 0x93, 0x76, 0x57, 0xcd, 0x14, 0xb0, 0x99, 0xfb, 0xdb,
+	0x79, 0xef, 0xea, 0x2c, 0x4e, 0x85, 0xe9, 0x6d, 0xd7, 0x92, 0x5e, 0xd9, 0x00, 0xd5, 0xba, 0xd7,
+	0x1f, 0xdf, 0x35, 0x9c, 0x66, 0x46, 0xca, 0x1b, 0x51, 0x58, 0x04, 0x48, 0x1b, 0x5b, 0x2c, 0x85,
+	0x68, 0xd3, 0x23, 0x42, 0x98, 0xd6, 0x59, 0x3c, 0x98, 0x6a, 0xae, 0xfc, 0x3c, 0x76, 0x70, 0xf7,
+	0x3d, 0x9b, 0x5f, 0xe6, 0x5a, 0x6e, 0x36, 0x0e, 0xdb, 0xff, 0x7c, 0x48, 0x4a, 0xab, 0x24, 0x98,
+	0xf6, 0x35, 0x5f, 0xd8, 0x3f, 0x04, 0x93, 0xee, 0x92, 0x1b, 0x27, 0x6c, 0x3c, 0xe5, 0x96, 0x01,
+	0xe3, 0x34, 0x0f, 0x49, 0xf1, 0x5b, 0xa6, 0x8e, 0xae, 0x2a, 0x03, 0x4e, 0xba, 0xdb, 0x94, 0x91,
+	0xc7, 0xe0, 0x52, 0x19, 0xb0, 0x19, 0x2f, 0xe4, 0x6a, 0x3a, 0xd6, 0x74, 0xcf, 0xca, 0x1e, 0x8e,
+	0x57, 0x3a, 0x79, 0xdf, 0xb1, 0xd2, 0xbf, 0xca, 0xfe, 0xa3, 0xff, 0xb1, 0x7f, 0x2d, 0x29, 0xd0,
+	0xc7, 0xa4, 0x0a, 0xcb, 0xcd, 0xec, 0xa5, 0x56, 0xbe, 0xdb, 0x28, 0x6c, 0xd5, 0x63, 0x25, 0x51,
+	0x62, 0x79, 0xfd, 0x97, 0x2a, 0xfa, 0xc7, 0x21, 0xb4, 0x1b, 0x27, 0xd3, 0x31, 0xd3, 0xb1, 0x4c,
+	0x97, 0x51, 0xfa, 0x8d, 0x99, 0x0e, 0xaf, 0x8b, 0x83, 0x12, 0xff, 0x64, 0xfb, 0x2e, 0x2c, 0x63,
+	0x9d, 0x1d, 0x68, 0xed, 0xf4, 0xac, 0xee, 0x20, 0x15, 0x48, 0xe2, 0x97, 0xc4, 0xcb, 0x90, 0x09,
+	0x1c, 0xb5, 0x7c, 0xd0, 0xd8, 0x5e, 0xc5, 0x30, 0x16, 0xda, 0x7c, 0x7a, 0x4c, 0x6e, 0x66, 0x9c,
+	0x45, 0xfd, 0x59, 0x16, 0x6b, 0xde, 0x57, 0x5c, 0xe3, 0x3b, 0x53, 0x3e, 0xf8, 0xf4, 0x52, 0x05,
+	0x7c, 0xed, 0x36, 0x4a, 0xb0, 0xe8, 0x25, 0xe4, 0x77, 0xb9, 0x0e, 0x2b, 0xd9, 0x86, 0xd7, 0x7c,
+	0x4a, 0x8a, 0xc7, 0x4a, 0x7c, 0x05, 0xdc, 0xef, 0x13, 0xb8, 0x05, 0xfd, 0x0d, 0x05, 0x16, 0x13,
+	0x25, 0x7a, 0x8b, 0xc9, 0xfa, 0x95, 0x82, 0x66, 0x2b, 0x66, 0x55, 0x4f, 0x3c, 0x50, 0x93, 0xef,
+	0x34, 0x7f, 0x72, 0x48, 0xa9, 0x37, 0x5f, 0x16, 0x79, 0xbc, 0x5a, 0x6c, 0xe1, 0xfd, 0xe4, 0xd8,
+	0x03, 0x1b, 0xbb, 0xbf, 0xb2, 0xb3, 0xfc, 0xf5, 0x77, 0x86, 0xca, 0x7e, 0xe7, 0x90, 0x5b, 0x5d,
+	0xce, 0xb2, 0xe1, 0xa8, 0x37, 0x57, 0x56, 0x68, 0x75, 0x52, 0xd6, 0x52, 0xb3, 0x71, 0x7f, 0x28,
+	0xa7, 0xa9, 0xb6, 0x72, 0x25, 0x08, 0x1d, 0x02, 0x02, 0x7a, 0x37, 0x21, 0x23, 0x56, 0xe3, 0xc0,
+	0xb1, 0x09, 0x13, 0xbc, 0x9f, 0x4e, 0x93, 0x01, 0xcf, 0x90, 0x62, 0x37, 0x24, 0x00, 0x7d, 0x8f,
+	0x08, 0xdc, 0x02, 0x4c, 0xc0, 0x4a, 0xf8, 0xa2, 0xbb, 0x61, 0x09, 0x90, 0x1e, 0x00, 0x50, 0x75,
+	0x1c, 0x27, 0xb1, 0xc6, 0x77, 0xdd, 0x0d, 0x8d, 0x43, 0xbf, 0x20, 0x05, 0x3d, 0x57, 0xbe, 0x87,
+	0x73, 0xdd, 0xdb, 0xce, 0xcd, 0xfa, 0x6b, 0x14, 0xc2, 0x01, 0x33, 0x5e, 0xe7, 0xe9, 0xdb, 0xbf,
+	0x6b, 0xb9, 0x37, 0xe7, 0x35, 0xe7, 0xf4, 0xbc, 0xe6, 0xfc, 0x75, 0x5e, 0x73, 0x7e, 0xb9, 0xa8,
+	0xe5, 0x4e, 0x2f, 0x6a, 0xb9, 0xb7, 0x17, 0xb5, 0xdc, 0x0f, 0x4d, 0x11, 0xeb, 0xd1, 0x74, 0x10,
+	0x0c, 0x65, 0xd2, 0xb6, 0xdf, 0x3d, 0xf3, 0xf3, 0xb9, 0x8a, 0x5e, 0x9b, 0x2f, 0xe6, 0xc0, 0x43,
+	0x0a, 0x1f, 0xfe, 0x37, 0x00, 0x85, 0xfe, 0x22, 0x4c, 0xa6, 0x07, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -1070,6 +1087,18 @@ func (m *SimulationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReadWriteSet != nil {
+		{
+			size, err := m.ReadWriteSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAbci(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Result != nil {
 		{
 			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
@@ -1431,6 +1460,10 @@ func (m *SimulationResponse) Size() (n int) {
 		l = m.Result.Size()
 		n += 1 + l + sovAbci(uint64(l))
 	}
+	if m.ReadWriteSet != nil {
+		l = m.ReadWriteSet.Size()
+		n += 1 + l + sovAbci(uint64(l))
+	}
 	return n
 }
 
@@ -2758,6 +2791,42 @@ func (m *SimulationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadWriteSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadWriteSet == nil {
+				m.ReadWriteSet = &types2.ReadWriteSet{}
+			}
+			if err := m.ReadWriteSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
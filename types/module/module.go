@@ -61,6 +61,12 @@ type AppModuleBasic interface {
 	GetQueryCmd() *cobra.Command
 }
 
+// HasStorePrefixes is implemented by the modules naming the key prefixes of their store, for state
+// size accounting and inspection tools.
+type HasStorePrefixes interface {
+	RegisterStorePrefixes(sdk.StorePrefixRegistry)
+}
+
 // BasicManager is a collection of AppModuleBasic
 type BasicManager map[string]AppModuleBasic
 
@@ -122,6 +128,16 @@ func (bm BasicManager) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *
 	}
 }
 
+// RegisterStorePrefixes registers the store key prefix names of the modules implementing
+// HasStorePrefixes.
+func (bm BasicManager) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	for _, b := range bm {
+		if module, ok := b.(HasStorePrefixes); ok {
+			module.RegisterStorePrefixes(registry)
+		}
+	}
+}
+
 // AddTxCommands adds all tx commands to the rootTxCmd.
 //
 // TODO: Remove clientCtx argument.
@@ -0,0 +1,89 @@
+// Package ormstore connects ORM module databases to the KVStores of the
+// multistore.
+package ormstore
+
+import (
+	"context"
+	"fmt"
+
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/kv"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreKeyDBOptions are options for constructing a ModuleDB with
+// NewStoreKeyDB.
+type StoreKeyDBOptions struct {
+	ormdb.ModuleDBOptions
+
+	// ValidateHooks are optional hooks into the ORM insert, update and delete
+	// operations of all the tables of the module.
+	ValidateHooks ormtable.ValidateHooks
+
+	// WriteHooks are optional hooks called after the ORM insert, update and
+	// delete operations of all the tables of the module.
+	WriteHooks ormtable.WriteHooks
+}
+
+// NewStoreKeyDB returns an ormdb.ModuleDB whose tables are stored in the
+// KVStore of the provided store key. Both the ORM commitment and index entries
+// are written to this store, and the context passed to the tables must wrap an
+// sdk.Context. Only the default storage type is supported.
+func NewStoreKeyDB(desc *ormv1alpha1.ModuleSchemaDescriptor, key storetypes.StoreKey, options StoreKeyDBOptions) (ormdb.ModuleDB, error) {
+	backendResolver := func(ctx context.Context) (ormtable.ReadBackend, error) {
+		store := kvStoreAdapter{sdk.UnwrapSDKContext(ctx).KVStore(key)}
+		return ormtable.NewBackend(ormtable.BackendOptions{
+			CommitmentStore: store,
+			IndexStore:      store,
+			ValidateHooks:   options.ValidateHooks,
+			WriteHooks:      options.WriteHooks,
+		}), nil
+	}
+
+	options.GetBackendResolver = func(storageType ormv1alpha1.StorageType) (ormtable.BackendResolver, error) {
+		if storageType != ormv1alpha1.StorageType_STORAGE_TYPE_DEFAULT_UNSPECIFIED {
+			return nil, fmt.Errorf("unsupported storage type %s", storageType)
+		}
+
+		return backendResolver, nil
+	}
+
+	return ormdb.NewModuleDB(desc, options.ModuleDBOptions)
+}
+
+// kvStoreAdapter adapts a KVStore to the kv.Store interface used by the ORM.
+type kvStoreAdapter struct {
+	store storetypes.KVStore
+}
+
+var _ kv.Store = kvStoreAdapter{}
+
+func (s kvStoreAdapter) Get(key []byte) ([]byte, error) {
+	return s.store.Get(key), nil
+}
+
+func (s kvStoreAdapter) Has(key []byte) (bool, error) {
+	return s.store.Has(key), nil
+}
+
+func (s kvStoreAdapter) Iterator(start, end []byte) (kv.Iterator, error) {
+	return s.store.Iterator(start, end), nil
+}
+
+func (s kvStoreAdapter) ReverseIterator(start, end []byte) (kv.Iterator, error) {
+	return s.store.ReverseIterator(start, end), nil
+}
+
+func (s kvStoreAdapter) Set(key, value []byte) error {
+	s.store.Set(key, value)
+	return nil
+}
+
+func (s kvStoreAdapter) Delete(key []byte) error {
+	s.store.Delete(key)
+	return nil
+}
@@ -30,6 +30,42 @@ type (
 // simulation.
 type StoreDecoderRegistry map[string]func(kvA, kvB kv.Pair) string
 
+// StorePrefixRegistry names the key prefixes of each module store, by store name and prefix. Used
+// by state size accounting and inspection tools.
+type StorePrefixRegistry map[string]map[string]string
+
+// Register names a key prefix of a store.
+func (r StorePrefixRegistry) Register(store string, prefix []byte, name string) {
+	if r[store] == nil {
+		r[store] = make(map[string]string)
+	}
+	r[store][string(prefix)] = name
+}
+
+// Lookup returns the longest registered prefix of a key of a store, and its name.
+func (r StorePrefixRegistry) Lookup(store string, key []byte) (prefix []byte, name string, found bool) {
+	for p, n := range r[store] {
+		if strings.HasPrefix(string(key), p) && (!found || len(p) > len(prefix)) {
+			prefix, name, found = []byte(p), n, true
+		}
+	}
+	return prefix, name, found
+}
+
+// PrefixFunc returns a function grouping the keys of a store by their registered prefix, or by
+// their first length bytes if no registered prefix matches.
+func (r StorePrefixRegistry) PrefixFunc(length int) func(store string, key []byte) []byte {
+	return func(store string, key []byte) []byte {
+		if prefix, _, ok := r.Lookup(store, key); ok {
+			return prefix
+		}
+		if len(key) < length {
+			return key
+		}
+		return key[:length]
+	}
+}
+
 // Iterator over all the keys with a certain prefix in ascending order
 func KVStorePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 	return types.KVStorePrefixIterator(kvs, prefix)
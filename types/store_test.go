@@ -106,6 +106,28 @@ func (s *storeTestSuite) TestDiffKVStores() {
 	s.checkDiffResults(store1, store2)
 }
 
+func (s *storeTestSuite) TestStorePrefixRegistry() {
+	registry := make(sdk.StorePrefixRegistry)
+	registry.Register("bank", []byte{0x02}, "balances")
+	registry.Register("bank", []byte{0x02, 0x01}, "balances of one account")
+	registry.Register("staking", []byte{0x31}, "delegations")
+
+	prefix, name, found := registry.Lookup("bank", []byte{0x02, 0x01, 0x05})
+	s.Require().True(found)
+	s.Require().Equal([]byte{0x02, 0x01}, prefix)
+	s.Require().Equal("balances of one account", name)
+	_, name, found = registry.Lookup("bank", []byte{0x02, 0x05})
+	s.Require().True(found)
+	s.Require().Equal("balances", name)
+	_, _, found = registry.Lookup("staking", []byte{0x02})
+	s.Require().False(found)
+
+	prefixFn := registry.PrefixFunc(2)
+	s.Require().Equal([]byte{0x31}, prefixFn("staking", []byte{0x31, 0x01, 0x02}))
+	s.Require().Equal([]byte{0x32, 0x01}, prefixFn("staking", []byte{0x32, 0x01, 0x02}))
+	s.Require().Equal([]byte{0x32}, prefixFn("staking", []byte{0x32}))
+}
+
 func (s *storeTestSuite) initTestStores() (types.KVStore, types.KVStore) {
 	db := dbm.NewMemDB()
 	ms := rootmulti.NewStore(db)
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func init() {
+	client.RegisterQueryVerifier("/cosmos.auth.v1beta1.Query/Account", verifyAccount)
+}
+
+// verifyAccount answers the Account query with the account read from the store of the module.
+func verifyAccount(clientCtx client.Context, req, reply interface{}) (int64, error) {
+	request, ok := req.(*types.QueryAccountRequest)
+	response, ok2 := reply.(*types.QueryAccountResponse)
+	if !ok || !ok2 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected account query %T", req)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return 0, err
+	}
+	acc, height, err := types.AccountRetriever{}.GetAccountWithHeight(clientCtx, addr)
+	if err != nil {
+		return 0, err
+	}
+	response.Account, err = codectypes.NewAnyWithValue(acc.(types.AccountI))
+	return height, err
+}
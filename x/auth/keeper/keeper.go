@@ -184,6 +184,12 @@ func (ak AccountKeeper) GetModuleAddressAndPermissions(moduleName string) (addr
 	return permAddr.GetAddress(), permAddr.GetPermissions()
 }
 
+// GetModulePermissions fetches the registered permissions of all module accounts
+// by module name.
+func (ak AccountKeeper) GetModulePermissions() map[string]types.PermissionsForAddress {
+	return ak.permAddrs
+}
+
 // GetModuleAccountAndPermissions gets the module account from the auth account store and its
 // registered permissions
 func (ak AccountKeeper) GetModuleAccountAndPermissions(ctx sdk.Context, moduleName string) (types.ModuleAccountI, []string) {
@@ -7,14 +7,19 @@ import (
 	"math/rand"
 
 	"github.com/gorilla/mux"
-	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/auth/module/v1"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/runtime/appmodule"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -22,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var (
@@ -65,7 +71,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the auth module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
@@ -81,6 +87,12 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, types.AddressStoreKeyPrefix, "accounts")
+	registry.Register(types.StoreKey, types.GlobalAccountNumberKey, "global account number")
+}
+
 // RegisterInterfaces registers interfaces and implementations of the auth module.
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
@@ -191,3 +203,45 @@ func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
 	return nil
 }
+
+//
+// New App Wiring Setup
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(provideModule),
+	)
+}
+
+type authInputs struct {
+	container.In
+
+	Config   *modulev1.Module
+	Key      *storetypes.KVStoreKey
+	Cdc      codec.Codec
+	Subspace paramtypes.Subspace
+}
+
+type authOutputs struct {
+	container.Out
+
+	AccountKeeper keeper.AccountKeeper
+	Module        runtime.AppModuleWrapper
+}
+
+func provideModule(in authInputs) authOutputs {
+	maccPerms := map[string][]string{}
+	for _, permission := range in.Config.ModuleAccountPermissions {
+		maccPerms[permission.Account] = permission.Permissions
+	}
+
+	bech32Prefix := in.Config.Bech32Prefix
+	if bech32Prefix == "" {
+		bech32Prefix = sdk.Bech32MainPrefix
+	}
+
+	k := keeper.NewAccountKeeper(in.Cdc, in.Key, in.Subspace, types.ProtoBaseAccount, maccPerms, bech32Prefix)
+	m := NewAppModule(in.Cdc, k, simulation.RandomGenesisAccounts)
+	return authOutputs{AccountKeeper: k, Module: runtime.WrapAppModule(m)}
+}
@@ -0,0 +1,62 @@
+// Package module allows the tx handler of an app to be built from its app
+// config using the default middlewares of x/auth.
+package module
+
+import (
+	"github.com/spf13/cast"
+
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/tx/module/v1"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/runtime/appmodule"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+)
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(provideModule),
+	)
+}
+
+type txInputs struct {
+	container.In
+
+	TxConfig         client.TxConfig
+	AccountKeeper    authkeeper.AccountKeeper
+	BankKeeper       bankkeeper.Keeper
+	FeegrantKeeper   authmiddleware.FeegrantKeeper `optional:"true"`
+	MsgServiceRouter *authmiddleware.MsgServiceRouter
+	LegacyRouter     sdk.Router
+}
+
+// provideModule provides the default tx handler, which uses the fee grant
+// keeper only if a fee grant module is part of the app.
+func provideModule(in txInputs) runtime.TxHandlerProvider {
+	return func(app *baseapp.BaseApp, appOpts servertypes.AppOptions) (tx.Handler, error) {
+		indexEvents := map[string]struct{}{}
+		for _, e := range cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents)) {
+			indexEvents[e] = struct{}{}
+		}
+
+		return authmiddleware.NewDefaultTxHandler(authmiddleware.TxHandlerOptions{
+			Debug:            app.Trace(),
+			IndexEvents:      indexEvents,
+			LegacyRouter:     in.LegacyRouter,
+			MsgServiceRouter: in.MsgServiceRouter,
+			AccountKeeper:    in.AccountKeeper,
+			BankKeeper:       in.BankKeeper,
+			FeegrantKeeper:   in.FeegrantKeeper,
+			SignModeHandler:  in.TxConfig.SignModeHandler(),
+			SigGasConsumer:   authmiddleware.DefaultSigVerificationGasConsumer,
+			TxDecoder:        in.TxConfig.TxDecoder(),
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"strconv"
 
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +35,11 @@ func (ar AccountRetriever) GetAccount(clientCtx client.Context, addr sdk.AccAddr
 // height of the query with the account. An error is returned if the query
 // or decoding fails.
 func (ar AccountRetriever) GetAccountWithHeight(clientCtx client.Context, addr sdk.AccAddress) (client.Account, int64, error) {
+	// gRPC query results have no proofs, so verified accounts are queried from the store
+	if clientCtx.LightClient != nil {
+		return ar.getAccountFromStore(clientCtx, addr)
+	}
+
 	var header metadata.MD
 
 	queryClient := NewQueryClient(clientCtx)
@@ -59,6 +66,25 @@ func (ar AccountRetriever) GetAccountWithHeight(clientCtx client.Context, addr s
 	return acc, int64(nBlockHeight), nil
 }
 
+// getAccountFromStore queries for an account given an address from the store of the module, which
+// verifies the account if the client context has a light client.
+func (ar AccountRetriever) getAccountFromStore(clientCtx client.Context, addr sdk.AccAddress) (client.Account, int64, error) {
+	bz, height, err := clientCtx.QueryStore(AddressStoreKey(addr), StoreKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(bz) == 0 {
+		return nil, 0, status.Errorf(codes.NotFound, "account %s not found", addr)
+	}
+
+	var acc AccountI
+	if err := clientCtx.Codec.UnmarshalInterface(bz, &acc); err != nil {
+		return nil, 0, err
+	}
+
+	return acc, height, nil
+}
+
 // EnsureExists returns an error if no account exists for the given address else nil.
 func (ar AccountRetriever) EnsureExists(clientCtx client.Context, addr sdk.AccAddress) error {
 	if _, err := ar.GetAccount(clientCtx, addr); err != nil {
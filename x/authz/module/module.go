@@ -93,6 +93,12 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(keeper.StoreKey, keeper.GrantKey, "grants")
+	registry.Register(keeper.StoreKey, keeper.GrantQueuePrefix, "grant queue")
+}
+
 // GetTxCmd returns the transaction commands for the authz module
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func init() {
+	client.RegisterQueryVerifier("/cosmos.bank.v1beta1.Query/Balance", verifyBalance)
+	client.RegisterQueryVerifier("/cosmos.bank.v1beta1.Query/SupplyOf", verifySupplyOf)
+}
+
+// verifyBalance answers the Balance query with the balance read from the store of the module.
+func verifyBalance(clientCtx client.Context, req, reply interface{}) (int64, error) {
+	request, ok := req.(*types.QueryBalanceRequest)
+	response, ok2 := reply.(*types.QueryBalanceResponse)
+	if !ok || !ok2 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected balance query %T", req)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return 0, err
+	}
+	key := append(types.CreateAccountBalancesPrefix(addr), request.Denom...)
+	amount, height, err := queryAmount(clientCtx, key)
+	if err != nil {
+		return 0, err
+	}
+	balance := sdk.NewCoin(request.Denom, amount)
+	response.Balance = &balance
+	return height, nil
+}
+
+// verifySupplyOf answers the SupplyOf query with the supply read from the store of the module.
+func verifySupplyOf(clientCtx client.Context, req, reply interface{}) (int64, error) {
+	request, ok := req.(*types.QuerySupplyOfRequest)
+	response, ok2 := reply.(*types.QuerySupplyOfResponse)
+	if !ok || !ok2 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected supply query %T", req)
+	}
+
+	amount, height, err := queryAmount(clientCtx, append(append([]byte{}, types.SupplyKey...), request.Denom...))
+	if err != nil {
+		return 0, err
+	}
+	response.Amount = sdk.NewCoin(request.Denom, amount)
+	return height, nil
+}
+
+// queryAmount queries an amount from the store of the module, which is zero if the key does not exist.
+func queryAmount(clientCtx client.Context, key []byte) (sdk.Int, int64, error) {
+	bz, height, err := clientCtx.QueryStore(key, types.StoreKey)
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+
+	amount := sdk.ZeroInt()
+	if len(bz) > 0 {
+		if err := amount.Unmarshal(bz); err != nil {
+			return sdk.Int{}, 0, err
+		}
+	}
+	return amount, height, nil
+}
@@ -8,22 +8,30 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
-	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/bank/module/v1"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/runtime/appmodule"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	v040 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v042"
 	"github.com/cosmos/cosmos-sdk/x/bank/simulation"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var (
@@ -67,7 +75,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
@@ -83,6 +91,14 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, types.SupplyKey, "supply")
+	registry.Register(types.StoreKey, types.DenomMetadataPrefix, "denom metadata")
+	registry.Register(types.StoreKey, types.BalancesPrefix, "balances")
+	registry.Register(types.StoreKey, types.DenomAddressPrefix, "denom owners")
+}
+
 // RegisterInterfaces registers interfaces and implementations of the bank module.
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
@@ -201,3 +217,51 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		simState.AppParams, simState.Cdc, am.accountKeeper, am.keeper,
 	)
 }
+
+//
+// New App Wiring Setup
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(provideModule),
+	)
+}
+
+type bankInputs struct {
+	container.In
+
+	Config        *modulev1.Module
+	Key           *storetypes.KVStoreKey
+	Cdc           codec.Codec
+	Subspace      paramtypes.Subspace
+	AccountKeeper authkeeper.AccountKeeper
+}
+
+type bankOutputs struct {
+	container.Out
+
+	BankKeeper keeper.Keeper
+	Module     runtime.AppModuleWrapper
+}
+
+func provideModule(in bankInputs) bankOutputs {
+	// Configure blocked module accounts.
+	//
+	// Default behavior for blockedAddresses is to regard any module mentioned in
+	// AccountKeeper's module account permissions as blocked.
+	blockedAddresses := make(map[string]bool)
+	if len(in.Config.BlockedModuleAccountsOverride) > 0 {
+		for _, moduleName := range in.Config.BlockedModuleAccountsOverride {
+			blockedAddresses[authtypes.NewModuleAddress(moduleName).String()] = true
+		}
+	} else {
+		for _, permAddr := range in.AccountKeeper.GetModulePermissions() {
+			blockedAddresses[permAddr.GetAddress().String()] = true
+		}
+	}
+
+	bankKeeper := keeper.NewBaseKeeper(in.Cdc, in.Key, in.AccountKeeper, in.Subspace, blockedAddresses)
+	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper)
+	return bankOutputs{BankKeeper: bankKeeper, Module: runtime.WrapAppModule(m)}
+}
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryDelegatorAutoCompound(),
 	)
 
 	return distQueryCmd
@@ -319,3 +320,48 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDelegatorAutoCompound implements the query delegator auto-compound command.
+func GetCmdQueryDelegatorAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether a delegator has automatic rewards compounding enabled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a delegator has automatic rewards compounding enabled.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoCompound(
+				cmd.Context(),
+				&types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,6 +42,8 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 
 	return distTxCmd
@@ -257,6 +260,78 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for
+// creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards of all the tokenize share records owned by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of all the tokenize share records owned by the sender.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Enable or disable automatic compounding of delegation rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable automatic compounding of delegation rewards. When enabled,
+staking rewards are periodically withdrawn and re-delegated to the validator they were
+earned from. Rewards denominated in other denoms are sent to the withdraw address.
+
+Example:
+$ %s tx distribution set-auto-compound true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
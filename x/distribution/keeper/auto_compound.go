@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound enables or disables the automatic compounding of the
+// rewards of a delegator.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	if enabled {
+		k.SetAutoCompoundDelegator(ctx, delAddr)
+	} else {
+		k.DeleteAutoCompoundDelegator(ctx, delAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+}
+
+// AutoCompoundRewards compounds the rewards of the delegators which opted in
+// for automatic compounding. A compounding round starts every
+// AutoCompoundInterval blocks and processes at most AutoCompoundBatchSize
+// delegations per block, so that a round may span several blocks.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	interval := k.GetAutoCompoundInterval(ctx)
+	if interval == 0 {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+
+	start := types.AutoCompoundDelegatorPrefix
+	var startVal []byte
+
+	cursor, inProgress := k.GetAutoCompoundCursor(ctx)
+	if inProgress {
+		delAddr, valAddr := types.ParseAutoCompoundCursor(cursor)
+		start = types.GetAutoCompoundDelegatorKey(delAddr)
+		startVal = address.MustLengthPrefix(valAddr)
+	} else if uint64(ctx.BlockHeight())%interval != 0 {
+		return
+	}
+
+	batchSize := int(k.GetAutoCompoundBatchSize(ctx))
+
+	var delegations []stakingtypes.DelegationI
+	var next []byte
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundDelegatorPrefix))
+	for ; iter.Valid() && next == nil; iter.Next() {
+		delAddr := types.GetAutoCompoundDelegatorAddress(iter.Key())
+		k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			// delegations are ordered by the length prefixed validator address
+			if startVal != nil && bytes.Compare(address.MustLengthPrefix(del.GetValidatorAddr()), startVal) < 0 {
+				return false
+			}
+
+			if len(delegations) == batchSize {
+				next = types.GetAutoCompoundCursor(delAddr, del.GetValidatorAddr())
+				return true
+			}
+
+			delegations = append(delegations, del)
+			return false
+		})
+
+		// the cursor only applies to the delegator the round stopped at
+		startVal = nil
+	}
+	iter.Close()
+
+	for _, del := range delegations {
+		// compound each delegation in its own cached context, so that a failure
+		// only discards the compounding of that delegation
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.compoundDelegationRewards(cacheCtx, del); err != nil {
+			k.Logger(ctx).Error(
+				"failed to compound delegation rewards",
+				"delegator", del.GetDelegatorAddr().String(),
+				"validator", del.GetValidatorAddr().String(),
+				"err", err,
+			)
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	if next != nil {
+		k.SetAutoCompoundCursor(ctx, next)
+	} else {
+		k.DeleteAutoCompoundCursor(ctx)
+	}
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation to the
+// delegator. The rewards in the staking denom are delegated again from the
+// delegator account to the same validator while the remaining denoms are sent
+// to the withdraw address.
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, del stakingtypes.DelegationI) error {
+	delAddr, valAddr := del.GetDelegatorAddr(), del.GetValidatorAddr()
+
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil
+	}
+
+	rewards, err := k.settleDelegationRewards(ctx, val, del)
+	if err != nil {
+		return err
+	}
+
+	// reinitialize the delegation before delegating the rewards, as the
+	// delegation hooks withdraw the rewards of the delegation
+	k.initializeDelegation(ctx, valAddr, delAddr)
+
+	if rewards.IsZero() {
+		return nil
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	restake := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	remaining := rewards.Sub(sdk.NewCoins(restake))
+
+	if !remaining.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, remaining); err != nil {
+			return err
+		}
+	}
+
+	if restake.IsPositive() {
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return stakingtypes.ErrNoValidatorFound
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(restake)); err != nil {
+			return err
+		}
+
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, restake.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, restake.String()),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAutoCompoundRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// compound every 5 blocks, one delegator per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 5
+	params.AutoCompoundBatchSize = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create validator with 50% commission and a second delegator
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(addr[1], valAddrs[0], valTokens)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(4)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// opt in both delegators
+	for _, del := range addr {
+		app.DistrKeeper.SetAutoCompound(ctx, del, true)
+		require.True(t, app.DistrKeeper.HasAutoCompound(ctx, del))
+	}
+
+	compounded := func() (n int) {
+		for _, del := range addr {
+			delegation, found := app.StakingKeeper.GetDelegation(ctx, del, valAddrs[0])
+			require.True(t, found)
+			if delegation.Shares.GT(valTokens.ToDec()) {
+				require.Equal(t, valTokens.Add(initial.QuoRaw(4)).ToDec(), delegation.Shares)
+				n++
+			}
+		}
+		return n
+	}
+
+	// nothing happens outside of a compounding round
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, 0, compounded())
+
+	// the round starts with the first delegator
+	ctx = ctx.WithBlockHeight(5)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, 1, compounded())
+	_, inProgress := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, inProgress)
+
+	// and continues in the next block with the second one
+	ctx = ctx.WithBlockHeight(6)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, 2, compounded())
+	_, inProgress = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, inProgress)
+
+	// the delegators have no outstanding rewards left
+	val = app.StakingKeeper.Validator(ctx, valAddrs[0])
+	for _, del := range addr {
+		delegation := app.StakingKeeper.Delegation(ctx, del, valAddrs[0])
+		endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+		require.True(t, app.DistrKeeper.CalculateDelegationRewards(ctx, val, delegation, endingPeriod).IsZero())
+	}
+
+	// opting out removes the delegator from the next rounds
+	app.DistrKeeper.SetAutoCompound(ctx, addr[1], false)
+	require.False(t, app.DistrKeeper.HasAutoCompound(ctx, addr[1]))
+}
+
+func TestAutoCompoundRewardsBatchesDelegations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// compound every 5 blocks, one delegation per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 5
+	params.AutoCompoundBatchSize = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create two validators without commission and a delegator to both
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk2, 100, true)
+	delAddr := addr[2]
+	tstaking.Delegate(delAddr, valAddrs[0], valTokens)
+	tstaking.Delegate(delAddr, valAddrs[1], valTokens)
+
+	// end block to bond the validators
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(5)
+
+	// allocate some rewards, half of them to the delegator
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	for _, valAddr := range valAddrs[:2] {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	}
+
+	app.DistrKeeper.SetAutoCompound(ctx, delAddr, true)
+	balance := app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	compounded := func() (n int) {
+		for _, valAddr := range valAddrs[:2] {
+			delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+			require.True(t, found)
+			if delegation.Shares.GT(valTokens.ToDec()) {
+				require.Equal(t, valTokens.Add(initial.QuoRaw(2)).ToDec(), delegation.Shares)
+				n++
+			}
+		}
+		return n
+	}
+
+	// the round compounds a single delegation of the delegator per block
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, 1, compounded())
+	_, inProgress := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, inProgress)
+
+	ctx = ctx.WithBlockHeight(6)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, 2, compounded())
+	_, inProgress = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, inProgress)
+
+	// the rewards were delegated from the delegator account
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+}
@@ -137,6 +137,28 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.Val
 }
 
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
+	coins, err := k.settleDelegationRewards(ctx, val, del)
+	if err != nil {
+		return nil, err
+	}
+
+	// add coins to user account
+	if !coins.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return coins, nil
+}
+
+// settleDelegationRewards ends the delegation rewards period and removes the
+// rewards of the delegation from the validator outstanding rewards. The
+// returned coins are left in the distribution module account for the caller
+// to pay out.
+func (k Keeper) settleDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
 		return nil, types.ErrEmptyDelegationDistInfo
@@ -164,17 +186,7 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 	// truncate coins, return remainder to community pool
 	coins, remainder := rewards.TruncateDecimal()
 
-	// add coins to user account
-	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// update the outstanding rewards and the community pool only if the
-	// transaction was successful
+	// update the outstanding rewards and the community pool
 	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
 	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -702,3 +703,56 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delAddr, owner := addr[1], addr[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 0% commission and a delegation of the same size
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(delAddr, valAddrs[0], valTokens)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize the whole delegation, the rewards belong to the owner
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgTokenizeShares(
+		delAddr, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens), owner,
+	))
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	ownerBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+	require.Equal(t, ownerBalance.Amount.Add(initial.QuoRaw(2)), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount)
+
+	// the delegator doesn't own any record
+	rewards, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, delAddr)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+}
@@ -27,6 +27,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetDelegatorWithdrawAddr(ctx, delegatorAddress, withdrawAddress)
 	}
 
+	for _, del := range data.AutoCompoundDelegators {
+		delegatorAddress, err := sdk.AccAddressFromBech32(del)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetAutoCompoundDelegator(ctx, delegatorAddress)
+	}
+
 	var previousProposer sdk.ConsAddress
 	if data.PreviousProposer != "" {
 		var err error
@@ -190,5 +199,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	var autoCompoundDelegators []string
+	k.IterateAutoCompoundDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompoundDelegators = append(autoCompoundDelegators, del.String())
+		return false
+	})
+
+	genState := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegators = autoCompoundDelegators
+
+	return genState
 }
@@ -248,3 +248,22 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// DelegatorAutoCompound queries whether the rewards of a delegator are automatically compounded
+func (k Keeper) DelegatorAutoCompound(c context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDelegatorAutoCompoundResponse{Enabled: k.HasAutoCompound(ctx, delAdr)}, nil
+}
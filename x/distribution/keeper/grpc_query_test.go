@@ -71,10 +71,12 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 			"valid request",
 			func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
-					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
-					WithdrawAddrEnabled: true,
+					CommunityTax:          sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:    sdk.NewDecWithPrec(2, 1),
+					BonusProposerReward:   sdk.NewDecWithPrec(1, 1),
+					WithdrawAddrEnabled:   true,
+					AutoCompoundInterval:  500,
+					AutoCompoundBatchSize: 50,
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -118,6 +118,48 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of all the tokenize
+// share records owned by the given address and sends them to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	totalRewards := sdk.Coins{}
+
+	for _, record := range k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		// the rewards are withdrawn to the record account first, which also
+		// holds rewards withdrawn when the delegation was modified
+		if k.stakingKeeper.Validator(ctx, valAddr) != nil && k.stakingKeeper.Delegation(ctx, record.GetModuleAddress(), valAddr) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, record.GetModuleAddress(), valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
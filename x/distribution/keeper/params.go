@@ -41,3 +41,17 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetAutoCompoundInterval returns the number of blocks between two automatic
+// compounding rounds.
+func (k Keeper) GetAutoCompoundInterval(ctx sdk.Context) (interval uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundInterval, &interval)
+	return interval
+}
+
+// GetAutoCompoundBatchSize returns the maximum number of delegations whose
+// rewards are compounded in a single block.
+func (k Keeper) GetAutoCompoundBatchSize(ctx sdk.Context) (size uint32) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundBatchSize, &size)
+	return size
+}
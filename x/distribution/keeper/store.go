@@ -43,6 +43,56 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// check whether a delegator opted in for automatic rewards compounding
+func (k Keeper) HasAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// set a delegator as opted in for automatic rewards compounding
+func (k Keeper) SetAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{})
+}
+
+// delete a delegator opted in for automatic rewards compounding
+func (k Keeper) DeleteAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// iterate over delegators opted in for automatic rewards compounding
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del := types.GetAutoCompoundDelegatorAddress(iter.Key())
+		if handler(del) {
+			break
+		}
+	}
+}
+
+// get the key of the next delegator of the ongoing automatic compounding round
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (cursor []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor = store.Get(types.AutoCompoundCursorKey)
+	return cursor, cursor != nil
+}
+
+// set the key of the next delegator of the ongoing automatic compounding round
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, cursor)
+}
+
+// delete the automatic compounding round cursor
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
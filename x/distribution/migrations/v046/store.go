@@ -0,0 +1,26 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3.
+// The migration includes:
+//
+// - Setting the AutoCompoundInterval and AutoCompoundBatchSize params in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+
+	return nil
+}
+
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyAutoCompoundInterval, types.DefaultAutoCompoundInterval)
+	paramstore.Set(ctx, types.ParamStoreKeyAutoCompoundBatchSize, types.DefaultAutoCompoundBatchSize)
+}
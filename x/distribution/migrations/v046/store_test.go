@@ -0,0 +1,34 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046distribution "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distributionKey := sdk.NewKVStoreKey("distribution")
+	tDistributionKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distributionKey, tDistributionKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, distributionKey, tDistributionKey, "distribution")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundInterval))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundBatchSize))
+
+	// Run migrations.
+	err := v046distribution.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundInterval))
+	require.True(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundBatchSize))
+}
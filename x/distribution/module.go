@@ -83,6 +83,21 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, types.FeePoolKey, "fee pool")
+	registry.Register(types.StoreKey, types.ProposerKey, "previous proposer")
+	registry.Register(types.StoreKey, types.ValidatorOutstandingRewardsPrefix, "validator outstanding rewards")
+	registry.Register(types.StoreKey, types.DelegatorWithdrawAddrPrefix, "delegator withdraw addresses")
+	registry.Register(types.StoreKey, types.DelegatorStartingInfoPrefix, "delegator starting infos")
+	registry.Register(types.StoreKey, types.ValidatorHistoricalRewardsPrefix, "validator historical rewards")
+	registry.Register(types.StoreKey, types.ValidatorCurrentRewardsPrefix, "validator current rewards")
+	registry.Register(types.StoreKey, types.ValidatorAccumulatedCommissionPrefix, "validator accumulated commissions")
+	registry.Register(types.StoreKey, types.ValidatorSlashEventPrefix, "validator slash events")
+	registry.Register(types.StoreKey, types.AutoCompoundDelegatorPrefix, "auto-compound delegators")
+	registry.Register(types.StoreKey, types.AutoCompoundCursorKey, "auto-compound cursor")
+}
+
 // RegisterInterfaces implements InterfaceModule
 func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
@@ -144,6 +159,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	m := keeper.NewMigrator(am.keeper)
 	cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
 }
 
 // InitGenesis performs genesis initialization for the distribution module. It returns
@@ -163,7 +179,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock returns the begin blocker for the distribution module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
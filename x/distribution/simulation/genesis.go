@@ -9,15 +9,18 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // Simulation parameter constants
 const (
-	CommunityTax        = "community_tax"
-	BaseProposerReward  = "base_proposer_reward"
-	BonusProposerReward = "bonus_proposer_reward"
-	WithdrawEnabled     = "withdraw_enabled"
+	CommunityTax          = "community_tax"
+	BaseProposerReward    = "base_proposer_reward"
+	BonusProposerReward   = "bonus_proposer_reward"
+	WithdrawEnabled       = "withdraw_enabled"
+	AutoCompoundInterval  = "auto_compound_interval"
+	AutoCompoundBatchSize = "auto_compound_batch_size"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +43,16 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundInterval returns a randomized AutoCompoundInterval parameter.
+func GenAutoCompoundInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 50))
+}
+
+// GenAutoCompoundBatchSize returns a randomized AutoCompoundBatchSize parameter.
+func GenAutoCompoundBatchSize(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 100))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,13 +79,27 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundInterval, &autoCompoundInterval, simState.Rand,
+		func(r *rand.Rand) { autoCompoundInterval = GenAutoCompoundInterval(r) },
+	)
+
+	var autoCompoundBatchSize uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundBatchSize, &autoCompoundBatchSize, simState.Rand,
+		func(r *rand.Rand) { autoCompoundBatchSize = GenAutoCompoundBatchSize(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:          communityTax,
+			BaseProposerReward:    baseProposerReward,
+			BonusProposerReward:   bonusProposerReward,
+			WithdrawAddrEnabled:   withdrawEnabled,
+			AutoCompoundInterval:  autoCompoundInterval,
+			AutoCompoundBatchSize: autoCompoundBatchSize,
 		},
 	}
 
@@ -32,6 +32,13 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between two automatic
+	// compounding rounds of the rewards of opted-in delegators. Zero disables
+	// automatic compounding.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_batch_size is the maximum number of delegations whose rewards
+	// are compounded in a single block.
+	AutoCompoundBatchSize uint32 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +80,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundBatchSize() uint32 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +101,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -626,65 +648,69 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7c, 0xe3, 0x38, 0xe9, 0x6b, 0x9b, 0x7c, 0x99, 0x38, 0xa9, 0xe3, 0x56, 0x76, 0xb4,
-	0x12, 0x10, 0x54, 0xc5, 0x69, 0xda, 0x5b, 0xc4, 0x25, 0x76, 0x82, 0xe0, 0xd4, 0x68, 0x83, 0x00,
-	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x39, 0xf0,
-	0xe3, 0x84, 0xc4, 0x05, 0x71, 0x40, 0x3d, 0x22, 0xce, 0xbd, 0x70, 0xe4, 0xd6, 0x63, 0xe9, 0x05,
-	0xc4, 0x21, 0xa0, 0x44, 0x48, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xd7, 0x81, 0x50, 0xf5, 0x10,
-	0x8b, 0x93, 0x3d, 0xef, 0xcd, 0x7e, 0x7e, 0xbc, 0x79, 0xfb, 0x66, 0xa1, 0x13, 0x0a, 0x95, 0x08,
-	0xb5, 0x19, 0x31, 0xa5, 0x25, 0xeb, 0x67, 0x9a, 0x09, 0xbe, 0x39, 0xda, 0xea, 0x53, 0x4d, 0xb6,
-	0x2e, 0x04, 0x3b, 0xa9, 0x14, 0x5a, 0xe0, 0xdb, 0x76, 0x7f, 0xe7, 0x42, 0xca, 0xed, 0x6f, 0xd6,
-	0x07, 0x62, 0x20, 0xf2, 0x7d, 0x9b, 0xe6, 0x9f, 0x7d, 0xa4, 0xd9, 0x72, 0x14, 0x7d, 0xa2, 0x68,
-	0x01, 0x1d, 0x0a, 0xe6, 0x20, 0x9b, 0xab, 0x36, 0x1f, 0xd8, 0x07, 0x1d, 0x7e, 0xbe, 0xf0, 0x3e,
-	0x99, 0x81, 0xda, 0x3e, 0x91, 0x24, 0x51, 0x98, 0xc0, 0xcd, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x14, 0x68, 0x72, 0xd2, 0x40, 0x6b, 0x68, 0xfd, 0x5a, 0xf7, 0xcd, 0xa7, 0xa7, 0xed, 0xca, 0x2f,
-	0xa7, 0xed, 0xd7, 0x06, 0x4c, 0x0f, 0xb3, 0x7e, 0x27, 0x14, 0x89, 0x83, 0x70, 0x3f, 0x1b, 0x2a,
-	0x3a, 0xda, 0xd4, 0x8f, 0x52, 0xaa, 0x3a, 0xbb, 0x34, 0x7c, 0xfe, 0x64, 0x03, 0x1c, 0xc3, 0x2e,
-	0x0d, 0xfd, 0x1b, 0x05, 0xe4, 0xbb, 0xe4, 0x04, 0x73, 0xa8, 0x1b, 0x8d, 0x46, 0x48, 0x2a, 0x14,
-	0x95, 0x81, 0xa4, 0xc7, 0x44, 0x46, 0x8d, 0xff, 0x5d, 0x01, 0x13, 0x36, 0xc8, 0xfb, 0x0e, 0xd8,
-	0xcf, 0x71, 0x71, 0x0a, 0xcb, 0x7d, 0xc1, 0x33, 0xf5, 0x0f, 0xc2, 0x99, 0x2b, 0x20, 0x5c, 0xca,
-	0xa1, 0xff, 0xc6, 0x78, 0x1f, 0x96, 0x8f, 0x99, 0x1e, 0x46, 0x92, 0x1c, 0x07, 0x24, 0x8a, 0x64,
-	0x40, 0x39, 0xe9, 0xc7, 0x34, 0x6a, 0x54, 0xd7, 0xd0, 0xfa, 0xbc, 0xbf, 0x34, 0x4e, 0xee, 0x44,
-	0x91, 0xdc, 0xb3, 0xa9, 0xed, 0xea, 0x57, 0x8f, 0xdb, 0x15, 0xef, 0x47, 0x04, 0xcd, 0xf7, 0x48,
-	0xcc, 0x22, 0xa2, 0x85, 0x7c, 0x9b, 0x29, 0x2d, 0x24, 0x0b, 0x49, 0x6c, 0x71, 0x15, 0xfe, 0x0c,
-	0xc1, 0xad, 0x30, 0x4b, 0xb2, 0x98, 0x68, 0x36, 0xa2, 0xce, 0x47, 0x20, 0x89, 0x66, 0xa2, 0x81,
-	0xd6, 0x66, 0xd6, 0xaf, 0xdf, 0xbf, 0xe3, 0x3a, 0xad, 0x63, 0x0a, 0x31, 0xee, 0x18, 0xa3, 0xb4,
-	0x27, 0x18, 0xef, 0x3e, 0x30, 0x5e, 0xbf, 0xfb, 0xb5, 0x7d, 0xf7, 0xe5, 0xbc, 0x9a, 0x67, 0x94,
-	0xbf, 0x5c, 0x32, 0x5a, 0x1d, 0xbe, 0xe1, 0xc3, 0xaf, 0xc3, 0xa2, 0xa4, 0x87, 0x54, 0x52, 0x1e,
-	0xd2, 0x20, 0x14, 0x19, 0xd7, 0xf9, 0x09, 0xde, 0xf4, 0x17, 0x8a, 0x70, 0xcf, 0x44, 0xbd, 0x6f,
-	0x10, 0xdc, 0x2a, 0x3c, 0xf5, 0x32, 0x29, 0x29, 0xd7, 0x63, 0x43, 0x47, 0x30, 0x67, 0x4d, 0xa8,
-	0xe9, 0xe9, 0x1f, 0x33, 0xe0, 0x15, 0xa8, 0xa5, 0x54, 0x32, 0x61, 0x5b, 0xad, 0xea, 0xbb, 0x95,
-	0xf7, 0x25, 0x82, 0x56, 0x21, 0x70, 0x27, 0x74, 0x76, 0x69, 0xd4, 0x13, 0x49, 0xc2, 0x94, 0x62,
-	0x82, 0xe3, 0x8f, 0x00, 0xc2, 0x62, 0x35, 0x3d, 0xa9, 0x13, 0x24, 0xde, 0xe7, 0x08, 0x6e, 0x17,
-	0xaa, 0x1e, 0x66, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0x07, 0xff, 0x45, 0xe9, 0xbc, 0xaf, 0x11, 0x2c,
-	0x15, 0x62, 0x0e, 0x62, 0xa2, 0x86, 0x7b, 0x23, 0xca, 0x35, 0x7e, 0x03, 0xfe, 0x3f, 0x1a, 0x87,
-	0x03, 0x57, 0x5c, 0x94, 0x17, 0x77, 0xb1, 0x88, 0xef, 0xe7, 0x61, 0xfc, 0x01, 0xcc, 0x1f, 0x4a,
-	0x12, 0x9a, 0x49, 0x76, 0x25, 0xaf, 0x7a, 0x81, 0x66, 0x2a, 0x55, 0xbf, 0x44, 0x9c, 0xc2, 0x31,
-	0xac, 0x94, 0xea, 0x94, 0x49, 0x04, 0x34, 0xcf, 0xb8, 0x8a, 0xdd, 0xeb, 0xbc, 0x60, 0xcc, 0x76,
-	0x2e, 0x81, 0xec, 0x56, 0x8d, 0x64, 0xbf, 0x3e, 0xba, 0x84, 0xcd, 0xbd, 0xc1, 0x1f, 0x23, 0x98,
-	0x7b, 0x8b, 0xd2, 0x7d, 0x21, 0x62, 0x7c, 0x02, 0x0b, 0xe5, 0x30, 0x4d, 0x85, 0x88, 0xa7, 0x77,
-	0x52, 0xe5, 0xd4, 0x36, 0xcc, 0xde, 0xef, 0x08, 0x9a, 0xbd, 0xc9, 0xc8, 0x41, 0x4a, 0x79, 0x64,
-	0xc7, 0x14, 0x89, 0x71, 0x1d, 0x66, 0x35, 0xd3, 0x31, 0xb5, 0xd3, 0xdd, 0xb7, 0x0b, 0xbc, 0x06,
-	0xd7, 0x23, 0xaa, 0x42, 0xc9, 0xd2, 0xf2, 0x90, 0xfc, 0xc9, 0x10, 0xbe, 0x03, 0xd7, 0x24, 0x0d,
-	0x59, 0xca, 0x28, 0xd7, 0x76, 0x7c, 0xfa, 0x65, 0x00, 0x87, 0x50, 0x23, 0x49, 0x3e, 0x08, 0xaa,
-	0xb9, 0xcd, 0xd5, 0x4b, 0x6d, 0xe6, 0x1e, 0xef, 0x39, 0x8f, 0xeb, 0x2f, 0xe1, 0xd1, 0x1a, 0x74,
-	0xd0, 0xdb, 0x37, 0x3e, 0x7d, 0xdc, 0xae, 0x98, 0x4a, 0xff, 0x61, 0xaa, 0xfd, 0x03, 0x82, 0xe5,
-	0x5d, 0x1a, 0xd3, 0x41, 0x7e, 0x18, 0x9a, 0x48, 0xcd, 0xf8, 0xe0, 0x1d, 0x7e, 0x98, 0x8f, 0xa7,
-	0x54, 0xd2, 0x11, 0x13, 0x66, 0xf0, 0x4f, 0x36, 0xe6, 0xc2, 0x38, 0xec, 0xfa, 0xd2, 0x87, 0x59,
-	0xa5, 0xc9, 0x11, 0xbd, 0x92, 0xa6, 0xb4, 0x50, 0xf8, 0x2e, 0xd4, 0x86, 0x94, 0x0d, 0x86, 0xb6,
-	0x48, 0xd5, 0xee, 0xd2, 0x9f, 0xa7, 0xed, 0xc5, 0x50, 0x52, 0x33, 0x38, 0x79, 0x60, 0x53, 0xbe,
-	0xdb, 0xe2, 0xfd, 0x84, 0x60, 0xd5, 0x79, 0x60, 0x82, 0x17, 0x6e, 0xdc, 0x5d, 0xb2, 0x07, 0xaf,
-	0x94, 0x3d, 0x6c, 0x2e, 0x13, 0xaa, 0x94, 0xbb, 0x94, 0x1b, 0xcf, 0x9f, 0x6c, 0xd4, 0x1d, 0xf9,
-	0x8e, 0xcd, 0x1c, 0x68, 0x69, 0x46, 0x44, 0xf9, 0x52, 0xba, 0x38, 0x66, 0x50, 0x2b, 0xae, 0xd9,
-	0x29, 0xb5, 0xa0, 0x23, 0xd8, 0x9e, 0x77, 0x27, 0x84, 0xbc, 0xef, 0x11, 0xbc, 0xfa, 0xef, 0x5d,
-	0xf8, 0x3e, 0xd3, 0xc3, 0x5d, 0x9a, 0x0a, 0xc5, 0xf4, 0x94, 0x1a, 0x72, 0x65, 0xa2, 0x21, 0x4d,
-	0xca, 0xad, 0x70, 0x03, 0xe6, 0x22, 0x4b, 0xdc, 0x98, 0xcd, 0x13, 0xe3, 0x65, 0xa9, 0xbd, 0xfb,
-	0xf0, 0xdb, 0xb3, 0x16, 0x7a, 0x7a, 0xd6, 0x42, 0xcf, 0xce, 0x5a, 0xe8, 0xb7, 0xb3, 0x16, 0xfa,
-	0xe2, 0xbc, 0x55, 0x79, 0x76, 0xde, 0xaa, 0xfc, 0x7c, 0xde, 0xaa, 0x7c, 0xb8, 0xf5, 0xc2, 0xc2,
-	0x9c, 0x5c, 0xfc, 0xce, 0xcb, 0xeb, 0xd4, 0xaf, 0xe5, 0xdf, 0x5a, 0x0f, 0xfe, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x12, 0x5a, 0x76, 0x0b, 0x0a, 0x00, 0x00,
+	// 983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x50, 0xc7, 0x49, 0x5e, 0x9b, 0x04, 0x26, 0x4e, 0xea, 0xb8, 0x95, 0x6d, 0x59, 0x02,
+	0x8c, 0xaa, 0x38, 0x4d, 0x8b, 0x84, 0x14, 0x71, 0x89, 0x9d, 0x20, 0x7a, 0x6a, 0xb4, 0x41, 0x80,
+	0xb8, 0xac, 0xc6, 0xbb, 0x13, 0x7b, 0x94, 0xdd, 0x99, 0x65, 0x66, 0xd6, 0x49, 0x7b, 0xe5, 0x02,
+	0x9c, 0x90, 0xb8, 0x20, 0x0e, 0xa8, 0x47, 0xc4, 0xb9, 0x17, 0x8e, 0xdc, 0x7a, 0x2c, 0xbd, 0x80,
+	0x38, 0x04, 0x94, 0x08, 0x09, 0xf1, 0x03, 0x38, 0xa3, 0xd9, 0x19, 0xaf, 0x1d, 0x08, 0x55, 0x0f,
+	0xb1, 0x38, 0xd9, 0xf3, 0xde, 0xcc, 0xfb, 0xde, 0xf7, 0xcd, 0xdb, 0xf7, 0x06, 0xda, 0x81, 0x50,
+	0xb1, 0x50, 0x1b, 0x21, 0x53, 0x5a, 0xb2, 0x5e, 0xaa, 0x99, 0xe0, 0x1b, 0xc3, 0xcd, 0x1e, 0xd5,
+	0x64, 0xf3, 0x9c, 0xb1, 0x9d, 0x48, 0xa1, 0x05, 0xbe, 0x61, 0xf7, 0xb7, 0xcf, 0xb9, 0xdc, 0xfe,
+	0x6a, 0xb9, 0x2f, 0xfa, 0x22, 0xdb, 0xb7, 0x61, 0xfe, 0xd9, 0x23, 0xd5, 0x9a, 0x83, 0xe8, 0x11,
+	0x45, 0xf3, 0xd0, 0x81, 0x60, 0x2e, 0x64, 0x75, 0xcd, 0xfa, 0x7d, 0x7b, 0xd0, 0xc5, 0xcf, 0x16,
+	0xcd, 0xbf, 0xae, 0x40, 0x69, 0x8f, 0x48, 0x12, 0x2b, 0x4c, 0x60, 0x21, 0x10, 0x71, 0x9c, 0x72,
+	0xa6, 0x1f, 0xf8, 0x9a, 0x1c, 0x57, 0x50, 0x03, 0xb5, 0xe6, 0x3b, 0x6f, 0x3f, 0x39, 0xa9, 0x17,
+	0x7e, 0x39, 0xa9, 0xbf, 0xd6, 0x67, 0x7a, 0x90, 0xf6, 0xda, 0x81, 0x88, 0x5d, 0x08, 0xf7, 0xb3,
+	0xae, 0xc2, 0xc3, 0x0d, 0xfd, 0x20, 0xa1, 0xaa, 0xbd, 0x43, 0x83, 0x67, 0x8f, 0xd7, 0xc1, 0x21,
+	0xec, 0xd0, 0xc0, 0xbb, 0x96, 0x87, 0x7c, 0x8f, 0x1c, 0x63, 0x0e, 0x65, 0x93, 0xa3, 0x49, 0x24,
+	0x11, 0x8a, 0x4a, 0x5f, 0xd2, 0x23, 0x22, 0xc3, 0xca, 0x4b, 0x97, 0x80, 0x84, 0x4d, 0xe4, 0x3d,
+	0x17, 0xd8, 0xcb, 0xe2, 0xe2, 0x04, 0x56, 0x7a, 0x82, 0xa7, 0xea, 0x5f, 0x80, 0x57, 0x2e, 0x01,
+	0x70, 0x39, 0x0b, 0xfd, 0x0f, 0xc4, 0x3b, 0xb0, 0x72, 0xc4, 0xf4, 0x20, 0x94, 0xe4, 0xc8, 0x27,
+	0x61, 0x28, 0x7d, 0xca, 0x49, 0x2f, 0xa2, 0x61, 0xa5, 0xd8, 0x40, 0xad, 0x39, 0x6f, 0x79, 0xe4,
+	0xdc, 0x0e, 0x43, 0xb9, 0x6b, 0x5d, 0xf8, 0x4d, 0x58, 0x25, 0xa9, 0x16, 0x7e, 0x20, 0xe2, 0x44,
+	0xa4, 0x3c, 0xf4, 0x19, 0xd7, 0x54, 0x0e, 0x49, 0x54, 0x99, 0x69, 0xa0, 0x56, 0xd1, 0x2b, 0x1b,
+	0x6f, 0xd7, 0x39, 0xef, 0x39, 0x1f, 0x7e, 0x0b, 0x2a, 0xe7, 0x4f, 0xf5, 0x88, 0x0e, 0x06, 0xbe,
+	0x62, 0x0f, 0x69, 0xa5, 0xd4, 0x40, 0xad, 0x05, 0x6f, 0x65, 0xf2, 0x5c, 0xc7, 0x78, 0xf7, 0xd9,
+	0x43, 0xba, 0x55, 0xfc, 0xea, 0x51, 0xbd, 0xd0, 0xfc, 0x11, 0x41, 0xf5, 0x7d, 0x12, 0xb1, 0x90,
+	0x68, 0x21, 0xdf, 0x65, 0x4a, 0x0b, 0xc9, 0x02, 0x12, 0x59, 0x1a, 0x0a, 0x7f, 0x86, 0xe0, 0x7a,
+	0x90, 0xc6, 0x69, 0x44, 0x34, 0x1b, 0x52, 0x27, 0x9b, 0x2f, 0x89, 0x66, 0xa2, 0x82, 0x1a, 0x57,
+	0x5a, 0x57, 0xef, 0xdc, 0x74, 0x85, 0xdd, 0x36, 0xba, 0x8f, 0x0a, 0xd4, 0x08, 0xd3, 0x15, 0x8c,
+	0x77, 0xee, 0x1a, 0x69, 0xbf, 0xfb, 0xb5, 0x7e, 0xeb, 0xc5, 0xa4, 0x35, 0x67, 0x94, 0xb7, 0x32,
+	0x46, 0xb4, 0x79, 0x78, 0x06, 0x0f, 0xbf, 0x0e, 0x4b, 0x92, 0x1e, 0x50, 0x49, 0x79, 0x40, 0xfd,
+	0x40, 0xa4, 0x5c, 0x67, 0x05, 0xb3, 0xe0, 0x2d, 0xe6, 0xe6, 0xae, 0xb1, 0x36, 0xbf, 0x41, 0x70,
+	0x3d, 0xe7, 0xd4, 0x4d, 0xa5, 0xa4, 0x5c, 0x8f, 0x08, 0x1d, 0xc2, 0xac, 0x25, 0xa1, 0xa6, 0x97,
+	0xff, 0x08, 0x01, 0xaf, 0x42, 0x29, 0xa1, 0x92, 0x09, 0x5b, 0xd9, 0x45, 0xcf, 0xad, 0x9a, 0x5f,
+	0x22, 0xa8, 0xe5, 0x09, 0x6e, 0x07, 0x8e, 0x2e, 0x0d, 0xbb, 0x22, 0x8e, 0x99, 0x52, 0x4c, 0x70,
+	0xfc, 0x31, 0x40, 0x90, 0xaf, 0xa6, 0x97, 0xea, 0x04, 0x48, 0xf3, 0x73, 0x04, 0x37, 0xf2, 0xac,
+	0xee, 0xa7, 0x5a, 0x69, 0xc2, 0x43, 0xc6, 0xfb, 0xff, 0x87, 0x74, 0xcd, 0xaf, 0x11, 0x2c, 0xe7,
+	0xc9, 0xec, 0x47, 0x44, 0x0d, 0x76, 0x87, 0x94, 0x6b, 0xfc, 0x06, 0xbc, 0x3c, 0x1c, 0x99, 0x7d,
+	0x27, 0x2e, 0xca, 0xc4, 0x5d, 0xca, 0xed, 0x7b, 0x99, 0x19, 0x7f, 0x08, 0x73, 0x07, 0x92, 0x04,
+	0xa6, 0x71, 0x5e, 0x4a, 0x67, 0xc9, 0xa3, 0x19, 0xa5, 0xca, 0x17, 0x24, 0xa7, 0x70, 0x04, 0xab,
+	0xe3, 0xec, 0x94, 0x71, 0xf8, 0x34, 0xf3, 0x38, 0xc5, 0x6e, 0xb7, 0x9f, 0xd3, 0xd5, 0xdb, 0x17,
+	0x84, 0xec, 0x14, 0x4d, 0xca, 0x5e, 0x79, 0x78, 0x01, 0x9a, 0xfb, 0x82, 0x3f, 0x41, 0x30, 0xfb,
+	0x0e, 0xa5, 0x7b, 0x42, 0x44, 0xf8, 0x18, 0x16, 0xc7, 0xbd, 0x3b, 0x11, 0x22, 0x9a, 0xde, 0x4d,
+	0x8d, 0x87, 0x84, 0x41, 0x6e, 0xfe, 0x8e, 0xa0, 0xda, 0x9d, 0xb4, 0xec, 0x27, 0x94, 0x87, 0xb6,
+	0x2b, 0x92, 0x08, 0x97, 0x61, 0x46, 0x33, 0x1d, 0x51, 0x3b, 0x4c, 0x3c, 0xbb, 0xc0, 0x0d, 0xb8,
+	0x1a, 0x52, 0x15, 0x48, 0x96, 0x8c, 0x2f, 0xc9, 0x9b, 0x34, 0xe1, 0x9b, 0x30, 0x2f, 0x69, 0xc0,
+	0x12, 0x46, 0xb9, 0xb6, 0xdd, 0xda, 0x1b, 0x1b, 0x70, 0x00, 0x25, 0x12, 0x67, 0x8d, 0xa0, 0x98,
+	0xd1, 0x5c, 0xbb, 0x90, 0x66, 0xc6, 0xf1, 0xb6, 0xe3, 0xd8, 0x7a, 0x01, 0x8e, 0x96, 0xa0, 0x0b,
+	0xbd, 0x75, 0xed, 0xd3, 0x47, 0xf5, 0x82, 0x51, 0xfa, 0x0f, 0xa3, 0xf6, 0x0f, 0x08, 0x56, 0x76,
+	0x68, 0x44, 0xfb, 0xd9, 0x65, 0x68, 0x22, 0x35, 0xe3, 0xfd, 0x7b, 0xfc, 0x20, 0x6b, 0x4f, 0x89,
+	0xa4, 0x43, 0x26, 0xcc, 0x9c, 0x99, 0x2c, 0xcc, 0xc5, 0x91, 0xd9, 0xd5, 0xa5, 0x07, 0x33, 0x4a,
+	0x93, 0x43, 0x7a, 0x29, 0x45, 0x69, 0x43, 0xe1, 0x5b, 0x50, 0x1a, 0x50, 0xd6, 0x1f, 0x58, 0x91,
+	0x8a, 0x9d, 0xe5, 0x3f, 0x4f, 0xea, 0x4b, 0x81, 0xa4, 0xa6, 0x71, 0x72, 0xdf, 0xba, 0x3c, 0xb7,
+	0xa5, 0xf9, 0x13, 0x82, 0x35, 0xc7, 0x81, 0x09, 0x9e, 0xb3, 0x71, 0xa3, 0x6b, 0x17, 0x5e, 0x19,
+	0xd7, 0xb0, 0x99, 0x5d, 0x54, 0x29, 0xf7, 0x06, 0xa8, 0x3c, 0x7b, 0xbc, 0x5e, 0x76, 0xe0, 0xdb,
+	0xd6, 0xb3, 0xaf, 0xa5, 0x69, 0x11, 0xe3, 0x8f, 0xd2, 0xd9, 0x31, 0x83, 0x52, 0x3e, 0xd5, 0xa7,
+	0x54, 0x82, 0x0e, 0x60, 0x6b, 0xce, 0xdd, 0x10, 0x6a, 0x7e, 0x8f, 0xe0, 0xd5, 0xff, 0xae, 0xc2,
+	0x0f, 0x98, 0x1e, 0xec, 0xd0, 0x44, 0x28, 0xa6, 0xa7, 0x54, 0x90, 0xab, 0x13, 0x05, 0x69, 0x5c,
+	0x6e, 0x85, 0x2b, 0x30, 0x1b, 0x5a, 0xe0, 0x6c, 0x96, 0xcf, 0x7b, 0xa3, 0xe5, 0x38, 0xf7, 0xce,
+	0xfd, 0x6f, 0x4f, 0x6b, 0xe8, 0xc9, 0x69, 0x0d, 0x3d, 0x3d, 0xad, 0xa1, 0xdf, 0x4e, 0x6b, 0xe8,
+	0x8b, 0xb3, 0x5a, 0xe1, 0xe9, 0x59, 0xad, 0xf0, 0xf3, 0x59, 0xad, 0xf0, 0xd1, 0xe6, 0x73, 0x85,
+	0x39, 0x3e, 0xff, 0xac, 0xcc, 0x74, 0xea, 0x95, 0xb2, 0xa7, 0xdd, 0xdd, 0xbf, 0x07, 0x00, 0xac,
+	0xd3, 0xc0, 0x8b, 0x7a, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -718,6 +744,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1045,6 +1077,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1587,6 +1629,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
 	return n
 }
 
@@ -1949,6 +1997,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
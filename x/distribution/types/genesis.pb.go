@@ -347,6 +347,9 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators which opted in for
+	// automatic rewards compounding at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -398,63 +401,65 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x93, 0x65, 0xbb, 0x3b, 0x29, 0xa2, 0xb8, 0xdb, 0xe0, 0xdd, 0x16, 0x27, 0x2d, 0x3d,
+	0x14, 0xa1, 0x3a, 0x6c, 0x8a, 0x00, 0x15, 0x81, 0x94, 0xa4, 0xcb, 0x9f, 0x53, 0x57, 0x09, 0xa2,
+	0x12, 0x12, 0xb2, 0x26, 0xf6, 0xc4, 0x19, 0x48, 0x3c, 0xd6, 0xcc, 0xd8, 0x5b, 0x24, 0x4e, 0x48,
+	0x48, 0x3d, 0x22, 0xc1, 0x07, 0xe8, 0x11, 0x21, 0x71, 0x41, 0x7c, 0x06, 0xd4, 0x63, 0xc5, 0x89,
+	0x03, 0x02, 0x94, 0xe5, 0xc0, 0x57, 0xe0, 0x86, 0x3c, 0x1e, 0x8f, 0x6d, 0xad, 0xd7, 0xcd, 0xb6,
+	0xbb, 0xa7, 0xdd, 0xf1, 0xbc, 0x3f, 0xbf, 0xdf, 0x7b, 0xbf, 0xbc, 0x37, 0xe0, 0x55, 0x87, 0xb0,
+	0x05, 0x61, 0x5d, 0x17, 0x33, 0x4e, 0xf1, 0x24, 0xe4, 0x98, 0xf8, 0xdd, 0x68, 0x77, 0x82, 0x38,
+	0xdc, 0xed, 0x7a, 0xc8, 0x47, 0x0c, 0x33, 0x2b, 0xa0, 0x84, 0x13, 0xfd, 0x72, 0x62, 0x6a, 0xe5,
+	0x4d, 0x2d, 0x69, 0xba, 0xb3, 0xe5, 0x11, 0x8f, 0x08, 0xbb, 0x6e, 0xfc, 0x5f, 0xe2, 0xb2, 0x63,
+	0xca, 0xe8, 0x13, 0xc8, 0x90, 0x8a, 0xea, 0x10, 0xec, 0xcb, 0x7b, 0xab, 0x2a, 0x7b, 0x21, 0x4f,
+	0x62, 0xbf, 0x9d, 0xd8, 0xdb, 0x49, 0x22, 0x89, 0x47, 0x1c, 0xae, 0xfd, 0xa4, 0x81, 0x4b, 0x77,
+	0xd0, 0x1c, 0x79, 0x90, 0x13, 0x7a, 0x0f, 0xf3, 0x99, 0x4b, 0xe1, 0xc1, 0x47, 0xfe, 0x94, 0xe8,
+	0x7b, 0xe0, 0x45, 0x37, 0xbd, 0xb0, 0xa1, 0xeb, 0x52, 0xc4, 0x98, 0xa1, 0x75, 0xb4, 0x1b, 0x9b,
+	0x03, 0xe3, 0xb7, 0x5f, 0x6e, 0x6e, 0xc9, 0x30, 0xfd, 0xe4, 0x66, 0xcc, 0x29, 0xf6, 0xbd, 0xd1,
+	0x05, 0xe5, 0x22, 0xbf, 0xeb, 0x43, 0x70, 0xe1, 0x40, 0x86, 0x55, 0x51, 0xea, 0x4f, 0x88, 0xf2,
+	0x42, 0xea, 0x21, 0x3f, 0xdf, 0xde, 0x78, 0xf0, 0xb0, 0x5d, 0xfb, 0xf7, 0x61, 0xbb, 0x76, 0xed,
+	0x3f, 0x0d, 0x5c, 0xfd, 0x04, 0xce, 0xb1, 0x1b, 0xe7, 0xb8, 0x1b, 0x72, 0xc6, 0xa1, 0xef, 0xc6,
+	0x3e, 0xe8, 0x00, 0x52, 0x97, 0x8d, 0x90, 0x43, 0xa8, 0x1b, 0x63, 0x8f, 0x52, 0xa3, 0xd5, 0xb1,
+	0x2b, 0x97, 0x14, 0xfb, 0xd7, 0x1a, 0xb8, 0x48, 0xb2, 0x1c, 0x36, 0x4d, 0x92, 0x18, 0xf5, 0x4e,
+	0xe3, 0x46, 0xb3, 0x77, 0x45, 0xb6, 0xc1, 0x8a, 0xdb, 0x94, 0x76, 0xd4, 0xba, 0x83, 0x9c, 0x21,
+	0xc1, 0xfe, 0xe0, 0xd6, 0xa3, 0x3f, 0xdb, 0xb5, 0x1f, 0xff, 0x6a, 0xbf, 0xe6, 0x61, 0x3e, 0x0b,
+	0x27, 0x96, 0x43, 0x16, 0xb2, 0xf2, 0xf2, 0xcf, 0x4d, 0xe6, 0x7e, 0xd1, 0xe5, 0x5f, 0x06, 0x88,
+	0xa5, 0x3e, 0x6c, 0xa4, 0x93, 0x23, 0x8c, 0x72, 0xdc, 0xff, 0xd0, 0xc0, 0x75, 0xc5, 0xbd, 0xef,
+	0x38, 0xe1, 0x22, 0x9c, 0x43, 0x8e, 0xdc, 0x21, 0x59, 0x2c, 0x30, 0x63, 0x98, 0xf8, 0xa7, 0x4b,
+	0xdf, 0x01, 0x4d, 0x98, 0x65, 0x11, 0x5d, 0x6b, 0xf6, 0xde, 0xb1, 0x2a, 0xf4, 0x6c, 0x55, 0xc3,
+	0x1b, 0xac, 0xc5, 0x45, 0x19, 0xe5, 0xa3, 0xe6, 0xe8, 0xfd, 0xa3, 0x81, 0x8e, 0xf2, 0xff, 0x10,
+	0x33, 0x4e, 0x28, 0x76, 0xe0, 0xfc, 0x4c, 0x3a, 0xdb, 0x02, 0xeb, 0x01, 0xa2, 0x98, 0x24, 0xac,
+	0xd6, 0x46, 0xf2, 0xa4, 0xdf, 0x03, 0xe7, 0xd2, 0x26, 0x37, 0x04, 0xdd, 0xb7, 0x56, 0xa3, 0x7b,
+	0x04, 0xae, 0xa4, 0x9a, 0x46, 0xcb, 0xd1, 0xfc, 0x55, 0x03, 0x2f, 0x2b, 0xbf, 0x61, 0x48, 0x29,
+	0xf2, 0xf9, 0x99, 0x70, 0xfc, 0x38, 0xe3, 0x92, 0xb4, 0xee, 0x8d, 0xd5, 0xb8, 0x14, 0x31, 0x1d,
+	0x4f, 0xe4, 0xfb, 0x3a, 0xb8, 0xac, 0x46, 0xc7, 0x98, 0x43, 0xca, 0xb1, 0xef, 0xc5, 0xa3, 0x23,
+	0xa3, 0x71, 0x1a, 0x03, 0xa4, 0xb4, 0x1a, 0xf5, 0x13, 0x57, 0xe3, 0x33, 0xf0, 0x3c, 0x93, 0x18,
+	0x6d, 0xec, 0x4f, 0x89, 0xec, 0x6f, 0xaf, 0xb2, 0x26, 0xa5, 0xf4, 0x64, 0x45, 0xce, 0xb3, 0xdc,
+	0xb7, 0x5c, 0x59, 0x1e, 0xd4, 0xc1, 0xb6, 0xaa, 0xe5, 0x78, 0x0e, 0xd9, 0x6c, 0x2f, 0x12, 0xe5,
+	0x3c, 0x65, 0xfd, 0xce, 0x10, 0xf6, 0x66, 0x3c, 0xd5, 0x6f, 0x72, 0xca, 0xe9, 0xba, 0x51, 0xd0,
+	0xf5, 0xe7, 0xe0, 0x52, 0x96, 0x96, 0xc5, 0xa0, 0x6c, 0x14, 0xa3, 0x32, 0xd6, 0x44, 0x15, 0x5e,
+	0x5f, 0x4d, 0x19, 0x19, 0x1b, 0x59, 0x83, 0x8b, 0xd1, 0xd1, 0xab, 0x5c, 0x29, 0x7e, 0xde, 0x04,
+	0xe7, 0x3f, 0x48, 0x96, 0xe1, 0x98, 0x43, 0x8e, 0xf4, 0x3e, 0x58, 0x0f, 0x20, 0x85, 0x8b, 0x84,
+	0x72, 0xb3, 0xf7, 0x4a, 0x65, 0xde, 0x7d, 0x61, 0x2a, 0x53, 0x49, 0x47, 0x7d, 0x0f, 0x6c, 0x4c,
+	0x11, 0xb2, 0x03, 0x42, 0xe6, 0x52, 0xd6, 0xd7, 0x2b, 0x83, 0xbc, 0x8f, 0xd0, 0x3e, 0x21, 0xf3,
+	0x54, 0xc6, 0xd3, 0xe4, 0xa8, 0x53, 0x60, 0x64, 0xe2, 0x54, 0x0b, 0x2a, 0x16, 0x46, 0xfc, 0xcb,
+	0x6f, 0xac, 0xae, 0x8c, 0xfc, 0xce, 0x94, 0x49, 0x5a, 0x6e, 0xd9, 0xa5, 0x50, 0x72, 0x40, 0x51,
+	0x84, 0x49, 0x28, 0x56, 0x71, 0x40, 0x18, 0xa2, 0xc6, 0xda, 0x93, 0x7a, 0x9f, 0xba, 0xec, 0x4b,
+	0x0f, 0x3d, 0x2c, 0x5f, 0x4a, 0xcf, 0x09, 0xd4, 0xef, 0xad, 0xd6, 0xc9, 0xe3, 0x36, 0xa7, 0x64,
+	0x50, 0xb2, 0x87, 0xf4, 0xef, 0x34, 0x70, 0x35, 0x27, 0xdd, 0x6c, 0x84, 0xdb, 0x8e, 0x1a, 0xf0,
+	0xcc, 0x58, 0x17, 0x28, 0xfa, 0xcf, 0xb0, 0x24, 0x0a, 0x40, 0xda, 0x51, 0xa5, 0x2d, 0xd3, 0xbf,
+	0xd1, 0xc0, 0x95, 0x0c, 0xd5, 0x4c, 0x8d, 0x61, 0x55, 0x96, 0x73, 0x02, 0xd0, 0xbb, 0x4f, 0x39,
+	0xc6, 0x0b, 0x60, 0x76, 0xa2, 0x63, 0xed, 0xf4, 0xaf, 0xc0, 0x76, 0x06, 0xc3, 0x49, 0x26, 0xa8,
+	0xc2, 0xb0, 0x21, 0x30, 0xdc, 0x7e, 0x9a, 0xf1, 0x5b, 0x00, 0xf0, 0x52, 0x54, 0x6e, 0xa4, 0xdf,
+	0xcf, 0xab, 0xb9, 0x30, 0xe6, 0x98, 0xb1, 0x29, 0x92, 0xbf, 0x7d, 0xf2, 0x39, 0x57, 0x48, 0xdd,
+	0x72, 0xcb, 0x4c, 0x98, 0x4e, 0x41, 0xab, 0x74, 0xb0, 0x30, 0x03, 0x88, 0xbc, 0x6f, 0x9e, 0x74,
+	0xb2, 0x14, 0xb2, 0x6e, 0x95, 0xcc, 0x17, 0xa6, 0x8f, 0x80, 0x01, 0x43, 0x4e, 0x62, 0xdd, 0x05,
+	0x24, 0xf4, 0x5d, 0x5b, 0x61, 0x63, 0x46, 0xb3, 0xd3, 0xa8, 0xfc, 0x39, 0xb5, 0x62, 0xcf, 0xa1,
+	0x74, 0x54, 0xb4, 0x73, 0x6b, 0x6d, 0x70, 0xf7, 0x87, 0xa5, 0xa9, 0x3d, 0x5a, 0x9a, 0xda, 0xe3,
+	0xa5, 0xa9, 0xfd, 0xbd, 0x34, 0xb5, 0x6f, 0x0f, 0xcd, 0xda, 0xe3, 0x43, 0xb3, 0xf6, 0xfb, 0xa1,
+	0x59, 0xfb, 0x74, 0xb7, 0xf2, 0x39, 0x77, 0xbf, 0xf8, 0x24, 0x17, 0xaf, 0xbb, 0xc9, 0xba, 0x78,
+	0x69, 0xdf, 0xfa, 0x7f, 0x00, 0xf4, 0x6f, 0xc9, 0xe5, 0x34, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -780,6 +785,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1099,6 +1113,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2367,6 +2387,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
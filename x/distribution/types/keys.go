@@ -42,6 +42,10 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{}
+//
+// - 0x0A: []byte (auto compound cursor)
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +57,9 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	AutoCompoundDelegatorPrefix = []byte{0x09} // key for delegators which opted in for automatic rewards compounding
+	AutoCompoundCursorKey       = []byte{0x0A} // key for the next delegation of the ongoing automatic compounding round
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -152,6 +159,35 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetAutoCompoundDelegatorAddress creates an address from a delegator's auto compound key.
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
+// ParseAutoCompoundCursor returns the delegator and validator addresses of the
+// delegation an automatic compounding cursor points at.
+func ParseAutoCompoundCursor(cursor []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// cursor is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(cursor, 3)
+	delAddrLen := int(cursor[1])
+	kv.AssertKeyAtLeastLength(cursor, 3+delAddrLen)
+	delAddr = sdk.AccAddress(cursor[2 : 2+delAddrLen])
+
+	valAddr = cursor[3+delAddrLen:]
+	kv.AssertKeyLength(valAddr, int(cursor[2+delAddrLen]))
+
+	return delAddr, valAddr
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -213,3 +249,15 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundDelegatorKey creates the key marking a delegator as opted in
+// for automatic rewards compounding.
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoCompoundCursor creates the automatic compounding cursor pointing at
+// the delegation of a delegator to a validator.
+func GetAutoCompoundCursor(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetAutoCompoundDelegatorKey(delAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
@@ -8,14 +8,16 @@ import (
 
 // distribution message types
 const (
-	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
-	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
-	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
-	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetWithdrawAddress                = "set_withdraw_address"
+	TypeMsgWithdrawDelegatorReward           = "withdraw_delegator_reward"
+	TypeMsgWithdrawValidatorCommission       = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool                 = "fund_community_pool"
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+	TypeMsgSetAutoCompound                   = "set_auto_compound"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgWithdrawTokenizeShareRecordReward{}, &MsgSetAutoCompound{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -152,3 +154,78 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward for the given owner.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+	}
+}
+
+// Route returns the MsgWithdrawTokenizeShareRecordReward message route.
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawTokenizeShareRecordReward message type.
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawTokenizeShareRecordReward
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawTokenizeShareRecordReward message validation.
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound for the given
+// delegator.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoCompound message route.
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoCompound message type.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that the
+// expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoCompound message validation.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
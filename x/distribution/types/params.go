@@ -15,6 +15,15 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundInterval  = []byte("autocompoundinterval")
+	ParamStoreKeyAutoCompoundBatchSize = []byte("autocompoundbatchsize")
+)
+
+// Default parameter values
+const (
+	DefaultAutoCompoundInterval  uint64 = 1000
+	DefaultAutoCompoundBatchSize uint32 = 100
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,6 +38,9 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundInterval:  DefaultAutoCompoundInterval,
+		AutoCompoundBatchSize: DefaultAutoCompoundBatchSize,
 	}
 }
 
@@ -44,6 +56,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundInterval, &p.AutoCompoundInterval, validateAutoCompoundInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundBatchSize, &p.AutoCompoundBatchSize, validateAutoCompoundBatchSize),
 	}
 }
 
@@ -69,6 +83,11 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if p.AutoCompoundInterval > 0 && p.AutoCompoundBatchSize == 0 {
+		return fmt.Errorf(
+			"auto compound batch size must be positive when auto compounding is enabled",
+		)
+	}
 
 	return nil
 }
@@ -138,3 +157,25 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundBatchSize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("auto compound batch size must be positive: %d", v)
+	}
+
+	return nil
+}
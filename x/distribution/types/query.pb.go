@@ -839,6 +839,94 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// enabled defines whether the rewards of the delegator are automatically
+	// compounded.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -858,6 +946,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
 }
 
 func init() {
@@ -865,78 +955,82 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x84, 0xdc, 0x4d, 0xb0, 0xa3, 0x0d,
-	0x25, 0x11, 0x51, 0xbc, 0x4d, 0x22, 0x15, 0x68, 0x41, 0x90, 0x5f, 0xa5, 0x52, 0xab, 0x36, 0x75,
-	0xab, 0xa6, 0x70, 0xb1, 0xd6, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0xc7, 0x44, 0x55,
-	0x2f, 0x94, 0x4a, 0x5c, 0x90, 0x90, 0xb8, 0xf4, 0x98, 0x33, 0x67, 0x10, 0x12, 0x7f, 0x41, 0x8f,
-	0x15, 0x48, 0xa8, 0x27, 0x40, 0x09, 0x42, 0xbd, 0x70, 0xe6, 0x5a, 0x79, 0x66, 0xd6, 0xde, 0xb5,
-	0xd7, 0x6b, 0x3b, 0xae, 0x4f, 0x75, 0x67, 0xe7, 0x7d, 0xdf, 0xfb, 0xbc, 0x99, 0x37, 0xef, 0x29,
-	0x30, 0x5f, 0xa4, 0xac, 0x42, 0x99, 0x61, 0x39, 0x8c, 0x7b, 0x4e, 0xa1, 0xc6, 0x1d, 0xea, 0x1a,
-	0xbb, 0xcb, 0x05, 0xc2, 0xcd, 0x65, 0xe3, 0x41, 0x8d, 0x78, 0x7b, 0xd9, 0xaa, 0x47, 0x39, 0xc5,
-	0xd3, 0x72, 0x63, 0x36, 0xb8, 0x31, 0xab, 0x36, 0x6a, 0xef, 0x2b, 0x95, 0x82, 0xc9, 0x88, 0xb4,
-	0x6a, 0x6a, 0x54, 0x4d, 0xdb, 0x71, 0x4d, 0xb1, 0x5b, 0x08, 0x69, 0x53, 0x36, 0xb5, 0xa9, 0xf8,
-	0x69, 0x34, 0x7e, 0xa9, 0xd5, 0x19, 0x9b, 0x52, 0xbb, 0x4c, 0x0c, 0xb3, 0xea, 0x18, 0xa6, 0xeb,
-	0x52, 0x2e, 0x4c, 0x98, 0xfa, 0x9a, 0x0e, 0xea, 0xfb, 0xca, 0x45, 0xea, 0xf8, 0x9a, 0xd9, 0x38,
-	0x8a, 0x50, 0xc4, 0x72, 0xff, 0x39, 0xb9, 0x3f, 0x2f, 0xc3, 0x50, 0x64, 0xe2, 0x3f, 0xfa, 0x14,
-	0xe0, 0x5b, 0x0d, 0x80, 0x6d, 0xd3, 0x33, 0x2b, 0x2c, 0x47, 0x1e, 0xd4, 0x08, 0xe3, 0xfa, 0x3d,
-	0x38, 0x1b, 0x5a, 0x65, 0x55, 0xea, 0x32, 0x82, 0xd7, 0x60, 0xbc, 0x2a, 0x56, 0x52, 0x68, 0x16,
-	0x2d, 0x9c, 0x5a, 0x99, 0xcb, 0xc6, 0x64, 0x29, 0x2b, 0x8d, 0xd7, 0xc7, 0x9e, 0xfd, 0x99, 0x49,
-	0xe4, 0x94, 0xa1, 0x5e, 0x85, 0x79, 0xa1, 0x7c, 0xd7, 0x2c, 0x3b, 0x96, 0xc9, 0xa9, 0x77, 0xb3,
-	0xc6, 0x19, 0x37, 0x5d, 0xcb, 0x71, 0xed, 0x1c, 0xa9, 0x9b, 0x9e, 0xe5, 0x07, 0x81, 0xb7, 0xe0,
-	0xcc, 0xae, 0xbf, 0x2b, 0x6f, 0x5a, 0x96, 0x47, 0x98, 0x74, 0x7c, 0x72, 0x3d, 0xf5, 0xdb, 0x4f,
-	0x4b, 0x53, 0xca, 0xf7, 0x9a, 0xfc, 0x72, 0x9b, 0x7b, 0x0d, 0x89, 0xc9, 0xa6, 0x89, 0x5a, 0xd7,
-	0xbf, 0x41, 0xb0, 0xd0, 0xdb, 0xa5, 0x22, 0xbc, 0x07, 0x27, 0x3c, 0xb9, 0xa4, 0x10, 0x3f, 0x8c,
-	0x45, 0x8c, 0x91, 0x54, 0xdc, 0xbe, 0x9c, 0x5e, 0x82, 0x4c, 0x38, 0x8a, 0x0d, 0x5a, 0xa9, 0x38,
-	0x8c, 0x39, 0xd4, 0x7d, 0xcd, 0xc0, 0x4f, 0x10, 0xcc, 0x76, 0x77, 0xa5, 0x40, 0x4d, 0x80, 0x62,
-	0x73, 0x55, 0xb1, 0x5e, 0xee, 0x8f, 0x75, 0xad, 0x58, 0xac, 0x55, 0x6a, 0x65, 0x93, 0x13, 0xab,
-	0x25, 0xac, 0x70, 0x03, 0xa2, 0xfa, 0x93, 0x24, 0xcc, 0x84, 0xe3, 0xb8, 0x5d, 0x36, 0x59, 0x89,
-	0xbc, 0xe6, 0x03, 0xc6, 0xf3, 0x30, 0xc1, 0xb8, 0xe9, 0x71, 0xc7, 0xb5, 0xf3, 0x25, 0xe2, 0xd8,
-	0x25, 0x9e, 0x4a, 0xce, 0xa2, 0x85, 0xb1, 0xdc, 0x5b, 0xfe, 0xf2, 0x55, 0xb1, 0x8a, 0xe7, 0xe0,
-	0x34, 0x11, 0x47, 0xe4, 0x6f, 0x3b, 0x26, 0xb6, 0xbd, 0x29, 0x17, 0xd5, 0xa6, 0x2b, 0x00, 0xad,
-	0x1a, 0x4e, 0x8d, 0x89, 0xc4, 0xbc, 0xe7, 0x27, 0xa6, 0x51, 0x90, 0x59, 0xf9, 0x4c, 0xb4, 0x6e,
-	0xb9, 0x4d, 0x14, 0x50, 0x2e, 0x60, 0x79, 0xe9, 0x8d, 0x6f, 0xf7, 0x33, 0x89, 0xa7, 0xfb, 0x19,
-	0xa4, 0xff, 0x8a, 0xe0, 0x9d, 0x2e, 0x79, 0x50, 0x87, 0xb1, 0x0d, 0x27, 0x98, 0x5c, 0x4a, 0xa1,
-	0xd9, 0x63, 0x0b, 0xa7, 0x56, 0x2e, 0xf4, 0x77, 0x12, 0x42, 0x67, 0x6b, 0x97, 0xb8, 0xdc, 0xbf,
-	0x6d, 0x4a, 0x06, 0x7f, 0x1e, 0xa2, 0x48, 0x0a, 0x8a, 0xf9, 0x9e, 0x14, 0x32, 0x9c, 0x20, 0x86,
-	0xfe, 0x8b, 0x1f, 0xfc, 0x26, 0x29, 0x13, 0x5b, 0xac, 0x75, 0x96, 0xa9, 0x25, 0xbf, 0x0d, 0x72,
-	0x8a, 0x4d, 0x13, 0xff, 0x14, 0x23, 0x2f, 0x43, 0x72, 0xd0, 0xcb, 0x20, 0xd3, 0xfe, 0x72, 0x3f,
-	0x93, 0xd0, 0xbf, 0x43, 0x90, 0xee, 0x16, 0xb9, 0xca, 0xfb, 0xfd, 0x60, 0xb5, 0x37, 0xf2, 0x3e,
-	0x13, 0x4a, 0x91, 0x9f, 0x9c, 0x4d, 0x52, 0xdc, 0xa0, 0x8e, 0xbb, 0xbe, 0xda, 0xc8, 0xf1, 0x8f,
-	0x7f, 0x65, 0x16, 0x6d, 0x87, 0x97, 0x6a, 0x85, 0x6c, 0x91, 0x56, 0xd4, 0x63, 0xaa, 0xfe, 0x59,
-	0x62, 0xd6, 0x7d, 0x83, 0xef, 0x55, 0x09, 0xf3, 0x6d, 0x58, 0xeb, 0x01, 0xa8, 0x81, 0xde, 0x16,
-	0xce, 0x1d, 0xca, 0xcd, 0xf2, 0x48, 0xb2, 0x19, 0x48, 0xc3, 0xbf, 0x08, 0xe6, 0x62, 0xfd, 0xaa,
-	0x5c, 0xdc, 0x6d, 0xcf, 0xc5, 0xc5, 0xd8, 0x3b, 0xd8, 0x52, 0xdb, 0xf4, 0x7d, 0x4b, 0xc5, 0xb6,
-	0x77, 0x0f, 0xdb, 0x70, 0x9c, 0x37, 0xfc, 0xa5, 0x92, 0xa3, 0xca, 0xb0, 0xd4, 0xd7, 0x3d, 0xf5,
-	0xc0, 0x36, 0xe3, 0x69, 0x96, 0xc9, 0xe8, 0x92, 0x7b, 0x5d, 0xbd, 0xb4, 0x91, 0x3e, 0x55, 0x62,
-	0xd3, 0x00, 0xcd, 0x5b, 0x2a, 0x73, 0x7b, 0x32, 0x17, 0x58, 0x09, 0xa8, 0xd5, 0xe1, 0xdd, 0xb0,
-	0xda, 0x8e, 0xc3, 0x4b, 0x96, 0x67, 0xd6, 0x95, 0xe3, 0x91, 0x61, 0xec, 0xc2, 0xf9, 0x1e, 0x8e,
-	0x15, 0xcb, 0x06, 0x4c, 0xd6, 0xd5, 0xa7, 0xbe, 0x1d, 0x4f, 0xd4, 0xc3, 0x62, 0x01, 0xbf, 0xd3,
-	0x70, 0x4e, 0xf8, 0x6d, 0xb4, 0x91, 0x9a, 0xeb, 0xf0, 0xbd, 0x6d, 0x4a, 0xcb, 0xfe, 0x0c, 0xf2,
-	0x18, 0x81, 0x16, 0xf5, 0x55, 0x85, 0x42, 0x60, 0xac, 0x4a, 0x69, 0x79, 0x74, 0x85, 0x2b, 0xe4,
-	0x57, 0x5e, 0x4c, 0xc0, 0x71, 0x11, 0x05, 0x7e, 0x8a, 0x60, 0x5c, 0x8e, 0x34, 0xd8, 0x88, 0x2d,
-	0x8d, 0xce, 0x79, 0x4a, 0xbb, 0xd0, 0xbf, 0x81, 0xc4, 0xd3, 0x17, 0xbf, 0xfe, 0xfd, 0x9f, 0x1f,
-	0x92, 0xe7, 0xf1, 0x9c, 0x11, 0x37, 0xeb, 0xc9, 0xa1, 0x0a, 0x3f, 0x4e, 0xc2, 0x74, 0xcc, 0x28,
-	0x82, 0x37, 0x7b, 0xbb, 0xef, 0x3d, 0x8f, 0x69, 0x5b, 0x43, 0xaa, 0x28, 0xb2, 0x1d, 0x41, 0x76,
-	0x0b, 0xdf, 0x8c, 0x25, 0x6b, 0x15, 0x88, 0xf1, 0xb0, 0xa3, 0x2f, 0x3c, 0x32, 0x68, 0x4b, 0x3f,
-	0xef, 0xbf, 0x34, 0x07, 0x08, 0xce, 0x46, 0x8c, 0x3c, 0xf8, 0xe3, 0x01, 0xe2, 0xee, 0x18, 0xca,
-	0xb4, 0x4f, 0x8e, 0x68, 0xad, 0x68, 0x6f, 0x08, 0xda, 0xab, 0xf8, 0xca, 0x30, 0xb4, 0xad, 0xa1,
-	0x0a, 0xff, 0x81, 0x60, 0xb2, 0x7d, 0x8e, 0xc0, 0x1f, 0x0d, 0x10, 0x63, 0x78, 0x06, 0xd3, 0x2e,
-	0x1d, 0xc5, 0x54, 0xb1, 0x5d, 0x13, 0x6c, 0x5b, 0x78, 0x63, 0x18, 0x36, 0x7f, 0x62, 0xf9, 0x0f,
-	0xc1, 0x99, 0x8e, 0x4e, 0x8d, 0xfb, 0x08, 0xaf, 0xdb, 0x60, 0xa2, 0x5d, 0x3e, 0x92, 0xad, 0x62,
-	0xcb, 0x0b, 0xb6, 0x2f, 0xf0, 0x4e, 0x2c, 0x5b, 0xf3, 0x4d, 0x65, 0xc6, 0xc3, 0x8e, 0x27, 0xf9,
-	0x91, 0xa1, 0x6e, 0x66, 0x14, 0x37, 0x7e, 0x89, 0xe0, 0xed, 0xe8, 0x96, 0x8c, 0x3f, 0x1d, 0x24,
-	0xf0, 0x88, 0x21, 0x42, 0xfb, 0xec, 0xe8, 0x02, 0x03, 0x1d, 0x6d, 0x7f, 0xf8, 0xa2, 0x30, 0x23,
-	0x3a, 0x64, 0x3f, 0x85, 0xd9, 0xbd, 0x99, 0xf7, 0x53, 0x98, 0x31, 0x6d, 0xb9, 0xcf, 0xc2, 0xec,
-	0x41, 0xd8, 0xba, 0xdb, 0xf8, 0x7f, 0x04, 0xa9, 0x6e, 0xfd, 0x13, 0xaf, 0x0d, 0x10, 0x6b, 0x74,
-	0xd3, 0xd7, 0xd6, 0x87, 0x91, 0x50, 0xcc, 0x77, 0x04, 0xf3, 0x0d, 0x7c, 0x7d, 0x18, 0xe6, 0xf6,
-	0x01, 0x00, 0xff, 0x8c, 0xe0, 0x74, 0xa8, 0x47, 0xe3, 0x8b, 0xbd, 0x63, 0x8d, 0x6a, 0xf9, 0xda,
-	0x07, 0x03, 0xdb, 0x29, 0xb0, 0x55, 0x01, 0xb6, 0x84, 0x17, 0x63, 0xc1, 0x8a, 0xbe, 0x6d, 0xbe,
-	0xd1, 0xda, 0xd7, 0xaf, 0x3d, 0x3b, 0x48, 0xa3, 0xe7, 0x07, 0x69, 0xf4, 0xf7, 0x41, 0x1a, 0x7d,
-	0x7f, 0x98, 0x4e, 0x3c, 0x3f, 0x4c, 0x27, 0x5e, 0x1c, 0xa6, 0x13, 0x5f, 0x2e, 0xc7, 0xce, 0x09,
-	0x5f, 0x85, 0xd5, 0xc5, 0xd8, 0x50, 0x18, 0x17, 0x7f, 0x4e, 0x59, 0x7d, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0xc9, 0xca, 0xd1, 0x61, 0x12, 0x00, 0x00,
+	// 1196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xb8, 0x69, 0xd3, 0xbe, 0x52, 0x9a, 0x4c, 0x03, 0x72, 0x37, 0xc1, 0x0e, 0x1b, 0x4a,
+	0x22, 0xa2, 0x78, 0x9b, 0x44, 0x2a, 0xd0, 0xd2, 0x96, 0xfc, 0x2a, 0x45, 0xa9, 0xda, 0xc4, 0xad,
+	0x9a, 0xc2, 0xc5, 0x5a, 0x7b, 0x47, 0xeb, 0x55, 0xed, 0x1d, 0x77, 0x77, 0x36, 0x21, 0xaa, 0x7a,
+	0xa1, 0x54, 0xe2, 0x82, 0x84, 0xc4, 0xa5, 0xc7, 0x9c, 0x39, 0x83, 0x90, 0xf8, 0x03, 0x50, 0x8f,
+	0x15, 0x48, 0x88, 0x13, 0xa0, 0x04, 0xa1, 0x4a, 0x88, 0x33, 0x57, 0xe4, 0x99, 0x59, 0x7b, 0x37,
+	0x5e, 0xaf, 0x7f, 0xd5, 0xa7, 0x78, 0xdf, 0xce, 0xfb, 0xde, 0xfb, 0xbe, 0x99, 0x37, 0xfb, 0x29,
+	0x30, 0x5d, 0xa4, 0x6e, 0x85, 0xba, 0x9a, 0x61, 0xb9, 0xcc, 0xb1, 0x0a, 0x1e, 0xb3, 0xa8, 0xad,
+	0x6d, 0xcf, 0x17, 0x08, 0xd3, 0xe7, 0xb5, 0x07, 0x1e, 0x71, 0x76, 0xb3, 0x55, 0x87, 0x32, 0x8a,
+	0xc7, 0xc5, 0xc2, 0x6c, 0x70, 0x61, 0x56, 0x2e, 0x54, 0xde, 0x91, 0x28, 0x05, 0xdd, 0x25, 0x22,
+	0xab, 0x8e, 0x51, 0xd5, 0x4d, 0xcb, 0xd6, 0xf9, 0x6a, 0x0e, 0xa4, 0x8c, 0x99, 0xd4, 0xa4, 0xfc,
+	0xa7, 0x56, 0xfb, 0x25, 0xa3, 0x13, 0x26, 0xa5, 0x66, 0x99, 0x68, 0x7a, 0xd5, 0xd2, 0x74, 0xdb,
+	0xa6, 0x8c, 0xa7, 0xb8, 0xf2, 0x6d, 0x3a, 0x88, 0xef, 0x23, 0x17, 0xa9, 0xe5, 0x63, 0x66, 0xe3,
+	0x58, 0x84, 0x3a, 0x16, 0xeb, 0xcf, 0x8a, 0xf5, 0x79, 0xd1, 0x86, 0x64, 0xc6, 0x1f, 0xd4, 0x31,
+	0xc0, 0x9b, 0x35, 0x02, 0x1b, 0xba, 0xa3, 0x57, 0xdc, 0x1c, 0x79, 0xe0, 0x11, 0x97, 0xa9, 0xf7,
+	0xe0, 0x4c, 0x28, 0xea, 0x56, 0xa9, 0xed, 0x12, 0xbc, 0x04, 0xc7, 0xaa, 0x3c, 0x92, 0x42, 0x93,
+	0x68, 0xe6, 0xe4, 0xc2, 0x54, 0x36, 0x46, 0xa5, 0xac, 0x48, 0x5e, 0x1e, 0x7a, 0xf6, 0x7b, 0x26,
+	0x91, 0x93, 0x89, 0x6a, 0x15, 0xa6, 0x39, 0xf2, 0x5d, 0xbd, 0x6c, 0x19, 0x3a, 0xa3, 0xce, 0x2d,
+	0x8f, 0xb9, 0x4c, 0xb7, 0x0d, 0xcb, 0x36, 0x73, 0x64, 0x47, 0x77, 0x0c, 0xbf, 0x09, 0xbc, 0x06,
+	0xa3, 0xdb, 0xfe, 0xaa, 0xbc, 0x6e, 0x18, 0x0e, 0x71, 0x45, 0xe1, 0x13, 0xcb, 0xa9, 0x9f, 0xbf,
+	0x9b, 0x1b, 0x93, 0xb5, 0x97, 0xc4, 0x9b, 0xdb, 0xcc, 0xa9, 0x41, 0x8c, 0xd4, 0x53, 0x64, 0x5c,
+	0xfd, 0x02, 0xc1, 0x4c, 0xfb, 0x92, 0x92, 0xe1, 0x3d, 0x18, 0x76, 0x44, 0x48, 0x52, 0x7c, 0x2f,
+	0x96, 0x62, 0x0c, 0xa4, 0xe4, 0xed, 0xc3, 0xa9, 0x25, 0xc8, 0x84, 0xbb, 0x58, 0xa1, 0x95, 0x8a,
+	0xe5, 0xba, 0x16, 0xb5, 0x5f, 0x32, 0xe1, 0x27, 0x08, 0x26, 0x5b, 0x97, 0x92, 0x44, 0x75, 0x80,
+	0x62, 0x3d, 0x2a, 0xb9, 0x5e, 0xea, 0x8c, 0xeb, 0x52, 0xb1, 0xe8, 0x55, 0xbc, 0xb2, 0xce, 0x88,
+	0xd1, 0x00, 0x96, 0x74, 0x03, 0xa0, 0xea, 0x93, 0x24, 0x4c, 0x84, 0xfb, 0xb8, 0x5d, 0xd6, 0xdd,
+	0x12, 0x79, 0xc9, 0x1b, 0x8c, 0xa7, 0xe1, 0xb4, 0xcb, 0x74, 0x87, 0x59, 0xb6, 0x99, 0x2f, 0x11,
+	0xcb, 0x2c, 0xb1, 0x54, 0x72, 0x12, 0xcd, 0x0c, 0xe5, 0x5e, 0xf5, 0xc3, 0xd7, 0x79, 0x14, 0x4f,
+	0xc1, 0x29, 0x62, 0x1b, 0x81, 0x65, 0x47, 0xf8, 0xb2, 0x57, 0x44, 0x50, 0x2e, 0xba, 0x06, 0xd0,
+	0x98, 0xe1, 0xd4, 0x10, 0x17, 0xe6, 0x6d, 0x5f, 0x98, 0xda, 0x40, 0x66, 0xc5, 0x35, 0xd1, 0x38,
+	0xe5, 0x26, 0x91, 0x84, 0x72, 0x81, 0xcc, 0x8b, 0xc7, 0xbf, 0xdc, 0xcb, 0x24, 0x9e, 0xee, 0x65,
+	0x90, 0xfa, 0x23, 0x82, 0x37, 0x5a, 0xe8, 0x20, 0x37, 0x63, 0x03, 0x86, 0x5d, 0x11, 0x4a, 0xa1,
+	0xc9, 0x23, 0x33, 0x27, 0x17, 0xce, 0x77, 0xb6, 0x13, 0x1c, 0x67, 0x6d, 0x9b, 0xd8, 0xcc, 0x3f,
+	0x6d, 0x12, 0x06, 0x7f, 0x14, 0x62, 0x91, 0xe4, 0x2c, 0xa6, 0xdb, 0xb2, 0x10, 0xed, 0x04, 0x69,
+	0xa8, 0x3f, 0xf8, 0xcd, 0xaf, 0x92, 0x32, 0x31, 0x79, 0xac, 0x79, 0x4c, 0x0d, 0xf1, 0xae, 0x9b,
+	0x5d, 0xac, 0xa7, 0xf8, 0xbb, 0x18, 0x79, 0x18, 0x92, 0xdd, 0x1e, 0x06, 0x21, 0xfb, 0x8b, 0xbd,
+	0x4c, 0x42, 0xfd, 0x0a, 0x41, 0xba, 0x55, 0xe7, 0x52, 0xf7, 0xfb, 0xc1, 0x69, 0xaf, 0xe9, 0x3e,
+	0x11, 0x92, 0xc8, 0x17, 0x67, 0x95, 0x14, 0x57, 0xa8, 0x65, 0x2f, 0x2f, 0xd6, 0x34, 0xfe, 0xf6,
+	0x8f, 0xcc, 0xac, 0x69, 0xb1, 0x92, 0x57, 0xc8, 0x16, 0x69, 0x45, 0x5e, 0xa6, 0xf2, 0xcf, 0x9c,
+	0x6b, 0xdc, 0xd7, 0xd8, 0x6e, 0x95, 0xb8, 0x7e, 0x8e, 0xdb, 0xb8, 0x00, 0x3c, 0x50, 0x0f, 0xb5,
+	0x73, 0x87, 0x32, 0xbd, 0x3c, 0x10, 0x35, 0x03, 0x32, 0xfc, 0x8d, 0x60, 0x2a, 0xb6, 0xae, 0xd4,
+	0xe2, 0xee, 0x61, 0x2d, 0x2e, 0xc4, 0x9e, 0xc1, 0x06, 0xda, 0xaa, 0x5f, 0x5b, 0x20, 0x1e, 0xba,
+	0xf7, 0xb0, 0x09, 0x47, 0x59, 0xad, 0x5e, 0x2a, 0x39, 0x28, 0x85, 0x05, 0xbe, 0xea, 0xc8, 0x0b,
+	0xb6, 0xde, 0x4f, 0x7d, 0x4c, 0x06, 0x27, 0xee, 0x0d, 0x98, 0x6c, 0x5d, 0x53, 0x0a, 0x9b, 0x06,
+	0xa8, 0x9f, 0x52, 0xa1, 0xed, 0x89, 0x5c, 0x20, 0x12, 0x40, 0xdb, 0x81, 0xb7, 0xc2, 0x68, 0x5b,
+	0x16, 0x2b, 0x19, 0x8e, 0xbe, 0x23, 0x0b, 0x0f, 0x8c, 0xc6, 0x36, 0x9c, 0x6b, 0x53, 0x58, 0x72,
+	0x59, 0x81, 0x91, 0x1d, 0xf9, 0xaa, 0xe3, 0xc2, 0xa7, 0x77, 0xc2, 0x60, 0x81, 0xba, 0xe3, 0x70,
+	0x96, 0xd7, 0xad, 0x7d, 0x46, 0x3c, 0xdb, 0x62, 0xbb, 0x1b, 0x94, 0x96, 0x7d, 0x0f, 0xf2, 0x18,
+	0x81, 0x12, 0xf5, 0x56, 0xb6, 0x42, 0x60, 0xa8, 0x4a, 0x69, 0x79, 0x70, 0x83, 0xcb, 0xe1, 0x55,
+	0x06, 0x6f, 0x86, 0xa5, 0x59, 0xf2, 0x18, 0x5d, 0xa1, 0x95, 0x2a, 0xf5, 0x6c, 0x63, 0x60, 0x1b,
+	0x72, 0x05, 0xd4, 0xb8, 0xaa, 0x52, 0x82, 0x14, 0x0c, 0x13, 0x5b, 0x2f, 0x94, 0x89, 0xc1, 0x8b,
+	0x1d, 0xcf, 0xf9, 0x8f, 0x0b, 0x3f, 0x8d, 0xc2, 0x51, 0x0e, 0x80, 0x9f, 0x22, 0x38, 0x26, 0x8c,
+	0x18, 0xd6, 0x62, 0x07, 0xba, 0xd9, 0x05, 0x2a, 0xe7, 0x3b, 0x4f, 0x10, 0x1d, 0xa9, 0xb3, 0x9f,
+	0xff, 0xf2, 0xd7, 0x37, 0xc9, 0x73, 0x78, 0x4a, 0x8b, 0x73, 0xa8, 0xc2, 0x0a, 0xe2, 0xc7, 0x49,
+	0x18, 0x8f, 0x31, 0x50, 0x78, 0xb5, 0x7d, 0xf9, 0xf6, 0x2e, 0x52, 0x59, 0xeb, 0x13, 0x45, 0x32,
+	0xdb, 0xe2, 0xcc, 0x36, 0xf1, 0xad, 0x58, 0x66, 0x8d, 0xb1, 0xd6, 0x1e, 0x36, 0x7d, 0xcd, 0x1e,
+	0x69, 0xb4, 0x81, 0x9f, 0xf7, 0xef, 0xc7, 0x7d, 0x04, 0x67, 0x22, 0x8c, 0x1a, 0xfe, 0xa0, 0x8b,
+	0xbe, 0x9b, 0xac, 0xa4, 0x72, 0xb9, 0xc7, 0x6c, 0xc9, 0xf6, 0x26, 0x67, 0x7b, 0x1d, 0x5f, 0xeb,
+	0x87, 0x6d, 0xc3, 0x0a, 0xe2, 0x5f, 0x11, 0x8c, 0x1c, 0x76, 0x3f, 0xf8, 0xfd, 0x2e, 0x7a, 0x0c,
+	0x3b, 0x47, 0xe5, 0x62, 0x2f, 0xa9, 0x92, 0xdb, 0x3a, 0xe7, 0xb6, 0x86, 0x57, 0xfa, 0xe1, 0xe6,
+	0xfb, 0xac, 0x7f, 0x11, 0x8c, 0x36, 0xf9, 0x0b, 0xdc, 0x41, 0x7b, 0xad, 0xec, 0x94, 0x72, 0xa9,
+	0xa7, 0x5c, 0xc9, 0x2d, 0xcf, 0xb9, 0x7d, 0x82, 0xb7, 0x62, 0xb9, 0xd5, 0x2f, 0x1e, 0x57, 0x7b,
+	0xd8, 0x74, 0x6f, 0x3d, 0xd2, 0xe4, 0xc9, 0x8c, 0xe2, 0x8d, 0x5f, 0x20, 0x78, 0x3d, 0xda, 0x48,
+	0xe0, 0xab, 0xdd, 0x34, 0x1e, 0x61, 0x7d, 0x94, 0x0f, 0x7b, 0x07, 0xe8, 0x6a, 0x6b, 0x3b, 0xa3,
+	0xcf, 0x07, 0x33, 0xe2, 0xbb, 0xde, 0xc9, 0x60, 0xb6, 0xb6, 0x20, 0xca, 0xe5, 0x1e, 0xb3, 0xbb,
+	0x1a, 0xcc, 0x36, 0x0c, 0x1b, 0x67, 0x1b, 0xff, 0x87, 0x20, 0xd5, 0xea, 0xab, 0x8f, 0x97, 0xba,
+	0xe8, 0x35, 0xda, 0xaa, 0x28, 0xcb, 0xfd, 0x40, 0x48, 0xce, 0x77, 0x38, 0xe7, 0x9b, 0xf8, 0x46,
+	0x3f, 0x9c, 0x0f, 0xdb, 0x16, 0xfc, 0x3d, 0x82, 0x53, 0x21, 0x67, 0x81, 0x2f, 0xb4, 0xef, 0x35,
+	0xca, 0xa8, 0x28, 0xef, 0x76, 0x9d, 0x27, 0x89, 0x2d, 0x72, 0x62, 0x73, 0x78, 0x36, 0x96, 0x58,
+	0xd1, 0xcf, 0xcd, 0xd7, 0x0c, 0x09, 0xfe, 0x07, 0xc1, 0x6b, 0x91, 0xb6, 0x00, 0x5f, 0xe9, 0x42,
+	0xeb, 0x08, 0x17, 0xa3, 0x5c, 0xed, 0x39, 0x5f, 0xf2, 0xd9, 0xe4, 0x7c, 0xd6, 0xf1, 0xc7, 0xfd,
+	0x6c, 0x94, 0xee, 0x31, 0x9a, 0x2f, 0x4a, 0xe8, 0xe5, 0xf5, 0x67, 0xfb, 0x69, 0xf4, 0x7c, 0x3f,
+	0x8d, 0xfe, 0xdc, 0x4f, 0xa3, 0xaf, 0x0f, 0xd2, 0x89, 0xe7, 0x07, 0xe9, 0xc4, 0x6f, 0x07, 0xe9,
+	0xc4, 0xa7, 0xf3, 0xb1, 0x5e, 0xee, 0xb3, 0x70, 0x6d, 0x6e, 0xed, 0x0a, 0xc7, 0xf8, 0xbf, 0xbc,
+	0x16, 0xff, 0x1f, 0x00, 0x5f, 0x38, 0xfe, 0x59, 0x05, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -970,6 +1064,9 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompound queries whether the rewards of a delegator are
+	// automatically compounded.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
 }
 
 type queryClient struct {
@@ -1061,6 +1158,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1082,6 +1188,9 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompound queries whether the rewards of a delegator are
+	// automatically compounded.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1115,6 +1224,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1282,6 +1394,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1322,6 +1452,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1947,6 +2081,69 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2212,6 +2409,31 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3817,6 +4039,158 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -70,6 +70,12 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// The validator may have rotated its consensus key since the infraction, in
+	// which case its signing info is tracked under the current consensus address.
+	if valConsAddr, err := validator.GetConsAddr(); err == nil {
+		consAddr = valConsAddr
+	}
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
@@ -99,6 +99,11 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, types.KeyPrefixEvidence, "evidence")
+}
+
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
 }
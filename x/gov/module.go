@@ -109,6 +109,16 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, types.ProposalsKeyPrefix, "proposals")
+	registry.Register(types.StoreKey, types.ActiveProposalQueuePrefix, "active proposal queue")
+	registry.Register(types.StoreKey, types.InactiveProposalQueuePrefix, "inactive proposal queue")
+	registry.Register(types.StoreKey, types.ProposalIDKey, "next proposal id")
+	registry.Register(types.StoreKey, types.DepositsKeyPrefix, "deposits")
+	registry.Register(types.StoreKey, types.VotesKeyPrefix, "votes")
+}
+
 // RegisterInterfaces implements InterfaceModule.RegisterInterfaces
 func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	v1.RegisterInterfaces(registry)
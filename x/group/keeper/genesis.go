@@ -6,37 +6,71 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 )
 
 func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState group.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	if err := k.groupTable.Import(ctx.KVStore(k.key), genesisState.Groups, genesisState.GroupSeq); err != nil {
+	groupTable := k.db.GetTable(&statev1.GroupInfo{}).(ormtable.AutoIncrementTable)
+	for _, g := range genesisState.Groups {
+		var state statev1.GroupInfo
+		if err := k.toState(g, &state); err != nil {
+			panic(errors.Wrap(err, "groups"))
+		}
+		if err := groupTable.InsertWithID(ctx, &state); err != nil {
+			panic(errors.Wrap(err, "groups"))
+		}
+	}
+	if err := groupTable.SetLastInsertedSequence(ctx, genesisState.GroupSeq); err != nil {
 		panic(errors.Wrap(err, "groups"))
 	}
 
-	if err := k.groupMemberTable.Import(ctx.KVStore(k.key), genesisState.GroupMembers, 0); err != nil {
-		panic(errors.Wrap(err, "group members"))
+	for _, m := range genesisState.GroupMembers {
+		if err := k.state.GroupMemberTable().Insert(ctx, groupMemberToState(m)); err != nil {
+			panic(errors.Wrap(err, "group members"))
+		}
 	}
 
-	if err := k.groupPolicyTable.Import(ctx.KVStore(k.key), genesisState.GroupPolicies, 0); err != nil {
-		panic(errors.Wrap(err, "group policies"))
+	for _, p := range genesisState.GroupPolicies {
+		if err := k.createGroupPolicyInfo(ctx, p); err != nil {
+			panic(errors.Wrap(err, "group policies"))
+		}
 	}
 
-	if err := k.groupPolicySeq.InitVal(ctx.KVStore(k.key), genesisState.GroupPolicySeq); err != nil {
+	if err := k.state.GroupPolicySeqTable().Save(ctx, &statev1.GroupPolicySeq{Seq: genesisState.GroupPolicySeq}); err != nil {
 		panic(errors.Wrap(err, "group policy account seq"))
 	}
 
-	if err := k.proposalTable.Import(ctx.KVStore(k.key), genesisState.Proposals, genesisState.ProposalSeq); err != nil {
+	proposalTable := k.db.GetTable(&statev1.Proposal{}).(ormtable.AutoIncrementTable)
+	for _, p := range genesisState.Proposals {
+		var state statev1.Proposal
+		if err := k.toState(p, &state); err != nil {
+			panic(errors.Wrap(err, "proposals"))
+		}
+		if err := proposalTable.InsertWithID(ctx, &state); err != nil {
+			panic(errors.Wrap(err, "proposals"))
+		}
+	}
+	if err := proposalTable.SetLastInsertedSequence(ctx, genesisState.ProposalSeq); err != nil {
 		panic(errors.Wrap(err, "proposals"))
 	}
 
-	if err := k.voteTable.Import(ctx.KVStore(k.key), genesisState.Votes, 0); err != nil {
-		panic(errors.Wrap(err, "votes"))
+	for _, v := range genesisState.Votes {
+		if err := k.createVote(ctx, v); err != nil {
+			panic(errors.Wrap(err, "votes"))
+		}
+	}
+
+	// store the schemas of the tables for later migrations, as the store migration does
+	if err := k.db.Migrate(ctx, ormdb.MigrateOptions{}); err != nil {
+		panic(errors.Wrap(err, "table schemas"))
 	}
 
 	return []abci.ValidatorUpdate{}
@@ -46,40 +80,79 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 func (k Keeper) ExportGenesis(ctx types.Context, cdc codec.JSONCodec) *group.GenesisState {
 	genesisState := group.NewGenesisState()
 
-	var groups []*group.GroupInfo
-
-	groupSeq, err := k.groupTable.Export(ctx.KVStore(k.key), &groups)
+	groupIt, err := k.state.GroupInfoTable().List(ctx, statev1.GroupInfoIdIndexKey{})
 	if err != nil {
 		panic(errors.Wrap(err, "groups"))
 	}
-	genesisState.Groups = groups
-	genesisState.GroupSeq = groupSeq
+	defer groupIt.Close()
+	for groupIt.Next() {
+		g, err := groupIt.Value()
+		if err != nil {
+			panic(errors.Wrap(err, "groups"))
+		}
+		groupInfo, err := k.groupInfoFromState(g)
+		if err != nil {
+			panic(errors.Wrap(err, "groups"))
+		}
+		genesisState.Groups = append(genesisState.Groups, &groupInfo)
+	}
+	genesisState.GroupSeq = k.GetGroupSequence(ctx)
 
-	var groupMembers []*group.GroupMember
-	_, err = k.groupMemberTable.Export(ctx.KVStore(k.key), &groupMembers)
+	memberIt, err := k.state.GroupMemberTable().List(ctx, statev1.GroupMemberGroupIdAddressIndexKey{})
 	if err != nil {
 		panic(errors.Wrap(err, "group members"))
 	}
-	genesisState.GroupMembers = groupMembers
+	defer memberIt.Close()
+	for memberIt.Next() {
+		m, err := memberIt.Value()
+		if err != nil {
+			panic(errors.Wrap(err, "group members"))
+		}
+		genesisState.GroupMembers = append(genesisState.GroupMembers, groupMemberFromState(m))
+	}
 
-	var groupPolicies []*group.GroupPolicyInfo
-	_, err = k.groupPolicyTable.Export(ctx.KVStore(k.key), &groupPolicies)
+	policyIt, err := k.state.GroupPolicyInfoTable().List(ctx, statev1.GroupPolicyInfoAddressIndexKey{})
+	if err != nil {
+		panic(errors.Wrap(err, "group policies"))
+	}
+	policies, _, err := k.listGroupPolicies(policyIt)
 	if err != nil {
 		panic(errors.Wrap(err, "group policies"))
 	}
-	genesisState.GroupPolicies = groupPolicies
-	genesisState.GroupPolicySeq = k.groupPolicySeq.CurVal(ctx.KVStore(k.key))
+	genesisState.GroupPolicies = policies
+
+	policySeq, err := k.state.GroupPolicySeqTable().Get(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "group policy account seq"))
+	}
+	genesisState.GroupPolicySeq = policySeq.Seq
 
-	var proposals []*group.Proposal
-	proposalSeq, err := k.proposalTable.Export(ctx.KVStore(k.key), &proposals)
+	proposalIt, err := k.state.ProposalTable().List(ctx, statev1.ProposalIdIndexKey{})
+	if err != nil {
+		panic(errors.Wrap(err, "proposals"))
+	}
+	defer proposalIt.Close()
+	for proposalIt.Next() {
+		p, err := proposalIt.Value()
+		if err != nil {
+			panic(errors.Wrap(err, "proposals"))
+		}
+		proposal, err := k.proposalFromState(p)
+		if err != nil {
+			panic(errors.Wrap(err, "proposals"))
+		}
+		genesisState.Proposals = append(genesisState.Proposals, &proposal)
+	}
+	genesisState.ProposalSeq, err = k.state.ProposalTable().LastInsertedSequence(ctx)
 	if err != nil {
 		panic(errors.Wrap(err, "proposals"))
 	}
-	genesisState.Proposals = proposals
-	genesisState.ProposalSeq = proposalSeq
 
-	var votes []*group.Vote
-	_, err = k.voteTable.Export(ctx.KVStore(k.key), &votes)
+	voteIt, err := k.state.VoteTable().List(ctx, statev1.VoteProposalIdVoterIndexKey{})
+	if err != nil {
+		panic(errors.Wrap(err, "votes"))
+	}
+	votes, _, err := k.listVotes(voteIt)
 	if err != nil {
 		panic(errors.Wrap(err, "votes"))
 	}
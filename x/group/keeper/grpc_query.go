@@ -11,7 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 )
 
 var _ group.QueryServer = Keeper{}
@@ -28,12 +28,6 @@ func (k Keeper) GroupInfo(goCtx context.Context, request *group.QueryGroupInfoRe
 	return &group.QueryGroupInfoResponse{Info: &groupInfo}, nil
 }
 
-func (k Keeper) getGroupInfo(ctx sdk.Context, id uint64) (group.GroupInfo, error) {
-	var obj group.GroupInfo
-	_, err := k.groupTable.GetOne(ctx.KVStore(k.key), id, &obj)
-	return obj, err
-}
-
 // GroupPolicyInfo queries info about a group policy.
 func (k Keeper) GroupPolicyInfo(goCtx context.Context, request *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -45,25 +39,25 @@ func (k Keeper) GroupPolicyInfo(goCtx context.Context, request *group.QueryGroup
 	return &group.QueryGroupPolicyInfoResponse{Info: &groupPolicyInfo}, nil
 }
 
-func (k Keeper) getGroupPolicyInfo(ctx sdk.Context, accountAddress string) (group.GroupPolicyInfo, error) {
-	var obj group.GroupPolicyInfo
-	return obj, k.groupPolicyTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupPolicyInfo{Address: accountAddress}), &obj)
-}
-
 // GroupMembers queries all members of a group.
 func (k Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	groupID := request.GroupId
-	it, err := k.getGroupMembers(ctx, groupID, request.Pagination)
+	it, err := k.state.GroupMemberTable().List(ctx, statev1.GroupMemberGroupIdAddressIndexKey{}.WithGroupId(groupID), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
 
 	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(it, request.Pagination, &members)
-	if err != nil {
-		return nil, err
+	for it.Next() {
+		m, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, groupMemberFromState(m))
 	}
+	pageRes := pageResponse(it)
 
 	return &group.QueryGroupMembersResponse{
 		Members:    members,
@@ -71,10 +65,6 @@ func (k Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMem
 	}, nil
 }
 
-func (k Keeper) getGroupMembers(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupMemberByGroupIndex.GetPaginated(ctx.KVStore(k.key), id, pageRequest)
-}
-
 // GroupsByAdmin queries all groups where a given address is admin.
 func (k Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -82,16 +72,25 @@ func (k Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsB
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getGroupsByAdmin(ctx, addr, request.Pagination)
+	it, err := k.state.GroupInfoTable().List(ctx, statev1.GroupInfoAdminIndexKey{}.WithAdmin(addr.String()), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
 
 	var groups []*group.GroupInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &groups)
-	if err != nil {
-		return nil, err
+	for it.Next() {
+		g, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		groupInfo, err := k.groupInfoFromState(g)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, &groupInfo)
 	}
+	pageRes := pageResponse(it)
 
 	return &group.QueryGroupsByAdminResponse{
 		Groups:     groups,
@@ -99,21 +98,16 @@ func (k Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsB
 	}, nil
 }
 
-func (k Keeper) getGroupsByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupByAdminIndex.GetPaginated(ctx.KVStore(k.key), admin.Bytes(), pageRequest)
-}
-
 // GroupPoliciesByGroup queries all groups policies of a given group.
 func (k Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.QueryGroupPoliciesByGroupRequest) (*group.QueryGroupPoliciesByGroupResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	groupID := request.GroupId
-	it, err := k.getGroupPoliciesByGroup(ctx, groupID, request.Pagination)
+	it, err := k.state.GroupPolicyInfoTable().List(ctx, statev1.GroupPolicyInfoGroupIdIndexKey{}.WithGroupId(groupID), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
 
-	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	policies, pageRes, err := k.listGroupPolicies(it)
 	if err != nil {
 		return nil, err
 	}
@@ -124,10 +118,6 @@ func (k Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.Query
 	}, nil
 }
 
-func (k Keeper) getGroupPoliciesByGroup(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupPolicyByGroupIndex.GetPaginated(ctx.KVStore(k.key), id, pageRequest)
-}
-
 // GroupPoliciesByAdmin queries all groups policies where a given address is
 // admin.
 func (k Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error) {
@@ -136,13 +126,12 @@ func (k Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.Query
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getGroupPoliciesByAdmin(ctx, addr, request.Pagination)
+	it, err := k.state.GroupPolicyInfoTable().List(ctx, statev1.GroupPolicyInfoAdminIndexKey{}.WithAdmin(addr.String()), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
 
-	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	policies, pageRes, err := k.listGroupPolicies(it)
 	if err != nil {
 		return nil, err
 	}
@@ -153,10 +142,6 @@ func (k Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.Query
 	}, nil
 }
 
-func (k Keeper) getGroupPoliciesByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupPolicyByAdminIndex.GetPaginated(ctx.KVStore(k.key), admin.Bytes(), pageRequest)
-}
-
 // Proposal queries a proposal.
 func (k Keeper) Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -176,16 +161,25 @@ func (k Keeper) ProposalsByGroupPolicy(goCtx context.Context, request *group.Que
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getProposalsByGroupPolicy(ctx, addr, request.Pagination)
+	it, err := k.state.ProposalTable().List(ctx, statev1.ProposalGroupPolicyAddressIndexKey{}.WithGroupPolicyAddress(addr.String()), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
 
 	var proposals []*group.Proposal
-	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
-	if err != nil {
-		return nil, err
+	for it.Next() {
+		p, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		proposal, err := k.proposalFromState(p)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, &proposal)
 	}
+	pageRes := pageResponse(it)
 
 	return &group.QueryProposalsByGroupPolicyResponse{
 		Proposals:  proposals,
@@ -193,18 +187,6 @@ func (k Keeper) ProposalsByGroupPolicy(goCtx context.Context, request *group.Que
 	}, nil
 }
 
-func (k Keeper) getProposalsByGroupPolicy(ctx sdk.Context, account sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.proposalByGroupPolicyIndex.GetPaginated(ctx.KVStore(k.key), account.Bytes(), pageRequest)
-}
-
-func (k Keeper) getProposal(ctx sdk.Context, proposalID uint64) (group.Proposal, error) {
-	var p group.Proposal
-	if _, err := k.proposalTable.GetOne(ctx.KVStore(k.key), proposalID, &p); err != nil {
-		return group.Proposal{}, sdkerrors.Wrap(err, "load proposal")
-	}
-	return p, nil
-}
-
 // VoteByProposalVoter queries a vote given a voter and a proposal ID.
 func (k Keeper) VoteByProposalVoter(goCtx context.Context, request *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -226,13 +208,12 @@ func (k Keeper) VoteByProposalVoter(goCtx context.Context, request *group.QueryV
 func (k Keeper) VotesByProposal(goCtx context.Context, request *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	proposalID := request.ProposalId
-	it, err := k.getVotesByProposal(ctx, proposalID, request.Pagination)
+	it, err := k.state.VoteTable().List(ctx, statev1.VoteProposalIdVoterIndexKey{}.WithProposalId(proposalID), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
 
-	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	votes, pageRes, err := k.listVotes(it)
 	if err != nil {
 		return nil, err
 	}
@@ -250,13 +231,12 @@ func (k Keeper) VotesByVoter(goCtx context.Context, request *group.QueryVotesByV
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getVotesByVoter(ctx, addr, request.Pagination)
+	it, err := k.state.VoteTable().List(ctx, statev1.VoteVoterIndexKey{}.WithVoter(addr.String()), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
 
-	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	votes, pageRes, err := k.listVotes(it)
 	if err != nil {
 		return nil, err
 	}
@@ -279,25 +259,25 @@ func (k Keeper) GroupsByMember(goCtx context.Context, request *group.QueryGroups
 		return nil, err
 	}
 
-	iter, err := k.groupMemberByMemberIndex.GetPaginated(ctx.KVStore(k.key), member.Bytes(), request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(iter, request.Pagination, &members)
+	it, err := k.state.GroupMemberTable().List(ctx, statev1.GroupMemberAddressIndexKey{}.WithAddress(member.String()), paginate(request.Pagination)...)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
 
 	var groups []*group.GroupInfo
-	for _, gm := range members {
+	for it.Next() {
+		gm, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
 		groupInfo, err := k.getGroupInfo(ctx, gm.GroupId)
 		if err != nil {
 			return nil, err
 		}
 		groups = append(groups, &groupInfo)
 	}
+	pageRes := pageResponse(it)
 
 	return &group.QueryGroupsByMemberResponse{
 		Groups:     groups,
@@ -305,19 +285,6 @@ func (k Keeper) GroupsByMember(goCtx context.Context, request *group.QueryGroups
 	}, nil
 }
 
-func (k Keeper) getVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (group.Vote, error) {
-	var v group.Vote
-	return v, k.voteTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.Vote{ProposalId: proposalID, Voter: voter.String()}), &v)
-}
-
-func (k Keeper) getVotesByProposal(ctx sdk.Context, proposalID uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.voteByProposalIndex.GetPaginated(ctx.KVStore(k.key), proposalID, pageRequest)
-}
-
-func (k Keeper) getVotesByVoter(ctx sdk.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.voteByVoterIndex.GetPaginated(ctx.KVStore(k.key), voter.Bytes(), pageRequest)
-}
-
 // TallyResult computes the live tally result of a proposal.
 func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResultRequest) (*group.QueryTallyResultResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -346,3 +313,41 @@ func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResu
 		Tally: tallyResult,
 	}, nil
 }
+
+func (k Keeper) listGroupPolicies(it statev1.GroupPolicyInfoIterator) ([]*group.GroupPolicyInfo, *query.PageResponse, error) {
+	defer it.Close()
+
+	var policies []*group.GroupPolicyInfo
+	for it.Next() {
+		p, err := it.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		policyInfo, err := k.groupPolicyInfoFromState(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		policies = append(policies, &policyInfo)
+	}
+
+	return policies, pageResponse(it), nil
+}
+
+func (k Keeper) listVotes(it statev1.VoteIterator) ([]*group.Vote, *query.PageResponse, error) {
+	defer it.Close()
+
+	var votes []*group.Vote
+	for it.Next() {
+		v, err := it.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		vote, err := k.voteFromState(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		votes = append(votes, &vote)
+	}
+
+	return votes, pageResponse(it), nil
+}
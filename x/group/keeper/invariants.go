@@ -2,14 +2,11 @@ package keeper
 
 import (
 	"fmt"
-	"math"
 
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 	groupmath "github.com/cosmos/cosmos-sdk/x/group/internal/math"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 const weightInvariant = "Group-TotalWeight"
@@ -22,60 +19,52 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 // GroupTotalWeightInvariant checks that group's TotalWeight must be equal to the sum of its members.
 func GroupTotalWeightInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		msg, broken := GroupTotalWeightInvariantHelper(ctx, keeper.key, keeper.groupTable, keeper.groupMemberByGroupIndex)
+		msg, broken := GroupTotalWeightInvariantHelper(ctx, keeper.state)
 		return sdk.FormatInvariant(group.ModuleName, weightInvariant, msg), broken
 	}
 }
 
-func GroupTotalWeightInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, groupTable orm.AutoUInt64Table, groupMemberByGroupIndex orm.Index) (string, bool) {
+func GroupTotalWeightInvariantHelper(ctx sdk.Context, state statev1.StateStore) (string, bool) {
 
 	var msg string
 	var broken bool
 
-	groupIt, err := groupTable.PrefixScan(ctx.KVStore(key), 1, math.MaxUint64)
+	groupIt, err := state.GroupInfoTable().List(ctx, statev1.GroupInfoIdIndexKey{})
 	if err != nil {
-		msg += fmt.Sprintf("PrefixScan failure on group table\n%v\n", err)
+		msg += fmt.Sprintf("List failure on group table\n%v\n", err)
 		return msg, broken
 	}
 	defer groupIt.Close()
 
-	for {
+	for groupIt.Next() {
 		membersWeight, err := groupmath.NewNonNegativeDecFromString("0")
 		if err != nil {
 			msg += fmt.Sprintf("error while parsing positive dec zero for group member\n%v\n", err)
 			return msg, broken
 		}
-		var groupInfo group.GroupInfo
-		_, err = groupIt.LoadNext(&groupInfo)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
+		groupInfo, err := groupIt.Value()
 		if err != nil {
-			msg += fmt.Sprintf("LoadNext failure on group table iterator\n%v\n", err)
+			msg += fmt.Sprintf("Value failure on group table iterator\n%v\n", err)
 			return msg, broken
 		}
 
-		memIt, err := groupMemberByGroupIndex.Get(ctx.KVStore(key), groupInfo.Id)
+		memIt, err := state.GroupMemberTable().List(ctx, statev1.GroupMemberGroupIdAddressIndexKey{}.WithGroupId(groupInfo.Id))
 		if err != nil {
 			msg += fmt.Sprintf("error while returning group member iterator for group with ID %d\n%v\n", groupInfo.Id, err)
 			return msg, broken
 		}
 		defer memIt.Close()
 
-		for {
-			var groupMember group.GroupMember
-			_, err = memIt.LoadNext(&groupMember)
-			if errors.ErrORMIteratorDone.Is(err) {
-				break
-			}
+		for memIt.Next() {
+			groupMember, err := memIt.Value()
 			if err != nil {
-				msg += fmt.Sprintf("LoadNext failure on member table iterator\n%v\n", err)
+				msg += fmt.Sprintf("Value failure on member table iterator\n%v\n", err)
 				return msg, broken
 			}
 
-			curMemWeight, err := groupmath.NewNonNegativeDecFromString(groupMember.GetMember().GetWeight())
+			curMemWeight, err := groupmath.NewNonNegativeDecFromString(groupMember.Weight)
 			if err != nil {
-				msg += fmt.Sprintf("error while parsing non-nengative decimal for group member %s\n%v\n", groupMember.Member.Address, err)
+				msg += fmt.Sprintf("error while parsing non-nengative decimal for group member %s\n%v\n", groupMember.Address, err)
 				return msg, broken
 			}
 			membersWeight, err = groupmath.Add(membersWeight, curMemWeight)
@@ -85,7 +74,7 @@ func GroupTotalWeightInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, g
 			}
 		}
 
-		groupWeight, err := groupmath.NewNonNegativeDecFromString(groupInfo.GetTotalWeight())
+		groupWeight, err := groupmath.NewNonNegativeDecFromString(groupInfo.TotalWeight)
 		if err != nil {
 			msg += fmt.Sprintf("error while parsing non-nengative decimal for group with ID %d\n%v\n", groupInfo.Id, err)
 			return msg, broken
@@ -12,12 +12,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/ormstore"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 )
 
@@ -52,77 +53,58 @@ func (s *invariantTestSuite) SetupSuite() {
 
 func (s *invariantTestSuite) TestGroupTotalWeightInvariant() {
 	sdkCtx, _ := s.ctx.CacheContext()
-	curCtx, cdc, key := sdkCtx, s.cdc, s.key
+	curCtx, key := sdkCtx, s.key
 
-	// Group Table
-	groupTable, err := orm.NewAutoUInt64Table([2]byte{keeper.GroupTablePrefix}, keeper.GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
+	db, err := ormstore.NewStoreKeyDB(keeper.ModuleSchema, key, ormstore.StoreKeyDBOptions{})
 	s.Require().NoError(err)
-
-	// Group Member Table
-	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{keeper.GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
-	s.Require().NoError(err)
-
-	groupMemberByGroupIndex, err := orm.NewIndex(groupMemberTable, keeper.GroupMemberByGroupIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		group := val.(*group.GroupMember).GroupId
-		return []interface{}{group}, nil
-	}, group.GroupMember{}.GroupId)
+	state, err := statev1.NewStateStore(db)
 	s.Require().NoError(err)
 
 	_, _, addr1 := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
 	specs := map[string]struct {
-		groupsInfo   *group.GroupInfo
-		groupMembers []*group.GroupMember
+		groupsInfo   *statev1.GroupInfo
+		groupMembers []*statev1.GroupMember
 		expBroken    bool
 	}{
 		"invariant not broken": {
-			groupsInfo: &group.GroupInfo{
-				Id:          1,
+			groupsInfo: &statev1.GroupInfo{
 				Admin:       addr1.String(),
 				Version:     1,
 				TotalWeight: "3",
 			},
-			groupMembers: []*group.GroupMember{
+			groupMembers: []*statev1.GroupMember{
 				{
 					GroupId: 1,
-					Member: &group.Member{
-						Address: addr1.String(),
-						Weight:  "1",
-					},
+					Address: addr1.String(),
+					Weight:  "1",
 				},
 				{
 					GroupId: 1,
-					Member: &group.Member{
-						Address: addr2.String(),
-						Weight:  "2",
-					},
+					Address: addr2.String(),
+					Weight:  "2",
 				},
 			},
 			expBroken: false,
 		},
 
 		"group's TotalWeight must be equal to sum of its members weight ": {
-			groupsInfo: &group.GroupInfo{
-				Id:          1,
+			groupsInfo: &statev1.GroupInfo{
 				Admin:       addr1.String(),
 				Version:     1,
 				TotalWeight: "3",
 			},
-			groupMembers: []*group.GroupMember{
+			groupMembers: []*statev1.GroupMember{
 				{
 					GroupId: 1,
-					Member: &group.Member{
-						Address: addr1.String(),
-						Weight:  "2",
-					},
+					Address: addr1.String(),
+					Weight:  "2",
 				},
 				{
 					GroupId: 1,
-					Member: &group.Member{
-						Address: addr2.String(),
-						Weight:  "2",
-					},
+					Address: addr2.String(),
+					Weight:  "2",
 				},
 			},
 			expBroken: true,
@@ -134,15 +116,15 @@ func (s *invariantTestSuite) TestGroupTotalWeightInvariant() {
 		groupsInfo := spec.groupsInfo
 		groupMembers := spec.groupMembers
 
-		_, err := groupTable.Create(cacheCurCtx.KVStore(key), groupsInfo)
+		err := state.GroupInfoTable().Insert(cacheCurCtx, groupsInfo)
 		s.Require().NoError(err)
 
 		for i := 0; i < len(groupMembers); i++ {
-			err := groupMemberTable.Create(cacheCurCtx.KVStore(key), groupMembers[i])
+			err := state.GroupMemberTable().Insert(cacheCurCtx, groupMembers[i])
 			s.Require().NoError(err)
 		}
 
-		_, broken := keeper.GroupTotalWeightInvariantHelper(cacheCurCtx, key, *groupTable, groupMemberByGroupIndex)
+		_, broken := keeper.GroupTotalWeightInvariantHelper(cacheCurCtx, state)
 		s.Require().Equal(spec.expBroken, broken)
 
 	}
@@ -6,74 +6,45 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/ormstore"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/errors"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 )
 
 const (
-	// Group Table
-	GroupTablePrefix        byte = 0x0
-	GroupTableSeqPrefix     byte = 0x1
-	GroupByAdminIndexPrefix byte = 0x2
-
-	// Group Member Table
-	GroupMemberTablePrefix         byte = 0x10
-	GroupMemberByGroupIndexPrefix  byte = 0x11
-	GroupMemberByMemberIndexPrefix byte = 0x12
-
-	// Group Policy Table
-	GroupPolicyTablePrefix        byte = 0x20
-	GroupPolicyTableSeqPrefix     byte = 0x21
-	GroupPolicyByGroupIndexPrefix byte = 0x22
-	GroupPolicyByAdminIndexPrefix byte = 0x23
-
-	// Proposal Table
-	ProposalTablePrefix              byte = 0x30
-	ProposalTableSeqPrefix           byte = 0x31
-	ProposalByGroupPolicyIndexPrefix byte = 0x32
-	ProposalsByVotingPeriodEndPrefix byte = 0x33
-
-	// Vote Table
-	VoteTablePrefix           byte = 0x40
-	VoteByProposalIndexPrefix byte = 0x41
-	VoteByVoterIndexPrefix    byte = 0x42
+	// ORMPrefix is the prefix of the group module ORM tables in the group
+	// store. It is distinct from the prefixes of the legacy tables so that
+	// both layouts can coexist while migrating.
+	ORMPrefix byte = 0x50
+
+	// GroupPolicyTablePrefix is the prefix of the legacy group policy table,
+	// it is still used to derive the account addresses of group policies.
+	GroupPolicyTablePrefix byte = 0x20
 )
 
+// ModuleSchema is the ORM schema of the group module state.
+var ModuleSchema = &ormv1alpha1.ModuleSchemaDescriptor{
+	SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{Id: 1, ProtoFileName: statev1.File_cosmos_group_state_v1_state_proto.Path()},
+	},
+	Prefix: []byte{ORMPrefix},
+}
+
 type Keeper struct {
 	key storetypes.StoreKey
+	cdc codec.Codec
 
 	accKeeper group.AccountKeeper
 
-	// Group Table
-	groupTable        orm.AutoUInt64Table
-	groupByAdminIndex orm.Index
-
-	// Group Member Table
-	groupMemberTable         orm.PrimaryKeyTable
-	groupMemberByGroupIndex  orm.Index
-	groupMemberByMemberIndex orm.Index
-
-	// Group Policy Table
-	groupPolicySeq          orm.Sequence
-	groupPolicyTable        orm.PrimaryKeyTable
-	groupPolicyByGroupIndex orm.Index
-	groupPolicyByAdminIndex orm.Index
-
-	// Proposal Table
-	proposalTable              orm.AutoUInt64Table
-	proposalByGroupPolicyIndex orm.Index
-	proposalsByVotingPeriodEnd orm.Index
-
-	// Vote Table
-	voteTable           orm.PrimaryKeyTable
-	voteByProposalIndex orm.Index
-	voteByVoterIndex    orm.Index
+	db    ormdb.ModuleDB
+	state statev1.StateStore
 
 	router *authmiddleware.MsgServiceRouter
 
@@ -84,123 +55,23 @@ type Keeper struct {
 func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *authmiddleware.MsgServiceRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
 	k := Keeper{
 		key:       storeKey,
+		cdc:       cdc,
 		router:    router,
 		accKeeper: accKeeper,
 	}
 
-	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupByAdminIndex, err = orm.NewIndex(groupTable, GroupByAdminIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		addr, err := sdk.AccAddressFromBech32(val.(*group.GroupInfo).Admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupTable = *groupTable
-
-	// Group Member Table
-	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupMemberByGroupIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		group := val.(*group.GroupMember).GroupId
-		return []interface{}{group}, nil
-	}, group.GroupMember{}.GroupId)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupMemberByMemberIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		memberAddr := val.(*group.GroupMember).Member.Address
-		addr, err := sdk.AccAddressFromBech32(memberAddr)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupMemberTable = *groupMemberTable
-
-	// Group Policy Table
-	k.groupPolicySeq = orm.NewSequence(GroupPolicyTableSeqPrefix)
-	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupPolicyByGroupIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.GroupPolicyInfo).GroupId}, nil
-	}, group.GroupPolicyInfo{}.GroupId)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupPolicyByAdminIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByAdminIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		admin := value.(*group.GroupPolicyInfo).Admin
-		addr, err := sdk.AccAddressFromBech32(admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupPolicyTable = *groupPolicyTable
-
-	// Proposal Table
-	proposalTable, err := orm.NewAutoUInt64Table([2]byte{ProposalTablePrefix}, ProposalTableSeqPrefix, &group.Proposal{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.proposalByGroupPolicyIndex, err = orm.NewIndex(proposalTable, ProposalByGroupPolicyIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		account := value.(*group.Proposal).GroupPolicyAddress
-		addr, err := sdk.AccAddressFromBech32(account)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.proposalsByVotingPeriodEnd, err = orm.NewIndex(proposalTable, ProposalsByVotingPeriodEndPrefix, func(value interface{}) ([]interface{}, error) {
-		votingPeriodEnd := value.(*group.Proposal).VotingPeriodEnd
-		return []interface{}{sdk.FormatTimeBytes(votingPeriodEnd)}, nil
-	}, []byte{})
+	db, err := ormstore.NewStoreKeyDB(ModuleSchema, storeKey, ormstore.StoreKeyDBOptions{
+		ValidateHooks: validateHooks{k},
+	})
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
-	k.proposalTable = *proposalTable
+	k.db = db
 
-	// Vote Table
-	voteTable, err := orm.NewPrimaryKeyTable([2]byte{VoteTablePrefix}, &group.Vote{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.voteByProposalIndex, err = orm.NewIndex(voteTable, VoteByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.Vote).ProposalId}, nil
-	}, group.Vote{}.ProposalId)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.voteByVoterIndex, err = orm.NewIndex(voteTable, VoteByVoterIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		addr, err := sdk.AccAddressFromBech32(value.(*group.Vote).Voter)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
+	k.state, err = statev1.NewStateStore(db)
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
-	k.voteTable = *voteTable
 
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
@@ -221,34 +92,31 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // GetGroupSequence returns the current value of the group table sequence
 func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
-	return k.groupTable.Sequence().CurVal(ctx.KVStore(k.key))
+	seq, err := k.state.GroupInfoTable().LastInsertedSequence(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return seq
 }
 
 // iterateProposalsByVPEnd iterates over all proposals whose voting_period_end is after the `endTime` time argument.
 func (k Keeper) iterateProposalsByVPEnd(ctx sdk.Context, endTime time.Time, cb func(proposal group.Proposal) (bool, error)) error {
-	timeBytes := sdk.FormatTimeBytes(endTime)
-	it, err := k.proposalsByVotingPeriodEnd.PrefixScan(ctx.KVStore(k.key), nil, timeBytes)
-
+	it, err := k.state.ProposalTable().List(ctx, statev1.ProposalVotingPeriodEndIndexKey{})
 	if err != nil {
 		return err
 	}
 	defer it.Close()
 
-	for {
-		// Important: this following line cannot outside the for loop.
-		// It seems that when one unmarshals into the same `group.Proposal`
-		// reference, then gogoproto somehow "adds" the new bytes to the old
-		// object for some fields. When running simulations, for proposals with
-		// each 1-2 proposers, after a couple of loop iterations we got to a
-		// proposal with 60k+ proposers.
-		// So we're declaring a local variable that gets GCed.
-		//
-		// Also see `x/group/types/proposal_test.go`, TestGogoUnmarshalProposal().
-		var proposal group.Proposal
-		_, err := it.LoadNext(&proposal)
-		if errors.ErrORMIteratorDone.Is(err) {
+	for it.Next() {
+		p, err := it.Value()
+		if err != nil {
+			return err
+		}
+		if !p.VotingPeriodEnd.AsTime().Before(endTime) {
 			break
 		}
+
+		proposal, err := k.proposalFromState(p)
 		if err != nil {
 			return err
 		}
@@ -267,9 +135,7 @@ func (k Keeper) iterateProposalsByVPEnd(ctx sdk.Context, endTime time.Time, cb f
 
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
-	store := ctx.KVStore(k.key)
-
-	err := k.proposalTable.Delete(store, proposalID)
+	err := k.state.ProposalTable().Delete(ctx, &statev1.Proposal{Id: proposalID})
 	if err != nil {
 		return err
 	}
@@ -280,30 +146,7 @@ func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
 
 // pruneVotes prunes all votes for a proposal from state.
 func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
-	store := ctx.KVStore(k.key)
-	it, err := k.voteByProposalIndex.Get(store, proposalID)
-	if err != nil {
-		return err
-	}
-	defer it.Close()
-
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
-		if err != nil {
-			return err
-		}
-
-		err = k.voteTable.Delete(store, &vote)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return k.state.VoteTable().DeleteBy(ctx, statev1.VoteProposalIdVoterIndexKey{}.WithProposalId(proposalID))
 }
 
 // PruneProposals prunes all proposals that are expired, i.e. whose
@@ -345,7 +188,7 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 			return true, sdkerrors.Wrap(err, "doTallyAndUpdate")
 		}
 
-		if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
+		if err := k.updateProposal(ctx, &proposal); err != nil {
 			return true, sdkerrors.Wrap(err, "proposal update")
 		}
 
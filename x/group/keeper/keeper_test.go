@@ -1,7 +1,6 @@
 package keeper_test
 
 import (
-	"bytes"
 	"context"
 	"sort"
 	"strings"
@@ -194,11 +193,7 @@ func (s *TestSuite) TestCreateGroup() {
 			s.Require().Equal(len(members), len(loadedMembers))
 			// we reorder members by address to be able to compare them
 			sort.Slice(members, func(i, j int) bool {
-				addri, err := sdk.AccAddressFromBech32(members[i].Address)
-				s.Require().NoError(err)
-				addrj, err := sdk.AccAddressFromBech32(members[j].Address)
-				s.Require().NoError(err)
-				return bytes.Compare(addri, addrj) < 0
+				return members[i].Address < members[j].Address
 			})
 			for i := range loadedMembers {
 				s.Assert().Equal(members[i].Metadata, loadedMembers[i].Member.Metadata)
@@ -650,11 +645,7 @@ func (s *TestSuite) TestUpdateGroupMembers() {
 			s.Require().Equal(len(spec.expMembers), len(loadedMembers))
 			// we reorder group members by address to be able to compare them
 			sort.Slice(spec.expMembers, func(i, j int) bool {
-				addri, err := sdk.AccAddressFromBech32(spec.expMembers[i].Member.Address)
-				s.Require().NoError(err)
-				addrj, err := sdk.AccAddressFromBech32(spec.expMembers[j].Member.Address)
-				s.Require().NoError(err)
-				return bytes.Compare(addri, addrj) < 0
+				return spec.expMembers[i].Member.Address < spec.expMembers[j].Member.Address
 			})
 			for i := range loadedMembers {
 				s.Assert().Equal(spec.expMembers[i].Member.Metadata, loadedMembers[i].Member.Metadata)
@@ -828,11 +819,7 @@ func (s *TestSuite) TestCreateGroupWithPolicy() {
 			s.Require().Equal(len(members), len(loadedMembers))
 			// we reorder members by address to be able to compare them
 			sort.Slice(members, func(i, j int) bool {
-				addri, err := sdk.AccAddressFromBech32(members[i].Address)
-				s.Require().NoError(err)
-				addrj, err := sdk.AccAddressFromBech32(members[j].Address)
-				s.Require().NoError(err)
-				return bytes.Compare(addri, addrj) < 0
+				return members[i].Address < members[j].Address
 			})
 			for i := range loadedMembers {
 				s.Assert().Equal(members[i].Metadata, loadedMembers[i].Member.Metadata)
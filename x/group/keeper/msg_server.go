@@ -8,14 +8,15 @@ import (
 
 	gogotypes "github.com/gogo/protobuf/types"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 var _ group.MsgServer = Keeper{}
@@ -60,14 +61,13 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 
 	// Create a new group in the groupTable.
 	groupInfo := &group.GroupInfo{
-		Id:          k.groupTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
 		Admin:       admin,
 		Metadata:    metadata,
 		Version:     1,
 		TotalWeight: totalWeight.String(),
 		CreatedAt:   ctx.BlockTime(),
 	}
-	groupID, err := k.groupTable.Create(ctx.KVStore(k.key), groupInfo)
+	groupID, err := k.createGroupInfo(ctx, groupInfo)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "could not create group")
 	}
@@ -75,7 +75,7 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 	// Create new group members in the groupMemberTable.
 	for i := range members.Members {
 		m := members.Members[i]
-		err := k.groupMemberTable.Create(ctx.KVStore(k.key), &group.GroupMember{
+		err := k.state.GroupMemberTable().Insert(ctx, groupMemberToState(&group.GroupMember{
 			GroupId: groupID,
 			Member: &group.Member{
 				Address:  m.Address,
@@ -83,7 +83,7 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 				Metadata: m.Metadata,
 				AddedAt:  ctx.BlockTime(),
 			},
-		})
+		}))
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "could not store member %d", i)
 		}
@@ -118,11 +118,11 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 
 			// Checking if the group member is already part of the group
 			var found bool
-			var prevGroupMember group.GroupMember
-			switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&groupMember), &prevGroupMember); {
+			var prevGroupMember *statev1.GroupMember
+			switch prevGroupMember, err = k.state.GroupMemberTable().Get(ctx, groupMember.GroupId, groupMember.Member.Address); {
 			case err == nil:
 				found = true
-			case sdkerrors.ErrNotFound.Is(err):
+			case ormerrors.IsNotFound(err):
 				found = false
 			default:
 				return sdkerrors.Wrap(err, "get group member")
@@ -140,7 +140,7 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 					return sdkerrors.Wrap(sdkerrors.ErrNotFound, "unknown member")
 				}
 
-				previousMemberWeight, err := math.NewNonNegativeDecFromString(prevGroupMember.Member.Weight)
+				previousMemberWeight, err := math.NewNonNegativeDecFromString(prevGroupMember.Weight)
 				if err != nil {
 					return err
 				}
@@ -152,14 +152,14 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 				}
 
 				// Delete group member in the groupMemberTable.
-				if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
+				if err := k.state.GroupMemberTable().Delete(ctx, prevGroupMember); err != nil {
 					return sdkerrors.Wrap(err, "delete member")
 				}
 				continue
 			}
 			// If group member already exists, handle update
 			if found {
-				previousMemberWeight, err := math.NewNonNegativeDecFromString(prevGroupMember.Member.Weight)
+				previousMemberWeight, err := math.NewNonNegativeDecFromString(prevGroupMember.Weight)
 				if err != nil {
 					return err
 				}
@@ -169,11 +169,11 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 					return err
 				}
 				// Save updated group member in the groupMemberTable.
-				if err := k.groupMemberTable.Update(ctx.KVStore(k.key), &groupMember); err != nil {
+				if err := k.state.GroupMemberTable().Update(ctx, groupMemberToState(&groupMember)); err != nil {
 					return sdkerrors.Wrap(err, "add member")
 				}
 				// else handle create.
-			} else if err := k.groupMemberTable.Create(ctx.KVStore(k.key), &groupMember); err != nil {
+			} else if err := k.state.GroupMemberTable().Insert(ctx, groupMemberToState(&groupMember)); err != nil {
 				return sdkerrors.Wrap(err, "add member")
 			}
 			// In both cases (handle + update), we need to add the new member's weight to the group total weight.
@@ -190,7 +190,7 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 			return err
 		}
 
-		return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
+		return k.updateGroupInfo(ctx, g)
 	}
 
 	err := k.doUpdateGroup(ctx, req, action, "members updated")
@@ -207,7 +207,7 @@ func (k Keeper) UpdateGroupAdmin(goCtx context.Context, req *group.MsgUpdateGrou
 		g.Admin = req.NewAdmin
 		g.Version++
 
-		return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
+		return k.updateGroupInfo(ctx, g)
 	}
 
 	err := k.doUpdateGroup(ctx, req, action, "admin updated")
@@ -223,7 +223,7 @@ func (k Keeper) UpdateGroupMetadata(goCtx context.Context, req *group.MsgUpdateG
 	action := func(g *group.GroupInfo) error {
 		g.Metadata = req.Metadata
 		g.Version++
-		return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
+		return k.updateGroupInfo(ctx, g)
 	}
 
 	if err := k.assertMetadataLength(req.Metadata, "group metadata"); err != nil {
@@ -328,7 +328,10 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 	var accountAddr sdk.AccAddress
 	// loop here in the rare case of a collision
 	for {
-		nextAccVal := k.groupPolicySeq.NextVal(ctx.KVStore(k.key))
+		nextAccVal, err := k.nextGroupPolicySeq(ctx)
+		if err != nil {
+			return nil, err
+		}
 		var buf = make([]byte, 8)
 		binary.BigEndian.PutUint64(buf, nextAccVal)
 
@@ -363,7 +366,7 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 		return nil, err
 	}
 
-	if err := k.groupPolicyTable.Create(ctx.KVStore(k.key), &groupPolicy); err != nil {
+	if err := k.createGroupPolicyInfo(ctx, &groupPolicy); err != nil {
 		return nil, sdkerrors.Wrap(err, "could not create group policy")
 	}
 
@@ -380,7 +383,7 @@ func (k Keeper) UpdateGroupPolicyAdmin(goCtx context.Context, req *group.MsgUpda
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Admin = req.NewAdmin
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.updateGroupPolicyInfo(ctx, groupPolicy)
 	}
 
 	err := k.doUpdateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, action, "group policy admin updated")
@@ -412,7 +415,7 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *grou
 		}
 
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.updateGroupPolicyInfo(ctx, groupPolicy)
 	}
 
 	err := k.doUpdateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, action, "group policy's decision policy updated")
@@ -430,7 +433,7 @@ func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, req *group.MsgU
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Metadata = metadata
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.updateGroupPolicyInfo(ctx, groupPolicy)
 	}
 
 	if err := k.assertMetadataLength(metadata, "group policy metadata"); err != nil {
@@ -471,7 +474,11 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 
 	// Only members of the group can submit a new proposal.
 	for i := range proposers {
-		if !k.groupMemberTable.Has(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{GroupId: g.Id, Member: &group.Member{Address: proposers[i]}})) {
+		found, err := k.state.GroupMemberTable().Has(ctx, g.Id, proposers[i])
+		if err != nil {
+			return nil, err
+		}
+		if !found {
 			return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "not in group: %s", proposers[i])
 		}
 	}
@@ -493,7 +500,6 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 	}
 
 	m := &group.Proposal{
-		Id:                 k.proposalTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
 		GroupPolicyAddress: req.GroupPolicyAddress,
 		Metadata:           metadata,
 		Proposers:          proposers,
@@ -510,7 +516,7 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
 
-	id, err := k.proposalTable.Create(ctx.KVStore(k.key), m)
+	id, err := k.createProposal(ctx, m)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
@@ -571,7 +577,7 @@ func (k Keeper) WithdrawProposal(goCtx context.Context, req *group.MsgWithdrawPr
 	}
 
 	storeUpdates := func() (*group.MsgWithdrawProposalResponse, error) {
-		if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+		if err := k.updateProposal(ctx, &proposal); err != nil {
 			return nil, err
 		}
 		return &group.MsgWithdrawProposalResponse{}, nil
@@ -645,9 +651,8 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 
 	// Count and store votes.
 	voterAddr := req.Voter
-	voter := group.GroupMember{GroupId: electorate.Id, Member: &group.Member{Address: voterAddr}}
-	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
-		return nil, sdkerrors.Wrapf(err, "address: %s", voterAddr)
+	if _, err := k.state.GroupMemberTable().Get(ctx, electorate.Id, voterAddr); err != nil {
+		return nil, sdkerrors.Wrapf(wrapNotFound(err), "address: %s", voterAddr)
 	}
 	newVote := group.Vote{
 		ProposalId: id,
@@ -659,7 +664,7 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 
 	// The ORM will return an error if the vote already exists,
 	// making sure than a voter hasn't already voted.
-	if err := k.voteTable.Create(ctx.KVStore(k.key), &newVote); err != nil {
+	if err := k.createVote(ctx, &newVote); err != nil {
 		return nil, sdkerrors.Wrap(err, "store vote")
 	}
 
@@ -739,15 +744,13 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	}
 
 	storeUpdates := func() (*group.MsgExecResponse, error) {
-		store := ctx.KVStore(k.key)
-
 		// If proposal has successfully run, delete it from state.
 		if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 			if err := k.pruneProposal(ctx, proposal.Id); err != nil {
 				return nil, err
 			}
 		} else {
-			if err := k.proposalTable.Update(store, id, &proposal); err != nil {
+			if err := k.updateProposal(ctx, &proposal); err != nil {
 				return nil, err
 			}
 		}
@@ -841,13 +844,13 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 	}
 
 	// delete group member in the groupMemberTable.
-	if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), gm); err != nil {
+	if err := k.state.GroupMemberTable().Delete(ctx, groupMemberToState(gm)); err != nil {
 		return nil, sdkerrors.Wrap(err, "group member")
 	}
 
 	// update group weight
 	groupInfo.TotalWeight = updatedWeight.String()
-	if err := k.groupTable.Update(ctx.KVStore(k.key), groupInfo.Id, &groupInfo); err != nil {
+	if err := k.updateGroupInfo(ctx, &groupInfo); err != nil {
 		return nil, err
 	}
 
@@ -864,18 +867,28 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 }
 
 func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*group.GroupMember, error) {
-	var groupMember group.GroupMember
-	switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key),
-		orm.PrimaryKey(member), &groupMember); {
+	groupMember, err := k.state.GroupMemberTable().Get(ctx, member.GroupId, member.Member.Address)
+	switch {
 	case err == nil:
 		break
-	case sdkerrors.ErrNotFound.Is(err):
+	case ormerrors.IsNotFound(err):
 		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not part of group %d", member.Member.Address, member.GroupId)
 	default:
 		return nil, err
 	}
 
-	return &groupMember, nil
+	return groupMemberFromState(groupMember), nil
+}
+
+// nextGroupPolicySeq increments the sequence used to derive the account
+// addresses of group policies and returns its new value.
+func (k Keeper) nextGroupPolicySeq(ctx sdk.Context) (uint64, error) {
+	seq, err := k.state.GroupPolicySeqTable().Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	seq.Seq++
+	return seq.Seq, k.state.GroupPolicySeqTable().Save(ctx, seq)
 }
 
 type authNGroupReq interface {
@@ -968,18 +981,18 @@ func (k Keeper) assertMetadataLength(metadata string, description string) error
 // validateDecisionPolicies loops through all decision policies from the group,
 // and calls each of their Validate() method.
 func (k Keeper) validateDecisionPolicies(ctx sdk.Context, g group.GroupInfo) error {
-	it, err := k.groupPolicyByGroupIndex.Get(ctx.KVStore(k.key), g.Id)
+	it, err := k.state.GroupPolicyInfoTable().List(ctx, statev1.GroupPolicyInfoGroupIdIndexKey{}.WithGroupId(g.Id))
 	if err != nil {
 		return err
 	}
 	defer it.Close()
 
-	for {
-		var groupPolicy group.GroupPolicyInfo
-		_, err = it.LoadNext(&groupPolicy)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	for it.Next() {
+		p, err := it.Value()
+		if err != nil {
+			return err
 		}
+		groupPolicy, err := k.groupPolicyInfoFromState(p)
 		if err != nil {
 			return err
 		}
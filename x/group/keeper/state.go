@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	queryv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
+)
+
+// The ORM state messages of GroupInfo, GroupPolicyInfo, Proposal and Vote have
+// the same wire format as the group types, so they are converted to each
+// other by marshaling. Unmarshaling with the codec also unpacks the Any
+// values of group policies and proposals.
+
+func (k Keeper) toState(src codec.ProtoMarshaler, dst proto.Message) error {
+	bz, err := k.cdc.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(bz, dst)
+}
+
+func (k Keeper) fromState(src proto.Message, dst codec.ProtoMarshaler) error {
+	bz, err := proto.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return k.cdc.Unmarshal(bz, dst)
+}
+
+func (k Keeper) groupInfoFromState(g *statev1.GroupInfo) (group.GroupInfo, error) {
+	var groupInfo group.GroupInfo
+	return groupInfo, k.fromState(g, &groupInfo)
+}
+
+func (k Keeper) groupPolicyInfoFromState(p *statev1.GroupPolicyInfo) (group.GroupPolicyInfo, error) {
+	var policyInfo group.GroupPolicyInfo
+	return policyInfo, k.fromState(p, &policyInfo)
+}
+
+func (k Keeper) proposalFromState(p *statev1.Proposal) (group.Proposal, error) {
+	var proposal group.Proposal
+	return proposal, k.fromState(p, &proposal)
+}
+
+func (k Keeper) voteFromState(v *statev1.Vote) (group.Vote, error) {
+	var vote group.Vote
+	return vote, k.fromState(v, &vote)
+}
+
+// GroupMember inlines the member fields in its ORM state message, so that the
+// member address can be part of the primary key.
+
+func groupMemberToState(m *group.GroupMember) *statev1.GroupMember {
+	return &statev1.GroupMember{
+		GroupId:  m.GroupId,
+		Address:  m.Member.Address,
+		Weight:   m.Member.Weight,
+		Metadata: m.Member.Metadata,
+		AddedAt:  timestamppb.New(m.Member.AddedAt),
+	}
+}
+
+func groupMemberFromState(m *statev1.GroupMember) *group.GroupMember {
+	return &group.GroupMember{
+		GroupId: m.GroupId,
+		Member: &group.Member{
+			Address:  m.Address,
+			Weight:   m.Weight,
+			Metadata: m.Metadata,
+			AddedAt:  m.AddedAt.AsTime(),
+		},
+	}
+}
+
+// wrapNotFound converts ORM not found errors to sdkerrors.ErrNotFound.
+func wrapNotFound(err error) error {
+	if ormerrors.IsNotFound(err) {
+		return sdkerrors.ErrNotFound.Wrap(err.Error())
+	}
+	return err
+}
+
+func (k Keeper) getGroupInfo(ctx sdk.Context, id uint64) (group.GroupInfo, error) {
+	g, err := k.state.GroupInfoTable().Get(ctx, id)
+	if err != nil {
+		return group.GroupInfo{}, wrapNotFound(err)
+	}
+	return k.groupInfoFromState(g)
+}
+
+// createGroupInfo stores a new group with the next group ID and returns this
+// ID.
+func (k Keeper) createGroupInfo(ctx sdk.Context, g *group.GroupInfo) (uint64, error) {
+	var state statev1.GroupInfo
+	if err := k.toState(g, &state); err != nil {
+		return 0, err
+	}
+	return k.state.GroupInfoTable().InsertReturningID(ctx, &state)
+}
+
+func (k Keeper) updateGroupInfo(ctx sdk.Context, g *group.GroupInfo) error {
+	var state statev1.GroupInfo
+	if err := k.toState(g, &state); err != nil {
+		return err
+	}
+	return k.state.GroupInfoTable().Update(ctx, &state)
+}
+
+func (k Keeper) getGroupPolicyInfo(ctx sdk.Context, accountAddress string) (group.GroupPolicyInfo, error) {
+	p, err := k.state.GroupPolicyInfoTable().Get(ctx, accountAddress)
+	if err != nil {
+		return group.GroupPolicyInfo{}, wrapNotFound(err)
+	}
+	return k.groupPolicyInfoFromState(p)
+}
+
+func (k Keeper) createGroupPolicyInfo(ctx sdk.Context, p *group.GroupPolicyInfo) error {
+	var state statev1.GroupPolicyInfo
+	if err := k.toState(p, &state); err != nil {
+		return err
+	}
+	return k.state.GroupPolicyInfoTable().Insert(ctx, &state)
+}
+
+func (k Keeper) updateGroupPolicyInfo(ctx sdk.Context, p *group.GroupPolicyInfo) error {
+	var state statev1.GroupPolicyInfo
+	if err := k.toState(p, &state); err != nil {
+		return err
+	}
+	return k.state.GroupPolicyInfoTable().Update(ctx, &state)
+}
+
+func (k Keeper) getProposal(ctx sdk.Context, proposalID uint64) (group.Proposal, error) {
+	p, err := k.state.ProposalTable().Get(ctx, proposalID)
+	if err != nil {
+		return group.Proposal{}, sdkerrors.Wrap(wrapNotFound(err), "load proposal")
+	}
+	return k.proposalFromState(p)
+}
+
+// createProposal stores a new proposal with the next proposal ID and returns
+// this ID.
+func (k Keeper) createProposal(ctx sdk.Context, p *group.Proposal) (uint64, error) {
+	var state statev1.Proposal
+	if err := k.toState(p, &state); err != nil {
+		return 0, err
+	}
+	return k.state.ProposalTable().InsertReturningID(ctx, &state)
+}
+
+func (k Keeper) updateProposal(ctx sdk.Context, p *group.Proposal) error {
+	var state statev1.Proposal
+	if err := k.toState(p, &state); err != nil {
+		return err
+	}
+	return k.state.ProposalTable().Update(ctx, &state)
+}
+
+func (k Keeper) getVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (group.Vote, error) {
+	v, err := k.state.VoteTable().Get(ctx, proposalID, voter.String())
+	if err != nil {
+		return group.Vote{}, wrapNotFound(err)
+	}
+	return k.voteFromState(v)
+}
+
+func (k Keeper) createVote(ctx sdk.Context, v *group.Vote) error {
+	var state statev1.Vote
+	if err := k.toState(v, &state); err != nil {
+		return err
+	}
+	return k.state.VoteTable().Insert(ctx, &state)
+}
+
+// paginate returns the ORM list options for a page request, using the same
+// defaults as the legacy group ORM: when no limit is provided, the default
+// limit is used and the total is counted.
+func paginate(pageRequest *query.PageRequest) []ormlist.Option {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	countTotal := pageRequest.CountTotal
+	if pageRequest.Limit == 0 {
+		countTotal = true
+	}
+
+	return []ormlist.Option{
+		ormlist.DefaultLimit(query.DefaultLimit),
+		ormlist.Paginate(&queryv1beta1.PageRequest{
+			Key:        pageRequest.Key,
+			Offset:     pageRequest.Offset,
+			Limit:      pageRequest.Limit,
+			CountTotal: countTotal,
+			Reverse:    pageRequest.Reverse,
+		}),
+	}
+}
+
+// pageResponse converts the page response of an exhausted ORM iterator.
+func pageResponse(it ormtable.Iterator) *query.PageResponse {
+	res := it.PageResponse()
+	if res == nil {
+		return &query.PageResponse{}
+	}
+	return &query.PageResponse{NextKey: res.NextKey, Total: res.Total}
+}
+
+// validateHooks validate the group state messages with their ValidateBasic
+// method before they are written.
+type validateHooks struct {
+	k Keeper
+}
+
+var _ ormtable.ValidateHooks = validateHooks{}
+
+func (h validateHooks) ValidateInsert(_ context.Context, message proto.Message) error {
+	return h.validate(message)
+}
+
+func (h validateHooks) ValidateUpdate(_ context.Context, _, new proto.Message) error {
+	return h.validate(new)
+}
+
+func (h validateHooks) ValidateDelete(context.Context, proto.Message) error {
+	return nil
+}
+
+func (h validateHooks) validate(message proto.Message) error {
+	var v interface{ ValidateBasic() error }
+	switch message := message.(type) {
+	case *statev1.GroupInfo:
+		g, err := h.k.groupInfoFromState(message)
+		if err != nil {
+			return err
+		}
+		v = g
+	case *statev1.GroupMember:
+		v = groupMemberFromState(message)
+	case *statev1.GroupPolicyInfo:
+		p, err := h.k.groupPolicyInfoFromState(message)
+		if err != nil {
+			return err
+		}
+		v = p
+	case *statev1.Proposal:
+		p, err := h.k.proposalFromState(message)
+		if err != nil {
+			return err
+		}
+		v = p
+	case *statev1.Vote:
+		vote, err := h.k.voteFromState(message)
+		if err != nil {
+			return err
+		}
+		v = vote
+	default:
+		return nil
+	}
+
+	return v.ValidateBasic()
+}
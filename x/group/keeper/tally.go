@@ -1,11 +1,11 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/errors"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 )
 
 // Tally is a function that tallies a proposal by iterating through its votes,
@@ -20,7 +20,7 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupId uint64) (group.
 		return p.FinalTallyResult, nil
 	}
 
-	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), p.Id)
+	it, err := k.state.VoteTable().List(ctx, statev1.VoteProposalIdVoterIndexKey{}.WithProposalId(p.Id))
 	if err != nil {
 		return group.TallyResult{}, err
 	}
@@ -28,24 +28,20 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupId uint64) (group.
 
 	tallyResult := group.DefaultTallyResult()
 
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	for it.Next() {
+		v, err := it.Value()
+		if err != nil {
+			return group.TallyResult{}, err
 		}
+		vote, err := k.voteFromState(v)
 		if err != nil {
 			return group.TallyResult{}, err
 		}
 
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupId,
-			Member:  &group.Member{Address: vote.Voter},
-		}), &member)
+		member, err := k.state.GroupMemberTable().Get(ctx, groupId, vote.Voter)
 
 		switch {
-		case sdkerrors.ErrNotFound.Is(err):
+		case ormerrors.IsNotFound(err):
 			// If the member left the group after voting, then we simply skip the
 			// vote.
 			continue
@@ -54,7 +50,7 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupId uint64) (group.
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		if err := tallyResult.Add(vote, member.Weight); err != nil {
 			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
 		}
 	}
@@ -0,0 +1,46 @@
+package v047
+
+// Prefixes of the legacy group store layout, where each table is stored under
+// a two bytes prefix made of its table prefix followed by a zero byte, and
+// the sequences of the group, group policy and proposal tables are stored
+// under their sequence prefix followed by sequenceStorageKey.
+const (
+	// Group Table
+	GroupTablePrefix        byte = 0x0
+	GroupTableSeqPrefix     byte = 0x1
+	GroupByAdminIndexPrefix byte = 0x2
+
+	// Group Member Table
+	GroupMemberTablePrefix         byte = 0x10
+	GroupMemberByGroupIndexPrefix  byte = 0x11
+	GroupMemberByMemberIndexPrefix byte = 0x12
+
+	// Group Policy Table
+	GroupPolicyTablePrefix        byte = 0x20
+	GroupPolicyTableSeqPrefix     byte = 0x21
+	GroupPolicyByGroupIndexPrefix byte = 0x22
+	GroupPolicyByAdminIndexPrefix byte = 0x23
+
+	// Proposal Table
+	ProposalTablePrefix              byte = 0x30
+	ProposalTableSeqPrefix           byte = 0x31
+	ProposalByGroupPolicyIndexPrefix byte = 0x32
+	ProposalsByVotingPeriodEndPrefix byte = 0x33
+
+	// Vote Table
+	VoteTablePrefix           byte = 0x40
+	VoteByProposalIndexPrefix byte = 0x41
+	VoteByVoterIndexPrefix    byte = 0x42
+
+	sequenceStorageKey byte = 0x1
+)
+
+// tablePrefix returns the prefix of the rows of a legacy table.
+func tablePrefix(prefix byte) []byte {
+	return []byte{prefix, 0x0}
+}
+
+// sequenceKey returns the key of a legacy sequence.
+func sequenceKey(prefix byte) []byte {
+	return []byte{prefix, sequenceStorageKey}
+}
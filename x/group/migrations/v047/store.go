@@ -0,0 +1,167 @@
+package v047
+
+import (
+	"encoding/binary"
+
+	"google.golang.org/protobuf/proto"
+
+	groupv1 "github.com/cosmos/cosmos-sdk/api/cosmos/group/v1"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
+)
+
+// legacyPrefixes are the prefixes of all the legacy tables, indexes and
+// sequences.
+var legacyPrefixes = []byte{
+	GroupTablePrefix, GroupTableSeqPrefix, GroupByAdminIndexPrefix,
+	GroupMemberTablePrefix, GroupMemberByGroupIndexPrefix, GroupMemberByMemberIndexPrefix,
+	GroupPolicyTablePrefix, GroupPolicyTableSeqPrefix, GroupPolicyByGroupIndexPrefix, GroupPolicyByAdminIndexPrefix,
+	ProposalTablePrefix, ProposalTableSeqPrefix, ProposalByGroupPolicyIndexPrefix, ProposalsByVotingPeriodEndPrefix,
+	VoteTablePrefix, VoteByProposalIndexPrefix, VoteByVoterIndexPrefix,
+}
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2.
+// The migration includes:
+//
+// - Moving the groups, group members, group policies, proposals and votes
+// from the legacy group ORM tables to the tables of the provided ORM
+// database, keeping the IDs of groups and proposals and the values of the
+// sequences.
+// - Deleting the legacy tables, indexes and sequences.
+// - Storing the schemas of the ORM tables for later migrations.
+//
+// The values of the legacy tables have the same wire format as the ORM
+// messages, except for group members whose member fields are inlined.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, db ormdb.ModuleDB) error {
+	store := ctx.KVStore(storeKey)
+
+	state, err := statev1.NewStateStore(db)
+	if err != nil {
+		return err
+	}
+
+	err = migrateAutoIncrementTable(ctx, store, db.GetTable(&statev1.GroupInfo{}), GroupTablePrefix, GroupTableSeqPrefix,
+		func() proto.Message { return &statev1.GroupInfo{} })
+	if err != nil {
+		return sdkerrors.Wrap(err, "groups")
+	}
+
+	err = migrateTable(store, GroupMemberTablePrefix, func(bz []byte) error {
+		var m groupv1.GroupMember
+		if err := proto.Unmarshal(bz, &m); err != nil {
+			return err
+		}
+		return state.GroupMemberTable().Insert(ctx, &statev1.GroupMember{
+			GroupId:  m.GroupId,
+			Address:  m.Member.GetAddress(),
+			Weight:   m.Member.GetWeight(),
+			Metadata: m.Member.GetMetadata(),
+			AddedAt:  m.Member.GetAddedAt(),
+		})
+	})
+	if err != nil {
+		return sdkerrors.Wrap(err, "group members")
+	}
+
+	err = migrateTable(store, GroupPolicyTablePrefix, func(bz []byte) error {
+		var p statev1.GroupPolicyInfo
+		if err := proto.Unmarshal(bz, &p); err != nil {
+			return err
+		}
+		return state.GroupPolicyInfoTable().Insert(ctx, &p)
+	})
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policies")
+	}
+
+	err = state.GroupPolicySeqTable().Save(ctx, &statev1.GroupPolicySeq{
+		Seq: decodeSequence(store.Get(sequenceKey(GroupPolicyTableSeqPrefix))),
+	})
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy account seq")
+	}
+
+	err = migrateAutoIncrementTable(ctx, store, db.GetTable(&statev1.Proposal{}), ProposalTablePrefix, ProposalTableSeqPrefix,
+		func() proto.Message { return &statev1.Proposal{} })
+	if err != nil {
+		return sdkerrors.Wrap(err, "proposals")
+	}
+
+	err = migrateTable(store, VoteTablePrefix, func(bz []byte) error {
+		var v statev1.Vote
+		if err := proto.Unmarshal(bz, &v); err != nil {
+			return err
+		}
+		return state.VoteTable().Insert(ctx, &v)
+	})
+	if err != nil {
+		return sdkerrors.Wrap(err, "votes")
+	}
+
+	deleteLegacyKeys(store)
+
+	return db.Migrate(ctx, ormdb.MigrateOptions{})
+}
+
+// migrateAutoIncrementTable inserts the rows of a legacy auto-increment table
+// with their IDs into the provided ORM table and sets the ORM table sequence
+// to the legacy sequence.
+func migrateAutoIncrementTable(ctx sdk.Context, store storetypes.KVStore, table ormtable.Table, prefix, seqPrefix byte, newMessage func() proto.Message) error {
+	autoIncTable := table.(ormtable.AutoIncrementTable)
+	err := migrateTable(store, prefix, func(bz []byte) error {
+		message := newMessage()
+		if err := proto.Unmarshal(bz, message); err != nil {
+			return err
+		}
+		return autoIncTable.InsertWithID(ctx, message)
+	})
+	if err != nil {
+		return err
+	}
+
+	return autoIncTable.SetLastInsertedSequence(ctx, decodeSequence(store.Get(sequenceKey(seqPrefix))))
+}
+
+// migrateTable calls insert with the value of each row of a legacy table.
+func migrateTable(store storetypes.KVStore, prefix byte, insert func(bz []byte) error) error {
+	var values [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, tablePrefix(prefix))
+	for ; iterator.Valid(); iterator.Next() {
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, bz := range values {
+		if err := insert(bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteLegacyKeys(store storetypes.KVStore) {
+	for _, prefix := range legacyPrefixes {
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, []byte{prefix})
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+func decodeSequence(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
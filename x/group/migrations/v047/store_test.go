@@ -0,0 +1,109 @@
+package v047_test
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	v047group "github.com/cosmos/cosmos-sdk/x/group/migrations/v047"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	groupKey := sdk.NewKVStoreKey(group.StoreKey)
+	tGroupKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(groupKey, tGroupKey)
+	store := ctx.KVStore(groupKey)
+	k := keeper.NewKeeper(groupKey, encCfg.Codec, nil, nil, group.DefaultConfig())
+
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, member := testdata.KeyTestPubAddr()
+	_, _, policyAddr := testdata.KeyTestPubAddr()
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	uint64Key := func(prefix byte, id uint64) []byte {
+		key := []byte{prefix, 0x0}
+		return append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	setSeq := func(prefix byte, seq uint64) {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, seq)
+		store.Set([]byte{prefix, 0x1}, bz)
+	}
+
+	groupInfo := &group.GroupInfo{Id: 1, Admin: admin.String(), Metadata: "group", Version: 1, TotalWeight: "2", CreatedAt: now}
+	store.Set(uint64Key(v047group.GroupTablePrefix, 1), encCfg.Codec.MustMarshal(groupInfo))
+	store.Set(append([]byte{v047group.GroupByAdminIndexPrefix}, admin...), []byte{})
+	setSeq(v047group.GroupTableSeqPrefix, 3)
+
+	groupMember := &group.GroupMember{GroupId: 1, Member: &group.Member{Address: member.String(), Weight: "2", AddedAt: now}}
+	store.Set(append(uint64Key(v047group.GroupMemberTablePrefix, 1), member...), encCfg.Codec.MustMarshal(groupMember))
+
+	policy := group.NewThresholdDecisionPolicy("1", time.Hour, 0)
+	policyInfo, err := group.NewGroupPolicyInfo(policyAddr, 1, admin, "policy", 1, policy, now)
+	require.NoError(t, err)
+	store.Set(append([]byte{v047group.GroupPolicyTablePrefix, 0x0}, policyAddr...), encCfg.Codec.MustMarshal(&policyInfo))
+	setSeq(v047group.GroupPolicyTableSeqPrefix, 4)
+
+	proposal := &group.Proposal{
+		Id:                 2,
+		GroupPolicyAddress: policyAddr.String(),
+		Proposers:          []string{member.String()},
+		SubmitTime:         now,
+		GroupVersion:       1,
+		GroupPolicyVersion: 1,
+		Status:             group.PROPOSAL_STATUS_SUBMITTED,
+		FinalTallyResult:   group.DefaultTallyResult(),
+		VotingPeriodEnd:    now.Add(time.Hour),
+		ExecutorResult:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+	}
+	require.NoError(t, proposal.SetMsgs([]sdk.Msg{&group.MsgLeaveGroup{Address: policyAddr.String(), GroupId: 1}}))
+	store.Set(uint64Key(v047group.ProposalTablePrefix, 2), encCfg.Codec.MustMarshal(proposal))
+	setSeq(v047group.ProposalTableSeqPrefix, 2)
+
+	vote := &group.Vote{ProposalId: 2, Voter: member.String(), Option: group.VOTE_OPTION_YES, SubmitTime: now}
+	store.Set(append(uint64Key(v047group.VoteTablePrefix, 2), member...), encCfg.Codec.MustMarshal(vote))
+
+	// Run migrations.
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	// The legacy keys are deleted.
+	for _, prefix := range []byte{
+		v047group.GroupTablePrefix, v047group.GroupTableSeqPrefix, v047group.GroupByAdminIndexPrefix,
+		v047group.GroupMemberTablePrefix, v047group.GroupPolicyTablePrefix, v047group.GroupPolicyTableSeqPrefix,
+		v047group.ProposalTablePrefix, v047group.ProposalTableSeqPrefix, v047group.VoteTablePrefix,
+	} {
+		it := sdk.KVStorePrefixIterator(store, []byte{prefix})
+		require.False(t, it.Valid())
+		it.Close()
+	}
+
+	// The state is migrated to the ORM tables.
+	genesis := k.ExportGenesis(ctx, encCfg.Codec)
+	require.Equal(t, []*group.GroupInfo{groupInfo}, genesis.Groups)
+	require.Equal(t, uint64(3), genesis.GroupSeq)
+	require.Equal(t, []*group.GroupMember{groupMember}, genesis.GroupMembers)
+	require.Len(t, genesis.GroupPolicies, 1)
+	require.Equal(t, policyInfo.Address, genesis.GroupPolicies[0].Address)
+	require.Equal(t, policy, genesis.GroupPolicies[0].GetDecisionPolicy())
+	require.Equal(t, uint64(4), genesis.GroupPolicySeq)
+	require.Len(t, genesis.Proposals, 1)
+	require.Equal(t, proposal.Id, genesis.Proposals[0].Id)
+	require.Equal(t, proposal.VotingPeriodEnd, genesis.Proposals[0].VotingPeriodEnd)
+	require.Equal(t, []sdk.Msg{&group.MsgLeaveGroup{Address: policyAddr.String(), GroupId: 1}}, genesis.Proposals[0].GetMsgs())
+	require.Equal(t, uint64(2), genesis.ProposalSeq)
+	require.Equal(t, []*group.Vote{vote}, genesis.Votes)
+
+	// Indexes are built for the migrated rows.
+	res, err := k.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{Admin: admin.String()})
+	require.NoError(t, err)
+	require.Equal(t, []*group.GroupInfo{groupInfo}, res.Groups)
+}
@@ -5,65 +5,71 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
-	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	"github.com/cosmos/cosmos-sdk/types/kv"
-	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	statev1 "github.com/cosmos/cosmos-sdk/x/group/internal/cosmos/group/state/v1"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 	"github.com/cosmos/cosmos-sdk/x/group/simulation"
 )
 
 func TestDecodeStore(t *testing.T) {
-	cdc := simapp.MakeTestEncodingConfig().Codec
-	dec := simulation.NewDecodeStore(cdc)
-
-	g := group.GroupInfo{Id: 1}
-	groupBz, err := cdc.Marshal(&g)
+	dec := simulation.NewDecodeStore()
+	db, err := ormdb.NewModuleDB(keeper.ModuleSchema, ormdb.ModuleDBOptions{})
 	require.NoError(t, err)
 
 	_, _, addr := testdata.KeyTestPubAddr()
-	member := group.GroupMember{GroupId: 1, Member: &group.Member{
-		Address: addr.String(),
-	}}
-	memberBz, err := cdc.Marshal(&member)
-	require.NoError(t, err)
-
 	_, _, accAddr := testdata.KeyTestPubAddr()
-	acc := group.GroupPolicyInfo{Address: accAddr.String()}
-	accBz, err := cdc.Marshal(&acc)
-	require.NoError(t, err)
-
-	proposal := group.Proposal{Id: 1}
-	proposalBz, err := cdc.Marshal(&proposal)
-	require.NoError(t, err)
-
-	vote := group.Vote{Voter: addr.String(), ProposalId: 1}
-	voteBz, err := cdc.Marshal(&vote)
-	require.NoError(t, err)
 
-	kvPairs := kv.Pairs{
-		Pairs: []kv.Pair{
-			{Key: append([]byte{keeper.GroupTablePrefix}, orm.PrimaryKey(&g)...), Value: groupBz},
-			{Key: append([]byte{keeper.GroupMemberTablePrefix}, orm.PrimaryKey(&member)...), Value: memberBz},
-			{Key: append([]byte{keeper.GroupPolicyTablePrefix}, orm.PrimaryKey(&acc)...), Value: accBz},
-			{Key: append([]byte{keeper.ProposalTablePrefix}, orm.PrimaryKey(&proposal)...), Value: proposalBz},
-			{Key: append([]byte{keeper.VoteTablePrefix}, orm.PrimaryKey(&vote)...), Value: voteBz},
-			{Key: []byte{0x99}, Value: []byte{0x99}},
+	entries := []*ormkv.PrimaryKeyEntry{
+		{
+			TableName: "cosmos.group.state.v1.GroupInfo",
+			Key:       []protoreflect.Value{protoreflect.ValueOfUint64(1)},
+			Value:     &statev1.GroupInfo{Id: 1, Admin: addr.String()},
+		},
+		{
+			TableName: "cosmos.group.state.v1.GroupMember",
+			Key:       []protoreflect.Value{protoreflect.ValueOfUint64(1), protoreflect.ValueOfString(addr.String())},
+			Value:     &statev1.GroupMember{GroupId: 1, Address: addr.String(), Weight: "1"},
+		},
+		{
+			TableName: "cosmos.group.state.v1.GroupPolicyInfo",
+			Key:       []protoreflect.Value{protoreflect.ValueOfString(accAddr.String())},
+			Value:     &statev1.GroupPolicyInfo{Address: accAddr.String(), GroupId: 1},
+		},
+		{
+			TableName: "cosmos.group.state.v1.Proposal",
+			Key:       []protoreflect.Value{protoreflect.ValueOfUint64(1)},
+			Value:     &statev1.Proposal{Id: 1, GroupPolicyAddress: accAddr.String()},
 		},
+		{
+			TableName: "cosmos.group.state.v1.Vote",
+			Key:       []protoreflect.Value{protoreflect.ValueOfUint64(1), protoreflect.ValueOfString(addr.String())},
+			Value:     &statev1.Vote{ProposalId: 1, Voter: addr.String()},
+		},
+	}
+
+	var pairs []kv.Pair
+	for _, entry := range entries {
+		k, v, err := db.EncodeEntry(entry)
+		require.NoError(t, err)
+		pairs = append(pairs, kv.Pair{Key: k, Value: v})
 	}
+	pairs = append(pairs, kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}})
 
 	tests := []struct {
 		name        string
 		expectErr   bool
 		expectedLog string
 	}{
-		{"Group", false, fmt.Sprintf("%v\n%v", g, g)},
-		{"GroupMember", false, fmt.Sprintf("%v\n%v", member, member)},
-		{"GroupPolicy", false, fmt.Sprintf("%v\n%v", acc, acc)},
-		{"Proposal", false, fmt.Sprintf("%v\n%v", proposal, proposal)},
-		{"Vote", false, fmt.Sprintf("%v\n%v", vote, vote)},
+		{"Group", false, fmt.Sprintf("%v\n%v", entries[0], entries[0])},
+		{"GroupMember", false, fmt.Sprintf("%v\n%v", entries[1], entries[1])},
+		{"GroupPolicy", false, fmt.Sprintf("%v\n%v", entries[2], entries[2])},
+		{"Proposal", false, fmt.Sprintf("%v\n%v", entries[3], entries[3])},
+		{"Vote", false, fmt.Sprintf("%v\n%v", entries[4], entries[4])},
 		{"other", true, ""},
 	}
 
@@ -71,9 +77,9 @@ func TestDecodeStore(t *testing.T) {
 		i, tt := i, tt
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.expectErr {
-				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+				require.Panics(t, func() { dec(pairs[i], pairs[i]) }, tt.name)
 			} else {
-				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+				require.Equal(t, tt.expectedLog, dec(pairs[i], pairs[i]), tt.name)
 			}
 		})
 	}
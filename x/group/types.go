@@ -12,7 +12,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 // DecisionPolicyResult is the result of whether a proposal passes or not a
@@ -229,8 +228,6 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string, si
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
-var _ orm.Validateable = GroupPolicyInfo{}
-
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
 func NewGroupPolicyInfo(address sdk.AccAddress, group uint64, admin sdk.AccAddress, metadata string,
 	version uint64, decisionPolicy DecisionPolicy, createdAt time.Time) (GroupPolicyInfo, error) {
@@ -278,10 +275,6 @@ func (g GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
 }
 
-func (g GroupInfo) PrimaryKeyFields() []interface{} {
-	return []interface{}{g.Id}
-}
-
 func (g GroupInfo) ValidateBasic() error {
 	if g.Id == 0 {
 		return sdkerrors.Wrap(errors.ErrEmpty, "group's GroupId")
@@ -301,18 +294,6 @@ func (g GroupInfo) ValidateBasic() error {
 	return nil
 }
 
-func (g GroupPolicyInfo) PrimaryKeyFields() []interface{} {
-	addr, err := sdk.AccAddressFromBech32(g.Address)
-	if err != nil {
-		panic(err)
-	}
-	return []interface{}{addr.Bytes()}
-}
-
-func (g Proposal) PrimaryKeyFields() []interface{} {
-	return []interface{}{g.Id}
-}
-
 func (g GroupPolicyInfo) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(g.Admin)
 	if err != nil {
@@ -340,14 +321,6 @@ func (g GroupPolicyInfo) ValidateBasic() error {
 	return nil
 }
 
-func (g GroupMember) PrimaryKeyFields() []interface{} {
-	addr, err := sdk.AccAddressFromBech32(g.Member.Address)
-	if err != nil {
-		panic(err)
-	}
-	return []interface{}{g.GroupId, addr.Bytes()}
-}
-
 func (g GroupMember) ValidateBasic() error {
 	if g.GroupId == 0 {
 		return sdkerrors.Wrap(errors.ErrEmpty, "group member's group id")
@@ -394,16 +367,6 @@ func (p Proposal) ValidateBasic() error {
 	return nil
 }
 
-func (v Vote) PrimaryKeyFields() []interface{} {
-	addr, err := sdk.AccAddressFromBech32(v.Voter)
-	if err != nil {
-		panic(err)
-	}
-	return []interface{}{v.ProposalId, addr.Bytes()}
-}
-
-var _ orm.Validateable = Vote{}
-
 func (v Vote) ValidateBasic() error {
 
 	_, err := sdk.AccAddressFromBech32(v.Voter)
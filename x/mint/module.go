@@ -83,6 +83,11 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, types.MinterKey, "minter")
+}
+
 // AppModule implements an application module for the mint module.
 type AppModule struct {
 	AppModuleBasic
@@ -6,13 +6,19 @@ import (
 	"math/rand"
 
 	"github.com/gorilla/mux"
-	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	modulev1 "github.com/cosmos/cosmos-sdk/api/cosmos/params/module/v1"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/container"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/runtime/appmodule"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -56,7 +62,7 @@ func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, config client.TxEncodin
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {}
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the params module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
 	if err := proposal.RegisterQueryHandlerClient(context.Background(), mux, proposal.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
@@ -153,3 +159,48 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+//
+// New App Wiring Setup
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(
+			provideModule,
+			provideSubspace,
+		),
+	)
+}
+
+type paramsInputs struct {
+	container.In
+
+	KvStoreKey        *storetypes.KVStoreKey
+	TransientStoreKey *storetypes.TransientStoreKey
+	Cdc               codec.Codec
+	LegacyAmino       *codec.LegacyAmino
+}
+
+type paramsOutputs struct {
+	container.Out
+
+	ParamsKeeper  keeper.Keeper
+	BaseAppOption runtime.BaseAppOption
+	Module        runtime.AppModuleWrapper
+}
+
+func provideModule(in paramsInputs) paramsOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.LegacyAmino, in.KvStoreKey, in.TransientStoreKey)
+	baseAppOption := func(app *baseapp.BaseApp) {
+		app.SetParamStore(k.Subspace(baseapp.Paramspace).WithKeyTable(types.ConsensusParamsKeyTable()))
+	}
+	m := runtime.WrapAppModule(NewAppModule(k))
+	return paramsOutputs{ParamsKeeper: k, BaseAppOption: baseAppOption, Module: m}
+}
+
+// provideSubspace provides the params subspace of each module depending on
+// it, named after the module.
+func provideSubspace(key container.ModuleKey, k keeper.Keeper) types.Subspace {
+	return k.Subspace(key.Name())
+}
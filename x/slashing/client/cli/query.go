@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryDowntimeOffenses(),
 	)
 
 	return slashingQueryCmd
@@ -109,6 +110,63 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryDowntimeOffenses implements the command to query the downtime
+// offense history of a validator or of all validators.
+func GetCmdQueryDowntimeOffenses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "downtime-offenses [validator-conspub]",
+		Short: "Query the downtime offense history of a validator or of all validators",
+		Long: strings.TrimSpace(`Query the downtime offense history of a validator using its consensus public key,
+or of all validators if no key is given:
+
+$ <appd> query slashing downtime-offenses '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+$ <appd> query slashing downtime-offenses
+`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				params := &types.QueryDowntimeOffensesRequest{Pagination: pageReq}
+				res, err := queryClient.DowntimeOffenses(cmd.Context(), params)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryValidatorDowntimeOffensesRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.ValidatorDowntimeOffenses(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "downtime offenses")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
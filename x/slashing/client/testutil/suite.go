@@ -67,7 +67,7 @@ func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfo() {
 				fmt.Sprintf("--%s=1", flags.FlagHeight),
 			},
 			false,
-			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\"}", sdk.ConsAddress(val.PubKey.Address())),
+			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\",\"recent_downtime_offenses\":\"0\",\"last_downtime_offense_time\":\"0001-01-01T00:00:00Z\"}", sdk.ConsAddress(val.PubKey.Address())),
 		},
 		{
 			"valid address (text output)",
@@ -80,7 +80,9 @@ func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfo() {
 			fmt.Sprintf(`address: %s
 index_offset: "0"
 jailed_until: "1970-01-01T00:00:00Z"
+last_downtime_offense_time: "0001-01-01T00:00:00Z"
 missed_blocks_counter: "0"
+recent_downtime_offenses: "0"
 start_height: "0"
 tombstoned: false`, sdk.ConsAddress(val.PubKey.Address())),
 		},
@@ -115,16 +117,21 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","downtime_offense_decay_window":"2592000s","downtime_jail_duration_multiplier":"2.000000000000000000","max_downtime_jail_duration":"604800s","slash_fraction_downtime_multiplier":"2.000000000000000000","max_slash_fraction_downtime":"0.050000000000000000"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`downtime_jail_duration: 600s
+downtime_jail_duration_multiplier: "2.000000000000000000"
+downtime_offense_decay_window: 2592000s
+max_downtime_jail_duration: 604800s
+max_slash_fraction_downtime: "0.050000000000000000"
 min_signed_per_window: "0.500000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
-slash_fraction_downtime: "0.010000000000000000"`,
+slash_fraction_downtime: "0.010000000000000000"
+slash_fraction_downtime_multiplier: "2.000000000000000000"`,
 		},
 	}
 
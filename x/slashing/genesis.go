@@ -39,6 +39,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for _, offense := range data.DowntimeOffenses {
+		address, err := sdk.ConsAddressFromBech32(offense.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetDowntimeOffense(ctx, address, offense)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -66,5 +74,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	downtimeOffenses := make([]types.DowntimeOffense, 0)
+	keeper.IterateDowntimeOffenses(ctx, func(offense types.DowntimeOffense) (stop bool) {
+		downtimeOffenses = append(downtimeOffenses, offense)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, downtimeOffenses)
 }
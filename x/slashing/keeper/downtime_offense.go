@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SetDowntimeOffense stores a downtime offense of a validator
+func (k Keeper) SetDowntimeOffense(ctx sdk.Context, address sdk.ConsAddress, offense types.DowntimeOffense) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&offense)
+	store.Set(types.DowntimeOffenseKey(address, offense.Height), bz)
+}
+
+// IterateValidatorDowntimeOffenses iterates over the downtime offenses of a
+// validator, ordered by height
+func (k Keeper) IterateValidatorDowntimeOffenses(ctx sdk.Context,
+	address sdk.ConsAddress, handler func(offense types.DowntimeOffense) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeOffensePrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var offense types.DowntimeOffense
+		k.cdc.MustUnmarshal(iter.Value(), &offense)
+		if handler(offense) {
+			break
+		}
+	}
+}
+
+// GetValidatorDowntimeOffenses returns the downtime offenses of a validator
+func (k Keeper) GetValidatorDowntimeOffenses(ctx sdk.Context, address sdk.ConsAddress) []types.DowntimeOffense {
+	offenses := []types.DowntimeOffense{}
+	k.IterateValidatorDowntimeOffenses(ctx, address, func(offense types.DowntimeOffense) (stop bool) {
+		offenses = append(offenses, offense)
+		return false
+	})
+
+	return offenses
+}
+
+// IterateDowntimeOffenses iterates over the downtime offenses of all validators
+func (k Keeper) IterateDowntimeOffenses(ctx sdk.Context, handler func(offense types.DowntimeOffense) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeOffenseKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var offense types.DowntimeOffense
+		k.cdc.MustUnmarshal(iter.Value(), &offense)
+		if handler(offense) {
+			break
+		}
+	}
+}
+
+// deleteValidatorDowntimeOffenses deletes every downtime offense of a validator
+func (k Keeper) deleteValidatorDowntimeOffenses(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeOffensePrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// pruneDowntimeOffenses deletes the downtime offenses of a validator which
+// were punished more than a decay window before the current block time.
+func (k Keeper) pruneDowntimeOffenses(ctx sdk.Context, address sdk.ConsAddress) {
+	window := k.DowntimeOffenseDecayWindow(ctx)
+	if window <= 0 {
+		return
+	}
+
+	cutoff := ctx.BlockTime().Add(-window)
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeOffensePrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var offense types.DowntimeOffense
+		k.cdc.MustUnmarshal(iter.Value(), &offense)
+
+		// offenses are ordered by height, so the remaining ones are recent
+		if !offense.Time.Before(cutoff) {
+			break
+		}
+
+		store.Delete(iter.Key())
+	}
+}
+
+// RecentDowntimeOffenses returns the number of recent downtime offenses of a
+// validator at the current block time, taking the decay of the counter since
+// the last offense into account.
+func (k Keeper) RecentDowntimeOffenses(ctx sdk.Context, signInfo types.ValidatorSigningInfo) uint64 {
+	count := signInfo.RecentDowntimeOffenses
+	window := k.DowntimeOffenseDecayWindow(ctx)
+	if count == 0 || window <= 0 {
+		return count
+	}
+
+	elapsed := ctx.BlockTime().Sub(signInfo.LastDowntimeOffenseTime)
+	if elapsed <= 0 {
+		return count
+	}
+
+	decay := uint64(elapsed / window)
+	if decay >= count {
+		return 0
+	}
+
+	return count - decay
+}
+
+// EscalatedSlashFractionDowntime returns the fraction slashed for a downtime offense of
+// a validator with the given number of recent downtime offenses.
+func (k Keeper) EscalatedSlashFractionDowntime(ctx sdk.Context, recentOffenses uint64) sdk.Dec {
+	return escalate(
+		k.SlashFractionDowntime(ctx),
+		k.SlashFractionDowntimeMultiplier(ctx),
+		k.MaxSlashFractionDowntime(ctx),
+		recentOffenses,
+	)
+}
+
+// EscalatedDowntimeJailDuration returns the jail duration for a downtime offense of
+// a validator with the given number of recent downtime offenses.
+func (k Keeper) EscalatedDowntimeJailDuration(ctx sdk.Context, recentOffenses uint64) time.Duration {
+	duration := escalate(
+		sdk.NewDec(int64(k.DowntimeJailDuration(ctx))),
+		k.DowntimeJailDurationMultiplier(ctx),
+		sdk.NewDec(int64(k.MaxDowntimeJailDuration(ctx))),
+		recentOffenses,
+	)
+
+	return time.Duration(duration.TruncateInt64())
+}
+
+// escalate multiplies base by multiplier once per recent offense. The result
+// is capped at max, but never falls below base.
+func escalate(base, multiplier, max sdk.Dec, recentOffenses uint64) sdk.Dec {
+	value := base
+	for i := uint64(0); i < recentOffenses && value.IsPositive() && value.LT(max) && multiplier.GT(sdk.OneDec()); i++ {
+		value = value.Mul(multiplier)
+	}
+
+	if value.GT(max) {
+		value = sdk.MaxDec(max, base)
+	}
+
+	return value
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestEscalatedDowntimePenalties(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	testCases := []struct {
+		recentOffenses   uint64
+		expSlashFraction sdk.Dec
+		expJailDuration  time.Duration
+	}{
+		{0, sdk.NewDecWithPrec(1, 2), 10 * time.Minute},
+		{1, sdk.NewDecWithPrec(2, 2), 20 * time.Minute},
+		{2, sdk.NewDecWithPrec(4, 2), 40 * time.Minute},
+		{3, params.MaxSlashFractionDowntime, 80 * time.Minute},
+		{100, params.MaxSlashFractionDowntime, params.MaxDowntimeJailDuration},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expSlashFraction, app.SlashingKeeper.EscalatedSlashFractionDowntime(ctx, tc.recentOffenses))
+		require.Equal(t, tc.expJailDuration, app.SlashingKeeper.EscalatedDowntimeJailDuration(ctx, tc.recentOffenses))
+	}
+
+	// a cap below the base value never reduces the penalties
+	params.MaxSlashFractionDowntime = sdk.NewDecWithPrec(5, 3)
+	app.SlashingKeeper.SetParams(ctx, params)
+	require.Equal(t, params.SlashFractionDowntime, app.SlashingKeeper.EscalatedSlashFractionDowntime(ctx, 2))
+}
+
+func TestRecentDowntimeOffensesDecay(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	app.SlashingKeeper.SetParams(ctx, params)
+	window := params.DowntimeOffenseDecayWindow
+
+	lastOffense := time.Unix(1000, 0).UTC()
+	signInfo := types.ValidatorSigningInfo{RecentDowntimeOffenses: 3, LastDowntimeOffenseTime: lastOffense}
+
+	ctx = ctx.WithBlockTime(lastOffense.Add(window - time.Second))
+	require.Equal(t, uint64(3), app.SlashingKeeper.RecentDowntimeOffenses(ctx, signInfo))
+
+	ctx = ctx.WithBlockTime(lastOffense.Add(2*window + time.Second))
+	require.Equal(t, uint64(1), app.SlashingKeeper.RecentDowntimeOffenses(ctx, signInfo))
+
+	ctx = ctx.WithBlockTime(lastOffense.Add(5 * window))
+	require.Equal(t, uint64(0), app.SlashingKeeper.RecentDowntimeOffenses(ctx, signInfo))
+
+	// a zero decay window disables the decay
+	params.DowntimeOffenseDecayWindow = 0
+	app.SlashingKeeper.SetParams(ctx, params)
+	require.Equal(t, uint64(3), app.SlashingKeeper.RecentDowntimeOffenses(ctx, signInfo))
+}
+
+// Test that the penalties of a validator escalate with repeated downtime and
+// that the offenses are recorded
+func TestHandleRepeatedDowntime(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	maxMissed := window - app.SlashingKeeper.MinSignedPerWindow(ctx)
+
+	// sign the first window, then miss blocks until the validator is jailed
+	height := int64(0)
+	for ; height < window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	missBlocks := func() {
+		for i := int64(0); i <= maxMissed; i++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+			height++
+		}
+	}
+
+	missBlocks()
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(1), info.RecentDowntimeOffenses)
+	require.Equal(t, ctx.BlockTime().Add(app.SlashingKeeper.DowntimeJailDuration(ctx)), info.JailedUntil)
+
+	// unjail the validator and let it go down again
+	app.StakingKeeper.Unjail(ctx, consAddr)
+	missBlocks()
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(2), info.RecentDowntimeOffenses)
+	require.Equal(t, ctx.BlockTime().Add(2*app.SlashingKeeper.DowntimeJailDuration(ctx)), info.JailedUntil)
+
+	offenses := app.SlashingKeeper.GetValidatorDowntimeOffenses(ctx, consAddr)
+	require.Len(t, offenses, 2)
+	require.Equal(t, uint64(1), offenses[0].OffenseCount)
+	require.Equal(t, app.SlashingKeeper.SlashFractionDowntime(ctx), offenses[0].SlashFraction)
+	require.Equal(t, uint64(2), offenses[1].OffenseCount)
+	require.Equal(t, app.SlashingKeeper.SlashFractionDowntime(ctx).MulInt64(2), offenses[1].SlashFraction)
+	require.Equal(t, 2*app.SlashingKeeper.DowntimeJailDuration(ctx), offenses[1].JailDuration)
+	require.True(t, offenses[0].Height < offenses[1].Height)
+
+	// once the counter decayed, the old offenses are pruned with the next one
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3 * app.SlashingKeeper.DowntimeOffenseDecayWindow(ctx)))
+	app.StakingKeeper.Unjail(ctx, consAddr)
+	missBlocks()
+
+	offenses = app.SlashingKeeper.GetValidatorDowntimeOffenses(ctx, consAddr)
+	require.Len(t, offenses, 1)
+	require.Equal(t, uint64(1), offenses[0].OffenseCount)
+	require.Equal(t, ctx.BlockTime(), offenses[0].Time)
+}
@@ -105,6 +105,37 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCDowntimeOffenses() {
+	queryClient := suite.queryClient
+
+	consAddr1 := sdk.ConsAddress(suite.addrDels[0])
+	consAddr2 := sdk.ConsAddress(suite.addrDels[1])
+	offenses := []types.DowntimeOffense{
+		types.NewDowntimeOffense(consAddr1, 10, time.Unix(10, 0).UTC(), 1, sdk.NewDecWithPrec(1, 2), time.Hour),
+		types.NewDowntimeOffense(consAddr1, 20, time.Unix(20, 0).UTC(), 2, sdk.NewDecWithPrec(2, 2), 2*time.Hour),
+		types.NewDowntimeOffense(consAddr2, 30, time.Unix(30, 0).UTC(), 1, sdk.NewDecWithPrec(1, 2), time.Hour),
+	}
+	suite.app.SlashingKeeper.SetDowntimeOffense(suite.ctx, consAddr1, offenses[0])
+	suite.app.SlashingKeeper.SetDowntimeOffense(suite.ctx, consAddr1, offenses[1])
+	suite.app.SlashingKeeper.SetDowntimeOffense(suite.ctx, consAddr2, offenses[2])
+
+	_, err := queryClient.ValidatorDowntimeOffenses(gocontext.Background(),
+		&types.QueryValidatorDowntimeOffensesRequest{ConsAddress: ""})
+	suite.Error(err)
+
+	valResp, err := queryClient.ValidatorDowntimeOffenses(gocontext.Background(),
+		&types.QueryValidatorDowntimeOffensesRequest{ConsAddress: consAddr1.String()})
+	suite.NoError(err)
+	suite.Equal(offenses[:2], valResp.Offenses)
+
+	allResp, err := queryClient.DowntimeOffenses(gocontext.Background(),
+		&types.QueryDowntimeOffensesRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.NoError(err)
+	suite.Len(allResp.Offenses, 2)
+	suite.NotNil(allResp.Pagination.NextKey)
+	suite.Equal(uint64(3), allResp.Pagination.Total)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -44,6 +45,51 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	return nil
 }
 
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new
+// consensus key of a validator and moves its signing info, missed blocks and
+// downtime offenses to the new consensus address. The relation for the old key
+// is kept so that evidence and signatures of the old key can still be handled.
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldAddress := sdk.ConsAddress(oldPubKey.Address())
+	newAddress := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldAddress)
+	if !found {
+		// the validator has never been bonded
+		return nil
+	}
+
+	signingInfo.Address = newAddress.String()
+	k.SetValidatorSigningInfo(ctx, newAddress, signingInfo)
+	k.deleteValidatorSigningInfo(ctx, oldAddress)
+
+	var missedIndexes []int64
+	k.IterateValidatorMissedBlockBitArray(ctx, oldAddress, func(index int64, missed bool) (stop bool) {
+		if missed {
+			missedIndexes = append(missedIndexes, index)
+		}
+		return false
+	})
+
+	k.clearValidatorMissedBlockBitArray(ctx, oldAddress)
+	for _, index := range missedIndexes {
+		k.SetValidatorMissedBlockBitArray(ctx, newAddress, index, true)
+	}
+
+	offenses := k.GetValidatorDowntimeOffenses(ctx, oldAddress)
+	k.deleteValidatorDowntimeOffenses(ctx, oldAddress)
+	for _, offense := range offenses {
+		offense.Address = newAddress.String()
+		k.SetDowntimeOffense(ctx, newAddress, offense)
+	}
+
+	return nil
+}
+
 // Hooks wrapper struct for slashing keeper
 type Hooks struct {
 	k Keeper
@@ -71,6 +117,11 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) er
 	return h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	return h.k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
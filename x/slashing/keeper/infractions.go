@@ -21,6 +21,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		// the validator may have rotated its consensus key, in which case the old
+		// key keeps signing until the validator set update takes effect while the
+		// signing info is tracked under the new consensus address
+		consAddr, signInfo, found = k.getRotatedValidatorSigningInfo(ctx, consAddr)
+	}
 	if !found {
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
@@ -84,7 +90,18 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			// The slash fraction and the jail duration escalate with the number of
+			// recent downtime offenses of the validator.
+			recentOffenses := k.RecentDowntimeOffenses(ctx, signInfo)
+			if recentOffenses < signInfo.RecentDowntimeOffenses {
+				// the counter decayed, drop the offenses which no longer count
+				k.pruneDowntimeOffenses(ctx, consAddr)
+			}
+
+			slashFraction := k.EscalatedSlashFractionDowntime(ctx, recentOffenses)
+			jailDuration := k.EscalatedDowntimeJailDuration(ctx, recentOffenses)
+
+			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -93,11 +110,18 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+					sdk.NewAttribute(types.AttributeKeyOffenseCount, fmt.Sprintf("%d", recentOffenses+1)),
 				),
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			signInfo.RecentDowntimeOffenses = recentOffenses + 1
+			signInfo.LastDowntimeOffenseTime = ctx.BlockHeader().Time
+
+			k.SetDowntimeOffense(ctx, consAddr, types.NewDowntimeOffense(
+				consAddr, height, ctx.BlockHeader().Time, signInfo.RecentDowntimeOffenses, slashFraction, jailDuration,
+			))
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -110,8 +134,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"recent_offenses", signInfo.RecentDowntimeOffenses,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
@@ -125,3 +150,21 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// getRotatedValidatorSigningInfo returns the current consensus address and
+// signing info of the validator which previously used the given consensus
+// address.
+func (k Keeper) getRotatedValidatorSigningInfo(ctx sdk.Context, oldAddr sdk.ConsAddress) (sdk.ConsAddress, types.ValidatorSigningInfo, bool) {
+	validator := k.sk.ValidatorByConsAddr(ctx, oldAddr)
+	if validator == nil {
+		return oldAddr, types.ValidatorSigningInfo{}, false
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil || consAddr.Equals(oldAddr) {
+		return oldAddr, types.ValidatorSigningInfo{}, false
+	}
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	return consAddr, signInfo, found
+}
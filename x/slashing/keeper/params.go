@@ -42,6 +42,36 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeOffenseDecayWindow - duration after which a recent downtime offense is forgotten
+func (k Keeper) DowntimeOffenseDecayWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeOffenseDecayWindow, &res)
+	return
+}
+
+// DowntimeJailDurationMultiplier - factor applied to the downtime jail duration per recent offense
+func (k Keeper) DowntimeJailDurationMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDurationMultiplier, &res)
+	return
+}
+
+// MaxDowntimeJailDuration - cap of the escalated downtime jail duration
+func (k Keeper) MaxDowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMaxDowntimeJailDuration, &res)
+	return
+}
+
+// SlashFractionDowntimeMultiplier - factor applied to the downtime slash fraction per recent offense
+func (k Keeper) SlashFractionDowntimeMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntimeMultiplier, &res)
+	return
+}
+
+// MaxSlashFractionDowntime - cap of the escalated downtime slash fraction
+func (k Keeper) MaxSlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyMaxSlashFractionDowntime, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
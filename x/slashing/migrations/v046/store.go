@@ -0,0 +1,28 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3.
+// The migration includes:
+//
+// - Setting the downtime offense escalation params in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore types.ParamSubspace) error {
+	migrateParamsStore(ctx, paramstore)
+
+	return nil
+}
+
+func migrateParamsStore(ctx sdk.Context, paramstore types.ParamSubspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyDowntimeOffenseDecayWindow, types.DefaultDowntimeOffenseDecayWindow)
+	paramstore.Set(ctx, types.KeyDowntimeJailDurationMultiplier, types.DefaultDowntimeJailDurationMultiplier)
+	paramstore.Set(ctx, types.KeyMaxDowntimeJailDuration, types.DefaultMaxDowntimeJailDuration)
+	paramstore.Set(ctx, types.KeySlashFractionDowntimeMultiplier, types.DefaultSlashFractionDowntimeMultiplier)
+	paramstore.Set(ctx, types.KeyMaxSlashFractionDowntime, types.DefaultMaxSlashFractionDowntime)
+}
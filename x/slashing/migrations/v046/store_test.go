@@ -0,0 +1,44 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v046slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey("slashing")
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, "slashing")
+
+	newKeys := [][]byte{
+		types.KeyDowntimeOffenseDecayWindow,
+		types.KeyDowntimeJailDurationMultiplier,
+		types.KeyMaxDowntimeJailDuration,
+		types.KeySlashFractionDowntimeMultiplier,
+		types.KeyMaxSlashFractionDowntime,
+	}
+
+	// Check no params
+	for _, key := range newKeys {
+		require.False(t, paramstore.Has(ctx, key))
+	}
+
+	// Run migrations.
+	err := v046slashing.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	for _, key := range newKeys {
+		require.True(t, paramstore.Has(ctx, key))
+	}
+}
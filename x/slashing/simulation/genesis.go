@@ -21,6 +21,12 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeOffenseDecayWindow      = "downtime_offense_decay_window"
+	DowntimeJailDurationMultiplier  = "downtime_jail_duration_multiplier"
+	MaxDowntimeJailDuration         = "max_downtime_jail_duration"
+	SlashFractionDowntimeMultiplier = "slash_fraction_downtime_multiplier"
+	MaxSlashFractionDowntime        = "max_slash_fraction_downtime"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +54,31 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeOffenseDecayWindow randomized DowntimeOffenseDecayWindow
+func GenDowntimeOffenseDecayWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*30)) * time.Second
+}
+
+// GenDowntimeJailDurationMultiplier randomized DowntimeJailDurationMultiplier
+func GenDowntimeJailDurationMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(31)), 1))
+}
+
+// GenMaxDowntimeJailDuration randomized MaxDowntimeJailDuration
+func GenMaxDowntimeJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60*24, 60*60*24*14)) * time.Second
+}
+
+// GenSlashFractionDowntimeMultiplier randomized SlashFractionDowntimeMultiplier
+func GenSlashFractionDowntimeMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(31)), 1))
+}
+
+// GenMaxSlashFractionDowntime randomized MaxSlashFractionDowntime
+func GenMaxSlashFractionDowntime(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(20) + 1)))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +111,44 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeOffenseDecayWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeOffenseDecayWindow, &downtimeOffenseDecayWindow, simState.Rand,
+		func(r *rand.Rand) { downtimeOffenseDecayWindow = GenDowntimeOffenseDecayWindow(r) },
+	)
+
+	var downtimeJailDurationMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDurationMultiplier, &downtimeJailDurationMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDurationMultiplier = GenDowntimeJailDurationMultiplier(r) },
+	)
+
+	var maxDowntimeJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeJailDuration, &maxDowntimeJailDuration, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeJailDuration = GenMaxDowntimeJailDuration(r) },
+	)
+
+	var slashFractionDowntimeMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionDowntimeMultiplier, &slashFractionDowntimeMultiplier, simState.Rand,
+		func(r *rand.Rand) { slashFractionDowntimeMultiplier = GenSlashFractionDowntimeMultiplier(r) },
+	)
+
+	var maxSlashFractionDowntime sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSlashFractionDowntime, &maxSlashFractionDowntime, simState.Rand,
+		func(r *rand.Rand) { maxSlashFractionDowntime = GenMaxSlashFractionDowntime(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeOffenseDecayWindow,
+		downtimeJailDurationMultiplier, maxDowntimeJailDuration,
+		slashFractionDowntimeMultiplier, maxSlashFractionDowntime,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.DowntimeOffense{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
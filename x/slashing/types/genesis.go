@@ -10,12 +10,14 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	downtimeOffenses []DowntimeOffense,
 ) *GenesisState {
 
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:           params,
+		SigningInfos:     signingInfos,
+		MissedBlocks:     missedBlocks,
+		DowntimeOffenses: downtimeOffenses,
 	}
 }
 
@@ -30,9 +32,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:           DefaultParams(),
+		SigningInfos:     []SigningInfo{},
+		MissedBlocks:     []ValidatorMissedBlocks{},
+		DowntimeOffenses: []DowntimeOffense{},
 	}
 }
 
@@ -63,5 +66,31 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateDowntimeOffenseDecayWindow(data.Params.DowntimeOffenseDecayWindow); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailDurationMultiplier(data.Params.DowntimeJailDurationMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateMaxDowntimeJailDuration(data.Params.MaxDowntimeJailDuration); err != nil {
+		return err
+	}
+
+	if err := validateSlashFractionDowntimeMultiplier(data.Params.SlashFractionDowntimeMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateMaxSlashFractionDowntime(data.Params.MaxSlashFractionDowntime); err != nil {
+		return err
+	}
+
+	for _, offense := range data.DowntimeOffenses {
+		if _, err := sdk.ConsAddressFromBech32(offense.Address); err != nil {
+			return fmt.Errorf("invalid downtime offense address %s: %w", offense.Address, err)
+		}
+	}
+
 	return nil
 }
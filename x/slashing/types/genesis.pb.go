@@ -34,6 +34,9 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// downtime_offenses represents the history of downtime offenses of all
+	// validators.
+	DowntimeOffenses []DowntimeOffense `protobuf:"bytes,4,rep,name=downtime_offenses,json=downtimeOffenses,proto3" json:"downtime_offenses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +93,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetDowntimeOffenses() []DowntimeOffense {
+	if m != nil {
+		return m.DowntimeOffenses
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -268,34 +278,36 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0xe3, 0x2e, 0x2c, 0xe0, 0xb4, 0x17, 0x2b, 0x94, 0xd0, 0x43, 0x5a, 0xad, 0x00, 0xf5,
-	0x92, 0x44, 0x5d, 0x8e, 0x88, 0x03, 0xb9, 0x54, 0x1c, 0x50, 0x51, 0x56, 0x42, 0x82, 0x4b, 0xe4,
-	0x6c, 0x5c, 0xd7, 0xea, 0xc6, 0x5e, 0x65, 0xcc, 0xaa, 0xbc, 0x05, 0x0f, 0xc0, 0x23, 0x70, 0xe4,
-	0x21, 0x7a, 0xac, 0x38, 0x71, 0x42, 0x68, 0xf7, 0x29, 0xb8, 0x21, 0x6c, 0x87, 0x8d, 0x60, 0xa3,
-	0x95, 0x38, 0x25, 0x63, 0x7f, 0xf3, 0xcf, 0xcc, 0xef, 0xc1, 0x8f, 0xa7, 0x0a, 0x6a, 0x05, 0x29,
-	0xcc, 0x28, 0x5c, 0x08, 0xc9, 0xd3, 0xc5, 0x49, 0xc9, 0x34, 0x3d, 0x49, 0x39, 0x93, 0x0c, 0x04,
-	0x24, 0xf3, 0x46, 0x69, 0x45, 0x1e, 0x58, 0x2c, 0x69, 0xb1, 0xc4, 0x61, 0x07, 0x01, 0x57, 0x5c,
-	0x19, 0x26, 0xfd, 0xfd, 0x67, 0xf1, 0x83, 0x27, 0x7d, 0xaa, 0x7f, 0xf2, 0x2d, 0xf7, 0xd0, 0x72,
-	0x85, 0x15, 0x70, 0x35, 0x4c, 0x30, 0xfa, 0x89, 0xf0, 0xee, 0xa9, 0xed, 0x61, 0xa2, 0xa9, 0x66,
-	0xe4, 0x39, 0x1e, 0xce, 0x69, 0x43, 0x6b, 0x08, 0xd1, 0x11, 0x3a, 0xf6, 0xc7, 0x87, 0x49, 0x4f,
-	0x4f, 0xc9, 0x6b, 0x83, 0x65, 0xb7, 0xae, 0xbf, 0x1f, 0x7a, 0xb9, 0x4b, 0x22, 0x67, 0x78, 0x0f,
-	0x04, 0x97, 0x42, 0xf2, 0x42, 0xc8, 0x73, 0x05, 0xe1, 0xce, 0xd1, 0xe0, 0xd8, 0x1f, 0x3f, 0xea,
-	0x55, 0x99, 0x58, 0xfa, 0xa5, 0x3c, 0x57, 0x4e, 0x6a, 0x17, 0xd6, 0x47, 0x40, 0xde, 0xe2, 0xbd,
-	0x5a, 0x00, 0xb0, 0xaa, 0x28, 0x67, 0x6a, 0x7a, 0x09, 0xe1, 0xc0, 0x08, 0x26, 0xbd, 0x82, 0x6f,
-	0xe8, 0x4c, 0x54, 0x54, 0xab, 0xe6, 0x95, 0x49, 0xcb, 0x4c, 0x56, 0x2b, 0x5d, 0x77, 0xce, 0x46,
-	0x9f, 0x11, 0xf6, 0x3b, 0xe5, 0xc9, 0x18, 0xdf, 0xa1, 0x55, 0xd5, 0x30, 0xb0, 0xb3, 0xdf, 0xcb,
-	0xc2, 0xaf, 0x5f, 0xe2, 0xc0, 0xd5, 0x79, 0x61, 0x6f, 0x26, 0xba, 0x11, 0x92, 0xe7, 0x2d, 0x48,
-	0x04, 0xde, 0x5f, 0xb4, 0x05, 0x8b, 0xee, 0xe4, 0xe1, 0x8e, 0xb1, 0x2f, 0xde, 0xde, 0xe7, 0xbf,
-	0x0e, 0x04, 0x8b, 0x0d, 0x77, 0xa3, 0x4f, 0x08, 0xdf, 0xdf, 0x38, 0xdc, 0x7f, 0x35, 0x7e, 0xf6,
-	0xb7, 0xaf, 0xdb, 0x1e, 0xaa, 0x53, 0x71, 0xa3, 0x9b, 0xcf, 0xb0, 0xdf, 0x41, 0x48, 0x80, 0x6f,
-	0x0b, 0x59, 0xb1, 0x2b, 0xd3, 0xd1, 0x20, 0xb7, 0x01, 0xd9, 0xc7, 0x43, 0x9b, 0x64, 0xec, 0xb9,
-	0x9b, 0xbb, 0x28, 0x3b, 0xbd, 0x5e, 0x46, 0xe8, 0x66, 0x19, 0xa1, 0x1f, 0xcb, 0x08, 0x7d, 0x5c,
-	0x45, 0xde, 0xcd, 0x2a, 0xf2, 0xbe, 0xad, 0x22, 0xef, 0x5d, 0xcc, 0x85, 0xbe, 0x78, 0x5f, 0x26,
-	0x53, 0x55, 0xbb, 0xcd, 0x75, 0x9f, 0x18, 0xaa, 0xcb, 0xf4, 0x6a, 0xbd, 0xfb, 0xfa, 0xc3, 0x9c,
-	0x41, 0x39, 0x34, 0x6b, 0xfd, 0xf4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xc1, 0x45, 0xfe,
-	0x71, 0x03, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0x51, 0xc0, 0xdd, 0x24, 0xb0, 0xca, 0x08, 0x3b, 0x64, 0x53, 0x05, 0xa8, 0x97,
+	0x26, 0x5a, 0x39, 0x22, 0x0e, 0x44, 0x48, 0x13, 0x07, 0x54, 0x94, 0x4a, 0x48, 0xc0, 0x21, 0x72,
+	0x6a, 0xc7, 0xb3, 0xd6, 0xd8, 0x55, 0x9e, 0x29, 0xe3, 0x5f, 0xf0, 0x03, 0xf8, 0x09, 0x1c, 0xf9,
+	0x11, 0x3b, 0x4e, 0x1c, 0x10, 0x27, 0x84, 0xda, 0x3f, 0x82, 0xb0, 0x1d, 0x16, 0xa0, 0xd1, 0xa4,
+	0x9d, 0x92, 0xf7, 0xfc, 0x7d, 0xdf, 0x7b, 0xfe, 0x9e, 0x1f, 0x7a, 0x30, 0x53, 0x50, 0x28, 0x88,
+	0x60, 0x4e, 0xe0, 0x58, 0x48, 0x1e, 0x2d, 0x0f, 0x33, 0xa6, 0xc9, 0x61, 0xc4, 0x99, 0x64, 0x20,
+	0x20, 0x5c, 0x94, 0x4a, 0x2b, 0x7c, 0xd7, 0xc2, 0xc2, 0x0a, 0x16, 0x3a, 0xd8, 0x5e, 0x9f, 0x2b,
+	0xae, 0x0c, 0x26, 0xfa, 0xfd, 0x67, 0xe1, 0x7b, 0x0f, 0x9b, 0x54, 0xff, 0xf0, 0x2d, 0xee, 0x9e,
+	0xc5, 0xa5, 0x56, 0xc0, 0xd5, 0x30, 0xc1, 0xe0, 0x5b, 0x1b, 0x6d, 0x1f, 0xd9, 0x1e, 0xa6, 0x9a,
+	0x68, 0x86, 0x9f, 0xa0, 0xee, 0x82, 0x94, 0xa4, 0x00, 0xdf, 0x3b, 0xf0, 0x86, 0xbd, 0xf1, 0x7e,
+	0xd8, 0xd0, 0x53, 0xf8, 0xd2, 0xc0, 0xe2, 0xad, 0xb3, 0x1f, 0xfb, 0xad, 0xc4, 0x91, 0xf0, 0x04,
+	0xed, 0x80, 0xe0, 0x52, 0x48, 0x9e, 0x0a, 0x99, 0x2b, 0xf0, 0xdb, 0x07, 0x9d, 0x61, 0x6f, 0x7c,
+	0xbf, 0x51, 0x65, 0x6a, 0xd1, 0xcf, 0x65, 0xae, 0x9c, 0xd4, 0x36, 0x5c, 0xa4, 0x00, 0xbf, 0x46,
+	0x3b, 0x85, 0x00, 0x60, 0x34, 0xcd, 0xe6, 0x6a, 0x76, 0x02, 0x7e, 0xc7, 0x08, 0x86, 0x8d, 0x82,
+	0xaf, 0xc8, 0x5c, 0x50, 0xa2, 0x55, 0xf9, 0xc2, 0xd0, 0x62, 0xc3, 0xaa, 0xa4, 0x8b, 0x5a, 0x0e,
+	0xbf, 0x45, 0xb7, 0xa9, 0x7a, 0x2f, 0xb5, 0x28, 0x58, 0xaa, 0xf2, 0x9c, 0x49, 0x60, 0xe0, 0x6f,
+	0x19, 0xf9, 0x61, 0xa3, 0xfc, 0x33, 0xc7, 0x98, 0x58, 0x82, 0x13, 0xbe, 0x45, 0xff, 0x4e, 0xc3,
+	0xe0, 0xb3, 0x87, 0x7a, 0xb5, 0xbb, 0xe1, 0x31, 0xba, 0x4e, 0x28, 0x2d, 0x19, 0x58, 0x63, 0x6f,
+	0xc6, 0xfe, 0xd7, 0x2f, 0xa3, 0xbe, 0xab, 0xf2, 0xd4, 0x9e, 0x4c, 0x75, 0x29, 0x24, 0x4f, 0x2a,
+	0x20, 0x16, 0x68, 0x77, 0x59, 0xdd, 0x26, 0xad, 0xdb, 0xea, 0xb7, 0xcd, 0x6c, 0x46, 0x97, 0x9b,
+	0xf0, 0xbf, 0xbd, 0xfd, 0xe5, 0x86, 0xb3, 0xc1, 0x27, 0x0f, 0xdd, 0xd9, 0xe8, 0xdc, 0x95, 0x1a,
+	0x9f, 0xfc, 0x3b, 0xb4, 0xcb, 0x5e, 0x41, 0xad, 0xe2, 0xa6, 0x51, 0x0d, 0x1e, 0xa3, 0x5e, 0x0d,
+	0x82, 0xfb, 0xe8, 0x9a, 0x90, 0x94, 0x9d, 0x9a, 0x8e, 0x3a, 0x89, 0x0d, 0xf0, 0x2e, 0xea, 0x5a,
+	0x92, 0xb1, 0xe7, 0x46, 0xe2, 0xa2, 0xf8, 0xe8, 0x6c, 0x15, 0x78, 0xe7, 0xab, 0xc0, 0xfb, 0xb9,
+	0x0a, 0xbc, 0x8f, 0xeb, 0xa0, 0x75, 0xbe, 0x0e, 0x5a, 0xdf, 0xd7, 0x41, 0xeb, 0xcd, 0x88, 0x0b,
+	0x7d, 0xfc, 0x2e, 0x0b, 0x67, 0xaa, 0x70, 0x6b, 0xe1, 0x3e, 0x23, 0xa0, 0x27, 0xd1, 0xe9, 0xc5,
+	0x62, 0xe9, 0x0f, 0x0b, 0x06, 0x59, 0xd7, 0xec, 0xcc, 0xa3, 0x5f, 0x03, 0x00, 0xa4, 0x7f, 0x4b,
+	0x90, 0xce, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -318,6 +330,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeOffenses) > 0 {
+		for iNdEx := len(m.DowntimeOffenses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeOffenses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -512,6 +538,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DowntimeOffenses) > 0 {
+		for _, e := range m.DowntimeOffenses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -700,6 +732,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeOffenses = append(m.DowntimeOffenses, DowntimeOffense{})
+			if err := m.DowntimeOffenses[len(m.DowntimeOffenses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -30,10 +30,13 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><height_Bytes>: DowntimeOffense
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	DowntimeOffenseKeyPrefix              = []byte{0x04} // Prefix for downtime offense history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -67,3 +70,13 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// DowntimeOffensePrefixKey - stored by *Consensus* address (not operator address)
+func DowntimeOffensePrefixKey(v sdk.ConsAddress) []byte {
+	return append(DowntimeOffenseKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// DowntimeOffenseKey - stored by *Consensus* address (not operator address) and height
+func DowntimeOffenseKey(v sdk.ConsAddress, height int64) []byte {
+	return append(DowntimeOffensePrefixKey(v), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -12,12 +12,19 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultDowntimeOffenseDecayWindow = 30 * 24 * time.Hour
+	DefaultMaxDowntimeJailDuration    = 7 * 24 * time.Hour
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	DefaultDowntimeJailDurationMultiplier  = sdk.NewDec(2)
+	DefaultSlashFractionDowntimeMultiplier = sdk.NewDec(2)
+	DefaultMaxSlashFractionDowntime        = sdk.NewDec(1).Quo(sdk.NewDec(20))
 )
 
 // Parameter store keys
@@ -27,6 +34,12 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyDowntimeOffenseDecayWindow      = []byte("DowntimeOffenseDecayWindow")
+	KeyDowntimeJailDurationMultiplier  = []byte("DowntimeJailDurationMultiplier")
+	KeyMaxDowntimeJailDuration         = []byte("MaxDowntimeJailDuration")
+	KeySlashFractionDowntimeMultiplier = []byte("SlashFractionDowntimeMultiplier")
+	KeyMaxSlashFractionDowntime        = []byte("MaxSlashFractionDowntime")
 )
 
 // ParamKeyTable for slashing module
@@ -37,15 +50,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, downtimeOffenseDecayWindow time.Duration,
+	downtimeJailDurationMultiplier sdk.Dec, maxDowntimeJailDuration time.Duration,
+	slashFractionDowntimeMultiplier, maxSlashFractionDowntime sdk.Dec,
 ) Params {
 
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:              signedBlocksWindow,
+		MinSignedPerWindow:              minSignedPerWindow,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashFractionDoubleSign:         slashFractionDoubleSign,
+		SlashFractionDowntime:           slashFractionDowntime,
+		DowntimeOffenseDecayWindow:      downtimeOffenseDecayWindow,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		MaxDowntimeJailDuration:         maxDowntimeJailDuration,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+		MaxSlashFractionDowntime:        maxSlashFractionDowntime,
 	}
 }
 
@@ -57,6 +77,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeOffenseDecayWindow, &p.DowntimeOffenseDecayWindow, validateDowntimeOffenseDecayWindow),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDurationMultiplier, &p.DowntimeJailDurationMultiplier, validateDowntimeJailDurationMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxDowntimeJailDuration, &p.MaxDowntimeJailDuration, validateMaxDowntimeJailDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntimeMultiplier, &p.SlashFractionDowntimeMultiplier, validateSlashFractionDowntimeMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxSlashFractionDowntime, &p.MaxSlashFractionDowntime, validateMaxSlashFractionDowntime),
 	}
 }
 
@@ -64,7 +89,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultDowntimeOffenseDecayWindow,
+		DefaultDowntimeJailDurationMultiplier, DefaultMaxDowntimeJailDuration,
+		DefaultSlashFractionDowntimeMultiplier, DefaultMaxSlashFractionDowntime,
 	)
 }
 
@@ -141,3 +168,71 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeOffenseDecayWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offense decay window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDurationMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail duration multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max downtime jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntimeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime slash fraction multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSlashFractionDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max downtime slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max downtime slash fraction too large: %s", v)
+	}
+
+	return nil
+}
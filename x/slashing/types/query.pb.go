@@ -308,6 +308,219 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryValidatorDowntimeOffensesRequest is the request type for the
+// Query/ValidatorDowntimeOffenses RPC method
+type QueryValidatorDowntimeOffensesRequest struct {
+	// cons_address is the address to query the downtime offenses of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorDowntimeOffensesRequest) Reset()         { *m = QueryValidatorDowntimeOffensesRequest{} }
+func (m *QueryValidatorDowntimeOffensesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDowntimeOffensesRequest) ProtoMessage()    {}
+func (*QueryValidatorDowntimeOffensesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryValidatorDowntimeOffensesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDowntimeOffensesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDowntimeOffensesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDowntimeOffensesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDowntimeOffensesRequest.Merge(m, src)
+}
+func (m *QueryValidatorDowntimeOffensesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDowntimeOffensesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDowntimeOffensesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDowntimeOffensesRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorDowntimeOffensesRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryValidatorDowntimeOffensesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorDowntimeOffensesResponse is the response type for the
+// Query/ValidatorDowntimeOffenses RPC method
+type QueryValidatorDowntimeOffensesResponse struct {
+	// offenses is the downtime offense history of the requested val cons address
+	Offenses   []DowntimeOffense   `protobuf:"bytes,1,rep,name=offenses,proto3" json:"offenses"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorDowntimeOffensesResponse) Reset() {
+	*m = QueryValidatorDowntimeOffensesResponse{}
+}
+func (m *QueryValidatorDowntimeOffensesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDowntimeOffensesResponse) ProtoMessage()    {}
+func (*QueryValidatorDowntimeOffensesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryValidatorDowntimeOffensesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDowntimeOffensesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDowntimeOffensesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDowntimeOffensesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDowntimeOffensesResponse.Merge(m, src)
+}
+func (m *QueryValidatorDowntimeOffensesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDowntimeOffensesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDowntimeOffensesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDowntimeOffensesResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorDowntimeOffensesResponse) GetOffenses() []DowntimeOffense {
+	if m != nil {
+		return m.Offenses
+	}
+	return nil
+}
+
+func (m *QueryValidatorDowntimeOffensesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDowntimeOffensesRequest is the request type for the
+// Query/DowntimeOffenses RPC method
+type QueryDowntimeOffensesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeOffensesRequest) Reset()         { *m = QueryDowntimeOffensesRequest{} }
+func (m *QueryDowntimeOffensesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffensesRequest) ProtoMessage()    {}
+func (*QueryDowntimeOffensesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{8}
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffensesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffensesRequest.Merge(m, src)
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffensesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffensesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffensesRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffensesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDowntimeOffensesResponse is the response type for the
+// Query/DowntimeOffenses RPC method
+type QueryDowntimeOffensesResponse struct {
+	// offenses is the downtime offense history of all validators
+	Offenses   []DowntimeOffense   `protobuf:"bytes,1,rep,name=offenses,proto3" json:"offenses"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeOffensesResponse) Reset()         { *m = QueryDowntimeOffensesResponse{} }
+func (m *QueryDowntimeOffensesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffensesResponse) ProtoMessage()    {}
+func (*QueryDowntimeOffensesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{9}
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffensesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffensesResponse.Merge(m, src)
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffensesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffensesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffensesResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffensesResponse) GetOffenses() []DowntimeOffense {
+	if m != nil {
+		return m.Offenses
+	}
+	return nil
+}
+
+func (m *QueryDowntimeOffensesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -315,6 +528,10 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryValidatorDowntimeOffensesRequest)(nil), "cosmos.slashing.v1beta1.QueryValidatorDowntimeOffensesRequest")
+	proto.RegisterType((*QueryValidatorDowntimeOffensesResponse)(nil), "cosmos.slashing.v1beta1.QueryValidatorDowntimeOffensesResponse")
+	proto.RegisterType((*QueryDowntimeOffensesRequest)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffensesRequest")
+	proto.RegisterType((*QueryDowntimeOffensesResponse)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffensesResponse")
 }
 
 func init() {
@@ -322,42 +539,51 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0x81, 0xdf, 0xa4, 0x88, 0x8c, 0x81, 0xa6, 0x41, 0x36, 0xba, 0x42,
-	0x5a, 0xd4, 0xec, 0x9a, 0x88, 0x78, 0x90, 0x1e, 0xcc, 0xc1, 0xe0, 0x4d, 0x53, 0xe9, 0x41, 0x90,
-	0x30, 0x9b, 0x4c, 0xa7, 0x83, 0x9b, 0x99, 0xed, 0xce, 0x24, 0x18, 0xc4, 0x8b, 0x67, 0x0f, 0x82,
-	0x7f, 0x83, 0x47, 0x0f, 0x82, 0x7f, 0x44, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xff, 0x02, 0xff,
-	0x02, 0xc9, 0xcc, 0x4b, 0xb2, 0x25, 0xae, 0xa6, 0x9e, 0x32, 0x79, 0xf3, 0xbe, 0xdf, 0xf7, 0x79,
-	0x33, 0x6f, 0x16, 0x5d, 0xeb, 0x4a, 0xd5, 0x97, 0x2a, 0x50, 0x11, 0x51, 0x47, 0x5c, 0xb0, 0x60,
-	0x58, 0x0f, 0xa9, 0x26, 0xf5, 0xe0, 0x78, 0x40, 0x93, 0x91, 0x1f, 0x27, 0x52, 0x4b, 0xbc, 0x65,
-	0x93, 0xfc, 0x59, 0x92, 0x0f, 0x49, 0xe5, 0xeb, 0xa0, 0x0e, 0x89, 0xa2, 0x56, 0x31, 0xd7, 0xc7,
-	0x84, 0x71, 0x41, 0x34, 0x97, 0xc2, 0x9a, 0x94, 0x8b, 0x4c, 0x32, 0x69, 0x96, 0xc1, 0x74, 0x05,
-	0xd1, 0xcb, 0x4c, 0x4a, 0x16, 0xd1, 0x80, 0xc4, 0x3c, 0x20, 0x42, 0x48, 0x6d, 0x24, 0x0a, 0x76,
-	0xab, 0x59, 0x74, 0x73, 0x12, 0x9b, 0xb7, 0x6d, 0xf3, 0x3a, 0xd6, 0x1e, 0x68, 0xcd, 0x1f, 0xaf,
-	0x88, 0xf0, 0xe3, 0x29, 0xd8, 0x23, 0x92, 0x90, 0xbe, 0x6a, 0xd3, 0xe3, 0x01, 0x55, 0xda, 0x7b,
-	0x82, 0x2e, 0x9d, 0x89, 0xaa, 0x58, 0x0a, 0x45, 0xf1, 0x1e, 0xca, 0xc7, 0x26, 0x52, 0x72, 0xae,
-	0x38, 0xbb, 0x85, 0x46, 0xc5, 0xcf, 0xe8, 0xdc, 0xb7, 0xc2, 0xe6, 0xc6, 0xc9, 0xb7, 0x4a, 0xae,
-	0x0d, 0x22, 0xef, 0x00, 0x6d, 0x19, 0xd7, 0x7d, 0xce, 0x04, 0x17, 0xec, 0xa1, 0x38, 0x94, 0x50,
-	0x10, 0xdf, 0x43, 0x9b, 0x5d, 0x29, 0x54, 0x87, 0xf4, 0x7a, 0x09, 0x55, 0xd6, 0xff, 0xff, 0x66,
-	0xe9, 0xf3, 0xa7, 0x5a, 0x11, 0x4a, 0xdc, 0xb7, 0x3b, 0xfb, 0x3a, 0xe1, 0x82, 0xb5, 0x0b, 0xd3,
-	0x6c, 0x08, 0x79, 0x23, 0x54, 0x5a, 0xf6, 0x05, 0xe4, 0x67, 0xe8, 0xe2, 0x90, 0x44, 0x1d, 0x65,
-	0xb7, 0x3a, 0x5c, 0x1c, 0x4a, 0x80, 0xaf, 0x65, 0xc2, 0x1f, 0x90, 0x88, 0xf7, 0x88, 0x96, 0x49,
-	0xca, 0x10, 0x5a, 0xb9, 0x30, 0x24, 0x51, 0x2a, 0xea, 0x85, 0xcb, 0xa5, 0x67, 0x87, 0x88, 0x1f,
-	0x20, 0xb4, 0xb8, 0x65, 0x28, 0x5a, 0x9d, 0x15, 0x9d, 0x8e, 0x84, 0x6f, 0x87, 0x68, 0x71, 0x66,
-	0x8c, 0x82, 0xb6, 0x9d, 0x52, 0x7a, 0x1f, 0x1c, 0xb4, 0xfd, 0x9b, 0x22, 0xd0, 0x60, 0x0b, 0x6d,
-	0x40, 0x53, 0xeb, 0xff, 0xda, 0x94, 0x31, 0xc0, 0xad, 0x33, 0xb8, 0x6b, 0x06, 0x77, 0xe7, 0xaf,
-	0xb8, 0x96, 0x22, 0xcd, 0xdb, 0xf8, 0xb9, 0x8e, 0xfe, 0x33, 0xbc, 0xf8, 0x8d, 0x83, 0xf2, 0x76,
-	0x12, 0xf0, 0x8d, 0x4c, 0xb0, 0xe5, 0xf1, 0x2b, 0xdf, 0x5c, 0x2d, 0xd9, 0xd6, 0xf6, 0x76, 0x5e,
-	0x7f, 0xf9, 0xf1, 0x6e, 0xed, 0x2a, 0xae, 0x04, 0x59, 0xcf, 0xc1, 0xce, 0x1f, 0xfe, 0xe8, 0xa0,
-	0x42, 0xaa, 0x7b, 0x7c, 0xeb, 0xcf, 0x65, 0x96, 0xc7, 0xb4, 0x5c, 0x3f, 0x87, 0x02, 0xe8, 0xf6,
-	0x0c, 0xdd, 0x5d, 0x7c, 0x27, 0x93, 0x2e, 0x3d, 0x9b, 0x2a, 0x78, 0x99, 0x7e, 0x07, 0xaf, 0xf0,
-	0x7b, 0x07, 0x6d, 0xa6, 0xef, 0x1d, 0xaf, 0x8e, 0x30, 0x3f, 0xce, 0xc6, 0x79, 0x24, 0x80, 0xed,
-	0x1b, 0xec, 0x5d, 0x5c, 0x5d, 0x0d, 0xbb, 0xd9, 0x3a, 0x19, 0xbb, 0xce, 0xe9, 0xd8, 0x75, 0xbe,
-	0x8f, 0x5d, 0xe7, 0xed, 0xc4, 0xcd, 0x9d, 0x4e, 0xdc, 0xdc, 0xd7, 0x89, 0x9b, 0x7b, 0x5a, 0x63,
-	0x5c, 0x1f, 0x0d, 0x42, 0xbf, 0x2b, 0xfb, 0x33, 0x2f, 0xfb, 0x53, 0x53, 0xbd, 0xe7, 0xc1, 0x8b,
-	0x85, 0xb1, 0x1e, 0xc5, 0x54, 0x85, 0x79, 0xf3, 0x5d, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0x03, 0x61, 0xef, 0x7a, 0x05, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xbf, 0x4f, 0x14, 0x41,
+	0x14, 0xc7, 0x6f, 0x10, 0x51, 0x07, 0x62, 0xc8, 0x48, 0x02, 0x5c, 0x70, 0xd1, 0x35, 0x1e, 0x04,
+	0xb9, 0x5d, 0x39, 0x83, 0x16, 0x06, 0x8d, 0xc4, 0x70, 0xc1, 0x06, 0x3d, 0x0c, 0x85, 0x89, 0xb9,
+	0xcc, 0x71, 0x73, 0xcb, 0xc6, 0xbb, 0x99, 0x65, 0x67, 0x41, 0x89, 0xb1, 0xb1, 0xb6, 0x30, 0xb1,
+	0xb3, 0xb7, 0xd3, 0xc2, 0x04, 0x4b, 0x7b, 0x4a, 0xa2, 0x8d, 0x95, 0x31, 0x60, 0xeb, 0xff, 0x60,
+	0x6e, 0xe6, 0xdd, 0xb2, 0xb8, 0x0c, 0x3f, 0x2e, 0x57, 0x58, 0xb1, 0xbc, 0x7d, 0xdf, 0xf7, 0x3e,
+	0xdf, 0xb7, 0x33, 0x2f, 0x87, 0xaf, 0x2c, 0x0b, 0xd9, 0x10, 0xd2, 0x95, 0x75, 0x2a, 0x57, 0x7c,
+	0xee, 0xb9, 0xeb, 0x53, 0x15, 0x16, 0xd1, 0x29, 0x77, 0x75, 0x8d, 0x85, 0x1b, 0x4e, 0x10, 0x8a,
+	0x48, 0x90, 0x41, 0x9d, 0xe4, 0xb4, 0x92, 0x1c, 0x48, 0xca, 0x4e, 0x80, 0xba, 0x42, 0x25, 0xd3,
+	0x8a, 0x58, 0x1f, 0x50, 0xcf, 0xe7, 0x34, 0xf2, 0x05, 0xd7, 0x45, 0xb2, 0x03, 0x9e, 0xf0, 0x84,
+	0x7a, 0x74, 0x9b, 0x4f, 0x10, 0x1d, 0xf1, 0x84, 0xf0, 0xea, 0xcc, 0xa5, 0x81, 0xef, 0x52, 0xce,
+	0x45, 0xa4, 0x24, 0x12, 0xde, 0xe6, 0x4c, 0x74, 0x31, 0x89, 0xce, 0x1b, 0xd6, 0x79, 0x65, 0x5d,
+	0x1e, 0x68, 0xd5, 0x3f, 0xf6, 0x00, 0x26, 0x8f, 0x9a, 0x60, 0x0f, 0x69, 0x48, 0x1b, 0xb2, 0xc4,
+	0x56, 0xd7, 0x98, 0x8c, 0xec, 0xc7, 0xf8, 0xc2, 0xbe, 0xa8, 0x0c, 0x04, 0x97, 0x8c, 0xcc, 0xe0,
+	0x9e, 0x40, 0x45, 0x86, 0xd0, 0x25, 0x34, 0xde, 0x5b, 0x18, 0x75, 0x0c, 0xce, 0x1d, 0x2d, 0x9c,
+	0xed, 0xde, 0xfa, 0x39, 0x9a, 0x29, 0x81, 0xc8, 0x5e, 0xc2, 0x83, 0xaa, 0xea, 0xa2, 0xef, 0x71,
+	0x9f, 0x7b, 0xf3, 0xbc, 0x26, 0xa0, 0x21, 0xb9, 0x8d, 0xfb, 0x96, 0x05, 0x97, 0x65, 0x5a, 0xad,
+	0x86, 0x4c, 0xea, 0xfa, 0xe7, 0x66, 0x87, 0xbe, 0x6d, 0xe6, 0x07, 0xa0, 0xc5, 0x3d, 0xfd, 0x66,
+	0x31, 0x0a, 0x7d, 0xee, 0x95, 0x7a, 0x9b, 0xd9, 0x10, 0xb2, 0x37, 0xf0, 0x50, 0xba, 0x2e, 0x20,
+	0x3f, 0xc5, 0xfd, 0xeb, 0xb4, 0x5e, 0x96, 0xfa, 0x55, 0xd9, 0xe7, 0x35, 0x01, 0xf0, 0x79, 0x23,
+	0xfc, 0x12, 0xad, 0xfb, 0x55, 0x1a, 0x89, 0x30, 0x51, 0x10, 0xac, 0x9c, 0x5f, 0xa7, 0xf5, 0x44,
+	0xd4, 0xae, 0xa4, 0x5b, 0xb7, 0x86, 0x48, 0xe6, 0x30, 0xde, 0xfb, 0xca, 0xd0, 0x34, 0xd7, 0x6a,
+	0xda, 0x3c, 0x12, 0x8e, 0x3e, 0x44, 0x7b, 0x33, 0xf3, 0x18, 0x68, 0x4b, 0x09, 0xa5, 0xfd, 0x09,
+	0xe1, 0xe1, 0x03, 0x9a, 0x80, 0xc1, 0x22, 0xee, 0x06, 0x53, 0xa7, 0xda, 0x35, 0xa5, 0x0a, 0x90,
+	0xe2, 0x3e, 0xdc, 0x2e, 0x85, 0x3b, 0x76, 0x24, 0xae, 0xa6, 0xd8, 0xc7, 0xfb, 0x11, 0xe1, 0xab,
+	0x8a, 0x37, 0x6e, 0x79, 0x5f, 0x3c, 0xe7, 0x91, 0xdf, 0x60, 0x0b, 0xb5, 0x1a, 0xe3, 0x92, 0xc9,
+	0x4e, 0x7c, 0x75, 0x32, 0x77, 0x00, 0x6f, 0x3b, 0xe3, 0xfd, 0x8a, 0x70, 0xee, 0x28, 0x5c, 0x98,
+	0xf5, 0x03, 0x7c, 0x56, 0x40, 0x0c, 0xe6, 0x3d, 0x6e, 0x9c, 0xf7, 0x3f, 0x45, 0x60, 0xd4, 0xb1,
+	0xbe, 0x73, 0xe3, 0xae, 0xe1, 0x11, 0x85, 0x6f, 0x1a, 0x72, 0xa7, 0x8e, 0xe1, 0x26, 0xc2, 0x17,
+	0x0d, 0x8d, 0xfe, 0xe3, 0xf1, 0x14, 0xde, 0x9f, 0xc1, 0xa7, 0x15, 0x36, 0x79, 0x83, 0x70, 0x8f,
+	0xde, 0x4b, 0xe4, 0x9a, 0x91, 0x2b, 0xbd, 0x0c, 0xb3, 0x93, 0xc7, 0x4b, 0xd6, 0xbd, 0xed, 0xb1,
+	0xd7, 0xdf, 0x7f, 0xbf, 0xeb, 0xba, 0x4c, 0x46, 0x5d, 0xd3, 0x72, 0xd6, 0xdb, 0x90, 0x7c, 0x46,
+	0xb8, 0x37, 0x71, 0x17, 0xc9, 0xf5, 0xc3, 0xdb, 0xa4, 0x97, 0x66, 0x76, 0xea, 0x04, 0x0a, 0xa0,
+	0x9b, 0x51, 0x74, 0xb7, 0xc8, 0xb4, 0x91, 0x2e, 0xb9, 0x29, 0xa5, 0xfb, 0x32, 0x79, 0x3f, 0x5f,
+	0x91, 0x0f, 0x08, 0xf7, 0x25, 0xca, 0x4a, 0x72, 0x7c, 0x84, 0x78, 0x9c, 0x85, 0x93, 0x48, 0x00,
+	0xdb, 0x51, 0xd8, 0xe3, 0x24, 0x77, 0x3c, 0x6c, 0xf2, 0x07, 0xe1, 0x61, 0xe3, 0x75, 0x26, 0x77,
+	0x0e, 0x27, 0x38, 0x6a, 0x6d, 0x65, 0xef, 0xb6, 0xad, 0x07, 0x3b, 0x0b, 0xca, 0xce, 0x3c, 0x29,
+	0xb6, 0xf5, 0x15, 0xdc, 0x2a, 0xd4, 0x2d, 0xc7, 0xb7, 0xe5, 0x0b, 0xc2, 0xfd, 0x29, 0x9b, 0xd3,
+	0x87, 0x63, 0x9a, 0xdc, 0xdd, 0x3c, 0xa9, 0x0c, 0x4c, 0x15, 0x94, 0xa9, 0x49, 0x32, 0x61, 0x34,
+	0x95, 0xe2, 0x9e, 0x2d, 0x6e, 0xed, 0x58, 0x68, 0x7b, 0xc7, 0x42, 0xbf, 0x76, 0x2c, 0xf4, 0x76,
+	0xd7, 0xca, 0x6c, 0xef, 0x5a, 0x99, 0x1f, 0xbb, 0x56, 0xe6, 0x49, 0xde, 0xf3, 0xa3, 0x95, 0xb5,
+	0x8a, 0xb3, 0x2c, 0x1a, 0xad, 0x7a, 0xfa, 0x4f, 0x5e, 0x56, 0x9f, 0xb9, 0x2f, 0xf6, 0x8a, 0x47,
+	0x1b, 0x01, 0x93, 0x95, 0x1e, 0xf5, 0x6b, 0xe6, 0xc6, 0xdf, 0x01, 0x00, 0xb2, 0x94, 0xa0, 0x4b,
+	0xb0, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +604,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// ValidatorDowntimeOffenses queries the downtime offense history of given
+	// cons address
+	ValidatorDowntimeOffenses(ctx context.Context, in *QueryValidatorDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryValidatorDowntimeOffensesResponse, error)
+	// DowntimeOffenses queries the downtime offense history of all validators
+	DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error)
 }
 
 type queryClient struct {
@@ -415,6 +646,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) ValidatorDowntimeOffenses(ctx context.Context, in *QueryValidatorDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryValidatorDowntimeOffensesResponse, error) {
+	out := new(QueryValidatorDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/ValidatorDowntimeOffenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error) {
+	out := new(QueryDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimeOffenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -423,6 +672,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// ValidatorDowntimeOffenses queries the downtime offense history of given
+	// cons address
+	ValidatorDowntimeOffenses(context.Context, *QueryValidatorDowntimeOffensesRequest) (*QueryValidatorDowntimeOffensesResponse, error)
+	// DowntimeOffenses queries the downtime offense history of all validators
+	DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -438,6 +692,12 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorDowntimeOffenses(ctx context.Context, req *QueryValidatorDowntimeOffensesRequest) (*QueryValidatorDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDowntimeOffenses not implemented")
+}
+func (*UnimplementedQueryServer) DowntimeOffenses(ctx context.Context, req *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeOffenses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -497,6 +757,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorDowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/ValidatorDowntimeOffenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDowntimeOffenses(ctx, req.(*QueryValidatorDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimeOffenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeOffenses(ctx, req.(*QueryDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -513,6 +809,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "ValidatorDowntimeOffenses",
+			Handler:    _Query_ValidatorDowntimeOffenses_Handler,
+		},
+		{
+			MethodName: "DowntimeOffenses",
+			Handler:    _Query_DowntimeOffenses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -721,70 +1025,245 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorDowntimeOffensesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryValidatorDowntimeOffensesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorDowntimeOffensesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorDowntimeOffensesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorDowntimeOffensesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDowntimeOffensesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offenses) > 0 {
+		for iNdEx := len(m.Offenses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offenses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeOffensesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffensesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffensesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeOffensesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffensesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffensesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offenses) > 0 {
+		for iNdEx := len(m.Offenses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offenses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -801,20 +1280,386 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDowntimeOffensesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDowntimeOffensesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offenses) > 0 {
+		for _, e := range m.Offenses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeOffensesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeOffensesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offenses) > 0 {
+		for _, e := range m.Offenses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -837,12 +1682,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -864,7 +1745,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -887,15 +1768,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -922,7 +1803,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -947,7 +1865,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDowntimeOffensesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -970,10 +1888,10 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDowntimeOffensesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDowntimeOffensesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1008,6 +1926,42 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1029,7 +1983,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDowntimeOffensesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1052,15 +2006,15 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDowntimeOffensesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDowntimeOffensesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1087,7 +2041,44 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offenses = append(m.Offenses, DowntimeOffense{})
+			if err := m.Offenses[len(m.Offenses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1112,7 +2103,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDowntimeOffensesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1135,10 +2126,10 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDowntimeOffensesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDowntimeOffensesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1198,7 +2189,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDowntimeOffensesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1221,15 +2212,15 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDowntimeOffensesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDowntimeOffensesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1256,8 +2247,8 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offenses = append(m.Offenses, DowntimeOffense{})
+			if err := m.Offenses[len(m.Offenses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28,14 +28,34 @@ func NewValidatorSigningInfo(
 // String implements the stringer interface for ValidatorSigningInfo
 func (i ValidatorSigningInfo) String() string {
 	return fmt.Sprintf(`Validator Signing Info:
-  Address:               %s
-  Start Height:          %d
-  Index Offset:          %d
-  Jailed Until:          %v
-  Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Address:                    %s
+  Start Height:               %d
+  Index Offset:               %d
+  Jailed Until:               %v
+  Tombstoned:                 %t
+  Missed Blocks Counter:      %d
+  Recent Downtime Offenses:   %d
+  Last Downtime Offense Time: %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.RecentDowntimeOffenses,
+		i.LastDowntimeOffenseTime)
+}
+
+// NewDowntimeOffense creates a new DowntimeOffense instance
+//nolint:interfacer
+func NewDowntimeOffense(
+	consAddr sdk.ConsAddress, height int64, offenseTime time.Time, offenseCount uint64,
+	slashFraction sdk.Dec, jailDuration time.Duration,
+) DowntimeOffense {
+
+	return DowntimeOffense{
+		Address:       consAddr.String(),
+		Height:        height,
+		Time:          offenseTime,
+		OffenseCount:  offenseCount,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
 }
 
 // unmarshal a validator signing info from a store value
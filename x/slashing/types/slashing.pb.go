@@ -48,6 +48,11 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of recent downtime offenses of the validator. The counter decays by
+	// one for every `DowntimeOffenseDecayWindow` elapsed since the last offense.
+	RecentDowntimeOffenses uint64 `protobuf:"varint,7,opt,name=recent_downtime_offenses,json=recentDowntimeOffenses,proto3" json:"recent_downtime_offenses,omitempty"`
+	// Timestamp of the last downtime offense of the validator.
+	LastDowntimeOffenseTime time.Time `protobuf:"bytes,8,opt,name=last_downtime_offense_time,json=lastDowntimeOffenseTime,proto3,stdtime" json:"last_downtime_offense_time"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -124,6 +129,104 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetRecentDowntimeOffenses() uint64 {
+	if m != nil {
+		return m.RecentDowntimeOffenses
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetLastDowntimeOffenseTime() time.Time {
+	if m != nil {
+		return m.LastDowntimeOffenseTime
+	}
+	return time.Time{}
+}
+
+// DowntimeOffense defines a record of a validator being slashed and jailed for
+// downtime.
+type DowntimeOffense struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Height at which the offense was punished.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp at which the offense was punished.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Number of recent downtime offenses of the validator, including this one.
+	OffenseCount uint64 `protobuf:"varint,4,opt,name=offense_count,json=offenseCount,proto3" json:"offense_count,omitempty"`
+	// Fraction of the validator stake slashed for the offense.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// Duration for which the validator was jailed for the offense.
+	JailDuration time.Duration `protobuf:"bytes,6,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *DowntimeOffense) Reset()         { *m = DowntimeOffense{} }
+func (m *DowntimeOffense) String() string { return proto.CompactTextString(m) }
+func (*DowntimeOffense) ProtoMessage()    {}
+func (*DowntimeOffense) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *DowntimeOffense) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeOffense) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeOffense.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeOffense) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeOffense.Merge(m, src)
+}
+func (m *DowntimeOffense) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeOffense) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeOffense.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeOffense proto.InternalMessageInfo
+
+func (m *DowntimeOffense) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DowntimeOffense) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeOffense) GetOffenseCount() uint64 {
+	if m != nil {
+		return m.OffenseCount
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -131,13 +234,26 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_offense_decay_window is the duration after which a recent downtime
+	// offense of a validator is forgotten. A zero duration disables the decay.
+	DowntimeOffenseDecayWindow time.Duration `protobuf:"bytes,6,opt,name=downtime_offense_decay_window,json=downtimeOffenseDecayWindow,proto3,stdduration" json:"downtime_offense_decay_window"`
+	// downtime_jail_duration_multiplier is the factor applied to the downtime
+	// jail duration for every recent downtime offense of a validator.
+	DowntimeJailDurationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_duration_multiplier"`
+	// max_downtime_jail_duration caps the escalated downtime jail duration.
+	MaxDowntimeJailDuration time.Duration `protobuf:"bytes,8,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3,stdduration" json:"max_downtime_jail_duration"`
+	// slash_fraction_downtime_multiplier is the factor applied to the downtime
+	// slash fraction for every recent downtime offense of a validator.
+	SlashFractionDowntimeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_multiplier"`
+	// max_slash_fraction_downtime caps the escalated downtime slash fraction.
+	MaxSlashFractionDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_slash_fraction_downtime,json=maxSlashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slash_fraction_downtime"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,8 +296,23 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeOffenseDecayWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeOffenseDecayWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxDowntimeJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*DowntimeOffense)(nil), "cosmos.slashing.v1beta1.DowntimeOffense")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -190,43 +321,58 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xc6, 0xc6, 0x76, 0xc7, 0x0e, 0x48, 0x83, 0x1b, 0x6f, 0x8d, 0x58, 0xbb, 0x41, 0xaa,
+	0x7c, 0xc9, 0x9a, 0x9a, 0x4b, 0xd5, 0x1b, 0xc6, 0x82, 0x02, 0x42, 0xad, 0xd6, 0x14, 0x04, 0x97,
+	0x65, 0xbc, 0x3b, 0x5e, 0x0f, 0xd9, 0x9d, 0xb1, 0x76, 0xc6, 0xc4, 0x11, 0x7f, 0xa2, 0xc7, 0x1e,
+	0x7b, 0xe4, 0x07, 0xf0, 0x23, 0x72, 0x8c, 0x10, 0x07, 0xc4, 0x21, 0x20, 0xfb, 0xc2, 0xaf, 0x40,
+	0x68, 0x3e, 0xd6, 0xf1, 0x57, 0x50, 0xe2, 0x53, 0xb2, 0xef, 0xc7, 0xf3, 0xbe, 0xcf, 0xfb, 0x3c,
+	0x23, 0x19, 0x3c, 0x0a, 0x18, 0x4f, 0x18, 0xef, 0xf0, 0x18, 0xf1, 0x31, 0xa1, 0x51, 0xe7, 0xa7,
+	0xc7, 0x43, 0x2c, 0xd0, 0xe3, 0x65, 0xc0, 0x9d, 0xa4, 0x4c, 0x30, 0x58, 0xd7, 0x75, 0xee, 0x32,
+	0x6c, 0xea, 0x1a, 0xb5, 0x88, 0x45, 0x4c, 0xd5, 0x74, 0xe4, 0x7f, 0xba, 0xbc, 0xe1, 0x44, 0x8c,
+	0x45, 0x31, 0xee, 0xa8, 0xaf, 0xe1, 0x74, 0xd4, 0x09, 0xa7, 0x29, 0x12, 0x84, 0x51, 0x93, 0x6f,
+	0x6e, 0xe6, 0x05, 0x49, 0x30, 0x17, 0x28, 0x99, 0x98, 0x82, 0x07, 0x7a, 0x9e, 0xaf, 0x91, 0xcd,
+	0x70, 0xf5, 0x71, 0xfc, 0x7b, 0x1e, 0xd4, 0xbe, 0x41, 0x31, 0x09, 0x91, 0x60, 0xe9, 0x80, 0x44,
+	0x94, 0xd0, 0xe8, 0x73, 0x3a, 0x62, 0xb0, 0x0b, 0x4a, 0x28, 0x0c, 0x53, 0xcc, 0xb9, 0x6d, 0xb5,
+	0xac, 0xf6, 0xbd, 0x9e, 0xfd, 0xdb, 0xaf, 0x27, 0x35, 0xd3, 0xfb, 0xb1, 0xce, 0x0c, 0x44, 0x4a,
+	0x68, 0xe4, 0x65, 0x85, 0xf0, 0x21, 0xa8, 0x72, 0x81, 0x52, 0xe1, 0x8f, 0x31, 0x89, 0xc6, 0xc2,
+	0x3e, 0x68, 0x59, 0xed, 0xbc, 0x57, 0x51, 0xb1, 0x67, 0x2a, 0x24, 0x4b, 0x08, 0x0d, 0xf1, 0xcc,
+	0x67, 0xa3, 0x11, 0xc7, 0xc2, 0xce, 0xeb, 0x12, 0x15, 0x7b, 0xae, 0x42, 0xf0, 0x33, 0x50, 0xfd,
+	0x11, 0x91, 0x18, 0x87, 0xfe, 0x94, 0x0a, 0x12, 0xdb, 0x85, 0x96, 0xd5, 0xae, 0x74, 0x1b, 0xae,
+	0x66, 0xe9, 0x66, 0x2c, 0xdd, 0xaf, 0x33, 0x96, 0xbd, 0xf2, 0xc5, 0x55, 0x33, 0xf7, 0xea, 0xaf,
+	0xa6, 0xe5, 0x55, 0x74, 0xe7, 0x4b, 0xd9, 0x08, 0x1d, 0x00, 0x04, 0x4b, 0x86, 0x5c, 0x30, 0x8a,
+	0x43, 0xfb, 0xad, 0x96, 0xd5, 0x2e, 0x7b, 0x2b, 0x11, 0xd8, 0x05, 0xf7, 0x13, 0xc2, 0x39, 0x0e,
+	0xfd, 0x61, 0xcc, 0x82, 0x53, 0xee, 0x07, 0x6c, 0x4a, 0x05, 0x4e, 0xed, 0xa2, 0x5a, 0xea, 0x5d,
+	0x9d, 0xec, 0xa9, 0xdc, 0x27, 0x3a, 0x05, 0x9f, 0x00, 0x3b, 0xc5, 0x01, 0xa6, 0xc2, 0x0f, 0xd9,
+	0x19, 0x95, 0x87, 0x96, 0x4c, 0x30, 0xe5, 0x98, 0xdb, 0xa5, 0x96, 0xd5, 0x2e, 0x78, 0x47, 0x3a,
+	0xdf, 0x37, 0xe9, 0xe7, 0x26, 0x0b, 0x11, 0x68, 0xc4, 0x88, 0x6f, 0xf7, 0xf9, 0xf2, 0xc3, 0x2e,
+	0xdf, 0x81, 0x64, 0x5d, 0xe2, 0x6c, 0xe0, 0xcb, 0xba, 0xa7, 0xe5, 0xd7, 0x6f, 0x9a, 0xb9, 0x7f,
+	0xde, 0x34, 0xad, 0xe3, 0xc5, 0x01, 0x78, 0x67, 0xa3, 0x62, 0x2f, 0x45, 0x8f, 0x40, 0x71, 0x4d,
+	0x4b, 0xf3, 0x05, 0x9f, 0x80, 0x82, 0x5a, 0x3b, 0x7f, 0x87, 0xb5, 0x55, 0x07, 0xfc, 0x00, 0x1c,
+	0x66, 0xc4, 0xd5, 0xb9, 0x95, 0xbc, 0x05, 0xaf, 0x6a, 0x82, 0xea, 0xce, 0xf0, 0x25, 0x78, 0x5b,
+	0xbd, 0x0d, 0x7f, 0x94, 0xa2, 0x40, 0x3a, 0x5d, 0xa9, 0x57, 0xed, 0xb9, 0x12, 0xec, 0xcf, 0xab,
+	0xe6, 0xa3, 0x88, 0x88, 0xf1, 0x74, 0xe8, 0x06, 0x2c, 0x31, 0x76, 0x36, 0x7f, 0x4e, 0x78, 0x78,
+	0xda, 0x11, 0xe7, 0x13, 0xcc, 0xdd, 0x3e, 0x0e, 0xbc, 0x43, 0x85, 0xf2, 0xa9, 0x01, 0x81, 0xcf,
+	0xc0, 0xa1, 0xf4, 0x87, 0x9f, 0xbd, 0x1f, 0x25, 0x74, 0xa5, 0xfb, 0x60, 0x6b, 0xfd, 0xbe, 0x29,
+	0xd0, 0xdb, 0xbf, 0x96, 0xdb, 0x2b, 0x4f, 0x66, 0xf1, 0xa7, 0x05, 0x75, 0xe5, 0x7f, 0x4b, 0xa0,
+	0xf8, 0x02, 0xa5, 0x28, 0xe1, 0xf0, 0x43, 0x50, 0xe3, 0x24, 0xa2, 0xd7, 0x5e, 0x3a, 0x23, 0x34,
+	0x64, 0x67, 0xea, 0xd2, 0x79, 0x0f, 0xea, 0x9c, 0xb6, 0xd2, 0xb7, 0x2a, 0x03, 0x91, 0x74, 0x1f,
+	0xf5, 0x4d, 0xd7, 0x04, 0xa7, 0x59, 0xcb, 0xc1, 0x5e, 0x54, 0x61, 0x42, 0xe8, 0x40, 0x61, 0xbd,
+	0xc0, 0xa9, 0x19, 0xf1, 0x1d, 0x38, 0x5a, 0xba, 0x6d, 0x9d, 0x78, 0xfe, 0xf6, 0xc4, 0x6b, 0x19,
+	0xc4, 0x17, 0x2b, 0x07, 0x80, 0xa7, 0xa0, 0xb1, 0xae, 0x90, 0x1f, 0xb2, 0xe9, 0x30, 0xc6, 0x8a,
+	0x8f, 0x5d, 0xd8, 0x8b, 0x42, 0x7d, 0x4d, 0xad, 0xbe, 0xc2, 0x93, 0x94, 0xe0, 0x08, 0xd4, 0xb7,
+	0x86, 0xe9, 0x9d, 0xf6, 0xf4, 0xc5, 0xfd, 0x8d, 0x49, 0x1a, 0x0c, 0x8e, 0xc0, 0xfb, 0x5b, 0xaf,
+	0x33, 0xc4, 0x01, 0x3a, 0xcf, 0xa4, 0xb9, 0x83, 0x5f, 0x1a, 0xe1, 0xfa, 0xf3, 0xeb, 0x4b, 0x1c,
+	0xa3, 0xcb, 0x39, 0x78, 0xb8, 0x5b, 0x17, 0x3f, 0x99, 0xc6, 0x82, 0x4c, 0x62, 0x82, 0x53, 0xbb,
+	0xb4, 0x17, 0x33, 0x67, 0x97, 0x5a, 0x5f, 0x2d, 0x51, 0xe1, 0x0f, 0xa0, 0x91, 0xa0, 0x99, 0x7f,
+	0x83, 0x2d, 0xca, 0xb7, 0xe7, 0x57, 0x4f, 0xd0, 0xac, 0xbf, 0xcb, 0x19, 0x3f, 0x83, 0xe3, 0x1b,
+	0xc4, 0x5a, 0x65, 0x77, 0x6f, 0x2f, 0x76, 0xcd, 0x9d, 0xba, 0xad, 0xd0, 0x4b, 0xc0, 0x7b, 0x92,
+	0xde, 0x4d, 0x6e, 0x01, 0x7b, 0x4d, 0xb5, 0x13, 0x34, 0x1b, 0xec, 0x1a, 0xdc, 0xfb, 0xf2, 0x97,
+	0xb9, 0x63, 0x5d, 0xcc, 0x1d, 0xeb, 0x72, 0xee, 0x58, 0x7f, 0xcf, 0x1d, 0xeb, 0xd5, 0xc2, 0xc9,
+	0x5d, 0x2e, 0x9c, 0xdc, 0x1f, 0x0b, 0x27, 0xf7, 0xfd, 0xc9, 0xff, 0xe2, 0xcf, 0xae, 0x7f, 0x26,
+	0xa8, 0x51, 0xc3, 0xa2, 0x3a, 0xf7, 0x47, 0xff, 0x0d, 0x00, 0x2d, 0x8b, 0xf7, 0x0b, 0x46, 0x08,
+	0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -266,6 +412,51 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.RecentDowntimeOffenses != that1.RecentDowntimeOffenses {
+		return false
+	}
+	if !this.LastDowntimeOffenseTime.Equal(that1.LastDowntimeOffenseTime) {
+		return false
+	}
+	return true
+}
+func (this *DowntimeOffense) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeOffense)
+	if !ok {
+		that2, ok := that.(DowntimeOffense)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.OffenseCount != that1.OffenseCount {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -302,6 +493,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeOffenseDecayWindow != that1.DowntimeOffenseDecayWindow {
+		return false
+	}
+	if !this.DowntimeJailDurationMultiplier.Equal(that1.DowntimeJailDurationMultiplier) {
+		return false
+	}
+	if this.MaxDowntimeJailDuration != that1.MaxDowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDowntimeMultiplier.Equal(that1.SlashFractionDowntimeMultiplier) {
+		return false
+	}
+	if !this.MaxSlashFractionDowntime.Equal(that1.MaxSlashFractionDowntime) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -324,6 +530,19 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastDowntimeOffenseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDowntimeOffenseTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.RecentDowntimeOffenses != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.RecentDowntimeOffenses))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -339,12 +558,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -367,6 +586,72 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeOffense) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeOffense) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeOffense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OffenseCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.OffenseCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -387,6 +672,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.MaxSlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SlashFractionDowntimeMultiplier.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntimeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailDurationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailDurationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeOffenseDecayWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeOffenseDecayWindow):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +738,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n7
+	i = encodeVarintSlashing(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -468,6 +799,36 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.RecentDowntimeOffenses != 0 {
+		n += 1 + sovSlashing(uint64(m.RecentDowntimeOffenses))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDowntimeOffenseTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DowntimeOffense) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.OffenseCount != 0 {
+		n += 1 + sovSlashing(uint64(m.OffenseCount))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -488,6 +849,16 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeOffenseDecayWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailDurationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntimeMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.MaxSlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -668,22 +1039,293 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDowntimeOffenses", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSlashing
+			m.RecentDowntimeOffenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecentDowntimeOffenses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeOffenseTime", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastDowntimeOffenseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeOffense) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeOffense: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeOffense: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenseCount", wireType)
+			}
+			m.OffenseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -869,6 +1511,171 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseDecayWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeOffenseDecayWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailDurationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntimeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
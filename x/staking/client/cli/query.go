@@ -39,6 +39,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordByDenom(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryValidatorScheduledCommissionChange(),
+		GetCmdQueryScheduledCommissionChanges(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +750,260 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordByID implements the query for a tokenize share
+// record by its id.
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by id.
+
+Example:
+$ %s query staking tokenize-share-record-by-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordById(cmd.Context(), &types.QueryTokenizeShareRecordByIdRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the query for a tokenize
+// share record by its share token denom.
+func GetCmdQueryTokenizeShareRecordByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by share denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by share denom.
+
+Example:
+$ %s query staking tokenize-share-record-by-denom cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordByDenom(cmd.Context(), &types.QueryTokenizeShareRecordByDenomRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query for the tokenize
+// share records owned by an address.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query tokenize share records by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query tokenize share records by address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{
+				Owner: owner.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query for the amount of tokens
+// converted into tokenized shares.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the amount of tokens converted into tokenized shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of tokens converted into tokenized shares.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorScheduledCommissionChange implements the query command
+// for the scheduled commission change of a validator.
+func GetCmdQueryValidatorScheduledCommissionChange() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "scheduled-commission-change [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the scheduled commission change of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the commission change scheduled by a validator and the time at which
+it takes effect.
+
+Example:
+$ %s query staking scheduled-commission-change %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorScheduledCommissionChangeRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorScheduledCommissionChange(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Change)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduledCommissionChanges implements the query command for all
+// scheduled commission changes.
+func GetCmdQueryScheduledCommissionChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-commission-changes",
+		Args:  cobra.NoArgs,
+		Short: "Query all scheduled commission changes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all commission changes scheduled by validators.
+
+Example:
+$ %s query staking scheduled-commission-changes
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduledCommissionChanges(cmd.Context(), &types.QueryScheduledCommissionChangesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled commission changes")
+
+	return cmd
+}
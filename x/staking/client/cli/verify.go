@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func init() {
+	client.RegisterQueryVerifier("/cosmos.staking.v1beta1.Query/Validator", verifyValidator)
+	client.RegisterQueryVerifier("/cosmos.staking.v1beta1.Query/Delegation", verifyDelegation)
+	client.RegisterQueryVerifier("/cosmos.staking.v1beta1.Query/UnbondingDelegation", verifyUnbondingDelegation)
+}
+
+// verifyValidator answers the Validator query with the validator read from the store of the module.
+func verifyValidator(clientCtx client.Context, req, reply interface{}) (int64, error) {
+	request, ok := req.(*types.QueryValidatorRequest)
+	response, ok2 := reply.(*types.QueryValidatorResponse)
+	if !ok || !ok2 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected validator query %T", req)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(request.ValidatorAddr)
+	if err != nil {
+		return 0, err
+	}
+	height, err := clientCtx.QueryStoreProto(types.GetValidatorKey(valAddr), types.StoreKey, &response.Validator)
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return 0, status.Errorf(codes.NotFound, "validator %s not found", request.ValidatorAddr)
+	}
+	return height, err
+}
+
+// verifyDelegation answers the Delegation query with the delegation, its validator and the bond denom
+// read from the stores at the same height.
+func verifyDelegation(clientCtx client.Context, req, reply interface{}) (int64, error) {
+	request, ok := req.(*types.QueryDelegationRequest)
+	response, ok2 := reply.(*types.QueryDelegationResponse)
+	if !ok || !ok2 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected delegation query %T", req)
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(request.DelegatorAddr)
+	if err != nil {
+		return 0, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(request.ValidatorAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	var delegation types.Delegation
+	height, err := clientCtx.QueryStoreProto(types.GetDelegationKey(delAddr, valAddr), types.StoreKey, &delegation)
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return 0, status.Errorf(codes.NotFound,
+			"delegation with delegator %s not found for validator %s", request.DelegatorAddr, request.ValidatorAddr)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	// the validator and the bond denom are read at the height of the delegation
+	clientCtx = clientCtx.WithHeight(height)
+	var validator types.Validator
+	if _, err := clientCtx.QueryStoreProto(types.GetValidatorKey(valAddr), types.StoreKey, &validator); err != nil {
+		return 0, err
+	}
+	bz, _, err := clientCtx.QueryStore(append([]byte(types.ModuleName+"/"), types.KeyBondDenom...), paramtypes.StoreKey)
+	if err != nil {
+		return 0, err
+	}
+	var bondDenom string
+	if err := json.Unmarshal(bz, &bondDenom); err != nil {
+		return 0, err
+	}
+
+	delResponse := types.NewDelegationResp(delAddr, valAddr, delegation.Shares,
+		sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()))
+	response.DelegationResponse = &delResponse
+	return height, nil
+}
+
+// verifyUnbondingDelegation answers the UnbondingDelegation query with the unbonding delegation read
+// from the store of the module.
+func verifyUnbondingDelegation(clientCtx client.Context, req, reply interface{}) (int64, error) {
+	request, ok := req.(*types.QueryUnbondingDelegationRequest)
+	response, ok2 := reply.(*types.QueryUnbondingDelegationResponse)
+	if !ok || !ok2 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected unbonding delegation query %T", req)
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(request.DelegatorAddr)
+	if err != nil {
+		return 0, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(request.ValidatorAddr)
+	if err != nil {
+		return 0, err
+	}
+	height, err := clientCtx.QueryStoreProto(types.GetUBDKey(delAddr, valAddr), types.StoreKey, &response.Unbond)
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return 0, status.Errorf(codes.NotFound,
+			"unbonding delegation with delegator %s not found for validator %s", request.DelegatorAddr, request.ValidatorAddr)
+	}
+	return height, err
+}
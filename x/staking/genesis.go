@@ -103,6 +103,53 @@ func InitGenesis(
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := keeper.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		keeper.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	}
+
+	for _, liquidShares := range data.ValidatorLiquidShares {
+		valAddr, err := sdk.ValAddressFromBech32(liquidShares.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorLiquidShares(ctx, valAddr, liquidShares.Shares)
+	}
+
+	for _, change := range data.ScheduledCommissionChanges {
+		if err := keeper.SetScheduledCommissionChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rotation := range data.ConsPubKeyRotations {
+		if err := keeper.SetConsPubKeyRotation(ctx, rotation); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rotated := range data.RotatedConsAddresses {
+		consAddr, err := sdk.ConsAddressFromBech32(rotated.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(rotated.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetRotatedConsAddr(ctx, consAddr, valAddr, rotated.EndTime)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -195,6 +242,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	var validatorLiquidShares []types.ValidatorLiquidShares
+
+	keeper.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool) {
+		validatorLiquidShares = append(validatorLiquidShares, types.ValidatorLiquidShares{ValidatorAddress: valAddr.String(), Shares: shares})
+		return false
+	})
+
 	return &types.GenesisState{
 		Params:               keeper.GetParams(ctx),
 		LastTotalPower:       keeper.GetLastTotalPower(ctx),
@@ -204,6 +258,16 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: keeper.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:   keeper.GetTotalLiquidStakedTokens(ctx),
+		ValidatorLiquidShares:     validatorLiquidShares,
+
+		ScheduledCommissionChanges: keeper.GetAllScheduledCommissionChanges(ctx),
+
+		ConsPubKeyRotations:  keeper.GetAllConsPubKeyRotations(ctx),
+		RotatedConsAddresses: keeper.GetAllRotatedConsAddrs(ctx),
 	}
 }
 
@@ -239,9 +303,119 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateScheduledCommissionChanges(data.ScheduledCommissionChanges); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotations(data.Validators, data.ConsPubKeyRotations, data.RotatedConsAddresses); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastRecordID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastRecordID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastRecordID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateScheduledCommissionChanges(changes []types.ScheduledCommissionChange) error {
+	valMap := make(map[string]bool, len(changes))
+
+	for _, change := range changes {
+		if valMap[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate scheduled commission change in genesis state: validator %s", change.ValidatorAddress)
+		}
+
+		if change.Rate.IsNil() || change.Rate.IsNegative() || change.Rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid scheduled commission rate for validator %s: %s", change.ValidatorAddress, change.Rate)
+		}
+
+		valMap[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStateConsPubKeyRotations checks that the pending rotations
+// and rotated consensus addresses belong to genesis validators, and that no
+// consensus address resolves to more than one validator.
+func validateGenesisStateConsPubKeyRotations(validators []types.Validator, rotations []types.ConsPubKeyRotation, rotated []types.RotatedConsAddress) error {
+	operators := make(map[string]bool, len(validators))
+	consAddrs := make(map[string]string, len(validators))
+
+	for _, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		operators[val.OperatorAddress] = true
+		consAddrs[consAddr.String()] = val.OperatorAddress
+	}
+
+	rotating := make(map[string]bool, len(rotations))
+	for _, rotation := range rotations {
+		if !operators[rotation.ValidatorAddress] {
+			return fmt.Errorf("consensus pubkey rotation of unknown validator %s in genesis state", rotation.ValidatorAddress)
+		}
+
+		if rotating[rotation.ValidatorAddress] {
+			return fmt.Errorf("duplicate consensus pubkey rotation in genesis state: validator %s", rotation.ValidatorAddress)
+		}
+
+		pk, err := rotation.ConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		consAddr := sdk.GetConsAddress(pk).String()
+		if owner, ok := consAddrs[consAddr]; ok {
+			return fmt.Errorf("consensus address %s of the pubkey rotation of validator %s is already used by validator %s",
+				consAddr, rotation.ValidatorAddress, owner)
+		}
+
+		rotating[rotation.ValidatorAddress] = true
+		consAddrs[consAddr] = rotation.ValidatorAddress
+	}
+
+	for _, r := range rotated {
+		if _, err := sdk.ConsAddressFromBech32(r.Address); err != nil {
+			return err
+		}
+
+		if !operators[r.ValidatorAddress] {
+			return fmt.Errorf("rotated consensus address %s of unknown validator %s in genesis state", r.Address, r.ValidatorAddress)
+		}
+
+		if owner, ok := consAddrs[r.Address]; ok {
+			return fmt.Errorf("rotated consensus address %s of validator %s is already used by validator %s",
+				r.Address, r.ValidatorAddress, owner)
+		}
+
+		consAddrs[r.Address] = r.ValidatorAddress
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
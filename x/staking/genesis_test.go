@@ -113,6 +113,43 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, abcivals, vals)
 }
 
+func TestInitGenesisConsPubKeyRotations(t *testing.T) {
+	app, ctx, _ := bootstrapGenesisTest(t, 1)
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+	valAddr := validators[0].GetOperator()
+
+	genesisState := staking.ExportGenesis(ctx, app.StakingKeeper)
+	genesisState.Exported = true
+
+	// a pending rotation and a consensus address rotated away from must survive
+	// an export and re-import
+	rotation, err := types.NewConsPubKeyRotation(valAddr, PKs[0], 10)
+	require.NoError(t, err)
+	genesisState.ConsPubKeyRotations = []types.ConsPubKeyRotation{rotation}
+
+	rotatedConsAddr := sdk.GetConsAddress(PKs[1])
+	genesisState.RotatedConsAddresses = []types.RotatedConsAddress{{
+		Address:          rotatedConsAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		EndTime:          ctx.BlockTime().Add(genesisState.Params.UnbondingTime).UTC(),
+	}}
+	require.NoError(t, staking.ValidateGenesis(genesisState))
+
+	staking.InitGenesis(ctx, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, genesisState)
+
+	actualGenesis := staking.ExportGenesis(ctx, app.StakingKeeper)
+	require.Equal(t, genesisState.ConsPubKeyRotations, actualGenesis.ConsPubKeyRotations)
+	require.Equal(t, genesisState.RotatedConsAddresses, actualGenesis.RotatedConsAddresses)
+
+	for _, consAddr := range []sdk.ConsAddress{sdk.GetConsAddress(PKs[0]), rotatedConsAddr} {
+		val, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, valAddr, val.GetOperator())
+	}
+}
+
 func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.NewContext(false, tmproto.Header{})
@@ -220,6 +257,11 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	rotatingPk := ed25519.GenPrivKey().PubKey()
+	rotatingVal := teststaking.NewValidator(t, sdk.ValAddress(rotatingPk.Address()), rotatingPk)
+	rotatingVal.Tokens = sdk.OneInt()
+	rotatingVal.DelegatorShares = sdk.OneDec()
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -240,6 +282,49 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate consensus pubkey rotations
+		{"rotation of unknown validator", func(data *types.GenesisState) {
+			rotation, err := types.NewConsPubKeyRotation(sdk.ValAddress(PKs[0].Address()), PKs[1], 10)
+			require.NoError(t, err)
+			data.ConsPubKeyRotations = []types.ConsPubKeyRotation{rotation}
+		}, true},
+		{"rotation to a used consensus pubkey", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{rotatingVal}
+			rotation, err := types.NewConsPubKeyRotation(sdk.ValAddress(rotatingPk.Address()), rotatingPk, 10)
+			require.NoError(t, err)
+			data.ConsPubKeyRotations = []types.ConsPubKeyRotation{rotation}
+		}, true},
+		{"duplicate rotation", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{rotatingVal}
+			rotation1, err := types.NewConsPubKeyRotation(sdk.ValAddress(rotatingPk.Address()), PKs[0], 10)
+			require.NoError(t, err)
+			rotation2, err := types.NewConsPubKeyRotation(sdk.ValAddress(rotatingPk.Address()), PKs[1], 10)
+			require.NoError(t, err)
+			data.ConsPubKeyRotations = []types.ConsPubKeyRotation{rotation1, rotation2}
+		}, true},
+		{"rotated consensus address of unknown validator", func(data *types.GenesisState) {
+			data.RotatedConsAddresses = []types.RotatedConsAddress{{
+				Address:          sdk.GetConsAddress(PKs[0]).String(),
+				ValidatorAddress: sdk.ValAddress(PKs[0].Address()).String(),
+			}}
+		}, true},
+		{"rotated consensus address in use", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{rotatingVal}
+			data.RotatedConsAddresses = []types.RotatedConsAddress{{
+				Address:          sdk.GetConsAddress(rotatingPk).String(),
+				ValidatorAddress: sdk.ValAddress(rotatingPk.Address()).String(),
+			}}
+		}, true},
+		{"valid rotations", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{rotatingVal}
+			rotation, err := types.NewConsPubKeyRotation(sdk.ValAddress(rotatingPk.Address()), PKs[0], 10)
+			require.NoError(t, err)
+			data.ConsPubKeyRotations = []types.ConsPubKeyRotation{rotation}
+			data.RotatedConsAddresses = []types.RotatedConsAddress{{
+				Address:          sdk.GetConsAddress(PKs[1]).String(),
+				ValidatorAddress: sdk.ValAddress(rotatingPk.Address()).String(),
+			}}
+		}, false},
 	}
 
 	for _, tt := range tests {
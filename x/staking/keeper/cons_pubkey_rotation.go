@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetConsPubKeyRotation gets the pending consensus pubkey rotation of a validator.
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetConsPubKeyRotationKey(valAddr))
+	if value == nil {
+		return rotation, false
+	}
+
+	rotation = types.MustUnmarshalConsPubKeyRotation(k.cdc, value)
+	return rotation, true
+}
+
+// SetConsPubKeyRotation sets the pending consensus pubkey rotation of a
+// validator and reserves the new consensus address for it, so that the new
+// key cannot be claimed by any other validator.
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) error {
+	valAddr, err := sdk.ValAddressFromBech32(rotation.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	pk, err := rotation.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationKey(valAddr), types.MustMarshalConsPubKeyRotation(k.cdc, &rotation))
+	store.Set(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(pk)), valAddr)
+
+	return nil
+}
+
+// DeleteConsPubKeyRotation removes the pending consensus pubkey rotation of a validator.
+func (k Keeper) DeleteConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetConsPubKeyRotationKey(valAddr))
+}
+
+// GetAllConsPubKeyRotations returns all pending consensus pubkey rotations,
+// ordered by validator operator address.
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotations = append(rotations, types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value()))
+	}
+
+	return rotations
+}
+
+// rotateConsPubKey applies a pending consensus pubkey rotation to the given
+// validator. The old consensus address keeps resolving to the validator for
+// the unbonding period, so that infractions committed with the old key can
+// still be slashed.
+func (k Keeper) rotateConsPubKey(ctx sdk.Context, validator types.Validator, rotation types.ConsPubKeyRotation) (types.Validator, error) {
+	oldPk, err := validator.ConsPubKey()
+	if err != nil {
+		return validator, err
+	}
+
+	newPk, err := rotation.ConsPubKey()
+	if err != nil {
+		return validator, err
+	}
+
+	validator.ConsensusPubkey = rotation.NewPubkey
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return validator, err
+	}
+
+	oldConsAddr := sdk.GetConsAddress(oldPk)
+	k.InsertRotatedConsAddrQueue(ctx, oldConsAddr, validator.GetOperator(), ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx)))
+	k.DeleteConsPubKeyRotation(ctx, validator.GetOperator())
+
+	if err := k.AfterConsensusPubKeyUpdate(ctx, oldPk, newPk); err != nil {
+		return validator, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(newPk).String()),
+		),
+	)
+
+	return validator, nil
+}
+
+// applyRemainingConsPubKeyRotations applies the pending consensus pubkey
+// rotations of validators which are not part of the bonded validator set and
+// therefore don't produce any validator set update.
+func (k Keeper) applyRemainingConsPubKeyRotations(ctx sdk.Context, rotations []types.ConsPubKeyRotation, applied map[string]bool) error {
+	for _, rotation := range rotations {
+		if applied[rotation.ValidatorAddress] {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(rotation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			// the validator has been removed since the rotation was requested,
+			// release the reserved consensus address
+			pk, err := rotation.ConsPubKey()
+			if err != nil {
+				return err
+			}
+
+			store := ctx.KVStore(k.storeKey)
+			store.Delete(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(pk)))
+			k.DeleteConsPubKeyRotation(ctx, valAddr)
+
+			continue
+		}
+
+		if _, err := k.rotateConsPubKey(ctx, validator, rotation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InsertRotatedConsAddrQueue inserts a rotated consensus address into the
+// queue of addresses which stop resolving to their validator at endTime.
+func (k Keeper) InsertRotatedConsAddrQueue(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRotatedConsAddrQueueKey(endTime, consAddr), valAddr)
+}
+
+// SetRotatedConsAddr makes a consensus address a validator rotated away from
+// resolve to the validator until endTime.
+func (k Keeper) SetRotatedConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorByConsAddrKey(consAddr), valAddr)
+	k.InsertRotatedConsAddrQueue(ctx, consAddr, valAddr, endTime)
+}
+
+// GetAllRotatedConsAddrs returns all the consensus addresses validators
+// rotated away from which still resolve to them, ordered by end time.
+func (k Keeper) GetAllRotatedConsAddrs(ctx sdk.Context) (rotated []types.RotatedConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.RotatedConsAddrQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		endTime, consAddr, err := types.ParseRotatedConsAddrQueueKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		rotated = append(rotated, types.RotatedConsAddress{
+			Address:          consAddr.String(),
+			ValidatorAddress: sdk.ValAddress(iterator.Value()).String(),
+			EndTime:          endTime,
+		})
+	}
+
+	return rotated
+}
+
+// RotatedConsAddrQueueIterator returns all the rotated consensus addresses
+// from time 0 until endTime.
+func (k Keeper) RotatedConsAddrQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.RotatedConsAddrQueueKey,
+		sdk.PrefixEndBytes(types.GetRotatedConsAddrQueueTimeKey(endTime)))
+}
+
+// DequeueAllMatureRotatedConsAddrs removes all rotated consensus addresses
+// which have been kept for the unbonding period, together with their index
+// to the validator they used to belong to.
+func (k Keeper) DequeueAllMatureRotatedConsAddrs(ctx sdk.Context, currTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.RotatedConsAddrQueueIterator(ctx, currTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, consAddr, err := types.ParseRotatedConsAddrQueueKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		// only remove the index if it still points to the same validator
+		byConsAddrKey := types.GetValidatorByConsAddrKey(consAddr)
+		if bytes.Equal(store.Get(byConsAddrKey), iterator.Value()) {
+			store.Delete(byConsAddrKey)
+		}
+
+		store.Delete(iterator.Key())
+	}
+}
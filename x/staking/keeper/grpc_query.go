@@ -470,6 +470,116 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecordById queries the tokenize share record with the given id
+func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries the tokenize share record of the given share token denom
+func (k Querier) TokenizeShareRecordByDenom(c context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record of denom %s not found", req.Denom)
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by the given address
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens converted into tokenized shares
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
+
+// ValidatorScheduledCommissionChange queries the scheduled commission change of a given validator
+func (k Querier) ValidatorScheduledCommissionChange(c context.Context, req *types.QueryValidatorScheduledCommissionChangeRequest) (*types.QueryValidatorScheduledCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	change, found := k.GetScheduledCommissionChange(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no scheduled commission change found for validator %s", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorScheduledCommissionChangeResponse{Change: change}, nil
+}
+
+// ScheduledCommissionChanges queries all scheduled commission changes
+func (k Querier) ScheduledCommissionChanges(c context.Context, req *types.QueryScheduledCommissionChangesRequest) (*types.QueryScheduledCommissionChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var changes []types.ScheduledCommissionChange
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	changeStore := prefix.NewStore(store, types.ScheduledCommissionChangeKey)
+
+	pageRes, err := query.Paginate(changeStore, req.Pagination, func(key []byte, value []byte) error {
+		change := types.MustUnmarshalScheduledCommissionChange(k.cdc, value)
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledCommissionChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -87,3 +88,11 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 	}
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate - call hook if registered
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if k.hooks != nil {
+		return k.hooks.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate4to5(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	params := app.StakingKeeper.GetParams(ctx)
+
+	// remove the params added since consensus version 4, as on a chain upgraded from it
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	for _, key := range [][]byte{
+		types.KeyKeyRotationFee,
+		types.KeyGlobalLiquidStakingCap,
+		types.KeyValidatorLiquidStakingCap,
+		types.KeyCommissionChangeNoticePeriod,
+	} {
+		paramsStore.Delete(append([]byte(types.ModuleName+"/"), key...))
+	}
+	require.Panics(t, func() { app.StakingKeeper.GetParams(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate4to5(ctx))
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+	require.Equal(t, types.DefaultKeyRotationFee, app.StakingKeeper.KeyRotationFee(ctx))
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, app.StakingKeeper.GlobalLiquidStakingCap(ctx))
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, app.StakingKeeper.ValidatorLiquidStakingCap(ctx))
+	require.Equal(t, types.DefaultCommissionChangeNoticePeriod, app.StakingKeeper.CommissionChangeNoticePeriod(ctx))
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -492,3 +494,398 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
+
+// RotateConsPubKey defines a method for rotating the consensus public key of
+// an existing validator. The rotation fee is sent to the fee collector and the
+// new key replaces the old one with the validator set updates of this block.
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	// the new key must not be used, or have been used during the unbonding
+	// period, by any validator
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+		return nil, types.ErrValidatorPubKeyExists
+	}
+
+	if _, found := k.GetConsPubKeyRotation(ctx, valAddr); found {
+		return nil, types.ErrConsPubKeyRotationPending
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Validator != nil {
+		if !tmstrings.StringInSlice(pk.Type(), cp.Validator.PubKeyTypes) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	rotationFee := k.KeyRotationFee(ctx)
+	if rotationFee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), authtypes.FeeCollectorName, sdk.NewCoins(rotationFee))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rotation, err := types.NewConsPubKeyRotation(valAddr, pk, ctx.BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetConsPubKeyRotation(ctx, rotation); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(pk).String()),
+			sdk.NewAttribute(types.AttributeKeyRotationFee, rotationFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+	})
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
+// TokenizeShares defines a method for converting a delegation into fungible
+// share tokens. The delegation is moved to an account dedicated to the new
+// tokenize share record, and share tokens mapping 1:1 to its shares are minted
+// to the delegator.
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOwner, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	if delegatorAddress.Equals(valAddr) {
+		return nil, types.ErrTokenizeSelfDelegation
+	}
+
+	// vesting coins would become transferable once tokenized
+	if acc := k.authKeeper.GetAccount(ctx, delegatorAddress); acc != nil {
+		if _, ok := acc.(vestexported.VestingAccount); ok {
+			return nil, types.ErrTokenizeSharesVestingAccount
+		}
+	}
+
+	// shares received from a redelegation must stay slashable for infractions
+	// committed on the source validator
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.increaseLiquidStake(ctx, validator, validator.TokensFromShares(shares).TruncateInt(), shares); err != nil {
+		return nil, err
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.NewTokenizeShareRecord(recordID, shareOwner, valAddr)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	// the tokens remain in the pool matching the validator status, only the
+	// owner of the delegation changes
+	newShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), returnAmount, validator.GetStatus(), validator, false)
+	if err != nil {
+		return nil, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return nil, types.ErrInsufficientShares
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for converting share tokens back into
+// a delegation of the sender. Once all the share tokens of a record have been
+// redeemed, the remaining rewards are sent to the record owner and the record
+// is removed.
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delegatorAddress, msg.Amount.Denom)
+	if balance.Amount.LT(msg.Amount.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, msg.Amount)
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// share tokens map 1:1 to the truncated shares of the delegation, redeeming
+	// all of them redeems the full delegation
+	shares := msg.Amount.Amount.ToDec()
+	if msg.Amount.Amount.Equal(delegation.Shares.TruncateInt()) {
+		shares = delegation.Shares
+	}
+
+	if validator.TokensFromShares(shares).TruncateInt().IsZero() {
+		return nil, types.ErrTinyRedemptionAmount
+	}
+
+	returnAmount, err := k.Unbond(ctx, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	k.decreaseLiquidStake(ctx, valAddr, returnAmount, shares)
+
+	shareTokens := sdk.NewCoins(msg.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, shareTokens); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); !found {
+		owner, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		// rewards have been withdrawn to the record account by the unbonding
+		rewards := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+		if !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, rewards); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if found {
+		_, err = k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, validator.GetStatus(), validator, false)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// the validator has been removed together with its last delegation,
+		// return the tokens to the sender instead
+		returnCoins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), returnAmount))
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, returnCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership
+// of the rewards of a tokenized delegation.
+func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, msg.TokenizeShareRecordId, sender, newOwner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(msg.TokenizeShareRecordId, 10)),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// ScheduleCommissionChange defines a method for scheduling a validator
+// commission rate change which is applied after the commission change notice
+// period.
+func (k msgServer) ScheduleCommissionChange(goCtx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if msg.Rate.LT(k.MinCommissionRate(ctx)) {
+		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	if err := validator.Commission.ValidateNewRate(msg.Rate, blockTime); err != nil {
+		return nil, err
+	}
+
+	effectiveTime := blockTime.Add(k.CommissionChangeNoticePeriod(ctx))
+	change := types.NewScheduledCommissionChange(valAddr, msg.Rate, effectiveTime)
+	if err := k.SetScheduledCommissionChange(ctx, change); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, effectiveTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+	})
+
+	return &types.MsgScheduleCommissionChangeResponse{EffectiveTime: effectiveTime}, nil
+}
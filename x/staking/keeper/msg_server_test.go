@@ -4,12 +4,15 @@ import (
 	"testing"
 	"time"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
@@ -144,3 +147,323 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestRotateConsPubKey(t *testing.T) {
+	// setup the app
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validators := app.StakingKeeper.GetValidators(ctx, 10)
+	require.Equal(t, len(validators), 1)
+
+	validator := validators[0]
+	valAddr := validator.GetOperator()
+	oldPk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+
+	// the genesis validator is bonded at genesis, so no signing info has been created yet
+	require.NoError(t, app.SlashingKeeper.AfterValidatorBonded(ctx, sdk.GetConsAddress(oldPk), valAddr))
+	require.True(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, sdk.GetConsAddress(oldPk)))
+
+	// fund the validator operator account with the rotation fee
+	rotationFee := app.StakingKeeper.KeyRotationFee(ctx)
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, sdk.AccAddress(valAddr), sdk.NewCoins(rotationFee)))
+
+	newPk := PKs[0]
+	msg, err := types.NewMsgRotateConsPubKey(valAddr, newPk)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name      string
+		ExceptErr bool
+		req       func() *types.MsgRotateConsPubKey
+	}{
+		{
+			Name:      "validator not exists",
+			ExceptErr: true,
+			req: func() *types.MsgRotateConsPubKey {
+				msg, err := types.NewMsgRotateConsPubKey(sdk.ValAddress(sdk.AccAddress("asdsad")), newPk)
+				require.NoError(t, err)
+				return msg
+			},
+		},
+		{
+			Name:      "pubkey already in use",
+			ExceptErr: true,
+			req: func() *types.MsgRotateConsPubKey {
+				msg, err := types.NewMsgRotateConsPubKey(valAddr, oldPk)
+				require.NoError(t, err)
+				return msg
+			},
+		},
+		{
+			Name:      "success",
+			ExceptErr: false,
+			req:       func() *types.MsgRotateConsPubKey { return msg },
+		},
+		{
+			Name:      "rotation already pending",
+			ExceptErr: true,
+			req: func() *types.MsgRotateConsPubKey {
+				msg, err := types.NewMsgRotateConsPubKey(valAddr, PKs[1])
+				require.NoError(t, err)
+				return msg
+			},
+		},
+		{
+			Name:      "pubkey reserved by pending rotation",
+			ExceptErr: true,
+			req:       func() *types.MsgRotateConsPubKey { return msg },
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := msgServer.RotateConsPubKey(ctx, testCase.req())
+			if testCase.ExceptErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the rotation fee has been charged to the operator
+	require.True(t, app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddr), bondDenom).IsZero())
+
+	// the rotation is applied with the validator set updates of the block
+	updates := app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Len(t, updates, 2)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+	require.NoError(t, err)
+	require.Equal(t, abci.ValidatorUpdate{PubKey: oldTmPk, Power: 0}, updates[0])
+	require.Equal(t, validator.ABCIValidatorUpdate(app.StakingKeeper.PowerReduction(ctx)), updates[1])
+
+	consPk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	require.True(t, newPk.Equals(consPk))
+
+	_, found = app.StakingKeeper.GetConsPubKeyRotation(ctx, valAddr)
+	require.False(t, found)
+
+	// the signing info has been moved to the new consensus address
+	require.False(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, sdk.GetConsAddress(oldPk)))
+	require.True(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, sdk.GetConsAddress(newPk)))
+
+	// the old consensus address resolves to the validator for the unbonding period
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPk))
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPk))
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPk))
+	require.True(t, found)
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 4, delTokens)
+	delAddr, owner, redeemer := delAddrs[0], delAddrs[1], delAddrs[2]
+
+	valAddr := sdk.ValAddress(delAddrs[3])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKs[1], delTokens, true)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+
+	tokenizeAmount := sdk.NewCoin(bondDenom, delTokens.QuoRaw(2))
+
+	// the validator cap prevents tokenizing a large part of the validator shares
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, tokenizeAmount, owner))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, tokenizeAmount, owner))
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the operator cannot tokenize its self-delegation
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(sdk.AccAddress(valAddr), valAddr, tokenizeAmount, owner))
+	require.ErrorIs(t, err, types.ErrTokenizeSelfDelegation)
+
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, tokenizeAmount, owner))
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, owner.String(), record.Owner)
+	require.Equal(t, valAddr.String(), record.Validator)
+	require.Equal(t, record.GetShareTokenDenom(), res.Amount.Denom)
+	require.Equal(t, tokenizeAmount.Amount, res.Amount.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delAddr, res.Amount.Denom))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	require.Equal(t, tokenizeAmount.Amount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(tokenizeAmount.Amount).ToDec(), delegation.Shares)
+
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeAmount.Amount.ToDec(), recordDelegation.Shares)
+
+	// transfer the reward ownership of the record
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(record.Id, delAddr, redeemer))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(record.Id, owner, redeemer))
+	require.NoError(t, err)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, redeemer), 1)
+
+	// share tokens are transferable and can be redeemed by their holder
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, redeemer, sdk.NewCoins(res.Amount)))
+
+	redeemAmount := sdk.NewCoin(res.Amount.Denom, res.Amount.Amount.QuoRaw(2))
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(redeemer, redeemAmount))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, redeemer, valAddr)
+	require.True(t, found)
+	require.Equal(t, redeemAmount.Amount.ToDec(), delegation.Shares)
+	require.Equal(t, tokenizeAmount.Amount.Sub(redeemAmount.Amount), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming the remaining share tokens removes the record
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(redeemer, res.Amount.Sub(redeemAmount)))
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, redeemer, valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeAmount.Amount.ToDec(), delegation.Shares)
+	require.True(t, app.BankKeeper.GetBalance(ctx, redeemer, res.Amount.Denom).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, res.Amount.Denom).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+}
+
+func TestSlashTokenizedShares(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, delTokens)
+	delAddr, valAddr := delAddrs[0], sdk.ValAddress(delAddrs[1])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKs[1], delTokens, true)
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), delAddr))
+	require.NoError(t, err)
+	require.Equal(t, delTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// slashing the validator by half halves the value of its tokenized shares
+	consAddr := sdk.GetConsAddress(PKs[1])
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 20, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, delTokens.QuoRaw(2), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming the share tokens clears the total
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delAddr, res.Amount))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+}
+
+func TestScheduleCommissionChange(t *testing.T) {
+	app := simapp.Setup(t, false)
+	startTime := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+	noticePeriod := app.StakingKeeper.CommissionChangeNoticePeriod(ctx)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, valTokens)
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	tstaking.CreateValidator(valAddr, PKs[1], valTokens, true)
+
+	// the commission cannot be changed within 24 hours of the last change
+	_, err := msgServer.ScheduleCommissionChange(sdk.WrapSDKContext(ctx), types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(15, 2)))
+	require.ErrorIs(t, err, types.ErrCommissionUpdateTime)
+
+	ctx = ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+
+	// the new rate must satisfy the validator commission rates and the minimum rate
+	_, err = msgServer.ScheduleCommissionChange(sdk.WrapSDKContext(ctx), types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(3, 1)))
+	require.ErrorIs(t, err, types.ErrCommissionGTMaxChangeRate)
+
+	_, err = msgServer.ScheduleCommissionChange(sdk.WrapSDKContext(ctx), types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(1, 2)))
+	require.ErrorIs(t, err, types.ErrCommissionLTMinRate)
+
+	res, err := msgServer.ScheduleCommissionChange(sdk.WrapSDKContext(ctx), types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(15, 2)))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(noticePeriod), res.EffectiveTime)
+
+	// scheduling a new change replaces the previous one
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = msgServer.ScheduleCommissionChange(sdk.WrapSDKContext(ctx), types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(2, 1)))
+	require.NoError(t, err)
+
+	changes := app.StakingKeeper.GetAllScheduledCommissionChanges(ctx)
+	require.Len(t, changes, 1)
+	require.Equal(t, types.NewScheduledCommissionChange(valAddr, sdk.NewDecWithPrec(2, 1), res.EffectiveTime), changes[0])
+
+	// the change is not applied before the end of the notice period
+	ctx = ctx.WithBlockTime(res.EffectiveTime.Add(-time.Second))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	ctx = ctx.WithBlockTime(res.EffectiveTime)
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.Equal(t, res.EffectiveTime, validator.Commission.UpdateTime)
+
+	_, found = app.StakingKeeper.GetScheduledCommissionChange(ctx, valAddr)
+	require.False(t, found)
+}
@@ -53,6 +53,33 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// KeyRotationFee - Fee charged for rotating a validator consensus public key
+func (k Keeper) KeyRotationFee(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyKeyRotationFee, &res)
+	return
+}
+
+// GlobalLiquidStakingCap - Maximum ratio of the total bonded tokens which can
+// be tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - Maximum ratio of a validator's delegator shares
+// which can be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
+// CommissionChangeNoticePeriod - Delay before a scheduled commission change is
+// applied
+func (k Keeper) CommissionChangeNoticePeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyCommissionChangeNoticePeriod, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -62,6 +89,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
 		k.MinCommissionRate(ctx),
+		k.KeyRotationFee(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
+		k.CommissionChangeNoticePeriod(ctx),
 	)
 }
 
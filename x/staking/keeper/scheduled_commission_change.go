@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetScheduledCommissionChange gets the scheduled commission change of a
+// validator.
+func (k Keeper) GetScheduledCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) (change types.ScheduledCommissionChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetScheduledCommissionChangeKey(valAddr))
+	if value == nil {
+		return change, false
+	}
+
+	change = types.MustUnmarshalScheduledCommissionChange(k.cdc, value)
+	return change, true
+}
+
+// SetScheduledCommissionChange sets the scheduled commission change of a
+// validator and inserts it into the scheduled commission change queue. A
+// previously scheduled change of the same validator is replaced.
+func (k Keeper) SetScheduledCommissionChange(ctx sdk.Context, change types.ScheduledCommissionChange) error {
+	valAddr, err := sdk.ValAddressFromBech32(change.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteScheduledCommissionChange(ctx, valAddr)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledCommissionChangeKey(valAddr), types.MustMarshalScheduledCommissionChange(k.cdc, &change))
+	store.Set(types.GetScheduledCommissionChangeQueueKey(change.EffectiveTime, valAddr), valAddr)
+
+	return nil
+}
+
+// DeleteScheduledCommissionChange removes the scheduled commission change of a
+// validator together with its queue entry.
+func (k Keeper) DeleteScheduledCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) {
+	change, found := k.GetScheduledCommissionChange(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledCommissionChangeKey(valAddr))
+	store.Delete(types.GetScheduledCommissionChangeQueueKey(change.EffectiveTime, valAddr))
+}
+
+// GetAllScheduledCommissionChanges returns all scheduled commission changes,
+// ordered by validator operator address.
+func (k Keeper) GetAllScheduledCommissionChanges(ctx sdk.Context) (changes []types.ScheduledCommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ScheduledCommissionChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		changes = append(changes, types.MustUnmarshalScheduledCommissionChange(k.cdc, iterator.Value()))
+	}
+
+	return changes
+}
+
+// ScheduledCommissionChangeQueueIterator returns all the scheduled commission
+// changes from time 0 until endTime.
+func (k Keeper) ScheduledCommissionChangeQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ScheduledCommissionChangeQueueKey,
+		sdk.PrefixEndBytes(types.GetScheduledCommissionChangeQueueTimeKey(endTime)))
+}
+
+// ApplyMatureScheduledCommissionChanges applies all scheduled commission
+// changes whose notice period has elapsed and removes them from the queue.
+func (k Keeper) ApplyMatureScheduledCommissionChanges(ctx sdk.Context, currTime time.Time) error {
+	var valAddrs []sdk.ValAddress
+
+	iterator := k.ScheduledCommissionChangeQueueIterator(ctx, currTime)
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, valAddr := range valAddrs {
+		change, found := k.GetScheduledCommissionChange(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		k.DeleteScheduledCommissionChange(ctx, valAddr)
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		if err := k.applyScheduledCommissionChange(ctx, validator, change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyScheduledCommissionChange sets the commission rate of a validator to
+// the scheduled rate. The rate is raised to the minimum commission rate if the
+// latter has been increased since the change was scheduled. Changes which are
+// no longer within the validator's commission bounds, e.g. because the rate
+// has been edited in the meantime, are dropped.
+func (k Keeper) applyScheduledCommissionChange(ctx sdk.Context, validator types.Validator, change types.ScheduledCommissionChange) error {
+	rate := sdk.MaxDec(change.Rate, k.MinCommissionRate(ctx))
+
+	commission := validator.Commission
+	if rate.GT(commission.MaxRate) || rate.Sub(commission.Rate).GT(commission.MaxChangeRate) {
+		k.Logger(ctx).Info(
+			"dropping scheduled commission change",
+			"validator", validator.OperatorAddress,
+			"rate", rate.String(),
+		)
+
+		return nil
+	}
+
+	if err := k.BeforeValidatorModified(ctx, validator.GetOperator()); err != nil {
+		return err
+	}
+
+	commission.Rate = rate
+	commission.UpdateTime = ctx.BlockHeader().Time
+	validator.Commission = commission
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, rate.String()),
+		),
+	)
+
+	return nil
+}
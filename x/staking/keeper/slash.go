@@ -119,8 +119,12 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
+	liquidTokens := k.validatorLiquidTokens(ctx, validator)
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
+	// the tokenized shares of the validator lost their part of the burned tokens
+	k.decreaseLiquidStake(ctx, operatorAddress, liquidTokens.Sub(k.validatorLiquidTokens(ctx, validator)), sdk.ZeroDec())
+
 	switch validator.GetStatus() {
 	case types.Bonded:
 		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
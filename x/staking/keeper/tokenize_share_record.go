@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize
+// share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share
+// record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (tokenizeShareRecord types.TokenizeShareRecord, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return tokenizeShareRecord, types.ErrTokenizeShareRecordNotExists
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenizeShareRecord)
+	return tokenizeShareRecord, nil
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records owned
+// by the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIdsByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			continue
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+
+	return tokenizeShareRecords
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the given
+// share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, types.ErrTokenizeShareRecordNotExists
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records, ordered
+// by id.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenizeShareRecord types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenizeShareRecord)
+
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+
+	return tokenizeShareRecords
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record together with its
+// owner and denom indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord types.TokenizeShareRecord) error {
+	if k.hasTokenizeShareRecord(ctx, tokenizeShareRecord.Id) {
+		return types.ErrTokenizeShareRecordAlreadyExists
+	}
+
+	owner, err := sdk.AccAddressFromBech32(tokenizeShareRecord.Owner)
+	if err != nil {
+		return err
+	}
+
+	k.setTokenizeShareRecord(ctx, tokenizeShareRecord)
+	k.setTokenizeShareRecordWithOwner(ctx, owner, tokenizeShareRecord.Id)
+	k.setTokenizeShareRecordWithDenom(ctx, tokenizeShareRecord.GetShareTokenDenom(), tokenizeShareRecord.Id)
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes the tokenize share record with the given
+// id together with its owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordID uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(recordID))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, recordID))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// TransferTokenizeShareRecord moves the ownership of a tokenize share record
+// to a new owner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, recordID uint64, fromOwner, toOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	if record.Owner != fromOwner.String() {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(fromOwner, recordID))
+
+	record.Owner = toOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithOwner(ctx, toOwner, recordID)
+
+	return nil
+}
+
+func (k Keeper) hasTokenizeShareRecord(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTokenizeShareRecordByIndexKey(id))
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(tokenizeShareRecord.Id), k.cdc.MustMarshal(&tokenizeShareRecord))
+}
+
+func (k Keeper) setTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id), sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) setTokenizeShareRecordWithDenom(ctx sdk.Context, denom string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(denom), sdk.Uint64ToBigEndian(id))
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens which have been
+// converted into tokenized shares.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var tokens sdk.Int
+	if err := tokens.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return tokens
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens which have been
+// converted into tokenized shares.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := tokens.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the amount of delegator shares of a
+// validator which have been converted into tokenized shares.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares sdk.Dec
+	if err := shares.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return shares
+}
+
+// SetValidatorLiquidShares sets the amount of delegator shares of a validator
+// which have been converted into tokenized shares.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz, err := shares.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// IterateValidatorLiquidShares iterates over the tokenized shares of all
+// validators.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromValidatorsKey(iterator.Key()))
+
+		var shares sdk.Dec
+		if err := shares.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(valAddr, shares) {
+			break
+		}
+	}
+}
+
+// validatorLiquidTokens returns the amount of tokens of a validator backing
+// its tokenized shares.
+func (k Keeper) validatorLiquidTokens(ctx sdk.Context, validator types.Validator) sdk.Int {
+	shares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	if !shares.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(shares).TruncateInt()
+}
+
+// increaseLiquidStake records tokens and shares of a validator which have been
+// tokenized, and checks they don't exceed the global and validator caps.
+func (k Keeper) increaseLiquidStake(ctx sdk.Context, validator types.Validator, tokens sdk.Int, shares sdk.Dec) error {
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+		totalBonded := k.TotalBondedTokens(ctx)
+		if totalBonded.IsZero() || totalLiquidStaked.ToDec().Quo(totalBonded.ToDec()).GT(globalCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	validatorLiquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+	if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) {
+		if validator.DelegatorShares.IsZero() || validatorLiquidShares.Quo(validator.DelegatorShares).GT(validatorCap) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+	k.SetValidatorLiquidShares(ctx, validator.GetOperator(), validatorLiquidShares)
+
+	return nil
+}
+
+// decreaseLiquidStake removes tokens and shares of a validator which have been
+// redeemed or slashed from the liquid stake accounting.
+func (k Keeper) decreaseLiquidStake(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdk.Int, shares sdk.Dec) {
+	if tokens.IsZero() && shares.IsZero() {
+		return
+	}
+
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Sub(tokens)
+	if totalLiquidStaked.IsNegative() {
+		totalLiquidStaked = sdk.ZeroInt()
+	}
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+}
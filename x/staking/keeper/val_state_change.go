@@ -32,6 +32,14 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
+	// release all rotated consensus addresses kept for the unbonding period
+	k.DequeueAllMatureRotatedConsAddrs(ctx, ctx.BlockHeader().Time)
+
+	// apply all scheduled commission changes whose notice period has elapsed
+	if err := k.ApplyMatureScheduledCommissionChanges(ctx, ctx.BlockHeader().Time); err != nil {
+		panic(err)
+	}
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
@@ -124,6 +132,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the consensus pubkey rotations requested in this block. They are
+	// applied while computing the validator set updates below.
+	rotations := k.GetAllConsPubKeyRotations(ctx)
+	pendingRotations := make(map[string]types.ConsPubKeyRotation, len(rotations))
+	for _, rotation := range rotations {
+		pendingRotations[rotation.ValidatorAddress] = rotation
+	}
+	appliedRotations := make(map[string]bool, len(rotations))
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -170,6 +187,22 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			return nil, err
 		}
 		oldPowerBytes, found := last[valAddrStr]
+
+		// replace the old consensus pubkey with the new one in the validator set
+		if rotation, ok := pendingRotations[valAddrStr]; ok {
+			if found {
+				updates = append(updates, validator.ABCIValidatorUpdateZero())
+			}
+
+			validator, err = k.rotateConsPubKey(ctx, validator, rotation)
+			if err != nil {
+				return nil, err
+			}
+
+			appliedRotations[valAddrStr] = true
+			found = false
+		}
+
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
@@ -202,6 +235,12 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	// apply the remaining rotations, these validators are not part of the
+	// validator set so no update is returned for them
+	if err := k.applyRemainingConsPubKeyRotations(ctx, rotations, appliedRotations); err != nil {
+		return nil, err
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
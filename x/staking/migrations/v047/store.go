@@ -0,0 +1,75 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 4 to 5.
+// The migration includes:
+//
+// - Setting the KeyRotationFee param in the paramstore
+// - Setting the GlobalLiquidStakingCap and ValidatorLiquidStakingCap params in the paramstore
+// - Setting the CommissionChangeNoticePeriod param in the paramstore
+// - Raising the commission rate of all validators to the MinCommissionRate
+// param, together with their max commission rate if needed
+//
+// Chains increasing the minimum commission rate as part of an upgrade should
+// set the MinCommissionRate param before running this migration.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+
+	var minCommissionRate sdk.Dec
+	paramstore.Get(ctx, types.KeyMinCommissionRate, &minCommissionRate)
+
+	return migrateValidatorsCommission(ctx, storeKey, cdc, minCommissionRate)
+}
+
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyKeyRotationFee, types.DefaultKeyRotationFee)
+	paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	paramstore.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	paramstore.Set(ctx, types.KeyCommissionChangeNoticePeriod, types.DefaultCommissionChangeNoticePeriod)
+}
+
+func migrateValidatorsCommission(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, minCommissionRate sdk.Dec) error {
+	if !minCommissionRate.IsPositive() {
+		return nil
+	}
+
+	store := ctx.KVStore(storeKey)
+
+	var validators []types.Validator
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := types.UnmarshalValidator(cdc, iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+
+		if validator.Commission.Rate.LT(minCommissionRate) {
+			validators = append(validators, validator)
+		}
+	}
+	iterator.Close()
+
+	for _, validator := range validators {
+		validator.Commission.Rate = minCommissionRate
+		if validator.Commission.MaxRate.LT(minCommissionRate) {
+			validator.Commission.MaxRate = minCommissionRate
+		}
+
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(cdc, &validator))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+	store := ctx.KVStore(stakingKey)
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	paramstore.Set(ctx, types.KeyMinCommissionRate, minRate)
+
+	// validator below the minimum rate with a max rate below the minimum rate
+	_, pk1, addr1 := testdata.KeyTestPubAddr()
+	val1 := teststaking.NewValidator(t, sdk.ValAddress(addr1), pk1)
+	val1.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	store.Set(types.GetValidatorKey(val1.GetOperator()), types.MustMarshalValidator(encCfg.Codec, &val1))
+
+	// validator below the minimum rate
+	_, pk2, addr2 := testdata.KeyTestPubAddr()
+	val2 := teststaking.NewValidator(t, sdk.ValAddress(addr2), pk2)
+	val2.Commission = types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 2))
+	store.Set(types.GetValidatorKey(val2.GetOperator()), types.MustMarshalValidator(encCfg.Codec, &val2))
+
+	// validator above the minimum rate
+	_, pk3, addr3 := testdata.KeyTestPubAddr()
+	val3 := teststaking.NewValidator(t, sdk.ValAddress(addr3), pk3)
+	val3.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	store.Set(types.GetValidatorKey(val3.GetOperator()), types.MustMarshalValidator(encCfg.Codec, &val3))
+
+	require.False(t, paramstore.Has(ctx, types.KeyKeyRotationFee))
+	require.False(t, paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap))
+	require.False(t, paramstore.Has(ctx, types.KeyValidatorLiquidStakingCap))
+	require.False(t, paramstore.Has(ctx, types.KeyCommissionChangeNoticePeriod))
+
+	// Run migrations.
+	err := v047staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyKeyRotationFee))
+	require.True(t, paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap))
+	require.True(t, paramstore.Has(ctx, types.KeyValidatorLiquidStakingCap))
+	require.True(t, paramstore.Has(ctx, types.KeyCommissionChangeNoticePeriod))
+
+	// Make sure the validators commission rates have been raised.
+	val1 = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(val1.GetOperator())))
+	require.Equal(t, minRate, val1.Commission.Rate)
+	require.Equal(t, minRate, val1.Commission.MaxRate)
+
+	val2 = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(val2.GetOperator())))
+	require.Equal(t, minRate, val2.Commission.Rate)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), val2.Commission.MaxRate)
+
+	val3 = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(val3.GetOperator())))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), val3.Commission.Rate)
+}
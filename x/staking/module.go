@@ -24,7 +24,7 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 4
+	consensusVersion uint64 = 5
 )
 
 var (
@@ -93,6 +93,35 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, types.LastValidatorPowerKey, "last validator powers")
+	registry.Register(types.StoreKey, types.LastTotalPowerKey, "last total power")
+	registry.Register(types.StoreKey, types.ValidatorsKey, "validators")
+	registry.Register(types.StoreKey, types.ValidatorsByConsAddrKey, "validators by consensus address")
+	registry.Register(types.StoreKey, types.ValidatorsByPowerIndexKey, "validators by power")
+	registry.Register(types.StoreKey, types.DelegationKey, "delegations")
+	registry.Register(types.StoreKey, types.UnbondingDelegationKey, "unbonding delegations")
+	registry.Register(types.StoreKey, types.UnbondingDelegationByValIndexKey, "unbonding delegations by validator")
+	registry.Register(types.StoreKey, types.RedelegationKey, "redelegations")
+	registry.Register(types.StoreKey, types.RedelegationByValSrcIndexKey, "redelegations by source validator")
+	registry.Register(types.StoreKey, types.RedelegationByValDstIndexKey, "redelegations by destination validator")
+	registry.Register(types.StoreKey, types.UnbondingQueueKey, "unbonding queue")
+	registry.Register(types.StoreKey, types.RedelegationQueueKey, "redelegation queue")
+	registry.Register(types.StoreKey, types.ValidatorQueueKey, "validator queue")
+	registry.Register(types.StoreKey, types.HistoricalInfoKey, "historical info")
+	registry.Register(types.StoreKey, types.ConsPubKeyRotationKey, "consensus pubkey rotations")
+	registry.Register(types.StoreKey, types.RotatedConsAddrQueueKey, "rotated consensus address queue")
+	registry.Register(types.StoreKey, types.TokenizeShareRecordPrefix, "tokenize share records")
+	registry.Register(types.StoreKey, types.TokenizeShareRecordIDByOwnerPrefix, "tokenize share records by owner")
+	registry.Register(types.StoreKey, types.TokenizeShareRecordIDByDenomPrefix, "tokenize share records by denom")
+	registry.Register(types.StoreKey, types.LastTokenizeShareRecordIDKey, "last tokenize share record id")
+	registry.Register(types.StoreKey, types.TotalLiquidStakedTokensKey, "total liquid staked tokens")
+	registry.Register(types.StoreKey, types.ValidatorLiquidSharesKey, "validator liquid shares")
+	registry.Register(types.StoreKey, types.ScheduledCommissionChangeKey, "scheduled commission changes")
+	registry.Register(types.StoreKey, types.ScheduledCommissionChangeQueueKey, "scheduled commission change queue")
+}
+
 // AppModule implements an application module for the staking module.
 type AppModule struct {
 	AppModuleBasic
@@ -146,6 +175,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	m := keeper.NewMigrator(am.keeper)
 	cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
 	cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
 }
 
 // InitGenesis performs genesis initialization for the staking module. It returns
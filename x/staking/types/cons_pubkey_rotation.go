@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotation{}
+
+// NewConsPubKeyRotation creates a new pending consensus pubkey rotation for
+// the given validator.
+//nolint:interfacer
+func NewConsPubKeyRotation(valAddr sdk.ValAddress, newPubKey cryptotypes.PubKey, height int64) (ConsPubKeyRotation, error) {
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotation{}, err
+	}
+
+	return ConsPubKeyRotation{
+		ValidatorAddress: valAddr.String(),
+		NewPubkey:        pkAny,
+		Height:           height,
+	}, nil
+}
+
+// MustMarshalConsPubKeyRotation returns the rotation bytes. Panics on error.
+func MustMarshalConsPubKeyRotation(cdc codec.BinaryCodec, rotation *ConsPubKeyRotation) []byte {
+	return cdc.MustMarshal(rotation)
+}
+
+// MustUnmarshalConsPubKeyRotation unmarshals a rotation from a store value. Panics on error.
+func MustUnmarshalConsPubKeyRotation(cdc codec.BinaryCodec, value []byte) ConsPubKeyRotation {
+	var rotation ConsPubKeyRotation
+	cdc.MustUnmarshal(value, &rotation)
+	return rotation
+}
+
+// ConsPubKey returns the consensus public key the validator is rotating to.
+func (r ConsPubKeyRotation) ConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ConsPubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(r.NewPubkey, &pk)
+}
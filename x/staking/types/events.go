@@ -2,14 +2,20 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding         = "complete_unbonding"
-	EventTypeCompleteRedelegation      = "complete_redelegation"
-	EventTypeCreateValidator           = "create_validator"
-	EventTypeEditValidator             = "edit_validator"
-	EventTypeDelegate                  = "delegate"
-	EventTypeUnbond                    = "unbond"
-	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
-	EventTypeRedelegate                = "redelegate"
+	EventTypeCompleteUnbonding           = "complete_unbonding"
+	EventTypeCompleteRedelegation        = "complete_redelegation"
+	EventTypeCreateValidator             = "create_validator"
+	EventTypeEditValidator               = "edit_validator"
+	EventTypeDelegate                    = "delegate"
+	EventTypeUnbond                      = "unbond"
+	EventTypeCancelUnbondingDelegation   = "cancel_unbonding_delegation"
+	EventTypeRedelegate                  = "redelegate"
+	EventTypeRotateConsPubKey            = "rotate_cons_pubkey"
+	EventTypeTokenizeShares              = "tokenize_shares"
+	EventTypeRedeemShares                = "redeem_tokens_for_shares"
+	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	EventTypeScheduleCommissionChange    = "schedule_commission_change"
+	EventTypeCommissionChange            = "commission_change"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,5 +26,11 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
+	AttributeKeyRotationFee       = "rotation_fee"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyEffectiveTime     = "effective_time"
 	AttributeValueCategory        = ModuleName
 )
@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -44,6 +48,25 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens tracks the amount of tokens which have been
+	// converted into tokenized shares.
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
+	// validator_liquid_shares defines the amount of tokenized shares of each validator.
+	ValidatorLiquidShares []ValidatorLiquidShares `protobuf:"bytes,12,rep,name=validator_liquid_shares,json=validatorLiquidShares,proto3" json:"validator_liquid_shares"`
+	// scheduled_commission_changes defines the commission changes which have
+	// been scheduled but not yet applied.
+	ScheduledCommissionChanges []ScheduledCommissionChange `protobuf:"bytes,13,rep,name=scheduled_commission_changes,json=scheduledCommissionChanges,proto3" json:"scheduled_commission_changes"`
+	// cons_pub_key_rotations defines the consensus pubkey rotations which have
+	// been requested but not yet applied.
+	ConsPubKeyRotations []ConsPubKeyRotation `protobuf:"bytes,14,rep,name=cons_pub_key_rotations,json=consPubKeyRotations,proto3" json:"cons_pub_key_rotations"`
+	// rotated_cons_addresses defines the consensus addresses validators rotated
+	// away from, which keep resolving to their validator until the end of the
+	// unbonding period.
+	RotatedConsAddresses []RotatedConsAddress `protobuf:"bytes,15,rep,name=rotated_cons_addresses,json=rotatedConsAddresses,proto3" json:"rotated_cons_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +151,48 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidatorLiquidShares() []ValidatorLiquidShares {
+	if m != nil {
+		return m.ValidatorLiquidShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledCommissionChanges() []ScheduledCommissionChange {
+	if m != nil {
+		return m.ScheduledCommissionChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsPubKeyRotations() []ConsPubKeyRotation {
+	if m != nil {
+		return m.ConsPubKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRotatedConsAddresses() []RotatedConsAddress {
+	if m != nil {
+		return m.RotatedConsAddresses
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -169,9 +234,98 @@ func (m *LastValidatorPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
+// ValidatorLiquidShares tracks the amount of delegator shares of a validator
+// which have been converted into tokenized shares.
+type ValidatorLiquidShares struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares is the amount of tokenized delegator shares.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *ValidatorLiquidShares) Reset()         { *m = ValidatorLiquidShares{} }
+func (m *ValidatorLiquidShares) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiquidShares) ProtoMessage()    {}
+func (*ValidatorLiquidShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{2}
+}
+func (m *ValidatorLiquidShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiquidShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiquidShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLiquidShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiquidShares.Merge(m, src)
+}
+func (m *ValidatorLiquidShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiquidShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiquidShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiquidShares proto.InternalMessageInfo
+
+// RotatedConsAddress defines a consensus address a validator rotated away
+// from.
+type RotatedConsAddress struct {
+	// address is the rotated consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// end_time is the time at which the address stops resolving to the
+	// validator.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *RotatedConsAddress) Reset()         { *m = RotatedConsAddress{} }
+func (m *RotatedConsAddress) String() string { return proto.CompactTextString(m) }
+func (*RotatedConsAddress) ProtoMessage()    {}
+func (*RotatedConsAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{3}
+}
+func (m *RotatedConsAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotatedConsAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotatedConsAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotatedConsAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotatedConsAddress.Merge(m, src)
+}
+func (m *RotatedConsAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotatedConsAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotatedConsAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotatedConsAddress proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
+	proto.RegisterType((*ValidatorLiquidShares)(nil), "cosmos.staking.v1beta1.ValidatorLiquidShares")
+	proto.RegisterType((*RotatedConsAddress)(nil), "cosmos.staking.v1beta1.RotatedConsAddress")
 }
 
 func init() {
@@ -179,38 +333,60 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xe3, 0xdd, 0xed, 0x6e, 0x3a, 0xbb, 0x2d, 0x65, 0x48, 0x52, 0x37, 0x82, 0x24, 0x44,
+	0x15, 0x8a, 0x80, 0x38, 0xda, 0xe5, 0x86, 0x2a, 0x01, 0xd9, 0x85, 0xaa, 0xd0, 0x43, 0xe4, 0x04,
+	0x84, 0xb8, 0x58, 0x8e, 0xe7, 0xd5, 0xb1, 0xe2, 0xcc, 0x04, 0xbf, 0xf1, 0xd2, 0xf0, 0x09, 0x38,
+	0xf6, 0x23, 0xf4, 0x43, 0xf4, 0xcc, 0x11, 0xf5, 0x58, 0xf5, 0x84, 0x7a, 0x28, 0x68, 0xf7, 0xc2,
+	0xc7, 0x40, 0x1e, 0x8f, 0x93, 0x80, 0xe3, 0x40, 0xf7, 0xb4, 0x3b, 0x9e, 0xff, 0xff, 0xf7, 0x7f,
+	0xf3, 0xf2, 0xec, 0x21, 0x77, 0x3d, 0x81, 0x33, 0x81, 0x3d, 0x94, 0xee, 0x34, 0xe0, 0x7e, 0xef,
+	0xfc, 0x78, 0x0c, 0xd2, 0x3d, 0xee, 0xf9, 0xc0, 0x01, 0x03, 0xb4, 0xe6, 0x91, 0x90, 0x82, 0xd6,
+	0x52, 0x95, 0xa5, 0x55, 0x96, 0x56, 0xd5, 0x2b, 0xbe, 0xf0, 0x85, 0x92, 0xf4, 0x92, 0xff, 0x52,
+	0x75, 0xbd, 0xe9, 0x0b, 0xe1, 0x87, 0xd0, 0x53, 0xab, 0x71, 0xfc, 0xa8, 0x27, 0x83, 0x19, 0xa0,
+	0x74, 0x67, 0x73, 0x2d, 0x28, 0x0a, 0xcd, 0xf0, 0xa9, 0xea, 0x4e, 0xaa, 0x72, 0x52, 0xbe, 0xae,
+	0x40, 0x2d, 0xda, 0xbf, 0x11, 0x72, 0x74, 0x3f, 0xad, 0x70, 0x28, 0x5d, 0x09, 0xf4, 0x1e, 0xd9,
+	0x9f, 0xbb, 0x91, 0x3b, 0x43, 0xd3, 0x68, 0x19, 0x9d, 0xc3, 0x93, 0x86, 0xb5, 0xb9, 0x62, 0x6b,
+	0xa0, 0x54, 0xfd, 0xbd, 0xe7, 0xaf, 0x9b, 0x25, 0x5b, 0x7b, 0xe8, 0xf7, 0xe4, 0x56, 0xe8, 0xa2,
+	0x74, 0xa4, 0x90, 0x6e, 0xe8, 0xcc, 0xc5, 0x4f, 0x10, 0x99, 0x3b, 0x2d, 0xa3, 0x73, 0xd4, 0xb7,
+	0x12, 0xdd, 0xab, 0xd7, 0xcd, 0x0f, 0xfc, 0x40, 0x4e, 0xe2, 0xb1, 0xe5, 0x89, 0x99, 0xae, 0x44,
+	0xff, 0xe9, 0x22, 0x9b, 0xf6, 0xe4, 0x62, 0x0e, 0x68, 0x3d, 0xe0, 0xd2, 0xbe, 0x99, 0x70, 0x46,
+	0x09, 0x66, 0x90, 0x50, 0x28, 0x23, 0x55, 0x45, 0x3e, 0x77, 0xc3, 0x80, 0xb9, 0x52, 0x44, 0x29,
+	0x1d, 0xcd, 0xdd, 0xd6, 0x6e, 0xe7, 0xf0, 0xe4, 0xc3, 0xa2, 0x32, 0x1f, 0xba, 0x28, 0xbf, 0xcb,
+	0x3c, 0x0a, 0xa5, 0x4b, 0x7e, 0x27, 0xcc, 0xed, 0x20, 0xbd, 0x4f, 0xc8, 0x32, 0x00, 0xcd, 0x3d,
+	0x85, 0x7e, 0xbf, 0x08, 0xbd, 0x34, 0x6b, 0xe2, 0x9a, 0x95, 0x7e, 0x4d, 0x0e, 0x19, 0x84, 0xe0,
+	0xbb, 0x32, 0x10, 0x1c, 0xcd, 0x6b, 0x8a, 0xd4, 0x2e, 0x22, 0x9d, 0x2d, 0xa5, 0x1a, 0xb5, 0x6e,
+	0xa6, 0x8f, 0x48, 0x35, 0xe6, 0x63, 0xc1, 0x59, 0xc0, 0x7d, 0x67, 0x9d, 0xba, 0xaf, 0xa8, 0x1f,
+	0x15, 0x51, 0xbf, 0xcd, 0x4c, 0x39, 0x7c, 0x25, 0xce, 0x6f, 0x21, 0x1d, 0x90, 0x1b, 0x11, 0xac,
+	0xf3, 0x0f, 0x14, 0xff, 0x6e, 0x11, 0xdf, 0x06, 0xf6, 0x6f, 0xf0, 0x3f, 0x01, 0xb4, 0x4e, 0xca,
+	0xf0, 0x78, 0x2e, 0x22, 0x09, 0xcc, 0x2c, 0xb7, 0x8c, 0x4e, 0xd9, 0x5e, 0xae, 0xa9, 0x4f, 0x6a,
+	0x52, 0x4c, 0x81, 0x07, 0x3f, 0x83, 0x83, 0x13, 0x37, 0x02, 0x27, 0x02, 0x4f, 0x44, 0x0c, 0xcd,
+	0xeb, 0xdb, 0x8f, 0x35, 0xd2, 0xae, 0x61, 0x62, 0xb2, 0x95, 0x27, 0x3b, 0x96, 0xcc, 0x6f, 0x21,
+	0xfd, 0x9c, 0xbc, 0xa7, 0x67, 0x72, 0x43, 0x9a, 0x13, 0x30, 0x93, 0xb4, 0x8c, 0xce, 0x9e, 0x7d,
+	0x27, 0x1d, 0xb8, 0x1c, 0xe0, 0x01, 0xa3, 0x53, 0x52, 0x4f, 0x07, 0x3a, 0x0c, 0x7e, 0x8c, 0x03,
+	0xe6, 0x24, 0x15, 0x01, 0x4b, 0x81, 0x68, 0x1e, 0x5e, 0x69, 0xbe, 0x6f, 0x2b, 0xe2, 0x43, 0x05,
+	0x1c, 0x2a, 0x9e, 0xca, 0x46, 0x3a, 0x25, 0xb7, 0x57, 0x33, 0x9e, 0x05, 0x26, 0xe5, 0xa0, 0x79,
+	0xa4, 0x1a, 0xd3, 0xfd, 0xcf, 0x79, 0xd4, 0x54, 0x65, 0xd2, 0xad, 0xa9, 0x9e, 0x6f, 0xda, 0xa4,
+	0x0b, 0xf2, 0x2e, 0x7a, 0x13, 0x60, 0x71, 0x08, 0xcc, 0xf1, 0xc4, 0x6c, 0x16, 0x20, 0x06, 0x82,
+	0x3b, 0xde, 0xc4, 0xe5, 0x3e, 0xa0, 0x79, 0x43, 0x25, 0x1e, 0x17, 0x25, 0x0e, 0x33, 0xef, 0xe9,
+	0xd2, 0x7a, 0xaa, 0x9c, 0x3a, 0xb5, 0x8e, 0x45, 0x02, 0xa4, 0x40, 0x6a, 0x9e, 0xe0, 0xe8, 0xcc,
+	0xe3, 0xb1, 0x33, 0x85, 0x85, 0x13, 0x09, 0xa9, 0xc7, 0xee, 0xe6, 0xf6, 0x37, 0xfa, 0x54, 0x70,
+	0x1c, 0xc4, 0xe3, 0x6f, 0x60, 0x61, 0x6b, 0x4b, 0xf6, 0x46, 0x7b, 0xb9, 0x9d, 0xe4, 0xe5, 0xa9,
+	0x29, 0xb2, 0x3a, 0x1f, 0x47, 0xc7, 0x65, 0x2c, 0x02, 0x44, 0x40, 0xf3, 0xad, 0xed, 0x31, 0x76,
+	0xea, 0x4a, 0xd2, 0xbe, 0x48, 0x3d, 0xd9, 0x94, 0x45, 0xb9, 0x1d, 0xc0, 0xf6, 0x84, 0xd0, 0xfc,
+	0xa7, 0x86, 0x9e, 0x90, 0x03, 0x1d, 0xa8, 0x3e, 0xa7, 0xd7, 0xfb, 0xe6, 0xcb, 0x67, 0xdd, 0x8a,
+	0x4e, 0xd4, 0xe6, 0xa1, 0x8c, 0x02, 0xee, 0xdb, 0x99, 0x90, 0x56, 0xc8, 0xb5, 0xd5, 0x87, 0x73,
+	0xd7, 0x4e, 0x17, 0x9f, 0x96, 0x7f, 0x79, 0xda, 0x2c, 0xfd, 0xf5, 0xb4, 0x59, 0x6a, 0xff, 0x6a,
+	0x90, 0xea, 0xc6, 0x9f, 0x9a, 0x7e, 0x49, 0xde, 0x5e, 0x8d, 0xce, 0xff, 0xcd, 0xbd, 0xb5, 0xb4,
+	0xe8, 0xe7, 0x74, 0x44, 0xf6, 0xf5, 0xc0, 0xed, 0x28, 0xef, 0xbd, 0x37, 0x18, 0xed, 0x33, 0xf0,
+	0x5e, 0x3e, 0xeb, 0x12, 0x9d, 0x74, 0x06, 0x9e, 0xad, 0x59, 0x6b, 0x07, 0x78, 0x65, 0x10, 0x9a,
+	0xef, 0xee, 0x95, 0x7a, 0xb5, 0xf1, 0xc4, 0x3b, 0x6f, 0x7c, 0xe2, 0xcf, 0x48, 0x19, 0x38, 0x73,
+	0x92, 0xdb, 0xd5, 0xdc, 0x55, 0xd7, 0x5e, 0xdd, 0x4a, 0xaf, 0x5e, 0x2b, 0xbb, 0x7a, 0xad, 0x51,
+	0x76, 0xf5, 0xf6, 0xcb, 0x49, 0x3f, 0x9e, 0xfc, 0xd1, 0x34, 0xec, 0x03, 0xe0, 0x2c, 0x79, 0xbe,
+	0x3a, 0x5c, 0xff, 0xab, 0xe7, 0x17, 0x0d, 0xe3, 0xc5, 0x45, 0xc3, 0xf8, 0xf3, 0xa2, 0x61, 0x3c,
+	0xb9, 0x6c, 0x94, 0x5e, 0x5c, 0x36, 0x4a, 0xbf, 0x5f, 0x36, 0x4a, 0x3f, 0x7c, 0xbc, 0xb5, 0x7d,
+	0x8f, 0x97, 0x77, 0xb8, 0x6a, 0xe4, 0x78, 0x5f, 0x05, 0x7f, 0xf2, 0xf7, 0x00, 0x2d, 0x5d, 0xf1,
+	0x59, 0x57, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +409,91 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RotatedConsAddresses) > 0 {
+		for iNdEx := len(m.RotatedConsAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotatedConsAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ConsPubKeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for iNdEx := len(m.ScheduledCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorLiquidShares) > 0 {
+		for iNdEx := len(m.ValidatorLiquidShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorLiquidShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -371,6 +632,91 @@ func (m *LastValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorLiquidShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiquidShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiquidShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotatedConsAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotatedConsAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotatedConsAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -425,6 +771,41 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorLiquidShares) > 0 {
+		for _, e := range m.ValidatorLiquidShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for _, e := range m.ScheduledCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsPubKeyRotations) > 0 {
+		for _, e := range m.ConsPubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RotatedConsAddresses) > 0 {
+		for _, e := range m.RotatedConsAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -444,6 +825,40 @@ func (m *LastValidatorPower) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorLiquidShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RotatedConsAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -735,19 +1150,241 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
 			}
-			iNdEx += skippy
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorLiquidShares = append(m.ValidatorLiquidShares, ValidatorLiquidShares{})
+			if err := m.ValidatorLiquidShares[len(m.ValidatorLiquidShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCommissionChanges = append(m.ScheduledCommissionChanges, ScheduledCommissionChange{})
+			if err := m.ScheduledCommissionChanges[len(m.ScheduledCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKeyRotations = append(m.ConsPubKeyRotations, ConsPubKeyRotation{})
+			if err := m.ConsPubKeyRotations[len(m.ConsPubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedConsAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotatedConsAddresses = append(m.RotatedConsAddresses, RotatedConsAddress{})
+			if err := m.RotatedConsAddresses[len(m.RotatedConsAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -857,6 +1494,269 @@ func (m *LastValidatorPower) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorLiquidShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiquidShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiquidShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotatedConsAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotatedConsAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotatedConsAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
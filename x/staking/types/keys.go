@@ -48,6 +48,19 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ConsPubKeyRotationKey   = []byte{0x60} // prefix for each key to a pending validator consensus pubkey rotation
+	RotatedConsAddrQueueKey = []byte{0x61} // prefix for the timestamps in the rotated consensus address queue
+
+	TokenizeShareRecordPrefix          = []byte{0x70} // prefix for each key to a tokenize share record
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x71} // prefix for each key to a tokenize share record id, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x72} // prefix for each key to a tokenize share record id, by share token denom
+	LastTokenizeShareRecordIDKey       = []byte{0x73} // key for the id of the last created tokenize share record
+	TotalLiquidStakedTokensKey         = []byte{0x74} // key for the total amount of tokenized tokens
+	ValidatorLiquidSharesKey           = []byte{0x75} // prefix for each key to the tokenized shares of a validator
+
+	ScheduledCommissionChangeKey      = []byte{0x80} // prefix for each key to a scheduled validator commission change
+	ScheduledCommissionChangeQueueKey = []byte{0x81} // prefix for the timestamps in the scheduled commission change queue
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -347,3 +360,94 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetConsPubKeyRotationKey creates the key for the pending consensus pubkey
+// rotation of the validator with the given operator address.
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetRotatedConsAddrQueueTimeKey returns the key prefix for all rotated
+// consensus addresses that expire at the given time.
+func GetRotatedConsAddrQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RotatedConsAddrQueueKey, bz...)
+}
+
+// GetRotatedConsAddrQueueKey returns the key for a rotated consensus address
+// which expires at the given time.
+// VALUE: validator operator address ([]byte)
+func GetRotatedConsAddrQueueKey(timestamp time.Time, consAddr sdk.ConsAddress) []byte {
+	return append(GetRotatedConsAddrQueueTimeKey(timestamp), address.MustLengthPrefix(consAddr)...)
+}
+
+// ParseRotatedConsAddrQueueKey returns the end time and the consensus address
+// from a key created from GetRotatedConsAddrQueueKey.
+func ParseRotatedConsAddrQueueKey(key []byte) (time.Time, sdk.ConsAddress, error) {
+	prefixL := len(RotatedConsAddrQueueKey)
+	timeBzL := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, prefixL+timeBzL+2)
+
+	endTime, err := sdk.ParseTimeBytes(key[prefixL : prefixL+timeBzL])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return endTime, sdk.ConsAddress(key[prefixL+timeBzL+1:]), nil
+}
+
+// GetTokenizeShareRecordByIndexKey returns the key of the tokenize share record
+// with the given id.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIdsByOwnerPrefix returns the key prefix of the tokenize
+// share record ids owned by the given address.
+func GetTokenizeShareRecordIdsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key indexing a tokenize
+// share record id by its owner.
+// VALUE: tokenize share record id ([]byte)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIdsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key indexing a tokenize share
+// record id by its share token denom.
+// VALUE: tokenize share record id ([]byte)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized shares of the
+// validator with the given operator address.
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetScheduledCommissionChangeKey creates the key for the scheduled commission
+// change of the validator with the given operator address.
+// VALUE: staking/ScheduledCommissionChange
+func GetScheduledCommissionChangeKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ScheduledCommissionChangeKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetScheduledCommissionChangeQueueTimeKey returns the key prefix for all
+// scheduled commission changes that take effect at the given time.
+func GetScheduledCommissionChangeQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ScheduledCommissionChangeQueueKey, bz...)
+}
+
+// GetScheduledCommissionChangeQueueKey returns the key for the scheduled
+// commission change of a validator which takes effect at the given time.
+// VALUE: validator operator address ([]byte)
+func GetScheduledCommissionChangeQueueKey(timestamp time.Time, operatorAddr sdk.ValAddress) []byte {
+	return append(GetScheduledCommissionChangeQueueTimeKey(timestamp), address.MustLengthPrefix(operatorAddr)...)
+}
@@ -30,11 +30,24 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultCommissionChangeNoticePeriod reflects one week as the default
+	// delay before a scheduled commission change is applied.
+	DefaultCommissionChangeNoticePeriod time.Duration = time.Hour * 24 * 7
 )
 
 var (
 	// DefaultMinCommissionRate is set to 0%
 	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultKeyRotationFee is fixed to 1000000 of the default bond denom
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, which disables the cap
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, which disables the cap
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
 )
 
 var (
@@ -44,6 +57,12 @@ var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
 	KeyMinCommissionRate = []byte("MinCommissionRate")
+	KeyKeyRotationFee    = []byte("KeyRotationFee")
+
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+
+	KeyCommissionChangeNoticePeriod = []byte("CommissionChangeNoticePeriod")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -54,7 +73,9 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec, keyRotationFee sdk.Coin,
+	globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec, commissionChangeNoticePeriod time.Duration,
+) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
 		MaxValidators:     maxValidators,
@@ -62,6 +83,12 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 		HistoricalEntries: historicalEntries,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
+		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+
+		CommissionChangeNoticePeriod: commissionChangeNoticePeriod,
 	}
 }
 
@@ -74,6 +101,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyKeyRotationFee, &p.KeyRotationFee, validateKeyRotationFee),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyCommissionChangeNoticePeriod, &p.CommissionChangeNoticePeriod, validateCommissionChangeNoticePeriod),
 	}
 }
 
@@ -86,6 +117,10 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultKeyRotationFee,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultCommissionChangeNoticePeriod,
 	)
 }
 
@@ -137,6 +172,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeNoticePeriod(p.CommissionChangeNoticePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -233,3 +284,48 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateCommissionChangeNoticePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change notice period cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewScheduledCommissionChange creates a new scheduled commission change for
+// the given validator.
+//
+//nolint:interfacer
+func NewScheduledCommissionChange(valAddr sdk.ValAddress, rate sdk.Dec, effectiveTime time.Time) ScheduledCommissionChange {
+	return ScheduledCommissionChange{
+		ValidatorAddress: valAddr.String(),
+		Rate:             rate,
+		EffectiveTime:    effectiveTime,
+	}
+}
+
+// MustMarshalScheduledCommissionChange returns the scheduled commission change
+// bytes. Panics on error.
+func MustMarshalScheduledCommissionChange(cdc codec.BinaryCodec, change *ScheduledCommissionChange) []byte {
+	return cdc.MustMarshal(change)
+}
+
+// MustUnmarshalScheduledCommissionChange unmarshals a scheduled commission
+// change from a store value. Panics on error.
+func MustUnmarshalScheduledCommissionChange(cdc codec.BinaryCodec, value []byte) ScheduledCommissionChange {
+	var change ScheduledCommissionChange
+	cdc.MustUnmarshal(value, &change)
+	return change
+}
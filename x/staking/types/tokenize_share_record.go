@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the accounts
+// holding tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new tokenize share record for a delegation
+// to the given validator.
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the account holding the tokenized
+// delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens minted for the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator) + "/" + strconv.FormatUint(r.Id, 10)
+}
@@ -58,6 +58,14 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
+// RegisterStorePrefixes registers the names of the key prefixes of the module store.
+func (AppModuleBasic) RegisterStorePrefixes(registry sdk.StorePrefixRegistry) {
+	registry.Register(types.StoreKey, []byte{types.PlanByte}, "plan")
+	registry.Register(types.StoreKey, []byte{types.DoneByte}, "done upgrades")
+	registry.Register(types.StoreKey, []byte{types.VersionMapByte}, "module versions")
+	registry.Register(types.StoreKey, []byte{types.ProtocolVersionByte}, "protocol version")
+}
+
 // GetTxCmd returns the transaction commands for this module
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()